@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui"
+)
+
+// completionTimeout bounds Redis lookups during shell completion so a slow or
+// unreachable server never stalls the shell.
+const completionTimeout = 500 * time.Millisecond
+
+// registerFlagCompletions wires shell completion for the root command flags.
+// Registration only fails for unknown or already registered flags, so errors
+// are ignored like MarkFlagRequired's.
+func registerFlagCompletions(rootCmd *cobra.Command) {
+	_ = rootCmd.RegisterFlagCompletionFunc("redis", cobra.FixedCompletions(
+		[]string{"redis://localhost:6379/0"},
+		cobra.ShellCompDirectiveNoFileComp,
+	))
+	_ = rootCmd.RegisterFlagCompletionFunc("leader-key", cobra.FixedCompletions(
+		[]string{sidekiq.DefaultLeaderKey},
+		cobra.ShellCompDirectiveNoFileComp,
+	))
+	_ = rootCmd.RegisterFlagCompletionFunc("poller-key", cobra.NoFileCompletions)
+	durations := cobra.FixedCompletions(
+		[]string{"0", "30s", "1m", "5m", "15m", "1h"},
+		cobra.ShellCompDirectiveNoFileComp,
+	)
+	_ = rootCmd.RegisterFlagCompletionFunc("latency-warn", durations)
+	_ = rootCmd.RegisterFlagCompletionFunc("latency-critical", durations)
+	_ = rootCmd.RegisterFlagCompletionFunc("beat-stale", durations)
+	_ = rootCmd.RegisterFlagCompletionFunc("long-running", durations)
+	_ = rootCmd.RegisterFlagCompletionFunc("dead-timeout", cobra.FixedCompletions(
+		[]string{"720h", "2160h", "4320h"},
+		cobra.ShellCompDirectiveNoFileComp,
+	))
+	_ = rootCmd.RegisterFlagCompletionFunc("dead-max", cobra.NoFileCompletions)
+	_ = rootCmd.RegisterFlagCompletionFunc("dead-action", cobra.NoFileCompletions)
+	_ = rootCmd.RegisterFlagCompletionFunc("queue-latency", completeQueueLatency)
+	_ = rootCmd.RegisterFlagCompletionFunc("record-max-size", cobra.NoFileCompletions)
+	_ = rootCmd.RegisterFlagCompletionFunc("view", completeStartView)
+}
+
+// completeStartView completes --view with view names, and "queues:<queue>"
+// with the queues known to Redis.
+func completeStartView(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	queuePrefix, ok := strings.CutPrefix(toComplete, "queues:")
+	if !ok {
+		var completions []string
+		for _, name := range ui.StartViewNames() {
+			if strings.HasPrefix(name, toComplete) {
+				completions = append(completions, name)
+			}
+		}
+		return completions, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	sidekiq.DisableRedisLogging()
+
+	client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	defer func() {
+		_ = client.Close()
+	}()
+
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	queues := completeQueueNames(ctx, client, queuePrefix, "")
+	for i, queue := range queues {
+		queues[i] = "queues:" + queue
+	}
+	return queues, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeQueueLatency completes "queue=" prefixes for --queue-latency from
+// the queues known to Redis. Any connection problem yields no suggestions.
+func completeQueueLatency(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if strings.Contains(toComplete, "=") {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	sidekiq.DisableRedisLogging()
+
+	client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	defer func() {
+		_ = client.Close()
+	}()
+
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return completeQueueNames(ctx, client, toComplete, "="), cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace
+}
+
+// completeQueueNames lists queue names starting with prefix, each followed by
+// suffix. It returns nil when Redis does not answer within completionTimeout.
+func completeQueueNames(ctx context.Context, client sidekiq.API, prefix, suffix string) []string {
+	ctx, cancel := context.WithTimeout(ctx, completionTimeout)
+	defer cancel()
+
+	queues, err := client.GetQueues(ctx)
+	if err != nil {
+		return nil
+	}
+
+	completions := make([]string, 0, len(queues))
+	for _, queue := range queues {
+		if strings.HasPrefix(queue.Name(), prefix) {
+			completions = append(completions, queue.Name()+suffix)
+		}
+	}
+	return completions
+}
@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+)
+
+type queuesStub struct {
+	sidekiq.API
+	names []string
+	err   error
+}
+
+func (s *queuesStub) GetQueues(context.Context) ([]*sidekiq.Queue, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	var client *sidekiq.Client
+	queues := make([]*sidekiq.Queue, len(s.names))
+	for i, name := range s.names {
+		queues[i] = client.NewQueue(name)
+	}
+	return queues, nil
+}
+
+func TestCompleteQueueNames(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		stub   *queuesStub
+		prefix string
+		want   []string
+	}{
+		"all":         {stub: &queuesStub{names: []string{"critical", "default"}}, want: []string{"critical=", "default="}},
+		"prefix":      {stub: &queuesStub{names: []string{"critical", "default"}}, prefix: "d", want: []string{"default="}},
+		"unreachable": {stub: &queuesStub{err: errors.New("connection refused")}},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := completeQueueNames(context.Background(), tc.stub, tc.prefix, "=")
+			if !slices.Equal(got, tc.want) {
+				t.Fatalf("completeQueueNames = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRegisterFlagCompletions(t *testing.T) {
+	t.Parallel()
+
+	rootCmd := &cobra.Command{Use: "lazykiq"}
+	for _, name := range []string{"redis", "leader-key", "latency-warn", "latency-critical", "dead-timeout", "dead-max", "queue-latency", "view", "poller-key", "beat-stale", "long-running", "dead-action"} {
+		rootCmd.Flags().String(name, "", "")
+	}
+	registerFlagCompletions(rootCmd)
+
+	for _, name := range []string{"redis", "queue-latency", "dead-timeout", "view"} {
+		if _, ok := rootCmd.GetFlagCompletionFunc(name); !ok {
+			t.Fatalf("flag %q has no completion", name)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package cmd
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/kpumuk/lazykiq/internal/ui/views"
+)
+
+// parseDeadActionRules parses --dead-action values of the form
+// pattern=action, keeping their order so earlier rules win.
+func parseDeadActionRules(values []string) ([]views.DeadActionRule, error) {
+	rules := make([]views.DeadActionRule, 0, len(values))
+	for _, value := range values {
+		pattern, actionText, ok := strings.Cut(value, "=")
+		pattern = strings.TrimSpace(pattern)
+		if !ok || pattern == "" {
+			return nil, fmt.Errorf("dead action %q: expected pattern=action", value)
+		}
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("dead action %q: %w", value, err)
+		}
+		action := views.DeadAction(strings.ToLower(strings.TrimSpace(actionText)))
+		switch action {
+		case views.DeadActionRetry, views.DeadActionDelete:
+		default:
+			return nil, fmt.Errorf("dead action %q: action must be %s or %s", value, views.DeadActionRetry, views.DeadActionDelete)
+		}
+		rules = append(rules, views.DeadActionRule{Pattern: pattern, Action: action})
+	}
+	return rules, nil
+}
@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/kpumuk/lazykiq/internal/ui/views"
+)
+
+func TestParseDeadActionRules(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		values  []string
+		want    []views.DeadActionRule
+		wantErr bool
+	}{
+		"none": {
+			want: []views.DeadActionRule{},
+		},
+		"rules keep order": {
+			values: []string{"Stripe::*=delete", " *Error = Retry "},
+			want: []views.DeadActionRule{
+				{Pattern: "Stripe::*", Action: views.DeadActionDelete},
+				{Pattern: "*Error", Action: views.DeadActionRetry},
+			},
+		},
+		"missing separator": {
+			values:  []string{"Stripe::CardError"},
+			wantErr: true,
+		},
+		"missing pattern": {
+			values:  []string{"=delete"},
+			wantErr: true,
+		},
+		"unknown action": {
+			values:  []string{"Stripe::CardError=kill"},
+			wantErr: true,
+		},
+		"bad pattern": {
+			values:  []string{"[Stripe=delete"},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseDeadActionRules(tc.values)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("parseDeadActionRules(%q) error = nil, want error", tc.values)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseDeadActionRules error = %v", err)
+			}
+			if !slices.Equal(got, tc.want) {
+				t.Fatalf("parseDeadActionRules = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kpumuk/lazykiq/internal/ui/views"
+)
+
+// parseLatencyThresholds builds queue latency thresholds from the global
+// warn/critical limits and per-queue overrides in "queue=warn/critical" form.
+func parseLatencyThresholds(warn, critical time.Duration, overrides []string) (views.LatencyThresholds, error) {
+	thresholds := views.LatencyThresholds{
+		Default: views.LatencyThreshold{Warn: warn, Critical: critical},
+	}
+	if err := validateLatencyThreshold(thresholds.Default); err != nil {
+		return views.LatencyThresholds{}, err
+	}
+
+	for _, override := range overrides {
+		name, limits, ok := strings.Cut(override, "=")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return views.LatencyThresholds{}, fmt.Errorf("queue latency %q: expected queue=warn/critical", override)
+		}
+		warnText, criticalText, ok := strings.Cut(limits, "/")
+		if !ok {
+			return views.LatencyThresholds{}, fmt.Errorf("queue latency %q: expected queue=warn/critical", override)
+		}
+		queueWarn, err := time.ParseDuration(strings.TrimSpace(warnText))
+		if err != nil {
+			return views.LatencyThresholds{}, fmt.Errorf("queue latency %q: %w", override, err)
+		}
+		queueCritical, err := time.ParseDuration(strings.TrimSpace(criticalText))
+		if err != nil {
+			return views.LatencyThresholds{}, fmt.Errorf("queue latency %q: %w", override, err)
+		}
+		threshold := views.LatencyThreshold{Warn: queueWarn, Critical: queueCritical}
+		if err := validateLatencyThreshold(threshold); err != nil {
+			return views.LatencyThresholds{}, fmt.Errorf("queue latency %q: %w", override, err)
+		}
+		if thresholds.Queues == nil {
+			thresholds.Queues = make(map[string]views.LatencyThreshold)
+		}
+		thresholds.Queues[name] = threshold
+	}
+
+	return thresholds, nil
+}
+
+func validateLatencyThreshold(threshold views.LatencyThreshold) error {
+	if threshold.Warn < 0 || threshold.Critical < 0 {
+		return errors.New("latency thresholds must not be negative")
+	}
+	if threshold.Warn > 0 && threshold.Critical > 0 && threshold.Warn > threshold.Critical {
+		return fmt.Errorf("warn latency %s exceeds critical latency %s", threshold.Warn, threshold.Critical)
+	}
+	return nil
+}
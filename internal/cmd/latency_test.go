@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kpumuk/lazykiq/internal/ui/views"
+)
+
+func TestParseLatencyThresholds(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		warn      time.Duration
+		critical  time.Duration
+		overrides []string
+		want      map[string]views.LatencyThreshold
+		wantErr   bool
+	}{
+		"defaults only": {
+			warn:     time.Minute,
+			critical: 5 * time.Minute,
+		},
+		"per queue": {
+			warn:      time.Minute,
+			critical:  5 * time.Minute,
+			overrides: []string{"critical=5s/30s", " low = 10m / 1h "},
+			want: map[string]views.LatencyThreshold{
+				"critical": {Warn: 5 * time.Second, Critical: 30 * time.Second},
+				"low":      {Warn: 10 * time.Minute, Critical: time.Hour},
+			},
+		},
+		"missing separator": {
+			warn:      time.Minute,
+			critical:  5 * time.Minute,
+			overrides: []string{"critical:5s/30s"},
+			wantErr:   true,
+		},
+		"missing critical": {
+			warn:      time.Minute,
+			critical:  5 * time.Minute,
+			overrides: []string{"critical=5s"},
+			wantErr:   true,
+		},
+		"invalid duration": {
+			warn:      time.Minute,
+			critical:  5 * time.Minute,
+			overrides: []string{"critical=soon/30s"},
+			wantErr:   true,
+		},
+		"warn above critical": {
+			warn:     10 * time.Minute,
+			critical: time.Minute,
+			wantErr:  true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			got, err := parseLatencyThresholds(tc.warn, tc.critical, tc.overrides)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseLatencyThresholds error = %v", err)
+			}
+			if got.Default != (views.LatencyThreshold{Warn: tc.warn, Critical: tc.critical}) {
+				t.Fatalf("Default = %+v", got.Default)
+			}
+			if len(got.Queues) != len(tc.want) {
+				t.Fatalf("Queues = %+v, want %+v", got.Queues, tc.want)
+			}
+			for queue, want := range tc.want {
+				if got.Queues[queue] != want {
+					t.Fatalf("Queues[%q] = %+v, want %+v", queue, got.Queues[queue], want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/display"
+)
+
+// newPruneDeadCmd builds the prune-dead subcommand. It reads the Redis URL
+// from the root command's persistent --redis flag.
+func newPruneDeadCmd() *cobra.Command {
+	pruneCmd := &cobra.Command{
+		Use:   "prune-dead",
+		Short: "Delete dead jobs older than a given age.",
+		Long:  "Delete dead jobs that died more than --older-than ago, e.g. 30d or 12h.",
+		Args:  cobra.NoArgs,
+	}
+
+	pruneCmd.Flags().String(
+		"older-than",
+		"",
+		"delete dead jobs older than this age (e.g. 30d, 12h)",
+	)
+	_ = pruneCmd.MarkFlagRequired("older-than")
+	_ = pruneCmd.RegisterFlagCompletionFunc("older-than", cobra.FixedCompletions(
+		[]string{"7d", "30d", "90d"},
+		cobra.ShellCompDirectiveNoFileComp,
+	))
+
+	pruneCmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		sidekiq.DisableRedisLogging()
+
+		olderThan, err := cmd.Flags().GetString("older-than")
+		if err != nil {
+			return fmt.Errorf("parse older-than flag: %w", err)
+		}
+
+		client, err := newClientFromFlags(cmd)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = client.Close()
+		}()
+
+		return pruneDead(cmd.Context(), client, olderThan, time.Now(), cmd.OutOrStdout())
+	}
+
+	return pruneCmd
+}
+
+// pruneDead deletes dead jobs that died more than olderThan before now and
+// reports the removed count to out.
+func pruneDead(ctx context.Context, client sidekiq.API, olderThan string, now time.Time, out io.Writer) error {
+	age, err := display.ParseDuration(olderThan)
+	if err != nil {
+		return fmt.Errorf("parse older-than: %w", err)
+	}
+	if age <= 0 {
+		return errors.New("older-than must be positive")
+	}
+
+	removed, err := client.DeleteDeadJobsOlderThan(ctx, now.Add(-age))
+	if err != nil {
+		return fmt.Errorf("prune dead jobs: %w", err)
+	}
+
+	_, err = fmt.Fprintf(out, "Deleted %s dead jobs older than %s.\n", display.Number(removed), olderThan)
+	return err
+}
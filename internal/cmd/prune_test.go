@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+)
+
+type pruneStub struct {
+	sidekiq.API
+	cutoff time.Time
+}
+
+func (s *pruneStub) DeleteDeadJobsOlderThan(_ context.Context, cutoff time.Time) (int64, error) {
+	s.cutoff = cutoff
+	return 1234, nil
+}
+
+func TestPruneDead(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.January, 31, 12, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		olderThan  string
+		wantCutoff time.Time
+		wantErr    bool
+	}{
+		"days":     {olderThan: "30d", wantCutoff: time.Date(2026, time.January, 1, 12, 0, 0, 0, time.UTC)},
+		"hours":    {olderThan: "12h", wantCutoff: time.Date(2026, time.January, 31, 0, 0, 0, 0, time.UTC)},
+		"invalid":  {olderThan: "month", wantErr: true},
+		"zero":     {olderThan: "0d", wantErr: true},
+		"negative": {olderThan: "-1h", wantErr: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			stub := &pruneStub{}
+			var out bytes.Buffer
+			err := pruneDead(context.Background(), stub, tc.olderThan, now, &out)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				if !stub.cutoff.IsZero() {
+					t.Fatal("expected no prune on invalid input")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("pruneDead error = %v", err)
+			}
+			if !stub.cutoff.Equal(tc.wantCutoff) {
+				t.Fatalf("cutoff = %v, want %v", stub.cutoff, tc.wantCutoff)
+			}
+			want := "Deleted 1,234 dead jobs older than " + tc.olderThan + ".\n"
+			if out.String() != want {
+				t.Fatalf("output = %q, want %q", out.String(), want)
+			}
+		})
+	}
+}
+
+func TestPruneDeadCmdRefusesInReadOnlyMode(t *testing.T) {
+	root := &cobra.Command{Use: "lazykiq"}
+	root.PersistentFlags().String("redis", "", "")
+	root.PersistentFlags().Bool("read-only", false, "")
+	addConnectionFlags(root.PersistentFlags())
+	root.AddCommand(newPruneDeadCmd())
+	root.SetOut(io.Discard)
+	root.SetErr(io.Discard)
+	// Read-only mode refuses before talking to Redis, so the address is never dialed.
+	root.SetArgs([]string{"prune-dead", "--older-than", "30d", "--read-only", "--redis", "redis://127.0.0.1:1/0"})
+
+	if err := root.Execute(); !errors.Is(err, sidekiq.ErrReadOnly) {
+		t.Fatalf("Execute() error = %v, want ErrReadOnly", err)
+	}
+}
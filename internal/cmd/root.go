@@ -4,11 +4,14 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"runtime"
 	"runtime/debug"
 	"runtime/pprof"
+	"slices"
+	"time"
 
 	tea "charm.land/bubbletea/v2"
 	"charm.land/fang/v2"
@@ -16,8 +19,11 @@ import (
 	"github.com/spf13/pflag"
 
 	"github.com/kpumuk/lazykiq/internal/devtools"
+	"github.com/kpumuk/lazykiq/internal/record"
 	"github.com/kpumuk/lazykiq/internal/sidekiq"
 	"github.com/kpumuk/lazykiq/internal/ui"
+	"github.com/kpumuk/lazykiq/internal/ui/display"
+	"github.com/kpumuk/lazykiq/internal/ui/views"
 )
 
 func buildVersion(version, commit, date, builtBy string) string {
@@ -39,10 +45,95 @@ func buildVersion(version, commit, date, builtBy string) string {
 	return result
 }
 
+// newClientFromFlags creates a Sidekiq client for the persistent --redis flag.
+// The interactive UI and the headless subcommands share it so they connect
+// the same way.
+func newClientFromFlags(cmd *cobra.Command) (*sidekiq.Client, error) {
+	redisURL, err := cmd.Flags().GetString("redis")
+	if err != nil {
+		return nil, fmt.Errorf("parse redis flag: %w", err)
+	}
+
+	readOnly, err := cmd.Flags().GetBool("read-only")
+	if err != nil {
+		return nil, fmt.Errorf("parse read-only flag: %w", err)
+	}
+
+	connection, err := connectionOptionsFromFlags(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := sidekiq.NewClientWithOptions(redisURL, connection)
+	if err != nil {
+		return nil, fmt.Errorf("create redis client: %w", err)
+	}
+	client.SetReadOnly(readOnly)
+	return client, nil
+}
+
+// addConnectionFlags registers the Redis pool and timeout flags read by
+// connectionOptionsFromFlags.
+func addConnectionFlags(flags *pflag.FlagSet) {
+	flags.Int(
+		"pool-size",
+		sidekiq.DefaultPoolSize,
+		"maximum number of Redis connections",
+	)
+	flags.Duration(
+		"dial-timeout",
+		sidekiq.DefaultDialTimeout,
+		"timeout for establishing a Redis connection",
+	)
+	flags.Duration(
+		"read-timeout",
+		sidekiq.DefaultReadTimeout,
+		"timeout for reading a Redis reply",
+	)
+	flags.Duration(
+		"write-timeout",
+		sidekiq.DefaultWriteTimeout,
+		"timeout for sending a Redis command",
+	)
+}
+
+// connectionOptionsFromFlags reads the persistent pool and timeout flags.
+func connectionOptionsFromFlags(cmd *cobra.Command) (sidekiq.ConnectionOptions, error) {
+	var opts sidekiq.ConnectionOptions
+	var err error
+
+	if opts.PoolSize, err = cmd.Flags().GetInt("pool-size"); err != nil {
+		return opts, fmt.Errorf("parse pool-size flag: %w", err)
+	}
+	if opts.PoolSize <= 0 {
+		return opts, fmt.Errorf("parse pool-size flag: must be positive, got %d", opts.PoolSize)
+	}
+
+	timeouts := []struct {
+		flag  string
+		value *time.Duration
+	}{
+		{"dial-timeout", &opts.DialTimeout},
+		{"read-timeout", &opts.ReadTimeout},
+		{"write-timeout", &opts.WriteTimeout},
+	}
+	for _, timeout := range timeouts {
+		if *timeout.value, err = cmd.Flags().GetDuration(timeout.flag); err != nil {
+			return opts, fmt.Errorf("parse %s flag: %w", timeout.flag, err)
+		}
+		if *timeout.value <= 0 {
+			return opts, fmt.Errorf("parse %s flag: must be positive, got %s", timeout.flag, *timeout.value)
+		}
+	}
+	return opts, nil
+}
+
 // Execute initializes and runs the lazykiq terminal application.
 func Execute(version, commit, date, builtBy string) error {
 	var enableDangerousActions bool
 	var development bool
+	var debug bool
+	var noState bool
 	rootCmd := &cobra.Command{
 		Use:   "lazykiq",
 		Short: "A terminal UI for Sidekiq.",
@@ -66,23 +157,218 @@ func Execute(version, commit, date, builtBy string) error {
 		"help for lazykiq",
 	)
 
-	rootCmd.Flags().String(
+	rootCmd.PersistentFlags().String(
 		"redis",
 		"redis://localhost:6379/0",
 		"redis URL",
 	)
+	addConnectionFlags(rootCmd.PersistentFlags())
+	rootCmd.PersistentFlags().Bool(
+		"read-only",
+		false,
+		"refuse every operation that changes Sidekiq data",
+	)
+	rootCmd.Flags().String(
+		"leader-key",
+		sidekiq.DefaultLeaderKey,
+		"redis key holding the leader process identity",
+	)
+	rootCmd.Flags().String(
+		"poller-key",
+		"",
+		"redis key holding the scheduled poller's last poll time",
+	)
+	rootCmd.Flags().Bool(
+		"discover-queues",
+		false,
+		"also list queue:* lists missing from the queues set (scans all keys)",
+	)
+	rootCmd.Flags().Duration(
+		"watch-timeout",
+		views.DefaultWatchTimeout,
+		"how long a job retried with W is watched before giving up",
+	)
+	rootCmd.Flags().String(
+		"thousands-separator",
+		"comma",
+		"digit grouping in numbers: comma, space, apostrophe, underscore, or none",
+	)
+	rootCmd.Flags().Bool(
+		"full-numbers",
+		false,
+		"show full numbers instead of 1.2K/3.4M in context bars and chart axes",
+	)
+	rootCmd.Flags().Bool(
+		"hide-empty-queues",
+		false,
+		"leave empty queues out of the queue list on the Queues view (toggle with e)",
+	)
+	rootCmd.Flags().String(
+		"metrics-prefix",
+		"",
+		"namespace prepended to Sidekiq metrics keys (j|, h|)",
+	)
+	rootCmd.Flags().Duration(
+		"latency-warn",
+		views.DefaultLatencyWarn,
+		"queue latency highlighted as a warning (0 disables)",
+	)
+	rootCmd.Flags().Duration(
+		"latency-critical",
+		views.DefaultLatencyCritical,
+		"queue latency highlighted as critical (0 disables)",
+	)
+	rootCmd.Flags().StringArray(
+		"queue-latency",
+		nil,
+		"per-queue latency thresholds as queue=warn/critical (repeatable)",
+	)
+	rootCmd.Flags().Duration(
+		"beat-stale",
+		views.DefaultBeatStale,
+		"process heartbeat age highlighted as stale in Busy (0 disables)",
+	)
+	rootCmd.Flags().Float64(
+		"retry-backoff",
+		views.DefaultRetryBackoff,
+		"multiplier applied to Sidekiq's default retry backoff when estimating later retries",
+	)
+	rootCmd.Flags().String(
+		"large-payload",
+		display.Bytes(views.DefaultLargePayload),
+		"job payload size flagged as large in job lists, such as \"512KB\" (0 disables)",
+	)
+	rootCmd.Flags().String(
+		"process-command",
+		"",
+		"command template copied for a process with I in Busy, such as \"ssh {hostname}\"",
+	)
+	rootCmd.Flags().String(
+		"trace-url-template",
+		"",
+		"tracing backend URL for a job's trace, such as \"https://tempo.example.com/trace/{trace_id}\"",
+	)
+	rootCmd.Flags().Duration(
+		"long-running",
+		views.DefaultLongRunning,
+		"busy job runtime highlighted as long running (0 disables)",
+	)
+	rootCmd.Flags().Int(
+		"utilization-warn",
+		views.DefaultUtilizationWarn,
+		"percent of process concurrency in use highlighted as a warning in Busy (0 disables)",
+	)
+	rootCmd.Flags().Int(
+		"utilization-critical",
+		views.DefaultUtilizationCritical,
+		"percent of process concurrency in use highlighted as critical in Busy (0 disables)",
+	)
+	rootCmd.Flags().Int64(
+		"dead-max",
+		0,
+		"dead set size limit (dead_max_jobs) when processes do not report it",
+	)
+	rootCmd.Flags().Duration(
+		"dead-timeout",
+		0,
+		"dead job retention (dead_timeout_in_seconds) when processes do not report it",
+	)
+	rootCmd.Flags().StringArray(
+		"dead-action",
+		nil,
+		"action suggested for dead jobs by error class as pattern=retry|delete (repeatable)",
+	)
+	rootCmd.Flags().StringSlice(
+		"redact-args",
+		nil,
+		"argument hash keys whose values are shown as [redacted] (comma-separated)",
+	)
+	rootCmd.Flags().Int(
+		"args-depth",
+		views.DefaultArgsDepth,
+		"nesting depth of job arguments expanded in job details",
+	)
+	rootCmd.Flags().Int(
+		"page-size",
+		views.DefaultPageSize,
+		"minimum rows per page fetched by lazily loaded tables",
+	)
+	rootCmd.Flags().Int(
+		"window-pages",
+		views.DefaultWindowPages,
+		"pages fetched around the cursor by lazily loaded tables",
+	)
+	rootCmd.Flags().StringSlice(
+		"home-key",
+		ui.DefaultHomeKeys,
+		"keys that return to the Dashboard; ctrl and alt chords also work while typing (comma-separated)",
+	)
+	rootCmd.Flags().String(
+		"events-channel",
+		"",
+		"redis pub/sub channel whose messages are shown in the Events view (ctrl+e)",
+	)
+	rootCmd.Flags().String(
+		"record",
+		"",
+		"append a stats sample to this JSON Lines file on every refresh",
+	)
+	rootCmd.Flags().Int64(
+		"record-max-size",
+		record.DefaultMaxSize>>20,
+		"size in MiB at which the --record file is rotated (0 disables rotation)",
+	)
+	rootCmd.Flags().String(
+		"view",
+		"",
+		"view to start on, such as errors, queues:<queue> or metrics:<job class>",
+	)
+	rootCmd.Flags().String(
+		"open",
+		"",
+		"open a job link such as lazykiq://retry/<jid> on start",
+	)
 	rootCmd.Flags().BoolVar(
 		&enableDangerousActions,
 		"danger",
 		false,
 		"enable dangerous operations",
 	)
+	rootCmd.Flags().Bool(
+		"allow-enqueue",
+		false,
+		"allow enqueuing copies of a job from job details (ctrl+n, also needs --danger)",
+	)
+	rootCmd.Flags().Bool(
+		"strict-confirm",
+		false,
+		"require typing the set or queue name to delete, prune or move all jobs, or delete or migrate a queue",
+	)
 	rootCmd.Flags().BoolVar(
 		&development,
 		"development",
 		false,
 		"enable development diagnostics",
 	)
+	rootCmd.Flags().BoolVar(
+		&debug,
+		"debug",
+		false,
+		"enable the Redis command inspector (ctrl+\\)",
+	)
+	rootCmd.Flags().BoolVar(
+		&noState,
+		"no-state",
+		false,
+		"do not restore or save UI state between runs",
+	)
+	rootCmd.AddCommand(newPruneDeadCmd())
+	rootCmd.AddCommand(newStatsCmd())
+	registerFlagCompletions(rootCmd)
+
+	rootCmd.MarkFlagsMutuallyExclusive("danger", "read-only")
+	rootCmd.MarkFlagsMutuallyExclusive("view", "open")
+
 	rootCmd.Flags().SetNormalizeFunc(func(_ *pflag.FlagSet, name string) pflag.NormalizedName {
 		switch name {
 		case "yolo":
@@ -99,15 +385,260 @@ func Execute(version, commit, date, builtBy string) error {
 			return fmt.Errorf("parse cpuprofile flag: %w", err)
 		}
 
-		redisURL, err := cmd.Flags().GetString("redis")
+		leaderKey, err := cmd.Flags().GetString("leader-key")
+		if err != nil {
+			return fmt.Errorf("parse leader-key flag: %w", err)
+		}
+
+		pollerKey, err := cmd.Flags().GetString("poller-key")
+		if err != nil {
+			return fmt.Errorf("parse poller-key flag: %w", err)
+		}
+
+		discoverQueues, err := cmd.Flags().GetBool("discover-queues")
+		if err != nil {
+			return fmt.Errorf("parse discover-queues flag: %w", err)
+		}
+
+		hideEmptyQueues, err := cmd.Flags().GetBool("hide-empty-queues")
+		if err != nil {
+			return fmt.Errorf("parse hide-empty-queues flag: %w", err)
+		}
+
+		metricsPrefix, err := cmd.Flags().GetString("metrics-prefix")
+		if err != nil {
+			return fmt.Errorf("parse metrics-prefix flag: %w", err)
+		}
+
+		latencyWarn, err := cmd.Flags().GetDuration("latency-warn")
+		if err != nil {
+			return fmt.Errorf("parse latency-warn flag: %w", err)
+		}
+
+		latencyCritical, err := cmd.Flags().GetDuration("latency-critical")
+		if err != nil {
+			return fmt.Errorf("parse latency-critical flag: %w", err)
+		}
+
+		queueLatency, err := cmd.Flags().GetStringArray("queue-latency")
+		if err != nil {
+			return fmt.Errorf("parse queue-latency flag: %w", err)
+		}
+
+		latencyThresholds, err := parseLatencyThresholds(latencyWarn, latencyCritical, queueLatency)
+		if err != nil {
+			return fmt.Errorf("parse latency thresholds: %w", err)
+		}
+
+		beatStale, err := cmd.Flags().GetDuration("beat-stale")
 		if err != nil {
-			return fmt.Errorf("parse redis flag: %w", err)
+			return fmt.Errorf("parse beat-stale flag: %w", err)
+		}
+		if beatStale < 0 {
+			return fmt.Errorf("parse beat-stale flag: must not be negative, got %s", beatStale)
+		}
+
+		retryBackoff, err := cmd.Flags().GetFloat64("retry-backoff")
+		if err != nil {
+			return fmt.Errorf("parse retry-backoff flag: %w", err)
+		}
+		if retryBackoff <= 0 {
+			return fmt.Errorf("parse retry-backoff flag: must be positive, got %v", retryBackoff)
+		}
+
+		largePayloadText, err := cmd.Flags().GetString("large-payload")
+		if err != nil {
+			return fmt.Errorf("parse large-payload flag: %w", err)
+		}
+		largePayload, err := display.ParseBytes(largePayloadText)
+		if err != nil {
+			return fmt.Errorf("parse large-payload flag: %w", err)
+		}
+
+		watchTimeout, err := cmd.Flags().GetDuration("watch-timeout")
+		if err != nil {
+			return fmt.Errorf("parse watch-timeout flag: %w", err)
+		}
+		if watchTimeout <= 0 {
+			return fmt.Errorf("parse watch-timeout flag: must be positive, got %s", watchTimeout)
 		}
 
-		client, err := sidekiq.NewClient(redisURL)
+		thousandsSeparatorText, err := cmd.Flags().GetString("thousands-separator")
+		if err != nil {
+			return fmt.Errorf("parse thousands-separator flag: %w", err)
+		}
+		thousandsSeparator, err := display.ParseThousandsSeparator(thousandsSeparatorText)
 		if err != nil {
-			return fmt.Errorf("create redis client: %w", err)
+			return fmt.Errorf("parse thousands-separator flag: %w", err)
 		}
+
+		fullNumbers, err := cmd.Flags().GetBool("full-numbers")
+		if err != nil {
+			return fmt.Errorf("parse full-numbers flag: %w", err)
+		}
+
+		allowEnqueue, err := cmd.Flags().GetBool("allow-enqueue")
+		if err != nil {
+			return fmt.Errorf("parse allow-enqueue flag: %w", err)
+		}
+
+		strictConfirm, err := cmd.Flags().GetBool("strict-confirm")
+		if err != nil {
+			return fmt.Errorf("parse strict-confirm flag: %w", err)
+		}
+
+		processCommandTemplate, err := cmd.Flags().GetString("process-command")
+		if err != nil {
+			return fmt.Errorf("parse process-command flag: %w", err)
+		}
+		processCommand, err := views.ParseProcessCommand(processCommandTemplate)
+		if err != nil {
+			return fmt.Errorf("parse process-command flag: %w", err)
+		}
+
+		traceURLTemplate, err := cmd.Flags().GetString("trace-url-template")
+		if err != nil {
+			return fmt.Errorf("parse trace-url-template flag: %w", err)
+		}
+		traceURL, err := views.ParseTraceURL(traceURLTemplate)
+		if err != nil {
+			return fmt.Errorf("parse trace-url-template flag: %w", err)
+		}
+
+		longRunning, err := cmd.Flags().GetDuration("long-running")
+		if err != nil {
+			return fmt.Errorf("parse long-running flag: %w", err)
+		}
+		if longRunning < 0 {
+			return fmt.Errorf("parse long-running flag: must not be negative, got %s", longRunning)
+		}
+
+		utilizationWarn, err := cmd.Flags().GetInt("utilization-warn")
+		if err != nil {
+			return fmt.Errorf("parse utilization-warn flag: %w", err)
+		}
+		if utilizationWarn < 0 || utilizationWarn > 100 {
+			return fmt.Errorf("parse utilization-warn flag: must be between 0 and 100, got %d", utilizationWarn)
+		}
+
+		utilizationCritical, err := cmd.Flags().GetInt("utilization-critical")
+		if err != nil {
+			return fmt.Errorf("parse utilization-critical flag: %w", err)
+		}
+		if utilizationCritical < 0 || utilizationCritical > 100 {
+			return fmt.Errorf("parse utilization-critical flag: must be between 0 and 100, got %d", utilizationCritical)
+		}
+
+		deadMax, err := cmd.Flags().GetInt64("dead-max")
+		if err != nil {
+			return fmt.Errorf("parse dead-max flag: %w", err)
+		}
+
+		deadTimeout, err := cmd.Flags().GetDuration("dead-timeout")
+		if err != nil {
+			return fmt.Errorf("parse dead-timeout flag: %w", err)
+		}
+
+		deadActions, err := cmd.Flags().GetStringArray("dead-action")
+		if err != nil {
+			return fmt.Errorf("parse dead-action flag: %w", err)
+		}
+
+		deadActionRules, err := parseDeadActionRules(deadActions)
+		if err != nil {
+			return fmt.Errorf("parse dead-action flag: %w", err)
+		}
+
+		redactArgs, err := cmd.Flags().GetStringSlice("redact-args")
+		if err != nil {
+			return fmt.Errorf("parse redact-args flag: %w", err)
+		}
+		sidekiq.SetRedactedArgKeys(redactArgs)
+
+		argsDepth, err := cmd.Flags().GetInt("args-depth")
+		if err != nil {
+			return fmt.Errorf("parse args-depth flag: %w", err)
+		}
+		if argsDepth < 1 {
+			return fmt.Errorf("parse args-depth flag: must be at least 1, got %d", argsDepth)
+		}
+
+		pageSize, err := cmd.Flags().GetInt("page-size")
+		if err != nil {
+			return fmt.Errorf("parse page-size flag: %w", err)
+		}
+		if pageSize < 1 {
+			return fmt.Errorf("parse page-size flag: must be at least 1, got %d", pageSize)
+		}
+
+		windowPages, err := cmd.Flags().GetInt("window-pages")
+		if err != nil {
+			return fmt.Errorf("parse window-pages flag: %w", err)
+		}
+		if windowPages < 1 {
+			return fmt.Errorf("parse window-pages flag: must be at least 1, got %d", windowPages)
+		}
+
+		homeKeys, err := cmd.Flags().GetStringSlice("home-key")
+		if err != nil {
+			return fmt.Errorf("parse home-key flag: %w", err)
+		}
+		if len(homeKeys) == 0 || slices.Contains(homeKeys, "") {
+			return errors.New("parse home-key flag: must list at least one key and no empty ones")
+		}
+
+		eventsChannel, err := cmd.Flags().GetString("events-channel")
+		if err != nil {
+			return fmt.Errorf("parse events-channel flag: %w", err)
+		}
+
+		recordPath, err := cmd.Flags().GetString("record")
+		if err != nil {
+			return fmt.Errorf("parse record flag: %w", err)
+		}
+		recordMaxSize, err := cmd.Flags().GetInt64("record-max-size")
+		if err != nil {
+			return fmt.Errorf("parse record-max-size flag: %w", err)
+		}
+		if recordMaxSize < 0 {
+			return fmt.Errorf("parse record-max-size flag: must not be negative, got %d", recordMaxSize)
+		}
+
+		viewName, err := cmd.Flags().GetString("view")
+		if err != nil {
+			return fmt.Errorf("parse view flag: %w", err)
+		}
+		var startView *ui.StartView
+		if viewName != "" {
+			start, err := ui.ParseStartView(viewName)
+			if err != nil {
+				return fmt.Errorf("parse view flag: %w", err)
+			}
+			startView = &start
+		}
+
+		openRef, err := cmd.Flags().GetString("open")
+		if err != nil {
+			return fmt.Errorf("parse open flag: %w", err)
+		}
+		var jobRef *views.JobRef
+		if openRef != "" {
+			ref, err := views.ParseJobRef(openRef)
+			if err != nil {
+				return fmt.Errorf("parse open flag: %w", err)
+			}
+			jobRef = &ref
+		}
+
+		client, err := newClientFromFlags(cmd)
+		if err != nil {
+			return err
+		}
+		client.SetLeaderKey(leaderKey)
+		client.SetPollerKey(pollerKey)
+		client.SetDiscoverQueues(discoverQueues)
+		client.SetMetricsPrefix(metricsPrefix)
+		client.SetDeadLimits(sidekiq.DeadLimits{MaxJobs: deadMax, Timeout: deadTimeout})
 		defer func() {
 			_ = client.Close()
 		}()
@@ -129,18 +660,88 @@ func Execute(version, commit, date, builtBy string) error {
 			}()
 		}
 
-		var tracker *devtools.Tracker
-		if development {
-			tracker = devtools.NewTracker()
+		// The tracker hook is only installed when requested, so regular runs
+		// pay nothing for command recording.
+		var devTracker, debugTracker *devtools.Tracker
+		if development || debug {
+			tracker := devtools.NewTracker()
 			client.AddHook(tracker.Hook())
+			if development {
+				devTracker = tracker
+			}
+			if debug {
+				debugTracker = tracker
+			}
+		}
+
+		display.SetNumberFormat(display.NumberFormat{
+			ThousandsSeparator: thousandsSeparator,
+			Abbreviate:         !fullNumbers,
+		})
+
+		app := ui.New(client, version, enableDangerousActions, devTracker, debugTracker)
+		app.SetLatencyThresholds(latencyThresholds)
+		app.SetBeatStale(beatStale)
+		app.SetProcessCommand(processCommand)
+		app.SetTraceURL(traceURL)
+		app.SetStrictConfirm(strictConfirm)
+		app.SetAllowEnqueue(allowEnqueue)
+		app.SetHideEmptyQueues(hideEmptyQueues)
+		app.SetWatchTimeout(watchTimeout)
+		app.SetRetryBackoff(retryBackoff)
+		app.SetLargePayload(largePayload)
+		app.SetLongRunning(longRunning)
+		app.SetUtilizationThresholds(views.UtilizationThresholds{Warn: utilizationWarn, Critical: utilizationCritical})
+		app.SetDeadActionRules(deadActionRules)
+		app.SetArgsDepth(argsDepth)
+		app.SetPaging(pageSize, windowPages)
+		app.SetEventsChannel(eventsChannel)
+		app.SetHomeKeys(homeKeys)
+
+		var recorder *record.Recorder
+		if recordPath != "" {
+			recorder, err = record.Open(recordPath, recordMaxSize<<20)
+			if err != nil {
+				return fmt.Errorf("open record file: %w", err)
+			}
+			app.SetRecorder(recorder)
+		}
+
+		var statePath string
+		if !noState {
+			// State is a convenience; a missing config dir only disables it.
+			if path, err := stateFilePath(); err == nil {
+				statePath = path
+				app.RestoreState(loadState(statePath))
+			}
+		}
+		if startView != nil {
+			app.SetStartView(*startView)
+		}
+		if jobRef != nil {
+			app.OpenJobRef(*jobRef)
 		}
 
-		app := ui.New(client, version, enableDangerousActions, tracker)
 		p := tea.NewProgram(app)
-		if _, err := p.Run(); err != nil {
+		model, err := p.Run()
+		var recordErr error
+		if recorder != nil {
+			recordErr = recorder.Close()
+		}
+		if err != nil {
 			return fmt.Errorf("run lazykiq: %w", err)
 		}
 
+		if statePath != "" {
+			if final, ok := model.(ui.App); ok {
+				// Failing to persist state must not turn a clean exit into an error.
+				_ = saveState(statePath, final.State())
+			}
+		}
+
+		if recordErr != nil {
+			return fmt.Errorf("write record file: %w", recordErr)
+		}
 		return nil
 	}
 
@@ -148,7 +749,6 @@ func Execute(version, commit, date, builtBy string) error {
 		context.Background(),
 		rootCmd,
 		fang.WithVersion(rootCmd.Version),
-		fang.WithoutCompletions(),
 		fang.WithoutManpage(),
 	)
 }
@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+)
+
+func TestConnectionOptionsFromFlags(t *testing.T) {
+	tests := map[string]struct {
+		args    []string
+		want    sidekiq.ConnectionOptions
+		wantErr bool
+	}{
+		"defaults": {want: sidekiq.DefaultConnectionOptions()},
+		"custom": {
+			args: []string{"--pool-size", "10", "--dial-timeout", "5s", "--read-timeout", "300ms", "--write-timeout", "1s"},
+			want: sidekiq.ConnectionOptions{PoolSize: 10, DialTimeout: 5 * time.Second, ReadTimeout: 300 * time.Millisecond, WriteTimeout: time.Second},
+		},
+		"zero pool":        {args: []string{"--pool-size", "0"}, wantErr: true},
+		"negative timeout": {args: []string{"--read-timeout", "-1s"}, wantErr: true},
+		"zero timeout":     {args: []string{"--dial-timeout", "0"}, wantErr: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			cmd := &cobra.Command{Use: "lazykiq"}
+			addConnectionFlags(cmd.Flags())
+			if err := cmd.ParseFlags(tc.args); err != nil {
+				t.Fatalf("ParseFlags() error = %v", err)
+			}
+
+			got, err := connectionOptionsFromFlags(cmd)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("connectionOptionsFromFlags() = %+v, want error", got)
+				}
+				return
+			}
+			if err != nil || got != tc.want {
+				t.Fatalf("connectionOptionsFromFlags() = %+v, %v; want %+v", got, err, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/kpumuk/lazykiq/internal/ui"
+)
+
+const stateFileName = "state.json"
+
+// stateFilePath returns the location of the UI state file under the user
+// config directory.
+func stateFilePath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("locate config dir: %w", err)
+	}
+	return filepath.Join(dir, "lazykiq", stateFileName), nil
+}
+
+// loadState reads persisted UI state. A missing or corrupt file yields an
+// empty state so startup is never blocked by it.
+func loadState(path string) ui.State {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ui.State{}
+	}
+	var state ui.State
+	if err := json.Unmarshal(data, &state); err != nil {
+		return ui.State{}
+	}
+	return state
+}
+
+// saveState writes UI state atomically so an interrupted write cannot leave
+// a truncated file behind.
+func saveState(path string, state ui.State) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode state: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("create state dir: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), stateFileName+".*")
+	if err != nil {
+		return fmt.Errorf("create state file: %w", err)
+	}
+	_, writeErr := tmp.Write(data)
+	closeErr := tmp.Close()
+	if err := errors.Join(writeErr, closeErr); err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("write state file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("replace state file: %w", err)
+	}
+	return nil
+}
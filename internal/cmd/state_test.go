@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/kpumuk/lazykiq/internal/ui"
+	"github.com/kpumuk/lazykiq/internal/ui/views"
+)
+
+func TestLoadStateToleratesBadFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	corrupt := filepath.Join(dir, "corrupt.json")
+	if err := os.WriteFile(corrupt, []byte("{not json"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		path string
+	}{
+		"missing": {path: filepath.Join(dir, "missing.json")},
+		"corrupt": {path: corrupt},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			state := loadState(tc.path)
+			if state.View != "" || len(state.Views) != 0 {
+				t.Fatalf("state = %+v, want empty", state)
+			}
+		})
+	}
+}
+
+func TestSaveStateRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "nested", stateFileName)
+	want := ui.State{
+		View: "metrics",
+		Views: map[string]views.ViewState{
+			"metrics": {Period: "8h", Filter: "Mailer"},
+			"queues":  {Queue: "default", Favorites: []string{"critical", "mailers"}},
+		},
+	}
+	if err := saveState(path, want); err != nil {
+		t.Fatalf("saveState: %v", err)
+	}
+
+	got := loadState(path)
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("state = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,147 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/display"
+)
+
+// statsJSON is the --json shape of the stats subcommand.
+type statsJSON struct {
+	Processed int64 `json:"processed"`
+	Failed    int64 `json:"failed"`
+	Busy      int64 `json:"busy"`
+	Enqueued  int64 `json:"enqueued"`
+	Scheduled int64 `json:"scheduled"`
+	Retries   int64 `json:"retries"`
+	Dead      int64 `json:"dead"`
+}
+
+// newStatsCmd builds the stats subcommand. It reads the Redis URL from the
+// root command's persistent --redis flag.
+func newStatsCmd() *cobra.Command {
+	statsCmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Print Sidekiq stats without the UI.",
+		Long:  "Print processed, failed, busy, enqueued, scheduled, retry, and dead counts and exit.",
+		Args:  cobra.NoArgs,
+	}
+
+	statsCmd.Flags().Bool(
+		"json",
+		false,
+		"print stats as a single JSON object",
+	)
+	statsCmd.Flags().Duration(
+		"watch",
+		0,
+		"reprint stats at this interval until interrupted (e.g. 5s)",
+	)
+	_ = statsCmd.RegisterFlagCompletionFunc("watch", cobra.FixedCompletions(
+		[]string{"2s", "5s", "30s"},
+		cobra.ShellCompDirectiveNoFileComp,
+	))
+
+	statsCmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		sidekiq.DisableRedisLogging()
+
+		asJSON, err := cmd.Flags().GetBool("json")
+		if err != nil {
+			return fmt.Errorf("parse json flag: %w", err)
+		}
+
+		watch, err := cmd.Flags().GetDuration("watch")
+		if err != nil {
+			return fmt.Errorf("parse watch flag: %w", err)
+		}
+		if watch < 0 {
+			return errors.New("watch must not be negative")
+		}
+
+		client, err := newClientFromFlags(cmd)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = client.Close()
+		}()
+
+		return watchStats(cmd.Context(), client, watch, asJSON, cmd.OutOrStdout())
+	}
+
+	return statsCmd
+}
+
+// watchStats prints stats once, or every interval until ctx is done when
+// interval is positive. Any fetch error stops the loop so callers exit
+// non-zero.
+func watchStats(ctx context.Context, client sidekiq.API, interval time.Duration, asJSON bool, out io.Writer) error {
+	if err := printStats(ctx, client, asJSON, out); err != nil {
+		return err
+	}
+	if interval <= 0 {
+		return nil
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := printStats(ctx, client, asJSON, out); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// printStats fetches stats and writes them to out as a table or JSON.
+func printStats(ctx context.Context, client sidekiq.API, asJSON bool, out io.Writer) error {
+	stats, err := client.GetStats(ctx)
+	if err != nil {
+		return fmt.Errorf("fetch stats: %w", err)
+	}
+
+	if asJSON {
+		return json.NewEncoder(out).Encode(statsJSON{
+			Processed: stats.Processed,
+			Failed:    stats.Failed,
+			Busy:      stats.Busy,
+			Enqueued:  stats.Enqueued,
+			Scheduled: stats.Scheduled,
+			Retries:   stats.Retries,
+			Dead:      stats.Dead,
+		})
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	rows := []struct {
+		label string
+		value int64
+	}{
+		{"Processed", stats.Processed},
+		{"Failed", stats.Failed},
+		{"Busy", stats.Busy},
+		{"Enqueued", stats.Enqueued},
+		{"Scheduled", stats.Scheduled},
+		{"Retries", stats.Retries},
+		{"Dead", stats.Dead},
+	}
+	for _, row := range rows {
+		if _, err := fmt.Fprintf(w, "%s\t%s\n", row.label, display.Number(row.value)); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+)
+
+type statsStub struct {
+	sidekiq.API
+	stats  sidekiq.Stats
+	err    error
+	calls  int
+	cancel context.CancelFunc
+}
+
+func (s *statsStub) GetStats(_ context.Context) (sidekiq.Stats, error) {
+	s.calls++
+	if s.cancel != nil && s.calls >= 2 {
+		s.cancel()
+	}
+	return s.stats, s.err
+}
+
+var testStats = sidekiq.Stats{
+	Processed: 1234567,
+	Failed:    89,
+	Busy:      3,
+	Enqueued:  42,
+	Retries:   5,
+	Scheduled: 7,
+	Dead:      1000,
+}
+
+func TestPrintStatsTable(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	if err := printStats(context.Background(), &statsStub{stats: testStats}, false, &out); err != nil {
+		t.Fatalf("printStats error = %v", err)
+	}
+
+	want := strings.Join([]string{
+		"Processed  1,234,567",
+		"Failed     89",
+		"Busy       3",
+		"Enqueued   42",
+		"Scheduled  7",
+		"Retries    5",
+		"Dead       1,000",
+		"",
+	}, "\n")
+	if out.String() != want {
+		t.Fatalf("output = %q, want %q", out.String(), want)
+	}
+}
+
+func TestPrintStatsJSON(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	if err := printStats(context.Background(), &statsStub{stats: testStats}, true, &out); err != nil {
+		t.Fatalf("printStats error = %v", err)
+	}
+
+	want := `{"processed":1234567,"failed":89,"busy":3,"enqueued":42,"scheduled":7,"retries":5,"dead":1000}` + "\n"
+	if out.String() != want {
+		t.Fatalf("output = %q, want %q", out.String(), want)
+	}
+}
+
+func TestPrintStatsError(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	err := printStats(context.Background(), &statsStub{err: errors.New("connection refused")}, false, &out)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if out.Len() != 0 {
+		t.Fatalf("output = %q, want empty", out.String())
+	}
+}
+
+func TestWatchStatsRepeatsUntilCanceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stub := &statsStub{stats: testStats, cancel: cancel}
+
+	var out bytes.Buffer
+	if err := watchStats(ctx, stub, time.Millisecond, true, &out); err != nil {
+		t.Fatalf("watchStats error = %v", err)
+	}
+	if stub.calls < 2 {
+		t.Fatalf("calls = %d, want at least 2", stub.calls)
+	}
+	if got := strings.Count(out.String(), "\n"); got != stub.calls {
+		t.Fatalf("printed %d lines, want %d", got, stub.calls)
+	}
+}
+
+func TestWatchStatsStopsOnError(t *testing.T) {
+	t.Parallel()
+
+	stub := &statsStub{err: errors.New("connection refused")}
+	var out bytes.Buffer
+	if err := watchStats(context.Background(), stub, time.Millisecond, false, &out); err == nil {
+		t.Fatal("expected error")
+	}
+	if stub.calls != 1 {
+		t.Fatalf("calls = %d, want 1", stub.calls)
+	}
+}
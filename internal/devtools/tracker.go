@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net"
 	"runtime"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -36,6 +37,9 @@ type Entry struct {
 	Kind     EntryKind
 	Command  string
 	Duration time.Duration
+	// Pipelined reports whether the command was sent as part of a pipeline.
+	// Pipelined commands carry the round-trip time of the whole pipeline.
+	Pipelined bool
 }
 
 // LogEntry captures a single tracked log line.
@@ -108,6 +112,23 @@ func (t *Tracker) LogEntries() []LogEntry {
 	return result
 }
 
+// RecentCommands returns up to limit of the most recent Redis commands in
+// chronological order. Pipeline markers and console results are skipped.
+func (t *Tracker) RecentCommands(limit int) []LogEntry {
+	if t == nil || limit <= 0 {
+		return nil
+	}
+	entries := t.LogEntries()
+	result := make([]LogEntry, 0, min(limit, len(entries)))
+	for i := len(entries) - 1; i >= 0 && len(result) < limit; i-- {
+		if entries[i].Entry.Kind == EntryCommand {
+			result = append(result, entries[i])
+		}
+	}
+	slices.Reverse(result)
+	return result
+}
+
 // AppendLog appends a log entry to the ring buffer.
 func (t *Tracker) AppendLog(entry LogEntry) {
 	if t == nil || t.logLimit == 0 {
@@ -178,10 +199,11 @@ func (h hook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessP
 		h.recordPipelineMarker(ctx, EntryPipelineBegin, 0)
 		start := time.Now()
 		err := next(ctx, cmds)
+		elapsed := time.Since(start)
 		for _, cmd := range cmds {
-			h.record(ctx, cmd, 0)
+			h.recordPipelined(ctx, cmd, elapsed)
 		}
-		h.recordPipelineMarker(ctx, EntryPipelineExec, time.Since(start))
+		h.recordPipelineMarker(ctx, EntryPipelineExec, elapsed)
 		return err
 	}
 }
@@ -199,6 +221,19 @@ func (h hook) record(ctx context.Context, cmd redis.Cmder, duration time.Duratio
 	})
 }
 
+func (h hook) recordPipelined(ctx context.Context, cmd redis.Cmder, duration time.Duration) {
+	if h.tracker == nil {
+		return
+	}
+
+	h.tracker.appendLogEntry(ctx, Entry{
+		Kind:      EntryCommand,
+		Command:   formatCommand(cmd),
+		Duration:  duration,
+		Pipelined: true,
+	})
+}
+
 func (h hook) recordPipelineMarker(ctx context.Context, kind EntryKind, duration time.Duration) {
 	if h.tracker == nil {
 		return
@@ -0,0 +1,143 @@
+// Package record appends Sidekiq stats samples to a JSON Lines file for
+// offline analysis.
+package record
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"os"
+	"sync"
+	"time"
+)
+
+// DefaultMaxSize is the default size at which the recording is rotated.
+const DefaultMaxSize int64 = 10 << 20
+
+// FlushInterval is how often buffered samples are written to disk.
+const FlushInterval = 10 * time.Second
+
+// Sample is one line of the recording.
+type Sample struct {
+	Time       time.Time        `json:"time"`
+	Processed  int64            `json:"processed"`
+	Failed     int64            `json:"failed"`
+	Busy       int64            `json:"busy"`
+	Enqueued   int64            `json:"enqueued"`
+	Retries    int64            `json:"retries"`
+	Scheduled  int64            `json:"scheduled"`
+	Dead       int64            `json:"dead"`
+	QueueSizes map[string]int64 `json:"queues,omitempty"`
+}
+
+// Recorder appends samples to a file. Once the file would grow past the size
+// limit it is renamed to path.1, replacing an earlier one, and a new file is
+// started, so a recording takes at most about twice the limit on disk.
+// After the first write error the recorder stops and Close reports it.
+type Recorder struct {
+	mu        sync.Mutex
+	path      string
+	maxSize   int64
+	file      *os.File
+	w         *bufio.Writer
+	size      int64
+	lastFlush time.Time
+	err       error
+	now       func() time.Time
+}
+
+// Open opens path for appending. maxSize is the rotation limit in bytes;
+// zero or less disables rotation.
+func Open(path string, maxSize int64) (*Recorder, error) {
+	r := &Recorder{path: path, maxSize: maxSize, now: time.Now}
+	if err := r.open(); err != nil {
+		return nil, err
+	}
+	r.lastFlush = r.now()
+	return r, nil
+}
+
+// Record appends a sample, flushing when FlushInterval has passed since the
+// last flush.
+func (r *Recorder) Record(sample Sample) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return r.err
+	}
+	if r.file == nil {
+		return errors.New("recorder is closed")
+	}
+
+	line, err := json.Marshal(sample)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	if r.maxSize > 0 && r.size > 0 && r.size+int64(len(line)) > r.maxSize {
+		if err := r.rotate(); err != nil {
+			return r.fail(err)
+		}
+	}
+	n, err := r.w.Write(line)
+	r.size += int64(n)
+	if err != nil {
+		return r.fail(err)
+	}
+	if now := r.now(); now.Sub(r.lastFlush) >= FlushInterval {
+		r.lastFlush = now
+		if err := r.w.Flush(); err != nil {
+			return r.fail(err)
+		}
+	}
+	return nil
+}
+
+// Close flushes buffered samples and closes the file. It returns the first
+// error the recorder ran into.
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.file == nil {
+		return r.err
+	}
+	err := errors.Join(r.err, r.w.Flush(), r.file.Close())
+	r.file = nil
+	return err
+}
+
+func (r *Recorder) open() error {
+	file, err := os.OpenFile(r.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+	r.file = file
+	r.w = bufio.NewWriter(file)
+	r.size = info.Size()
+	return nil
+}
+
+func (r *Recorder) rotate() error {
+	if err := r.w.Flush(); err != nil {
+		return err
+	}
+	if err := r.file.Close(); err != nil {
+		return err
+	}
+	r.file = nil
+	if err := os.Rename(r.path, r.path+".1"); err != nil {
+		return err
+	}
+	return r.open()
+}
+
+func (r *Recorder) fail(err error) error {
+	r.err = err
+	return err
+}
@@ -0,0 +1,114 @@
+package record
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func readSamples(t *testing.T, path string) []Sample {
+	t.Helper()
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("open %s: %v", path, err)
+	}
+	defer file.Close()
+
+	var samples []Sample
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var sample Sample
+		if err := json.Unmarshal(scanner.Bytes(), &sample); err != nil {
+			t.Fatalf("decode %q: %v", scanner.Text(), err)
+		}
+		samples = append(samples, sample)
+	}
+	return samples
+}
+
+func TestRecorderAppendsSamples(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "stats.jsonl")
+	at := time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	for i := range 2 {
+		r, err := Open(path, 0)
+		if err != nil {
+			t.Fatalf("Open failed: %v", err)
+		}
+		err = r.Record(Sample{
+			Time:       at,
+			Processed:  int64(100 + i),
+			QueueSizes: map[string]int64{"default": 3},
+		})
+		if err != nil {
+			t.Fatalf("Record failed: %v", err)
+		}
+		if err := r.Close(); err != nil {
+			t.Fatalf("Close failed: %v", err)
+		}
+	}
+
+	samples := readSamples(t, path)
+	if len(samples) != 2 {
+		t.Fatalf("samples = %d, want 2 appended across runs", len(samples))
+	}
+	if got := samples[1]; got.Processed != 101 || !got.Time.Equal(at) || got.QueueSizes["default"] != 3 {
+		t.Fatalf("second sample = %+v", got)
+	}
+}
+
+func TestRecorderFlushesPeriodically(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "stats.jsonl")
+	r, err := Open(path, 0)
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	defer r.Close()
+	now := r.lastFlush
+	r.now = func() time.Time { return now }
+
+	if err := r.Record(Sample{Processed: 1}); err != nil {
+		t.Fatalf("Record failed: %v", err)
+	}
+	if samples := readSamples(t, path); len(samples) != 0 {
+		t.Fatalf("samples on disk before the flush interval = %d, want 0", len(samples))
+	}
+
+	now = now.Add(FlushInterval)
+	if err := r.Record(Sample{Processed: 2}); err != nil {
+		t.Fatalf("Record failed: %v", err)
+	}
+	if samples := readSamples(t, path); len(samples) != 2 {
+		t.Fatalf("samples on disk after the flush interval = %d, want 2", len(samples))
+	}
+}
+
+func TestRecorderRotatesAtMaxSize(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "stats.jsonl")
+	line, _ := json.Marshal(Sample{Processed: 1})
+	// Room for two samples per file.
+	r, err := Open(path, int64(len(line)+1)*2)
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	for i := range 5 {
+		if err := r.Record(Sample{Processed: int64(i)}); err != nil {
+			t.Fatalf("Record %d failed: %v", i, err)
+		}
+	}
+	if err := r.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+
+	current := readSamples(t, path)
+	if len(current) != 1 || current[0].Processed != 4 {
+		t.Fatalf("current file = %+v, want only sample 4", current)
+	}
+	previous := readSamples(t, path+".1")
+	if len(previous) != 2 || previous[0].Processed != 2 || previous[1].Processed != 3 {
+		t.Fatalf("rotated file = %+v, want samples 2 and 3", previous)
+	}
+}
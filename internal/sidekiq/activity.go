@@ -0,0 +1,110 @@
+package sidekiq
+
+import (
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// MaxActivityEntries caps how many entries the activity log keeps; older
+// entries are dropped first.
+const MaxActivityEntries = 500
+
+// ActivityAction names a mutating client call recorded in the activity log.
+type ActivityAction string
+
+// Actions recorded in the activity log.
+const (
+	ActivityDelete        ActivityAction = "delete"
+	ActivityForceDelete   ActivityAction = "force delete"
+	ActivityDeleteAll     ActivityAction = "delete all"
+	ActivityEnqueue       ActivityAction = "enqueue"
+	ActivityEnqueueAll    ActivityAction = "enqueue all"
+	ActivityRequeue       ActivityAction = "requeue"
+	ActivityRetryLater    ActivityAction = "retry later"
+	ActivityRetryAllLater ActivityAction = "retry all later"
+	ActivityKill          ActivityAction = "kill"
+	ActivityKillAll       ActivityAction = "kill all"
+	ActivityPrune         ActivityAction = "prune"
+	ActivityEnqueueCopies ActivityAction = "enqueue copies"
+	ActivityClearQueue    ActivityAction = "clear queue"
+	ActivityMigrateQueue  ActivityAction = "migrate queue"
+	ActivityDrainQueue    ActivityAction = "drain queue"
+	ActivityPause         ActivityAction = "pause"
+	ActivityStop          ActivityAction = "stop"
+	ActivityUndo          ActivityAction = "undo"
+)
+
+// ActivityEntry records one mutating call made through the client during this
+// session. Set is the sorted set, queue, or process the call targeted; JID is
+// empty for bulk actions. Err is nil when the call succeeded.
+type ActivityEntry struct {
+	Time   time.Time
+	Action ActivityAction
+	Set    string
+	JID    string
+	Detail string
+	Err    error
+}
+
+// activityLog is a capped, concurrency-safe list of activity entries. It also
+// holds the most recent reversible move for UndoLast.
+type activityLog struct {
+	mu      sync.Mutex
+	entries []ActivityEntry
+	undo    *undoMove
+}
+
+// Activity returns the recorded activity, newest first. It only covers what
+// this client did, not changes made by Sidekiq or other clients.
+func (c *Client) Activity() []ActivityEntry {
+	c.activity.mu.Lock()
+	defer c.activity.mu.Unlock()
+
+	entries := slices.Clone(c.activity.entries)
+	slices.Reverse(entries)
+	return entries
+}
+
+// ClearActivity empties the activity log. The move UndoLast would revert is
+// kept, so clearing the log never loses the chance to undo.
+func (c *Client) ClearActivity() {
+	c.activity.mu.Lock()
+	defer c.activity.mu.Unlock()
+
+	c.activity.entries = nil
+}
+
+func (c *Client) recordActivity(action ActivityAction, set, jid, detail string, err error) {
+	c.activity.mu.Lock()
+	defer c.activity.mu.Unlock()
+
+	if len(c.activity.entries) >= MaxActivityEntries {
+		c.activity.entries = slices.Delete(c.activity.entries, 0, len(c.activity.entries)-MaxActivityEntries+1)
+	}
+	c.activity.entries = append(c.activity.entries, ActivityEntry{
+		Time:   nowFuncSidekiq(),
+		Action: action,
+		Set:    set,
+		JID:    jid,
+		Detail: detail,
+		Err:    err,
+	})
+}
+
+// jobCountDetail formats a bulk action's job count for the activity log.
+func jobCountDetail(count int64) string {
+	if count == 1 {
+		return "1 job"
+	}
+	return strconv.FormatInt(count, 10) + " jobs"
+}
+
+// entryJID returns the entry's JID, or "" for a nil entry.
+func entryJID(entry *SortedEntry) string {
+	if entry == nil || entry.JobRecord == nil {
+		return ""
+	}
+	return entry.JID()
+}
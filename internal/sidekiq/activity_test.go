@@ -0,0 +1,93 @@
+package sidekiq
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestActivityRecordsMutatingCalls(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx := context.Background()
+
+	originalNow := nowFuncSidekiq
+	nowFuncSidekiq = func() time.Time { return time.Unix(1700000000, 0) }
+	t.Cleanup(func() { nowFuncSidekiq = originalNow })
+
+	jobA := `{"jid":"a","class":"MyJob","queue":"default","args":[]}`
+	jobB := `{"jid":"b","class":"MyJob","queue":"default","args":[]}`
+	mr.ZAdd("retry", testScoreA, jobA)
+	mr.ZAdd("retry", testScoreB, jobB)
+
+	if err := client.MoveSortedEntryToDead(ctx, SortedSetRetry, NewSortedEntry(jobA, testScoreA)); err != nil {
+		t.Fatalf("MoveSortedEntryToDead failed: %v", err)
+	}
+	if err := client.DeleteSortedEntry(ctx, SortedSetRetry, NewSortedEntry(jobB, testScoreB)); err != nil {
+		t.Fatalf("DeleteSortedEntry failed: %v", err)
+	}
+	if _, err := client.RetryAllDeadJobsToRetry(ctx); err != nil {
+		t.Fatalf("RetryAllDeadJobsToRetry failed: %v", err)
+	}
+	if err := client.MoveSortedEntryToDead(ctx, SortedSetScheduled, NewSortedEntry(jobA, testScoreA)); err == nil {
+		t.Fatal("expected kill from scheduled to fail")
+	}
+
+	got := client.Activity()
+	want := []struct {
+		action ActivityAction
+		set    string
+		jid    string
+		detail string
+		failed bool
+	}{
+		{action: ActivityKill, set: "scheduled", jid: "a", failed: true},
+		{action: ActivityRetryAllLater, set: "dead", detail: "1 job"},
+		{action: ActivityDelete, set: "retry", jid: "b"},
+		{action: ActivityKill, set: "retry", jid: "a"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("len(Activity()) = %d, want %d: %+v", len(got), len(want), got)
+	}
+	for i, w := range want {
+		entry := got[i]
+		if entry.Action != w.action || entry.Set != w.set || entry.JID != w.jid || entry.Detail != w.detail || (entry.Err != nil) != w.failed {
+			t.Fatalf("Activity()[%d] = %+v, want %+v", i, entry, w)
+		}
+		if !entry.Time.Equal(time.Unix(1700000000, 0)) {
+			t.Fatalf("Activity()[%d].Time = %v", i, entry.Time)
+		}
+	}
+
+	client.ClearActivity()
+	if got := client.Activity(); len(got) != 0 {
+		t.Fatalf("Activity() after clear = %+v, want empty", got)
+	}
+}
+
+func TestActivityRecordsReadOnlyRefusals(t *testing.T) {
+	_, client := setupTestRedis(t)
+	client.SetReadOnly(true)
+
+	err := client.NewQueue("default").Clear(context.Background())
+	got := client.Activity()
+	if len(got) != 1 || got[0].Action != ActivityClearQueue || got[0].Set != "default" || !errors.Is(got[0].Err, ErrReadOnly) {
+		t.Fatalf("Activity() = %+v (err %v), want refused clear", got, err)
+	}
+}
+
+func TestActivityIsCapped(t *testing.T) {
+	_, client := setupTestRedis(t)
+
+	for i := range MaxActivityEntries + 10 {
+		client.recordActivity(ActivityDelete, "retry", strconv.Itoa(i), "", nil)
+	}
+	got := client.Activity()
+	if len(got) != MaxActivityEntries {
+		t.Fatalf("len(Activity()) = %d, want %d", len(got), MaxActivityEntries)
+	}
+	if newest, oldest := got[0].JID, got[len(got)-1].JID; newest != strconv.Itoa(MaxActivityEntries+9) || oldest != "10" {
+		t.Fatalf("Activity() spans %s..%s, want the latest %d entries", oldest, newest, MaxActivityEntries)
+	}
+}
@@ -1,6 +1,9 @@
 package sidekiq
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // API defines the interface for interacting with Sidekiq via Redis.
 // This interface enables mocking the client for testing purposes.
@@ -11,6 +14,18 @@ type API interface {
 	// DisplayRedisURL returns a sanitized URL safe for display.
 	DisplayRedisURL() string
 
+	// ConnectionOptions returns the effective connection pool and timeout settings.
+	ConnectionOptions() ConnectionOptions
+
+	// ReadOnly reports whether mutating calls are refused with ErrReadOnly.
+	ReadOnly() bool
+
+	// DB returns the index of the Redis database the client is connected to.
+	DB() int
+
+	// SelectDB reconnects the client to another database on the same server.
+	SelectDB(ctx context.Context, db int) error
+
 	// DetectVersion detects which Sidekiq version is being used based on key format.
 	DetectVersion(ctx context.Context) Version
 
@@ -23,39 +38,69 @@ type API interface {
 	// GetRedisInfo fetches Redis INFO and extracts fields used on the dashboard.
 	GetRedisInfo(ctx context.Context) (RedisInfo, error)
 
+	// QueuesMemoryUsage estimates the bytes used by every queue, or ErrMemoryUsageUnsupported.
+	QueuesMemoryUsage(ctx context.Context) (int64, error)
+
 	// GetStatsHistory fetches per-day processed and failed stats for the last N days.
 	GetStatsHistory(ctx context.Context, days int) (StatsHistory, error)
 
-	// GetMetricsTopJobs fetches aggregated metrics for all jobs within the period.
-	GetMetricsTopJobs(ctx context.Context, period MetricsPeriod, classFilter string) (MetricsTopJobsResult, error)
+	// GetMetricsTopJobs fetches aggregated metrics for all jobs within the period, ranked and limited.
+	GetMetricsTopJobs(ctx context.Context, period MetricsPeriod, classFilter string, sortBy MetricsSort, limit int) (MetricsTopJobsResult, error)
 
 	// GetMetricsJobDetail fetches detailed metrics for a single job within the period.
 	GetMetricsJobDetail(ctx context.Context, className string, period MetricsPeriod) (MetricsJobDetailResult, error)
 
+	// EstimateClockSkew estimates the workers' clock offset from the newest heartbeat, or ErrNoHeartbeats.
+	EstimateClockSkew(ctx context.Context) (time.Duration, error)
+
 	// NewQueue creates a new Queue instance for the given queue name.
 	NewQueue(name string) *Queue
 
 	// GetQueues fetches all known queues from Redis, sorted alphabetically.
 	GetQueues(ctx context.Context) ([]*Queue, error)
 
+	// MigrateQueue moves every job from one queue to another, rewriting each payload's queue field.
+	MigrateQueue(ctx context.Context, from, to string, opts MigrateQueueOptions) (int64, error)
+
+	// DrainQueueToDead moves every job in a queue into the dead set.
+	DrainQueueToDead(ctx context.Context, queue string) (int64, error)
+
+	// GetQueuesHead fetches the next-to-run jobs of several queues merged by enqueue time.
+	GetQueuesHead(ctx context.Context, names []string, perQueue int) ([]*PositionedEntry, error)
+
 	// NewProcess creates a new Process instance for the given identity.
 	NewProcess(identity string) *Process
 
 	// GetProcesses fetches all process identities from Redis, sorted alphabetically.
 	GetProcesses(ctx context.Context) ([]*Process, error)
 
+	// GetLeader returns the leader process identity, or "" when there is none.
+	GetLeader(ctx context.Context) (string, error)
+
+	// GetPollerHeartbeat returns when the scheduled poller last ran, or ErrNoPollerHeartbeat.
+	GetPollerHeartbeat(ctx context.Context) (time.Time, error)
+
 	// GetBusyData fetches detailed process and active job information from Redis.
 	// If filter is non-empty, only jobs whose raw payload contains the substring are returned.
 	GetBusyData(ctx context.Context, filter string) (BusyData, error)
 
-	// GetSortedEntries fetches sorted-set jobs with pagination.
-	GetSortedEntries(ctx context.Context, kind SortedSetKind, start, count int) ([]*SortedEntry, int64, error)
+	// GetSortedEntries fetches sorted-set jobs in the given order with pagination.
+	GetSortedEntries(ctx context.Context, kind SortedSetKind, order SortOrder, start, count int) ([]*SortedEntry, int64, error)
 
 	// ScanSortedEntries scans sorted-set jobs using a match pattern (no paging).
-	ScanSortedEntries(ctx context.Context, kind SortedSetKind, match string) ([]*SortedEntry, error)
+	ScanSortedEntries(ctx context.Context, kind SortedSetKind, order SortOrder, match string) ([]*SortedEntry, error)
 
 	// ScanSortedEntriesWindow scans sorted-set jobs using a match pattern and returns one window.
-	ScanSortedEntriesWindow(ctx context.Context, kind SortedSetKind, match string, start, count int) (SortedEntriesWindow, error)
+	ScanSortedEntriesWindow(ctx context.Context, kind SortedSetKind, order SortOrder, match string, start, count int) (SortedEntriesWindow, error)
+
+	// GetSortedEntriesInRange fetches sorted-set jobs scored in [from, to); zero bounds are open.
+	GetSortedEntriesInRange(ctx context.Context, kind SortedSetKind, order SortOrder, from, to time.Time) ([]*SortedEntry, error)
+
+	// FindSortedEntry returns the sorted-set job with the given JID, or ErrJobNotFound.
+	FindSortedEntry(ctx context.Context, kind SortedSetKind, jid string) (*SortedEntry, error)
+
+	// LocateJob reports whether a job is in its queue, running, or in the retry, scheduled, or dead set.
+	LocateJob(ctx context.Context, job *JobRecord) (JobLocation, error)
 
 	// GetSortedEntryBounds fetches the oldest and newest entries for a sorted set.
 	GetSortedEntryBounds(ctx context.Context, kind SortedSetKind) (*SortedEntry, *SortedEntry, error)
@@ -63,26 +108,89 @@ type API interface {
 	// GetErrorSummary fetches exact error summary rows across dead and retry sets.
 	GetErrorSummary(ctx context.Context, query string) ([]ErrorSummaryRow, ErrorSummaryMeta, error)
 
+	// GetErrorClassCounts tallies dead jobs, and optionally retries, by error class.
+	GetErrorClassCounts(ctx context.Context, includeRetries bool) ([]ErrorClassCount, error)
+
+	// FindDuplicateJIDs maps JIDs found more than once across the sorted sets and queue heads to where they were found.
+	FindDuplicateJIDs(ctx context.Context) (map[string][]string, error)
+
+	// GetRetryCounts tallies the retry set by attempt, sampling large sets.
+	GetRetryCounts(ctx context.Context) (RetryCounts, error)
+
+	// GetScheduledTimeline counts scheduled jobs by time until they run.
+	GetScheduledTimeline(ctx context.Context, buckets []time.Duration) ([]int64, error)
+
 	// GetErrorGroupWindow fetches one exact paged error group window across dead and retry sets.
 	GetErrorGroupWindow(ctx context.Context, key ErrorGroupKey, query string, start, count int) (ErrorGroupWindow, error)
 
 	// DeleteSortedEntry removes a job from a sorted set.
 	DeleteSortedEntry(ctx context.Context, kind SortedSetKind, entry *SortedEntry) error
 
+	// ForceDeleteEntry removes a raw member from a sorted set without parsing it.
+	ForceDeleteEntry(ctx context.Context, kind SortedSetKind, rawValue string) error
+
 	// DeleteAllSortedEntries removes all jobs from a sorted set.
 	DeleteAllSortedEntries(ctx context.Context, kind SortedSetKind) error
 
 	// EnqueueSortedEntry moves a sorted-set job to its queue immediately.
 	EnqueueSortedEntry(ctx context.Context, kind SortedSetKind, entry *SortedEntry) error
 
+	// EnqueueSortedEntryJob moves a sorted-set job to its queue immediately and returns the job as pushed.
+	EnqueueSortedEntryJob(ctx context.Context, kind SortedSetKind, entry *SortedEntry) (*JobRecord, error)
+
+	// EnqueueSortedEntryToFront moves a sorted-set job to its queue to run next.
+	EnqueueSortedEntryToFront(ctx context.Context, kind SortedSetKind, entry *SortedEntry) error
+
+	// RequeueDeadJob moves a dead job to its queue without touching retry_count.
+	RequeueDeadJob(ctx context.Context, entry *SortedEntry) error
+
+	// RetryDeadJobWithDelay moves a dead job to the schedule set to run after delay.
+	RetryDeadJobWithDelay(ctx context.Context, entry *SortedEntry, delay time.Duration) error
+
+	// GetDeadLimits returns the configured dead set limits, zero when unknown.
+	GetDeadLimits(ctx context.Context) (DeadLimits, error)
+
+	// DeleteDeadJobsOlderThan removes dead jobs that died before cutoff.
+	DeleteDeadJobsOlderThan(ctx context.Context, cutoff time.Time) (int64, error)
+
 	// EnqueueAllSortedEntries moves all sorted-set jobs to their queues immediately.
 	EnqueueAllSortedEntries(ctx context.Context, kind SortedSetKind) error
 
+	// RetryAllDeadJobsToRetry moves all dead jobs into the retry set for a new retry cycle.
+	RetryAllDeadJobsToRetry(ctx context.Context) (int, error)
+
 	// MoveSortedEntryToDead moves a supported sorted-set job to the dead set.
 	MoveSortedEntryToDead(ctx context.Context, kind SortedSetKind, entry *SortedEntry) error
 
 	// MoveAllSortedEntriesToDead moves all supported sorted-set jobs to the dead set.
 	MoveAllSortedEntriesToDead(ctx context.Context, kind SortedSetKind) error
+
+	// EnqueueJobCopies pushes up to MaxEnqueueCopies copies of a job with new JIDs onto its queue.
+	EnqueueJobCopies(ctx context.Context, job *JobRecord, count int) (int, error)
+
+	// Activity returns the mutating calls made through this client, newest first.
+	Activity() []ActivityEntry
+
+	// ClearActivity empties the activity log.
+	ClearActivity()
+
+	// LastUndo describes the move UndoLast would revert, if any.
+	LastUndo() (ActivityEntry, bool)
+
+	// UndoLast reverts the most recent retry-now, requeue, or kill of a single job.
+	UndoLast(ctx context.Context) error
+
+	// PreviewCommands returns the redis-cli commands action would run to change data, without running them.
+	PreviewCommands(ctx context.Context, action func(context.Context, API) error) ([]string, error)
+
+	// GetBatch fetches Sidekiq Pro batch status, or ErrBatchNotFound.
+	GetBatch(ctx context.Context, bid string) (*Batch, error)
+
+	// GetRateLimiters lists Sidekiq Enterprise rate limiters, or none on OSS and Pro.
+	GetRateLimiters(ctx context.Context) ([]RateLimiter, error)
+
+	// SubscribeEvents delivers messages published on a pub/sub channel until ctx is canceled.
+	SubscribeEvents(ctx context.Context, channel string) <-chan Event
 }
 
 // Ensure Client implements API at compile time.
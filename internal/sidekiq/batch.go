@@ -0,0 +1,125 @@
+package sidekiq
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// ErrBatchNotFound is returned when a batch has no data in Redis, either
+// because it expired or because the server runs OSS Sidekiq without batches.
+var ErrBatchNotFound = errors.New("batch not found")
+
+// batchCallbackEvents lists the Sidekiq Pro batch callback events in display order.
+var batchCallbackEvents = []string{"complete", "success", "death"}
+
+// Batch represents a Sidekiq Pro batch.
+type Batch struct {
+	BID         string
+	Description string
+	CreatedAt   time.Time
+	Total       int64
+	Pending     int64
+	Failures    int64
+	FailedJIDs  []string
+	Callbacks   []BatchCallback
+}
+
+// BatchCallback describes a callback registered for a batch event.
+type BatchCallback struct {
+	Event  string
+	Target string
+}
+
+// Completed returns the number of jobs that are no longer pending.
+func (b *Batch) Completed() int64 {
+	return max(b.Total-b.Pending, 0)
+}
+
+// GetBatch fetches batch status from the keys Sidekiq Pro maintains: the
+// b-<bid> hash (total, pending, failures, description, created_at and
+// callbacks) and the b-<bid>-failed set of failed JIDs.
+// Returns ErrBatchNotFound when the batch hash does not exist.
+func (c *Client) GetBatch(ctx context.Context, bid string) (*Batch, error) {
+	key := "b-" + bid
+
+	var fields *redis.MapStringStringCmd
+	var failed *redis.StringSliceCmd
+	_, err := c.rdb().Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		fields = pipe.HGetAll(ctx, key)
+		failed = pipe.SMembers(ctx, key+"-failed")
+		return nil
+	})
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+
+	data := fields.Val()
+	if len(data) == 0 {
+		return nil, ErrBatchNotFound
+	}
+
+	batch := &Batch{
+		BID:         bid,
+		Description: data["description"],
+		CreatedAt:   parseTimestamp(parseBatchNumber(data["created_at"])),
+		Total:       parseBatchCount(data["total"]),
+		Pending:     parseBatchCount(data["pending"]),
+		Failures:    parseBatchCount(data["failures"]),
+		FailedJIDs:  failed.Val(),
+	}
+	slices.Sort(batch.FailedJIDs)
+	for _, event := range batchCallbackEvents {
+		batch.Callbacks = append(batch.Callbacks, parseBatchCallbacks(event, data[event])...)
+	}
+
+	return batch, nil
+}
+
+func parseBatchCount(raw string) int64 {
+	value, _ := strconv.ParseInt(raw, 10, 64)
+	return value
+}
+
+func parseBatchNumber(raw string) any {
+	value, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return nil
+	}
+	return value
+}
+
+// parseBatchCallbacks decodes a callback list stored as JSON. Entries are
+// either class names or single-key objects mapping a class to its options.
+func parseBatchCallbacks(event, raw string) []BatchCallback {
+	if raw == "" {
+		return nil
+	}
+
+	var entries []any
+	if err := json.Unmarshal([]byte(raw), &entries); err != nil {
+		return []BatchCallback{{Event: event, Target: raw}}
+	}
+
+	callbacks := make([]BatchCallback, 0, len(entries))
+	for _, entry := range entries {
+		switch value := entry.(type) {
+		case string:
+			callbacks = append(callbacks, BatchCallback{Event: event, Target: value})
+		case map[string]any:
+			targets := make([]string, 0, len(value))
+			for target := range value {
+				targets = append(targets, target)
+			}
+			slices.Sort(targets)
+			callbacks = append(callbacks, BatchCallback{Event: event, Target: strings.Join(targets, ", ")})
+		}
+	}
+	return callbacks
+}
@@ -0,0 +1,73 @@
+package sidekiq
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestGetBatch(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx := testContext(t)
+
+	mr.HSet("b-abc123",
+		"total", "10",
+		"pending", "3",
+		"failures", "2",
+		"description", "Nightly import",
+		"created_at", "1700000000.5",
+		"complete", `[{"ImportCallback":{"user":1}}]`,
+		"success", `["NotifyCallback"]`,
+	)
+	_, _ = mr.SetAdd("b-abc123-failed", "jid-b", "jid-a")
+
+	batch, err := client.GetBatch(ctx, "abc123")
+	if err != nil {
+		t.Fatalf("GetBatch failed: %v", err)
+	}
+
+	if batch.BID != "abc123" || batch.Description != "Nightly import" {
+		t.Fatalf("batch = %+v, want bid and description", batch)
+	}
+	if batch.Total != 10 || batch.Pending != 3 || batch.Failures != 2 {
+		t.Fatalf("counts = %d/%d/%d, want 10/3/2", batch.Total, batch.Pending, batch.Failures)
+	}
+	if got := batch.Completed(); got != 7 {
+		t.Fatalf("Completed() = %d, want 7", got)
+	}
+	if want := time.Unix(1700000000, 500000000); !batch.CreatedAt.Equal(want) {
+		t.Fatalf("CreatedAt = %v, want %v", batch.CreatedAt, want)
+	}
+	if len(batch.FailedJIDs) != 2 || batch.FailedJIDs[0] != "jid-a" {
+		t.Fatalf("FailedJIDs = %v, want sorted [jid-a jid-b]", batch.FailedJIDs)
+	}
+	want := []BatchCallback{
+		{Event: "complete", Target: "ImportCallback"},
+		{Event: "success", Target: "NotifyCallback"},
+	}
+	if len(batch.Callbacks) != len(want) {
+		t.Fatalf("Callbacks = %v, want %v", batch.Callbacks, want)
+	}
+	for i := range want {
+		if batch.Callbacks[i] != want[i] {
+			t.Fatalf("Callbacks = %v, want %v", batch.Callbacks, want)
+		}
+	}
+}
+
+func TestGetBatch_NotFound(t *testing.T) {
+	_, client := setupTestRedis(t)
+	ctx := testContext(t)
+
+	_, err := client.GetBatch(ctx, "missing")
+	if !errors.Is(err, ErrBatchNotFound) {
+		t.Fatalf("err = %v, want ErrBatchNotFound", err)
+	}
+}
+
+func TestParseBatchCallbacks_RawValue(t *testing.T) {
+	got := parseBatchCallbacks("death", "DeathHandler")
+	if len(got) != 1 || got[0].Target != "DeathHandler" || got[0].Event != "death" {
+		t.Fatalf("parseBatchCallbacks() = %v, want raw target", got)
+	}
+}
@@ -0,0 +1,93 @@
+package sidekiq
+
+import (
+	"context"
+	"strings"
+	"sync"
+)
+
+// capabilities records which optional commands the server supports. Older
+// Redis releases and forks such as Valkey lack some of them, or disable them
+// through ACLs.
+type capabilities struct {
+	// memoryUsage reports MEMORY USAGE, used for queue memory estimates.
+	memoryUsage bool
+	// bitfieldRO reports BITFIELD_RO (Redis 6.2+), used to read metrics
+	// histograms.
+	bitfieldRO bool
+	// zrandMember reports ZRANDMEMBER (Redis 6.2+), used to sample the retry
+	// set.
+	zrandMember bool
+}
+
+// capabilityProbe caches the result of probing the server once per client.
+type capabilityProbe struct {
+	mu     sync.Mutex
+	probed bool
+	caps   capabilities
+	// memoryUsageDenied is set once MEMORY USAGE fails as unsupported.
+	memoryUsageDenied bool
+}
+
+// capabilities probes the server with COMMAND INFO the first time it is
+// called and returns the cached result afterwards. When the probe itself is
+// refused, every command is assumed to be available and callers fall back on
+// the errors the commands return; other failures are retried next time.
+func (c *Client) capabilities(ctx context.Context) capabilities {
+	probe := &c.capabilityProbe
+	probe.mu.Lock()
+	defer probe.mu.Unlock()
+	if !probe.probed {
+		probe.caps, probe.probed = c.probeCapabilities(ctx)
+	}
+	caps := probe.caps
+	caps.memoryUsage = caps.memoryUsage && !probe.memoryUsageDenied
+	return caps
+}
+
+func (c *Client) probeCapabilities(ctx context.Context) (capabilities, bool) {
+	reply, err := c.rdb().Do(ctx, "COMMAND", "INFO", "memory", "bitfield_ro", "zrandmember").Result()
+	if err != nil {
+		return capabilities{memoryUsage: true, bitfieldRO: true, zrandMember: true}, unsupportedCommandError(err)
+	}
+	names := commandInfoNames(reply)
+	return capabilities{
+		memoryUsage: names["memory"],
+		bitfieldRO:  names["bitfield_ro"],
+		zrandMember: names["zrandmember"],
+	}, true
+}
+
+// disableMemoryUsage records that MEMORY USAGE failed as unsupported even
+// though the probe listed it, e.g. because an ACL denies it.
+func (c *Client) disableMemoryUsage() {
+	c.capabilityProbe.mu.Lock()
+	defer c.capabilityProbe.mu.Unlock()
+	c.capabilityProbe.memoryUsageDenied = true
+}
+
+// commandInfoNames returns the lower-cased names of the commands described
+// in a COMMAND INFO reply. Unknown commands come back as nil entries.
+func commandInfoNames(reply any) map[string]bool {
+	names := make(map[string]bool)
+	entries, _ := reply.([]any)
+	for _, entry := range entries {
+		doc, ok := entry.([]any)
+		if !ok || len(doc) == 0 {
+			continue
+		}
+		if name, ok := doc[0].(string); ok {
+			names[strings.ToLower(name)] = true
+		}
+	}
+	return names
+}
+
+// unsupportedCommandError reports whether err means the server does not
+// offer a command, or does not allow this connection to run it.
+func unsupportedCommandError(err error) bool {
+	msg := err.Error()
+	return strings.Contains(msg, "unknown command") ||
+		strings.Contains(msg, "unknown subcommand") ||
+		strings.HasPrefix(msg, "NOPERM")
+}
@@ -0,0 +1,40 @@
+package sidekiq
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestClientCapabilities(t *testing.T) {
+	ctx := testContext(t)
+	_, client := setupTestRedis(t)
+
+	// miniredis lists MEMORY but has no BITFIELD_RO.
+	caps := client.capabilities(ctx)
+	if !caps.memoryUsage || caps.bitfieldRO {
+		t.Fatalf("capabilities = %+v, want memoryUsage only", caps)
+	}
+
+	client.disableMemoryUsage()
+	if client.capabilities(ctx).memoryUsage {
+		t.Fatal("memoryUsage still reported after it was denied")
+	}
+	if _, err := client.QueuesMemoryUsage(ctx); !errors.Is(err, ErrMemoryUsageUnsupported) {
+		t.Fatalf("QueuesMemoryUsage() error = %v, want ErrMemoryUsageUnsupported", err)
+	}
+}
+
+func TestCommandInfoNames(t *testing.T) {
+	reply := []any{
+		[]any{"memory", int64(-2)},
+		nil,
+		[]any{"BITFIELD_RO", int64(-2)},
+	}
+	names := commandInfoNames(reply)
+	if !names["memory"] || !names["bitfield_ro"] || len(names) != 2 {
+		t.Fatalf("commandInfoNames() = %v, want memory and bitfield_ro", names)
+	}
+	if got := commandInfoNames("unexpected"); len(got) != 0 {
+		t.Fatalf("commandInfoNames(non-array) = %v, want empty", got)
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"net/url"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/redis/go-redis/v9"
@@ -23,10 +25,61 @@ const (
 	Version7
 	// Version8 uses j|YYMMDD|H:M format (6-digit date).
 	Version8
+)
 
-	uiRedisPoolSize = 4
+// Default connection settings, tuned for a UI that fails fast.
+const (
+	DefaultPoolSize     = 4
+	DefaultDialTimeout  = 2 * time.Second
+	DefaultReadTimeout  = 2 * time.Second
+	DefaultWriteTimeout = 2 * time.Second
 )
 
+// ConnectionOptions tunes the Redis connection pool and per-command network
+// timeouts. Zero fields fall back to the defaults. Deadlines on a call's
+// context always take precedence over the timeouts.
+type ConnectionOptions struct {
+	PoolSize     int
+	DialTimeout  time.Duration
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+}
+
+// DefaultConnectionOptions returns the settings NewClient uses.
+func DefaultConnectionOptions() ConnectionOptions {
+	return ConnectionOptions{
+		PoolSize:     DefaultPoolSize,
+		DialTimeout:  DefaultDialTimeout,
+		ReadTimeout:  DefaultReadTimeout,
+		WriteTimeout: DefaultWriteTimeout,
+	}
+}
+
+func (o ConnectionOptions) withDefaults() ConnectionOptions {
+	defaults := DefaultConnectionOptions()
+	if o.PoolSize <= 0 {
+		o.PoolSize = defaults.PoolSize
+	}
+	if o.DialTimeout <= 0 {
+		o.DialTimeout = defaults.DialTimeout
+	}
+	if o.ReadTimeout <= 0 {
+		o.ReadTimeout = defaults.ReadTimeout
+	}
+	if o.WriteTimeout <= 0 {
+		o.WriteTimeout = defaults.WriteTimeout
+	}
+	return o
+}
+
+// String formats the options as "pool 4 · dial 2s · read 2s · write 2s".
+func (o ConnectionOptions) String() string {
+	return fmt.Sprintf(
+		"pool %d · dial %s · read %s · write %s",
+		o.PoolSize, o.DialTimeout, o.ReadTimeout, o.WriteTimeout,
+	)
+}
+
 // DisableRedisLogging silences go-redis process-level logging for the TUI.
 func DisableRedisLogging() {
 	redis.SetLogger(&logging.VoidLogger{})
@@ -34,14 +87,31 @@ func DisableRedisLogging() {
 
 // Client is a Sidekiq API client.
 type Client struct {
+	redisMu         sync.RWMutex // Guards redis and displayRedisURL, swapped by SelectDB
 	redis           *redis.Client
+	hooks           []redis.Hook // Reattached when SelectDB reconnects
 	displayRedisURL string
-	version         Version
-	versionDetected bool
+	leaderKey       string
+	pollerKey       string
+	discoverQueues  bool
+	metricsPrefix   string
+	deadLimits      DeadLimits
+	version         atomic.Int32 // Detected Version plus one, zero until DetectVersion caches it
+	readOnly        bool
+	connection      ConnectionOptions
+	activity        activityLog
+	capabilityProbe capabilityProbe
+	clockSkew       atomic.Int64 // Applied metrics clock offset, see EstimateClockSkew
 }
 
 // NewClient creates a new Sidekiq client configured from a Redis URL.
 func NewClient(redisURL string) (*Client, error) {
+	return NewClientWithOptions(redisURL, DefaultConnectionOptions())
+}
+
+// NewClientWithOptions creates a new Sidekiq client configured from a Redis
+// URL with tuned connection settings.
+func NewClientWithOptions(redisURL string, connection ConnectionOptions) (*Client, error) {
 	if redisURL == "" {
 		redisURL = "redis://localhost:6379/0"
 	}
@@ -51,25 +121,40 @@ func NewClient(redisURL string) (*Client, error) {
 		return nil, fmt.Errorf("parse redis url: %w", err)
 	}
 
-	// Disable connection pool logging by disabling retries entirely.
-	opts.MaxRetries = -1               // Disable retries completely
-	opts.DialTimeout = 2 * time.Second // Short timeout to fail fast
-	opts.ReadTimeout = 2 * time.Second
-	opts.WriteTimeout = 2 * time.Second
-	opts.ContextTimeoutEnabled = true
-	opts.PoolSize = uiRedisPoolSize
-	opts.MaxActiveConns = uiRedisPoolSize
+	connection = connection.withDefaults()
+	applyConnectionOptions(opts, connection)
 
 	rdb := redis.NewClient(opts)
 
 	return &Client{
 		redis:           rdb,
 		displayRedisURL: sanitizeRedisURL(redisURL),
+		leaderKey:       DefaultLeaderKey,
+		connection:      connection,
 	}, nil
 }
 
+func applyConnectionOptions(opts *redis.Options, connection ConnectionOptions) {
+	// Disable connection pool logging by disabling retries entirely.
+	opts.MaxRetries = -1 // Disable retries completely
+	opts.DialTimeout = connection.DialTimeout
+	opts.ReadTimeout = connection.ReadTimeout
+	opts.WriteTimeout = connection.WriteTimeout
+	// Context deadlines from the UI win over the per-command timeouts.
+	opts.ContextTimeoutEnabled = true
+	opts.PoolSize = connection.PoolSize
+	opts.MaxActiveConns = connection.PoolSize
+}
+
+// ConnectionOptions returns the effective connection settings.
+func (c *Client) ConnectionOptions() ConnectionOptions {
+	return c.connection
+}
+
 // DisplayRedisURL returns a sanitized URL safe for display.
 func (c *Client) DisplayRedisURL() string {
+	c.redisMu.RLock()
+	defer c.redisMu.RUnlock()
 	return c.displayRedisURL
 }
 
@@ -92,32 +177,46 @@ func sanitizeRedisURL(redisURL string) string {
 	return parsed.String()
 }
 
+// rdb returns the Redis connection, which SelectDB may replace.
+func (c *Client) rdb() *redis.Client {
+	c.redisMu.RLock()
+	defer c.redisMu.RUnlock()
+	return c.redis
+}
+
 // Close closes the Redis connection.
 func (c *Client) Close() error {
-	return c.redis.Close()
+	return c.rdb().Close()
 }
 
 // Redis returns the underlying Redis client for benchmarking and testing.
 func (c *Client) Redis() *redis.Client {
-	return c.redis
+	return c.rdb()
 }
 
 // Do executes a raw Redis command.
 func (c *Client) Do(ctx context.Context, args ...any) (any, error) {
-	if c == nil || c.redis == nil {
+	if c == nil || c.rdb() == nil {
 		return nil, errors.New("redis client is nil")
 	}
 	if len(args) == 0 {
 		return nil, errors.New("no command provided")
 	}
-	return c.redis.Do(ctx, args...).Result()
+	// Raw commands may write, so read-only mode refuses them all.
+	if err := c.checkWritable(); err != nil {
+		return nil, err
+	}
+	return c.rdb().Do(ctx, args...).Result()
 }
 
 // AddHook attaches a Redis hook to the underlying client.
 func (c *Client) AddHook(h redis.Hook) {
-	if c == nil || c.redis == nil || h == nil {
+	if c == nil || c.rdb() == nil || h == nil {
 		return
 	}
+	c.redisMu.Lock()
+	defer c.redisMu.Unlock()
+	c.hooks = append(c.hooks, h)
 	c.redis.AddHook(h)
 }
 
@@ -125,8 +224,8 @@ func (c *Client) AddHook(h redis.Hook) {
 // Uses SCAN to efficiently find any existing metrics key.
 // This should be called once at startup and the result is cached.
 func (c *Client) DetectVersion(ctx context.Context) Version {
-	if c.versionDetected {
-		return c.version
+	if version, ok := c.cachedVersion(); ok {
+		return version
 	}
 
 	// Sidekiq 8 uses j|YYMMDD|H:M (6-digit date)
@@ -139,22 +238,20 @@ func (c *Client) DetectVersion(ctx context.Context) Version {
 	cursor := uint64(0)
 	found7 := false
 	processed := 0
+	rdb := c.rdb()
 
 	for {
 		// Redis can return zero keys and a cursor for the next scan.
-		keys, nextCursor, err := c.redis.Scan(ctx, cursor, "j|*", 100).Result()
+		keys, nextCursor, err := rdb.Scan(ctx, cursor, escapeGlob(c.metricsPrefix)+"j|*", 100).Result()
 		if err != nil {
-			c.versionDetected = true
-			return VersionUnknown
+			return c.cacheVersion(rdb, VersionUnknown)
 		}
 
 		for _, key := range keys {
 			processed++
-			switch metricsKeyVersion(key) {
+			switch metricsKeyVersion(strings.TrimPrefix(key, c.metricsPrefix)) {
 			case Version8:
-				c.version = Version8
-				c.versionDetected = true
-				return c.version
+				return c.cacheVersion(rdb, Version8)
 			case Version7:
 				found7 = true
 			case VersionUnknown:
@@ -168,18 +265,32 @@ func (c *Client) DetectVersion(ctx context.Context) Version {
 	}
 
 	if processed == 0 {
-		c.versionDetected = true
-		return VersionUnknown
+		return c.cacheVersion(rdb, VersionUnknown)
 	}
 	if found7 {
-		c.version = Version7
-		c.versionDetected = true
-		return c.version
+		return c.cacheVersion(rdb, Version7)
 	}
 
-	c.version = VersionUnknown
-	c.versionDetected = true
-	return c.version
+	return c.cacheVersion(rdb, VersionUnknown)
+}
+
+// cachedVersion returns the version DetectVersion cached, if any.
+func (c *Client) cachedVersion() (Version, bool) {
+	v := c.version.Load()
+	return Version(v - 1), v != 0
+}
+
+// cacheVersion stores version for later DetectVersion calls and returns it.
+// The version is not stored if SelectDB switched away from rdb meanwhile,
+// since it was detected on the old database.
+func (c *Client) cacheVersion(rdb *redis.Client, version Version) Version {
+	c.redisMu.RLock()
+	defer c.redisMu.RUnlock()
+
+	if c.redis == rdb {
+		c.version.Store(int32(version) + 1)
+	}
+	return version
 }
 
 // MetricsPeriodOrder returns the appropriate period order based on detected Sidekiq version.
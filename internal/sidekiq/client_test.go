@@ -2,9 +2,13 @@ package sidekiq
 
 import (
 	"context"
+	"errors"
+	"net"
 	"net/url"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/alicebob/miniredis/v2"
 	"github.com/redis/go-redis/v9"
@@ -284,14 +288,123 @@ func TestNewClient_ConfiguresRequestBackpressure(t *testing.T) {
 	if !opts.ContextTimeoutEnabled {
 		t.Fatal("ContextTimeoutEnabled = false, want true")
 	}
-	if opts.PoolSize != uiRedisPoolSize {
-		t.Fatalf("PoolSize = %d, want %d", opts.PoolSize, uiRedisPoolSize)
+	if opts.PoolSize != DefaultPoolSize {
+		t.Fatalf("PoolSize = %d, want %d", opts.PoolSize, DefaultPoolSize)
 	}
-	if opts.MaxActiveConns != uiRedisPoolSize {
-		t.Fatalf("MaxActiveConns = %d, want %d", opts.MaxActiveConns, uiRedisPoolSize)
+	if opts.MaxActiveConns != DefaultPoolSize {
+		t.Fatalf("MaxActiveConns = %d, want %d", opts.MaxActiveConns, DefaultPoolSize)
 	}
 }
 
+func TestNewClientWithOptions(t *testing.T) {
+	mr := miniredis.RunT(t)
+
+	client, err := NewClientWithOptions("redis://"+mr.Addr()+"/0", ConnectionOptions{
+		PoolSize:    16,
+		ReadTimeout: 5 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("NewClientWithOptions failed: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+
+	want := ConnectionOptions{
+		PoolSize:     16,
+		DialTimeout:  DefaultDialTimeout,
+		ReadTimeout:  5 * time.Second,
+		WriteTimeout: DefaultWriteTimeout,
+	}
+	if got := client.ConnectionOptions(); got != want {
+		t.Fatalf("ConnectionOptions() = %+v, want %+v", got, want)
+	}
+
+	opts := client.Redis().Options()
+	if opts.PoolSize != 16 || opts.MaxActiveConns != 16 {
+		t.Fatalf("pool = %d/%d, want 16/16", opts.PoolSize, opts.MaxActiveConns)
+	}
+	if opts.ReadTimeout != 5*time.Second || opts.DialTimeout != DefaultDialTimeout || opts.WriteTimeout != DefaultWriteTimeout {
+		t.Fatalf("timeouts = dial %s read %s write %s", opts.DialTimeout, opts.ReadTimeout, opts.WriteTimeout)
+	}
+	if got := want.String(); got != "pool 16 · dial 2s · read 5s · write 2s" {
+		t.Fatalf("String() = %q", got)
+	}
+}
+
+func TestNewClientWithOptions_ContextDeadlineWins(t *testing.T) {
+	addr := silentRedis(t)
+
+	client, err := NewClientWithOptions("redis://"+addr+"/0", ConnectionOptions{ReadTimeout: time.Minute})
+	if err != nil {
+		t.Fatalf("NewClientWithOptions failed: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if _, err := client.GetStats(ctx); err == nil {
+		t.Fatal("expected error from a server that never answers")
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Fatalf("call took %s, want the context deadline to cut it short", elapsed)
+	}
+}
+
+func TestNewClientWithOptions_ReadTimeout(t *testing.T) {
+	addr := silentRedis(t)
+
+	client, err := NewClientWithOptions("redis://"+addr+"/0", ConnectionOptions{ReadTimeout: 50 * time.Millisecond})
+	if err != nil {
+		t.Fatalf("NewClientWithOptions failed: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+
+	_, err = client.Redis().Ping(context.Background()).Result()
+	var netErr net.Error
+	if !errors.As(err, &netErr) || !netErr.Timeout() {
+		t.Fatalf("Ping error = %v, want a network timeout", err)
+	}
+}
+
+// silentRedis accepts connections but never replies, so every read times out.
+func silentRedis(t *testing.T) string {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	var conns []net.Conn
+	var mu sync.Mutex
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			mu.Lock()
+			conns = append(conns, conn)
+			mu.Unlock()
+		}
+	}()
+	t.Cleanup(func() {
+		_ = listener.Close()
+		mu.Lock()
+		defer mu.Unlock()
+		for _, conn := range conns {
+			_ = conn.Close()
+		}
+	})
+	return listener.Addr().String()
+}
+
 // setupTestRedis starts a miniredis instance and creates a Sidekiq client.
 // Cleanup is handled automatically via t.Cleanup().
 //
@@ -300,7 +413,7 @@ func TestNewClient_ConfiguresRequestBackpressure(t *testing.T) {
 //	mr, client := setupTestRedis(t)
 //	// Use mr for data setup
 //	// Use client for Sidekiq operations
-func setupTestRedis(t *testing.T) (*miniredis.Miniredis, *Client) {
+func setupTestRedis(t testing.TB) (*miniredis.Miniredis, *Client) {
 	t.Helper()
 
 	mr := miniredis.RunT(t)
@@ -0,0 +1,70 @@
+package sidekiq
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// ClockSkewThreshold is the clock skew beyond which metrics bucket keys are
+// shifted. Processes heartbeat every few seconds, so smaller differences are
+// indistinguishable from heartbeat age.
+const ClockSkewThreshold = 30 * time.Second
+
+// ErrNoHeartbeats is returned when no process has reported a heartbeat.
+var ErrNoHeartbeats = errors.New("no process heartbeats")
+
+// EstimateClockSkew estimates how far the workers' clocks are ahead of the
+// local clock (negative when behind) from the newest process heartbeat.
+//
+// Metrics buckets are keyed by the workers' clocks. When the skew exceeds
+// ClockSkewThreshold, later metrics queries shift their bucket keys by it;
+// otherwise the local clock is used as is.
+func (c *Client) EstimateClockSkew(ctx context.Context) (time.Duration, error) {
+	identities, err := c.rdb().SMembers(ctx, "processes").Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return 0, err
+	}
+	if len(identities) == 0 {
+		return 0, ErrNoHeartbeats
+	}
+
+	pipe := c.rdb().Pipeline()
+	cmds := make([]*redis.StringCmd, len(identities))
+	for i, identity := range identities {
+		cmds[i] = pipe.HGet(ctx, identity, "beat")
+	}
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return 0, err
+	}
+
+	var newest time.Time
+	for _, cmd := range cmds {
+		beat, err := cmd.Float64()
+		if err != nil {
+			continue
+		}
+		if t := parseTimestamp(beat); t.After(newest) {
+			newest = t
+		}
+	}
+	if newest.IsZero() {
+		return 0, ErrNoHeartbeats
+	}
+
+	skew := newest.Sub(time.Now())
+	if skew > -ClockSkewThreshold && skew < ClockSkewThreshold {
+		c.clockSkew.Store(0)
+	} else {
+		c.clockSkew.Store(int64(skew))
+	}
+	return skew, nil
+}
+
+// metricsNow returns the current time on the workers' clock as far as it is
+// known, for picking metrics buckets.
+func (c *Client) metricsNow() time.Time {
+	return time.Now().UTC().Add(time.Duration(c.clockSkew.Load()))
+}
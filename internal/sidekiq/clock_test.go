@@ -0,0 +1,84 @@
+package sidekiq
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func seedHeartbeat(t *testing.T, client *Client, identity string, beat time.Time) {
+	t.Helper()
+
+	ctx := testContext(t)
+	client.redis.SAdd(ctx, "processes", identity)
+	client.redis.HSet(ctx, identity, "beat", strconv.FormatFloat(float64(beat.UnixNano())/1e9, 'f', 3, 64))
+}
+
+func TestEstimateClockSkew_NoProcesses(t *testing.T) {
+	_, client := setupTestRedis(t)
+
+	if _, err := client.EstimateClockSkew(testContext(t)); !errors.Is(err, ErrNoHeartbeats) {
+		t.Fatalf("EstimateClockSkew error = %v, want ErrNoHeartbeats", err)
+	}
+}
+
+func TestEstimateClockSkew_UsesNewestBeat(t *testing.T) {
+	_, client := setupTestRedis(t)
+	ctx := testContext(t)
+
+	now := time.Now()
+	seedHeartbeat(t, client, "worker:1:abc", now.Add(-2*time.Hour))
+	seedHeartbeat(t, client, "worker:2:def", now.Add(5*time.Minute))
+	client.redis.SAdd(ctx, "processes", "worker:3:ghi") // no beat yet
+
+	skew, err := client.EstimateClockSkew(ctx)
+	if err != nil {
+		t.Fatalf("EstimateClockSkew failed: %v", err)
+	}
+	if skew < 4*time.Minute || skew > 6*time.Minute {
+		t.Fatalf("skew = %v, want about 5m", skew)
+	}
+}
+
+func TestEstimateClockSkew_ShiftsMetricsBuckets(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx := testContext(t)
+
+	ahead := time.Now().Add(10 * time.Minute)
+	seedHeartbeat(t, client, "worker:1:abc", ahead)
+
+	key := metricsRollupKeySidekiq8("", ahead.UTC().Truncate(time.Minute), MetricsGranularityMinutely)
+	mr.HSet(key, "App::FooJob|p", "4")
+
+	result, err := client.GetMetricsTopJobs(ctx, MetricsPeriod{Minutes: 1}, "", MetricsSortTotal, 0)
+	if err != nil {
+		t.Fatalf("GetMetricsTopJobs failed: %v", err)
+	}
+	if len(result.Jobs) != 0 {
+		t.Fatalf("len(Jobs) before estimating skew = %d, want 0", len(result.Jobs))
+	}
+
+	if _, err := client.EstimateClockSkew(ctx); err != nil {
+		t.Fatalf("EstimateClockSkew failed: %v", err)
+	}
+	result, err = client.GetMetricsTopJobs(ctx, MetricsPeriod{Minutes: 2}, "", MetricsSortTotal, 0)
+	if err != nil {
+		t.Fatalf("GetMetricsTopJobs failed: %v", err)
+	}
+	if got := result.Jobs["App::FooJob"].Processed; got != 4 {
+		t.Fatalf("Processed = %d, want 4 from the worker-clock bucket", got)
+	}
+}
+
+func TestEstimateClockSkew_IgnoresSmallSkew(t *testing.T) {
+	_, client := setupTestRedis(t)
+
+	seedHeartbeat(t, client, "worker:1:abc", time.Now().Add(-5*time.Second))
+	if _, err := client.EstimateClockSkew(testContext(t)); err != nil {
+		t.Fatalf("EstimateClockSkew failed: %v", err)
+	}
+	if got := client.clockSkew.Load(); got != 0 {
+		t.Fatalf("applied skew = %v, want 0 below threshold", time.Duration(got))
+	}
+}
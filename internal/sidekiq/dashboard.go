@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/redis/go-redis/v9"
@@ -11,13 +12,36 @@ import (
 
 // RedisInfo holds Redis INFO fields needed for the dashboard.
 type RedisInfo struct {
-	Version        string
+	// Server names the server implementation, e.g. "Redis" or "Valkey".
+	Server string
+	// Version is the version of Server, which for forks differs from the
+	// Redis version they report for compatibility.
+	Version string
+	// RedisVersion is the redis_version field as reported.
+	RedisVersion   string
 	UptimeDays     int64
 	Connections    int64
 	UsedMemory     string
 	UsedMemoryPeak string
 }
 
+// ServerVersion returns Version, prefixed with the server name for servers
+// other than Redis.
+func (i RedisInfo) ServerVersion() string {
+	if i.Version == "" || i.Server == "" || i.Server == "Redis" {
+		return i.Version
+	}
+	return i.Server + " " + i.Version
+}
+
+// redisServerNames maps INFO server_name values to display names.
+var redisServerNames = map[string]string{
+	"redis":     "Redis",
+	"valkey":    "Valkey",
+	"dragonfly": "Dragonfly",
+	"keydb":     "KeyDB",
+}
+
 // StatsHistory holds daily processed and failed counts.
 type StatsHistory struct {
 	// Use parallel slices to match chart data sets without extra struct mapping.
@@ -28,31 +52,60 @@ type StatsHistory struct {
 
 // GetRedisInfo fetches Redis INFO and extracts fields used on the dashboard.
 func (c *Client) GetRedisInfo(ctx context.Context) (RedisInfo, error) {
-	info := RedisInfo{}
-	data, err := c.redis.InfoMap(ctx, "server", "clients", "memory").Result()
+	text, err := c.rdb().Info(ctx, "server", "clients", "memory").Result()
 	if err != nil && !errors.Is(err, redis.Nil) {
-		return info, err
+		return RedisInfo{}, err
 	}
+	return parseRedisInfo(text), nil
+}
 
-	if server, ok := data["Server"]; ok {
-		info.Version = server["redis_version"]
-		if v, ok := server["uptime_in_days"]; ok {
-			info.UptimeDays, _ = strconv.ParseInt(v, 10, 64)
+// parseRedisInfo extracts dashboard fields from INFO output. Fields are read
+// regardless of the section they appear in, since forks such as Valkey and
+// Dragonfly do not always group them like Redis does.
+func parseRedisInfo(text string) RedisInfo {
+	fields := make(map[string]string)
+	for line := range strings.Lines(text) {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		name, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		if _, seen := fields[name]; !seen {
+			fields[name] = value
 		}
 	}
 
-	if clients, ok := data["Clients"]; ok {
-		if v, ok := clients["connected_clients"]; ok {
-			info.Connections, _ = strconv.ParseInt(v, 10, 64)
-		}
+	info := RedisInfo{
+		Server:         "Redis",
+		Version:        fields["redis_version"],
+		RedisVersion:   fields["redis_version"],
+		UsedMemory:     fields["used_memory_human"],
+		UsedMemoryPeak: fields["used_memory_peak_human"],
 	}
+	info.UptimeDays, _ = strconv.ParseInt(fields["uptime_in_days"], 10, 64)
+	info.Connections, _ = strconv.ParseInt(fields["connected_clients"], 10, 64)
 
-	if memory, ok := data["Memory"]; ok {
-		info.UsedMemory = memory["used_memory_human"]
-		info.UsedMemoryPeak = memory["used_memory_peak_human"]
+	if serverName := fields["server_name"]; serverName != "" {
+		info.Server = serverName
+		if name, ok := redisServerNames[strings.ToLower(serverName)]; ok {
+			info.Server = name
+		}
+	}
+	for _, fork := range []struct{ name, versionField string }{
+		{name: "Valkey", versionField: "valkey_version"},
+		{name: "Dragonfly", versionField: "dragonfly_version"},
+	} {
+		if version := fields[fork.versionField]; version != "" {
+			info.Server = fork.name
+			info.Version = version
+			break
+		}
 	}
 
-	return info, nil
+	return info
 }
 
 // GetStatsHistory fetches per-day processed and failed stats for the last N days.
@@ -77,7 +130,7 @@ func (c *Client) GetStatsHistory(ctx context.Context, days int) (StatsHistory, e
 	}
 
 	// Single MGET for all keys
-	results, err := c.redis.MGet(ctx, allKeys...).Result()
+	results, err := c.rdb().MGet(ctx, allKeys...).Result()
 	if err != nil && !errors.Is(err, redis.Nil) {
 		return StatsHistory{}, err
 	}
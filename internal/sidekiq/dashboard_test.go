@@ -108,3 +108,61 @@ func TestGetStatsHistory_InvalidDays(t *testing.T) {
 		t.Errorf("len(Dates) = %d, want 1 (minimum)", len(history.Dates))
 	}
 }
+
+func TestParseRedisInfo(t *testing.T) {
+	tests := map[string]struct {
+		text string
+		want RedisInfo
+	}{
+		"redis": {
+			text: "# Server\r\nredis_version:7.2.4\r\nuptime_in_days:12\r\n\r\n" +
+				"# Clients\r\nconnected_clients:7\r\n\r\n" +
+				"# Memory\r\nused_memory_human:1.5M\r\nused_memory_peak_human:2.0M\r\n",
+			want: RedisInfo{
+				Server: "Redis", Version: "7.2.4", RedisVersion: "7.2.4",
+				UptimeDays: 12, Connections: 7, UsedMemory: "1.5M", UsedMemoryPeak: "2.0M",
+			},
+		},
+		"valkey 8": {
+			text: "# Server\r\nredis_version:7.2.4\r\nserver_name:valkey\r\nvalkey_version:8.0.1\r\n" +
+				"uptime_in_days:3\r\n\r\n# Clients\r\nconnected_clients:2\r\n\r\n" +
+				"# Memory\r\nused_memory_human:900K\r\nused_memory_peak_human:1.1M\r\n",
+			want: RedisInfo{
+				Server: "Valkey", Version: "8.0.1", RedisVersion: "7.2.4",
+				UptimeDays: 3, Connections: 2, UsedMemory: "900K", UsedMemoryPeak: "1.1M",
+			},
+		},
+		"valkey 7.2 without valkey_version": {
+			text: "# Server\nredis_version:7.2.5\nserver_name:valkey\n",
+			want: RedisInfo{Server: "Valkey", Version: "7.2.5", RedisVersion: "7.2.5"},
+		},
+		"dragonfly": {
+			text: "# Server\nredis_version:7.2.0\ndragonfly_version:df-v1.21.2\n# Clients\nconnected_clients:1\n",
+			want: RedisInfo{Server: "Dragonfly", Version: "df-v1.21.2", RedisVersion: "7.2.0", Connections: 1},
+		},
+		"fields outside their usual sections": {
+			text: "# Stats\nconnected_clients:4\nmalformed line\nredis_version:6.0.0\n",
+			want: RedisInfo{Server: "Redis", Version: "6.0.0", RedisVersion: "6.0.0", Connections: 4},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := parseRedisInfo(tc.text); got != tc.want {
+				t.Fatalf("parseRedisInfo() = %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRedisInfoServerVersion(t *testing.T) {
+	if got := (RedisInfo{Server: "Redis", Version: "7.2.4"}).ServerVersion(); got != "7.2.4" {
+		t.Fatalf("redis ServerVersion() = %q, want 7.2.4", got)
+	}
+	if got := (RedisInfo{Server: "Valkey", Version: "8.0.1"}).ServerVersion(); got != "Valkey 8.0.1" {
+		t.Fatalf("valkey ServerVersion() = %q, want Valkey 8.0.1", got)
+	}
+	if got := (RedisInfo{Server: "Valkey"}).ServerVersion(); got != "" {
+		t.Fatalf("ServerVersion() without version = %q, want empty", got)
+	}
+}
@@ -0,0 +1,114 @@
+package sidekiq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// DB returns the index of the Redis database the client is connected to.
+func (c *Client) DB() int {
+	return c.rdb().Options().DB
+}
+
+// Databases returns how many databases the server is configured with (the
+// databases setting). Servers that refuse CONFIG, as many managed ones do,
+// return an error.
+func (c *Client) Databases(ctx context.Context) (int, error) {
+	config, err := c.rdb().ConfigGet(ctx, "databases").Result()
+	if err != nil {
+		return 0, err
+	}
+	value, ok := config["databases"]
+	if !ok {
+		return 0, errors.New("databases setting not reported")
+	}
+	count, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("parse databases setting %q: %w", value, err)
+	}
+	return count, nil
+}
+
+// SelectDB reconnects the client to database db on the same server. The index
+// is checked against the server's databases setting when the server reports
+// it; otherwise the new connection is left to reject it. Hooks are carried
+// over. The undo history, detected Sidekiq version, probed capabilities and
+// clock skew are dropped since the new database may hold another app, and
+// the old connection is closed, ending subscriptions made through it. On
+// error the client stays on its current database.
+func (c *Client) SelectDB(ctx context.Context, db int) error {
+	if db < 0 {
+		return fmt.Errorf("redis db must not be negative, got %d", db)
+	}
+	current := c.rdb()
+	if db == current.Options().DB {
+		return nil
+	}
+	if count, err := c.Databases(ctx); err == nil {
+		if err := checkDBIndex(db, count); err != nil {
+			return err
+		}
+	}
+
+	opts := *current.Options()
+	opts.DB = db
+	rdb := redis.NewClient(&opts)
+	if err := rdb.Ping(ctx).Err(); err != nil {
+		_ = rdb.Close()
+		return fmt.Errorf("select redis db %d: %w", db, err)
+	}
+
+	c.redisMu.Lock()
+	for _, hook := range c.hooks {
+		rdb.AddHook(hook)
+	}
+	old := c.redis
+	c.redis = rdb
+	c.displayRedisURL = redisURLWithDB(c.displayRedisURL, db)
+	c.version.Store(0)
+	c.clockSkew.Store(0)
+	c.redisMu.Unlock()
+
+	c.capabilityProbe.mu.Lock()
+	c.capabilityProbe.probed = false
+	c.capabilityProbe.caps = capabilities{}
+	c.capabilityProbe.memoryUsageDenied = false
+	c.capabilityProbe.mu.Unlock()
+
+	c.activity.mu.Lock()
+	c.activity.undo = nil
+	c.activity.mu.Unlock()
+
+	return old.Close()
+}
+
+// checkDBIndex reports whether db is valid on a server with count databases.
+func checkDBIndex(db, count int) error {
+	if db < 0 || db >= count {
+		return fmt.Errorf("redis db %d out of range, server has %d databases (0-%d)", db, count, count-1)
+	}
+	return nil
+}
+
+// redisURLWithDB points a Redis URL at database db: the path of a TCP URL,
+// or the db query parameter of a unix socket URL.
+func redisURLWithDB(redisURL string, db int) string {
+	parsed, err := url.Parse(redisURL)
+	if err != nil || parsed.Scheme == "" {
+		return redisURL
+	}
+	if parsed.Scheme == "unix" {
+		query := parsed.Query()
+		query.Set("db", strconv.Itoa(db))
+		parsed.RawQuery = query.Encode()
+		return parsed.String()
+	}
+	parsed.Path = "/" + strconv.Itoa(db)
+	parsed.RawPath = ""
+	return parsed.String()
+}
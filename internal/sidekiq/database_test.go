@@ -0,0 +1,143 @@
+package sidekiq
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type countingHook struct {
+	calls int
+}
+
+func (h *countingHook) DialHook(next redis.DialHook) redis.DialHook {
+	return next
+}
+
+func (h *countingHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		h.calls++
+		return next(ctx, cmd)
+	}
+}
+
+func (h *countingHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return next
+}
+
+func TestSelectDB_SwitchesDatabase(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx := testContext(t)
+	client.displayRedisURL = "redis://cache.internal:6379/0"
+	hook := &countingHook{}
+	client.AddHook(hook)
+	client.rememberUndo(&undoMove{jid: "a"})
+
+	_, _ = mr.DB(3).Lpush("queue:default", `{"jid":"other-db"}`)
+
+	if err := client.SelectDB(ctx, 3); err != nil {
+		t.Fatalf("SelectDB failed: %v", err)
+	}
+	if client.DB() != 3 {
+		t.Fatalf("DB() = %d, want 3", client.DB())
+	}
+	if got := client.DisplayRedisURL(); got != "redis://cache.internal:6379/3" {
+		t.Fatalf("DisplayRedisURL() = %q, want db 3", got)
+	}
+	size, err := client.NewQueue("default").Size(ctx)
+	if err != nil {
+		t.Fatalf("Size failed: %v", err)
+	}
+	if size != 1 {
+		t.Fatalf("queue size = %d, want the job from db 3", size)
+	}
+	if hook.calls == 0 {
+		t.Fatal("hook was not carried over to the new connection")
+	}
+	if _, ok := client.LastUndo(); ok {
+		t.Fatal("undo history survived the switch")
+	}
+}
+
+func TestSelectDB_ForgetsDatabaseState(t *testing.T) {
+	_, client := setupTestRedis(t)
+	ctx := testContext(t)
+	client.cacheVersion(client.rdb(), Version8)
+	client.capabilityProbe.probed = true
+	client.capabilityProbe.memoryUsageDenied = true
+	client.clockSkew.Store(int64(time.Minute))
+
+	if err := client.SelectDB(ctx, 2); err != nil {
+		t.Fatalf("SelectDB failed: %v", err)
+	}
+	if _, ok := client.cachedVersion(); ok {
+		t.Fatal("detected version survived the switch")
+	}
+	if client.capabilityProbe.probed || client.capabilityProbe.memoryUsageDenied {
+		t.Fatal("capability probe survived the switch")
+	}
+	if skew := client.clockSkew.Load(); skew != 0 {
+		t.Fatalf("clock skew = %v after the switch, want 0", time.Duration(skew))
+	}
+}
+
+func TestSelectDB_ConcurrentDetectVersion(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx := testContext(t)
+	_ = mr.Set("j|260101|12:00", "some-data")
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for range 20 {
+			client.DetectVersion(ctx)
+		}
+	}()
+	for db := range 4 {
+		if err := client.SelectDB(ctx, db); err != nil {
+			t.Fatalf("SelectDB(%d) failed: %v", db, err)
+		}
+	}
+	<-done
+}
+
+func TestSelectDB_RejectsInvalidIndex(t *testing.T) {
+	_, client := setupTestRedis(t)
+	ctx := testContext(t)
+
+	if err := client.SelectDB(ctx, -1); err == nil {
+		t.Fatal("SelectDB accepted a negative index")
+	}
+	if err := checkDBIndex(16, 16); err == nil {
+		t.Fatal("checkDBIndex accepted an index past the databases setting")
+	}
+	if err := checkDBIndex(15, 16); err != nil {
+		t.Fatalf("checkDBIndex rejected the last database: %v", err)
+	}
+	if client.DB() != 0 {
+		t.Fatalf("DB() = %d after failed switches, want 0", client.DB())
+	}
+	if err := client.NewQueue("default").Clear(ctx); err != nil {
+		t.Fatalf("old connection unusable after failed switch: %v", err)
+	}
+}
+
+func TestRedisURLWithDB(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{url: "redis://localhost:6379/0", want: "redis://localhost:6379/5"},
+		{url: "redis://localhost:6379", want: "redis://localhost:6379/5"},
+		{url: "rediss://user@host:6380/2?protocol=3", want: "rediss://user@host:6380/5?protocol=3"},
+		{url: "unix:///tmp/redis.sock?db=1", want: "unix:///tmp/redis.sock?db=5"},
+		{url: "", want: ""},
+	}
+	for _, tt := range tests {
+		if got := redisURLWithDB(tt.url, 5); got != tt.want {
+			t.Errorf("redisURLWithDB(%q, 5) = %q, want %q", tt.url, got, tt.want)
+		}
+	}
+}
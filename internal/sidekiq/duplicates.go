@@ -0,0 +1,75 @@
+package sidekiq
+
+import (
+	"context"
+	"errors"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// DuplicateScanLimit is the number of jobs FindDuplicateJIDs reads from each
+// sorted set and each queue.
+const DuplicateScanLimit = 10000
+
+// FindDuplicateJIDs maps every JID found more than once across the retry,
+// scheduled, and dead sets and the queues to the places it was found, such
+// as "retry", "dead", or "queue:default". A JID listed twice in one place
+// has that place twice.
+//
+// Only the first DuplicateScanLimit jobs of each place are read: sorted sets
+// in their natural order, queues from the end workers fetch next. Duplicates
+// deeper in larger sets and queues are missed.
+func (c *Client) FindDuplicateJIDs(ctx context.Context) (map[string][]string, error) {
+	queues, err := c.GetQueues(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	kinds := []SortedSetKind{SortedSetRetry, SortedSetScheduled, SortedSetDead}
+	locations := make([]string, 0, len(kinds)+len(queues))
+	cmds := make([]*redis.StringSliceCmd, 0, len(kinds)+len(queues))
+	_, err = c.rdb().Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, kind := range kinds {
+			spec, err := sortedSetSpecFor(kind)
+			if err != nil {
+				return err
+			}
+			var cmd *redis.StringSliceCmd
+			if spec.reverse {
+				cmd = pipe.ZRevRange(ctx, spec.key, 0, DuplicateScanLimit-1)
+			} else {
+				cmd = pipe.ZRange(ctx, spec.key, 0, DuplicateScanLimit-1)
+			}
+			locations = append(locations, kind.String())
+			cmds = append(cmds, cmd)
+		}
+		for _, queue := range queues {
+			// Sidekiq pushes to the head and pops from the tail.
+			locations = append(locations, queuePrefixKey+queue.Name())
+			cmds = append(cmds, pipe.LRange(ctx, queuePrefixKey+queue.Name(), -DuplicateScanLimit, -1))
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+
+	seen := make(map[string][]string)
+	for i, cmd := range cmds {
+		for _, member := range cmd.Val() {
+			jid := NewJobRecord(member, "").JID()
+			if jid == "" {
+				continue
+			}
+			seen[jid] = append(seen[jid], locations[i])
+		}
+	}
+
+	duplicates := make(map[string][]string)
+	for jid, found := range seen {
+		if len(found) > 1 {
+			duplicates[jid] = found
+		}
+	}
+	return duplicates, nil
+}
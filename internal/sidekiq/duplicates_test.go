@@ -0,0 +1,52 @@
+package sidekiq
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestFindDuplicateJIDs(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx := testContext(t)
+
+	_, _ = mr.ZAdd("retry", testScoreA, `{"jid":"dup1","class":"MyJob","retry_count":1}`)
+	_, _ = mr.ZAdd("dead", testScoreB, `{"jid":"dup1","class":"MyJob","retry_count":25}`)
+	_, _ = mr.ZAdd("dead", testScoreC, `{"jid":"unique","class":"MyJob"}`)
+	_, _ = mr.SetAdd("queues", "default")
+	// The same payload enqueued twice is a duplicate within one place.
+	_, _ = mr.Lpush("queue:default", `{"jid":"dup2","class":"MyJob"}`)
+	_, _ = mr.Lpush("queue:default", `{"jid":"dup2","class":"MyJob"}`)
+	_, _ = mr.Lpush("queue:default", `{"jid":"once","class":"MyJob"}`)
+	_, _ = mr.Lpush("queue:default", `not json`)
+
+	duplicates, err := client.FindDuplicateJIDs(ctx)
+	if err != nil {
+		t.Fatalf("FindDuplicateJIDs failed: %v", err)
+	}
+
+	if len(duplicates) != 2 {
+		t.Fatalf("duplicates = %v, want dup1 and dup2", duplicates)
+	}
+	if got := duplicates["dup1"]; !slices.Equal(got, []string{"retry", "dead"}) {
+		t.Fatalf("dup1 locations = %v, want [retry dead]", got)
+	}
+	if got := duplicates["dup2"]; !slices.Equal(got, []string{"queue:default", "queue:default"}) {
+		t.Fatalf("dup2 locations = %v, want queue:default twice", got)
+	}
+}
+
+func TestFindDuplicateJIDs_None(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx := testContext(t)
+
+	_, _ = mr.ZAdd("retry", testScoreA, `{"jid":"a","class":"MyJob"}`)
+	_, _ = mr.ZAdd("schedule", testScoreA, `{"jid":"b","class":"MyJob"}`)
+
+	duplicates, err := client.FindDuplicateJIDs(ctx)
+	if err != nil {
+		t.Fatalf("FindDuplicateJIDs failed: %v", err)
+	}
+	if len(duplicates) != 0 {
+		t.Fatalf("duplicates = %v, want none", duplicates)
+	}
+}
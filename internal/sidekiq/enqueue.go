@@ -0,0 +1,103 @@
+package sidekiq
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// MaxEnqueueCopies caps how many copies EnqueueJobCopies pushes in one call.
+const MaxEnqueueCopies = 1000
+
+// jobCopyResetFields are dropped from copies so each one starts as a fresh
+// job: retry state would make Sidekiq continue the original's retry count,
+// and a batch ID would skew the batch's pending and total counters.
+var jobCopyResetFields = []string{
+	"at",
+	"bid",
+	"error_backtrace",
+	"error_class",
+	"error_message",
+	"failed_at",
+	"retried_at",
+	"retry_count",
+}
+
+// EnqueueJobCopies pushes count copies of job onto its queue and returns how
+// many were enqueued. Each copy gets a new jid and fresh created_at and
+// enqueued_at timestamps; count is capped at MaxEnqueueCopies. All copies are
+// written in a single pipeline.
+func (c *Client) EnqueueJobCopies(ctx context.Context, job *JobRecord, count int) (enqueued int, err error) {
+	defer func() {
+		var queue, jid string
+		if job != nil {
+			queue, jid = job.Queue(), job.JID()
+		}
+		c.recordActivity(ActivityEnqueueCopies, queue, jid, strconv.Itoa(enqueued)+" copies", err)
+	}()
+	if err := c.checkWritable(); err != nil {
+		return 0, err
+	}
+	if job == nil || job.Value() == "" {
+		return 0, errors.New("job payload is empty")
+	}
+	if count <= 0 {
+		return 0, errors.New("copy count must be positive")
+	}
+	count = min(count, MaxEnqueueCopies)
+
+	payload := make(map[string]any)
+	if err := safeParseJSON([]byte(job.Value()), &payload); err != nil {
+		return 0, err
+	}
+	queueName, _ := payload["queue"].(string)
+	if strings.TrimSpace(queueName) == "" {
+		return 0, errors.New("job payload missing queue")
+	}
+
+	format := detectTimestampFormat(payload, c.DetectVersion(ctx))
+	for _, field := range jobCopyResetFields {
+		delete(payload, field)
+	}
+	payload["created_at"] = nowTimestamp(format)
+	payload["enqueued_at"] = nowTimestamp(format)
+
+	values := make([]any, count)
+	for i := range values {
+		jid, err := newJID()
+		if err != nil {
+			return 0, err
+		}
+		payload["jid"] = jid
+		encoded, err := json.Marshal(payload)
+		if err != nil {
+			return 0, err
+		}
+		values[i] = encoded
+	}
+
+	_, err = c.rdb().TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.SAdd(ctx, queueSetKey, queueName)
+		pipe.LPush(ctx, queuePrefixKey+queueName, values...)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// newJID returns a random job ID in Sidekiq's format (12 random bytes, hex).
+func newJID() (string, error) {
+	var buf [12]byte
+	if _, err := rand.Read(buf[:]); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf[:]), nil
+}
@@ -0,0 +1,105 @@
+package sidekiq
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestEnqueueJobCopies(t *testing.T) {
+	_, client := setupTestRedis(t)
+	ctx := context.Background()
+
+	originalNow := nowFuncSidekiq
+	nowFuncSidekiq = func() time.Time {
+		return time.Unix(1700000000, 0)
+	}
+	t.Cleanup(func() { nowFuncSidekiq = originalNow })
+
+	jobJSON := `{"jid":"original","class":"MyJob","queue":"load","args":[1,"two"],"bid":"b-1","retry_count":2,"error_class":"RuntimeError","error_message":"boom","failed_at":1699990000.0,"created_at":1699990000.0,"enqueued_at":1699990000.0}`
+	job := NewJobRecord(jobJSON, "")
+
+	enqueued, err := client.EnqueueJobCopies(ctx, job, 3)
+	if err != nil {
+		t.Fatalf("EnqueueJobCopies failed: %v", err)
+	}
+	if enqueued != 3 {
+		t.Fatalf("enqueued = %d, want 3", enqueued)
+	}
+
+	if ok, _ := client.redis.SIsMember(ctx, "queues", "load").Result(); !ok {
+		t.Fatal("queue load not registered in queues set")
+	}
+	queued, err := client.redis.LRange(ctx, "queue:load", 0, -1).Result()
+	if err != nil || len(queued) != 3 {
+		t.Fatalf("queue = %v (err %v), want three jobs", queued, err)
+	}
+
+	seen := make(map[string]bool)
+	for _, raw := range queued {
+		var payload map[string]any
+		if err := safeParseJSON([]byte(raw), &payload); err != nil {
+			t.Fatalf("safeParseJSON queued payload: %v", err)
+		}
+		jid, _ := payload["jid"].(string)
+		if len(jid) != 24 || jid == "original" || seen[jid] {
+			t.Fatalf("jid = %q, want a new unique 24-char jid", jid)
+		}
+		seen[jid] = true
+
+		if got := payload["class"]; got != "MyJob" {
+			t.Fatalf("class = %v, want MyJob", got)
+		}
+		if got := payload["enqueued_at"]; got != json.Number("1700000000") {
+			t.Fatalf("enqueued_at = %v, want 1700000000", got)
+		}
+		if got := payload["created_at"]; got != json.Number("1700000000") {
+			t.Fatalf("created_at = %v, want 1700000000", got)
+		}
+		for _, field := range []string{"bid", "retry_count", "error_class", "error_message", "failed_at"} {
+			if _, ok := payload[field]; ok {
+				t.Fatalf("copy kept %s, want it dropped", field)
+			}
+		}
+	}
+}
+
+func TestEnqueueJobCopies_CapsCount(t *testing.T) {
+	_, client := setupTestRedis(t)
+	ctx := context.Background()
+
+	job := NewJobRecord(`{"jid":"original","class":"MyJob","queue":"default","args":[]}`, "")
+	enqueued, err := client.EnqueueJobCopies(ctx, job, MaxEnqueueCopies+50)
+	if err != nil {
+		t.Fatalf("EnqueueJobCopies failed: %v", err)
+	}
+	if enqueued != MaxEnqueueCopies {
+		t.Fatalf("enqueued = %d, want %d", enqueued, MaxEnqueueCopies)
+	}
+	if size, _ := client.redis.LLen(ctx, "queue:default").Result(); size != MaxEnqueueCopies {
+		t.Fatalf("queue size = %d, want %d", size, MaxEnqueueCopies)
+	}
+}
+
+func TestEnqueueJobCopies_RejectsInvalidInput(t *testing.T) {
+	_, client := setupTestRedis(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		job   *JobRecord
+		count int
+	}{
+		{name: "nil job", job: nil, count: 1},
+		{name: "zero count", job: NewJobRecord(`{"jid":"a","class":"MyJob","queue":"default"}`, ""), count: 0},
+		{name: "missing queue", job: NewJobRecord(`{"jid":"a","class":"MyJob"}`, ""), count: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := client.EnqueueJobCopies(ctx, tt.job, tt.count); err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package sidekiq
+
+import (
+	"strings"
+	"unicode"
+)
+
+// errorFilter is an Errors view query split into field tokens, such as
+// `class:Mailer queue:default msg:"connection reset"`, and plain terms.
+// Every token must match for an entry to be kept.
+type errorFilter struct {
+	terms    []string // Substrings of the raw job payload
+	classes  []string // Substrings of the display class
+	errors   []string // Substrings of the error class
+	queues   []string // Exact queue names
+	messages []string // Substrings of the error message
+}
+
+// parseErrorFilter splits query on whitespace, keeping double-quoted runs
+// together. Tokens with a known field prefix (class:, error:, queue:, msg:)
+// match that field; anything else, such as "Net::ReadTimeout", is a plain
+// term.
+func parseErrorFilter(query string) errorFilter {
+	var filter errorFilter
+	for _, token := range splitFilterTokens(query) {
+		field, value, ok := strings.Cut(token, ":")
+		if !ok || value == "" {
+			filter.terms = append(filter.terms, token)
+			continue
+		}
+		switch field {
+		case "class":
+			filter.classes = append(filter.classes, value)
+		case "error":
+			filter.errors = append(filter.errors, value)
+		case "queue":
+			filter.queues = append(filter.queues, value)
+		case "msg":
+			filter.messages = append(filter.messages, value)
+		default:
+			filter.terms = append(filter.terms, token)
+		}
+	}
+	return filter
+}
+
+// splitFilterTokens splits query on unquoted whitespace and drops the double
+// quotes, so `msg:"connection reset"` is one token. An unterminated quote
+// runs to the end of the query.
+func splitFilterTokens(query string) []string {
+	var tokens []string
+	var token strings.Builder
+	inToken, quoted := false, false
+	for _, r := range query {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			inToken = true
+		case unicode.IsSpace(r) && !quoted:
+			if inToken {
+				tokens = append(tokens, token.String())
+				token.Reset()
+				inToken = false
+			}
+		default:
+			token.WriteRune(r)
+			inToken = true
+		}
+	}
+	if inToken && token.Len() > 0 {
+		tokens = append(tokens, token.String())
+	}
+	return tokens
+}
+
+// scanMatch returns the ZSCAN pattern that narrows the scan. The first plain
+// term is passed through as before, so it may use glob wildcards; otherwise
+// a field value is matched literally against the raw payload.
+func (f errorFilter) scanMatch() string {
+	if len(f.terms) > 0 {
+		return f.terms[0]
+	}
+	for _, values := range [][]string{f.queues, f.errors, f.classes, f.messages} {
+		for _, value := range values {
+			// Quotes and backslashes are escaped in the JSON payload, so
+			// the raw text would not match it.
+			if !strings.ContainsAny(value, `"\`) {
+				return "*" + escapeGlob(value) + "*"
+			}
+		}
+	}
+	return ""
+}
+
+// matches reports whether entry satisfies every token. The first plain term
+// is left to the scan pattern.
+func (f errorFilter) matches(entry *SortedEntry) bool {
+	if entry == nil || entry.JobRecord == nil {
+		return false
+	}
+	if len(f.terms) > 1 {
+		raw := entry.Value()
+		for _, term := range f.terms[1:] {
+			if !strings.Contains(raw, term) {
+				return false
+			}
+		}
+	}
+	return containsAll(entry.DisplayClass(), f.classes) &&
+		containsAll(entry.ErrorClass(), f.errors) &&
+		containsAll(errorMessageOnly(entry), f.messages) &&
+		equalsAll(entry.Queue(), f.queues)
+}
+
+func containsAll(value string, substrings []string) bool {
+	for _, substring := range substrings {
+		if !strings.Contains(value, substring) {
+			return false
+		}
+	}
+	return true
+}
+
+func equalsAll(value string, wanted []string) bool {
+	for _, want := range wanted {
+		if value != want {
+			return false
+		}
+	}
+	return true
+}
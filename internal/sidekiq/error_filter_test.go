@@ -0,0 +1,162 @@
+package sidekiq
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestParseErrorFilter(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  errorFilter
+	}{
+		{
+			name:  "empty",
+			query: "  ",
+			want:  errorFilter{},
+		},
+		{
+			name:  "plain terms",
+			query: "needle  haystack",
+			want:  errorFilter{terms: []string{"needle", "haystack"}},
+		},
+		{
+			name:  "fields",
+			query: "class:Mailer error:Timeout queue:default msg:reset",
+			want: errorFilter{
+				classes:  []string{"Mailer"},
+				errors:   []string{"Timeout"},
+				queues:   []string{"default"},
+				messages: []string{"reset"},
+			},
+		},
+		{
+			name:  "quoted value with spaces",
+			query: `msg:"connection reset by peer" queue:default`,
+			want: errorFilter{
+				queues:   []string{"default"},
+				messages: []string{"connection reset by peer"},
+			},
+		},
+		{
+			name:  "quoted plain term",
+			query: `"dead cleanup" class:CleanupJob`,
+			want: errorFilter{
+				terms:   []string{"dead cleanup"},
+				classes: []string{"CleanupJob"},
+			},
+		},
+		{
+			name:  "unknown prefix stays a term",
+			query: "Net::ReadTimeout jid:abc",
+			want:  errorFilter{terms: []string{"Net::ReadTimeout", "jid:abc"}},
+		},
+		{
+			name:  "empty value stays a term",
+			query: "class:",
+			want:  errorFilter{terms: []string{"class:"}},
+		},
+		{
+			name:  "unterminated quote",
+			query: `msg:"connection reset`,
+			want:  errorFilter{messages: []string{"connection reset"}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := parseErrorFilter(tc.query)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("parseErrorFilter(%q) = %#v, want %#v", tc.query, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestErrorFilterScanMatch(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{query: "", want: ""},
+		{query: "need*le class:Mailer", want: "need*le"},
+		{query: "class:Mailer queue:default", want: "*default*"},
+		{query: "msg:[1]", want: `*\[1\]*`},
+		{query: `msg:"say \"hi\""`, want: ""},
+	}
+
+	for _, tc := range tests {
+		if got := parseErrorFilter(tc.query).scanMatch(); got != tc.want {
+			t.Fatalf("scanMatch(%q) = %q, want %q", tc.query, got, tc.want)
+		}
+	}
+}
+
+func TestGetErrorSummaryCombinedFilters(t *testing.T) {
+	ctx := testContext(t)
+	client, mr := newErrorsTestClient(t)
+
+	for i := range 30 {
+		addSortedSetJob(t, mr, deadSetKey, float64(i+1), errorPayload(
+			fmt.Sprintf("dead-mail-%03d", i),
+			"MailJob",
+			"mailers",
+			"TimeoutError",
+			"connection reset by peer",
+			"needle",
+		))
+	}
+	for i := range 20 {
+		addSortedSetJob(t, mr, retrySetKey, float64(i+1), errorPayload(
+			fmt.Sprintf("retry-mail-%03d", i),
+			"MailJob",
+			"default",
+			"TimeoutError",
+			"connection reset by peer",
+			"needle",
+		))
+	}
+	for i := range 10 {
+		addSortedSetJob(t, mr, retrySetKey, float64(100+i), errorPayload(
+			fmt.Sprintf("retry-refused-%03d", i),
+			"MailJob",
+			"mailers",
+			"TimeoutError",
+			"connection refused",
+			"",
+		))
+	}
+
+	rows, meta, err := client.GetErrorSummary(ctx, `class:Mail queue:mailers msg:"reset by peer"`)
+	if err != nil {
+		t.Fatalf("GetErrorSummary failed: %v", err)
+	}
+	if meta.DeadCount != 30 || meta.RetryCount != 0 {
+		t.Fatalf("meta = %+v, want 30 dead and 0 retries", meta)
+	}
+	if len(rows) != 1 || rows[0].Queue != "mailers" || rows[0].Count != 30 {
+		t.Fatalf("rows = %+v, want one mailers row of 30", rows)
+	}
+
+	rows, meta, err = client.GetErrorSummary(ctx, "needle error:Timeout queue:default")
+	if err != nil {
+		t.Fatalf("GetErrorSummary failed: %v", err)
+	}
+	if meta.DeadCount != 0 || meta.RetryCount != 20 || len(rows) != 1 {
+		t.Fatalf("meta = %+v, rows = %+v, want 20 retries in one row", meta, rows)
+	}
+
+	window, err := client.GetErrorGroupWindow(ctx, ErrorGroupKey{
+		DisplayClass: "MailJob",
+		ErrorClass:   "TimeoutError",
+		Queue:        "mailers",
+	}, `msg:"connection refused"`, 0, 5)
+	if err != nil {
+		t.Fatalf("GetErrorGroupWindow failed: %v", err)
+	}
+	if window.Total != 10 || len(window.Entries) != 5 {
+		t.Fatalf("window total = %d, entries = %d, want 10 and 5", window.Total, len(window.Entries))
+	}
+}
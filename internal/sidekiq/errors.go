@@ -41,6 +41,12 @@ type ErrorGroupWindow struct {
 	WindowStart int
 }
 
+// ErrorClassCount is the number of jobs that failed with one error class.
+type ErrorClassCount struct {
+	ErrorClass string
+	Count      int64
+}
+
 type errorSummaryState struct {
 	row    ErrorSummaryRow
 	source string
@@ -51,8 +57,13 @@ type errorSummaryState struct {
 func (c *Client) GetErrorSummary(ctx context.Context, query string) ([]ErrorSummaryRow, ErrorSummaryMeta, error) {
 	rowsByKey := make(map[ErrorGroupKey]*errorSummaryState)
 	meta := ErrorSummaryMeta{}
+	filter := parseErrorFilter(query)
+	match := filter.scanMatch()
 
-	if err := c.scanSortedSetEntries(ctx, deadSetKey, query, func(entry *SortedEntry) error {
+	if err := c.scanSortedSetEntries(ctx, deadSetKey, match, func(entry *SortedEntry) error {
+		if !filter.matches(entry) {
+			return nil
+		}
 		meta.DeadCount++
 		addErrorSummaryEntry(rowsByKey, entry, "dead")
 		return nil
@@ -60,7 +71,10 @@ func (c *Client) GetErrorSummary(ctx context.Context, query string) ([]ErrorSumm
 		return nil, ErrorSummaryMeta{}, err
 	}
 
-	if err := c.scanSortedSetEntries(ctx, retrySetKey, query, func(entry *SortedEntry) error {
+	if err := c.scanSortedSetEntries(ctx, retrySetKey, match, func(entry *SortedEntry) error {
+		if !filter.matches(entry) {
+			return nil
+		}
 		meta.RetryCount++
 		addErrorSummaryEntry(rowsByKey, entry, "retry")
 		return nil
@@ -79,6 +93,39 @@ func (c *Client) GetErrorSummary(ctx context.Context, query string) ([]ErrorSumm
 	return rows, meta, nil
 }
 
+// GetErrorClassCounts tallies dead jobs, and retries when includeRetries is
+// set, by error class, grouped like the Errors summary. The most frequent
+// class comes first.
+func (c *Client) GetErrorClassCounts(ctx context.Context, includeRetries bool) ([]ErrorClassCount, error) {
+	counts := make(map[string]int64)
+	tally := func(entry *SortedEntry) error {
+		counts[normalizedErrorGroupKeyFromEntry(entry).ErrorClass]++
+		return nil
+	}
+
+	if err := c.scanSortedSetEntries(ctx, deadSetKey, "", tally); err != nil {
+		return nil, err
+	}
+	if includeRetries {
+		if err := c.scanSortedSetEntries(ctx, retrySetKey, "", tally); err != nil {
+			return nil, err
+		}
+	}
+
+	rows := make([]ErrorClassCount, 0, len(counts))
+	for errorClass, count := range counts {
+		rows = append(rows, ErrorClassCount{ErrorClass: errorClass, Count: count})
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].Count != rows[j].Count {
+			return rows[i].Count > rows[j].Count
+		}
+		return rows[i].ErrorClass < rows[j].ErrorClass
+	})
+
+	return rows, nil
+}
+
 // GetErrorGroupWindow fetches one exact paged error group window across dead and retry sets.
 func (c *Client) GetErrorGroupWindow(
 	ctx context.Context,
@@ -116,9 +163,10 @@ func (c *Client) getErrorGroupWindow(
 	query string,
 	start, count int,
 ) (ErrorGroupWindow, error) {
-	match := errorGroupScanMatch(key, query)
+	filter := parseErrorFilter(query)
+	match := errorGroupScanMatch(key, filter)
 
-	deadEntries, deadTotal, err := c.collectErrorGroupEntries(ctx, deadSetKey, match, true, key, start, count)
+	deadEntries, deadTotal, err := c.collectErrorGroupEntries(ctx, deadSetKey, match, filter, true, key, start, count)
 	if err != nil {
 		return ErrorGroupWindow{}, err
 	}
@@ -129,7 +177,7 @@ func (c *Client) getErrorGroupWindow(
 		retryCount = max(count-len(deadEntries), 0)
 	}
 
-	retryEntries, retryTotal, err := c.collectErrorGroupEntries(ctx, retrySetKey, match, false, key, retryStart, retryCount)
+	retryEntries, retryTotal, err := c.collectErrorGroupEntries(ctx, retrySetKey, match, filter, false, key, retryStart, retryCount)
 	if err != nil {
 		return ErrorGroupWindow{}, err
 	}
@@ -151,6 +199,7 @@ func (c *Client) getErrorGroupWindow(
 func (c *Client) collectErrorGroupEntries(
 	ctx context.Context,
 	setKey, match string,
+	filter errorFilter,
 	reverse bool,
 	groupKey ErrorGroupKey,
 	start, count int,
@@ -168,7 +217,7 @@ func (c *Client) collectErrorGroupEntries(
 	total := int64(0)
 	selected := make([]*SortedEntry, 0, max(min(limit, int(sortedSetScanCount)), 0))
 	err := c.scanSortedSetEntries(ctx, setKey, match, func(entry *SortedEntry) error {
-		if normalizedErrorGroupKeyFromEntry(entry) != groupKey {
+		if normalizedErrorGroupKeyFromEntry(entry) != groupKey || !filter.matches(entry) {
 			return nil
 		}
 
@@ -254,9 +303,9 @@ func errorSummaryRowBefore(a, b ErrorSummaryRow) bool {
 	return a.ErrorMessage < b.ErrorMessage
 }
 
-func errorGroupScanMatch(key ErrorGroupKey, query string) string {
-	if query != "" {
-		return query
+func errorGroupScanMatch(key ErrorGroupKey, filter errorFilter) string {
+	if match := filter.scanMatch(); match != "" {
+		return match
 	}
 	if key.ErrorClass != "" && key.ErrorClass != "unknown" {
 		return key.ErrorClass
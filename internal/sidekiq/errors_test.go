@@ -2,6 +2,7 @@ package sidekiq
 
 import (
 	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/alicebob/miniredis/v2"
@@ -201,6 +202,50 @@ func TestGetErrorGroupWindowPagedAcrossDeadAndRetry(t *testing.T) {
 	}
 }
 
+func TestGetErrorClassCounts(t *testing.T) {
+	ctx := testContext(t)
+	client, mr := newErrorsTestClient(t)
+
+	for i := range 3 {
+		addSortedSetJob(t, mr, deadSetKey, float64(i+1), errorPayload(
+			fmt.Sprintf("dead-timeout-%d", i), "MailJob", "mailers", "TimeoutError", "timeout", "",
+		))
+	}
+	addSortedSetJob(t, mr, deadSetKey, 10, errorPayload("dead-argument", "CleanupJob", "default", "ArgumentError", "bad", ""))
+	addSortedSetJob(t, mr, deadSetKey, 11, errorPayload("dead-unknown", "CleanupJob", "default", " ", "bad", ""))
+	for i := range 4 {
+		addSortedSetJob(t, mr, retrySetKey, float64(i+1), errorPayload(
+			fmt.Sprintf("retry-argument-%d", i), "CleanupJob", "default", "ArgumentError", "bad", "",
+		))
+	}
+
+	counts, err := client.GetErrorClassCounts(ctx, false)
+	if err != nil {
+		t.Fatalf("GetErrorClassCounts failed: %v", err)
+	}
+	want := []ErrorClassCount{
+		{ErrorClass: "TimeoutError", Count: 3},
+		{ErrorClass: "ArgumentError", Count: 1},
+		{ErrorClass: "unknown", Count: 1},
+	}
+	if !slices.Equal(counts, want) {
+		t.Fatalf("dead counts = %v, want %v", counts, want)
+	}
+
+	counts, err = client.GetErrorClassCounts(ctx, true)
+	if err != nil {
+		t.Fatalf("GetErrorClassCounts with retries failed: %v", err)
+	}
+	want = []ErrorClassCount{
+		{ErrorClass: "ArgumentError", Count: 5},
+		{ErrorClass: "TimeoutError", Count: 3},
+		{ErrorClass: "unknown", Count: 1},
+	}
+	if !slices.Equal(counts, want) {
+		t.Fatalf("dead and retry counts = %v, want %v", counts, want)
+	}
+}
+
 func newErrorsTestClient(t *testing.T) (*Client, *miniredis.Miniredis) {
 	t.Helper()
 
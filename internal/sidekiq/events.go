@@ -0,0 +1,54 @@
+package sidekiq
+
+import (
+	"context"
+	"time"
+)
+
+// eventsBuffer is how many events SubscribeEvents holds for a slow reader.
+const eventsBuffer = 100
+
+// Event is a message published on an events channel, such as a process
+// lifecycle notification.
+type Event struct {
+	Time    time.Time
+	Channel string
+	Payload string
+}
+
+// SubscribeEvents subscribes to a Redis pub/sub channel and delivers its
+// messages until ctx is canceled, when the returned channel is closed.
+// A channel nobody publishes on simply delivers nothing. After a dropped
+// connection the subscription reconnects and subscribes again; messages
+// published in between are lost, as pub/sub does not keep them.
+func (c *Client) SubscribeEvents(ctx context.Context, channel string) <-chan Event {
+	// Subscribe only fails when the connection does, and the pub/sub
+	// channel below retries until it succeeds.
+	pubsub := c.rdb().Subscribe(ctx, channel)
+	messages := pubsub.Channel()
+	events := make(chan Event, eventsBuffer)
+
+	go func() {
+		defer close(events)
+		defer func() {
+			_ = pubsub.Close()
+		}()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-messages:
+				if !ok {
+					return
+				}
+				event := Event{Time: time.Now(), Channel: msg.Channel, Payload: msg.Payload}
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return events
+}
@@ -0,0 +1,47 @@
+package sidekiq
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestSubscribeEvents(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx, cancel := context.WithCancel(testContext(t))
+	defer cancel()
+
+	events := client.SubscribeEvents(ctx, "sidekiq:events")
+
+	// The subscription is set up in the background, so publish until a
+	// subscriber receives the message.
+	deadline := time.Now().Add(2 * time.Second)
+	for mr.Publish("sidekiq:events", "worker-1 started") == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("no subscriber on sidekiq:events")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	select {
+	case event := <-events:
+		if event.Channel != "sidekiq:events" || event.Payload != "worker-1 started" {
+			t.Fatalf("event = %+v, want worker-1 started on sidekiq:events", event)
+		}
+		if event.Time.IsZero() {
+			t.Fatal("event time is zero")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("no event received")
+	}
+
+	cancel()
+	select {
+	case _, ok := <-events:
+		if ok {
+			t.Fatal("events channel delivered after cancel, want it closed")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("events channel not closed after cancel")
+	}
+}
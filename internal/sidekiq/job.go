@@ -5,7 +5,9 @@ import (
 	"compress/zlib"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"strings"
 	"time"
 )
@@ -104,7 +106,7 @@ func (jr *JobRecord) DisplayArgs() []any {
 
 	klass := jr.Klass()
 	if isActiveJobWrapper(klass) {
-		jr.displayArgs = jr.unwrapActiveJobArgs()
+		jr.displayArgs = jr.redactDisplayArgs(jr.unwrapActiveJobArgs())
 		jr.displayArgsLoaded = true
 		return jr.displayArgs
 	}
@@ -124,11 +126,21 @@ func (jr *JobRecord) DisplayArgs() []any {
 		displayArgs[len(displayArgs)-1] = "[encrypted data]"
 	}
 
-	jr.displayArgs = displayArgs
+	jr.displayArgs = jr.redactDisplayArgs(displayArgs)
 	jr.displayArgsLoaded = true
 	return jr.displayArgs
 }
 
+// redactDisplayArgs masks configured sensitive keys inside args.
+func (jr *JobRecord) redactDisplayArgs(args []any) []any {
+	keys := currentRedactedArgKeys()
+	if len(keys) == 0 || len(args) == 0 {
+		return args
+	}
+	redacted, _ := redactArgs(args, keys).([]any)
+	return redacted
+}
+
 // Context returns the current attributes (cattr) for the job.
 func (jr *JobRecord) Context() map[string]any {
 	jr.ensureParsed()
@@ -258,19 +270,12 @@ func (jr *JobRecord) ErrorBacktrace() []string {
 		jr.errorBacktrace = lines
 		return jr.errorBacktrace
 	case string:
-		decoded, err := base64.StdEncoding.DecodeString(raw)
-		if err != nil {
-			return nil
-		}
-		reader, err := zlib.NewReader(bytes.NewReader(decoded))
+		decoded, err := DecodeCompressed(raw)
 		if err != nil {
 			return nil
 		}
-		defer func() {
-			_ = reader.Close()
-		}()
 		var backtrace []string
-		if err := json.NewDecoder(reader).Decode(&backtrace); err != nil {
+		if err := json.Unmarshal(decoded, &backtrace); err != nil {
 			return nil
 		}
 		jr.errorBacktrace = backtrace
@@ -280,6 +285,39 @@ func (jr *JobRecord) ErrorBacktrace() []string {
 	}
 }
 
+// MaxDecodedSize caps how many bytes DecodeCompressed inflates, so a
+// malicious or corrupt blob cannot exhaust memory.
+const MaxDecodedSize = 1 << 20
+
+// ErrDecodedTooLarge is returned by DecodeCompressed when the inflated data
+// exceeds MaxDecodedSize.
+var ErrDecodedTooLarge = errors.New("decoded data exceeds 1 MiB")
+
+// DecodeCompressed reverses the encoding Sidekiq uses for compressed
+// backtraces: base64 around a zlib stream. It returns the inflated bytes.
+func DecodeCompressed(raw string) ([]byte, error) {
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(raw))
+	if err != nil {
+		return nil, fmt.Errorf("decode base64: %w", err)
+	}
+	reader, err := zlib.NewReader(bytes.NewReader(decoded))
+	if err != nil {
+		return nil, fmt.Errorf("inflate zlib: %w", err)
+	}
+	defer func() {
+		_ = reader.Close()
+	}()
+
+	data, err := io.ReadAll(io.LimitReader(reader, MaxDecodedSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("inflate zlib: %w", err)
+	}
+	if len(data) > MaxDecodedSize {
+		return nil, ErrDecodedTooLarge
+	}
+	return data, nil
+}
+
 // Latency returns the time since enqueue/create in seconds.
 func (jr *JobRecord) Latency() float64 {
 	enqueuedAt := jr.EnqueuedAt()
@@ -5,6 +5,7 @@ import (
 	"compress/zlib"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"reflect"
 	"strconv"
 	"testing"
@@ -304,6 +305,29 @@ func TestJobRecord_ErrorBacktrace(t *testing.T) {
 	}
 }
 
+func TestDecodeCompressed(t *testing.T) {
+	payload := []byte(`{"tenant":42}`)
+	got, err := DecodeCompressed(compressBytes(t, payload))
+	if err != nil {
+		t.Fatalf("DecodeCompressed failed: %v", err)
+	}
+	if !bytes.Equal(got, payload) {
+		t.Fatalf("DecodeCompressed() = %q, want %q", got, payload)
+	}
+
+	if _, err := DecodeCompressed("not base64!"); err == nil {
+		t.Fatal("DecodeCompressed accepted invalid base64")
+	}
+	if _, err := DecodeCompressed(base64.StdEncoding.EncodeToString([]byte("plain text"))); err == nil {
+		t.Fatal("DecodeCompressed accepted data that is not zlib")
+	}
+
+	huge := compressBytes(t, bytes.Repeat([]byte{'a'}, MaxDecodedSize+1))
+	if _, err := DecodeCompressed(huge); !errors.Is(err, ErrDecodedTooLarge) {
+		t.Fatalf("DecodeCompressed(huge) error = %v, want ErrDecodedTooLarge", err)
+	}
+}
+
 func encodeBacktrace(t *testing.T, backtrace []string) string {
 	t.Helper()
 
@@ -311,6 +335,11 @@ func encodeBacktrace(t *testing.T, backtrace []string) string {
 	if err != nil {
 		t.Fatalf("json.Marshal: %v", err)
 	}
+	return compressBytes(t, raw)
+}
+
+func compressBytes(t *testing.T, raw []byte) string {
+	t.Helper()
 
 	var buf bytes.Buffer
 	writer := zlib.NewWriter(&buf)
@@ -0,0 +1,110 @@
+package sidekiq
+
+import (
+	"context"
+	"errors"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// JobLocationKind identifies where LocateJob found a job.
+type JobLocationKind int
+
+const (
+	// JobLocationNone means the job is in none of the places checked.
+	JobLocationNone JobLocationKind = iota
+	// JobLocationQueue means the job is waiting in a queue.
+	JobLocationQueue
+	// JobLocationBusy means a process is running the job.
+	JobLocationBusy
+	// JobLocationRetry means the job failed and waits in the retry set.
+	JobLocationRetry
+	// JobLocationScheduled means the job waits in the scheduled set.
+	JobLocationScheduled
+	// JobLocationDead means the job is in the dead set.
+	JobLocationDead
+)
+
+// JobLocation is where a job was found.
+type JobLocation struct {
+	Kind JobLocationKind
+	// Name is the queue holding the job, or the identity of the process
+	// running it. It is empty for the sorted sets.
+	Name string
+}
+
+// String describes the location, such as "queue:default" or "busy".
+func (l JobLocation) String() string {
+	switch l.Kind {
+	case JobLocationQueue:
+		return queuePrefixKey + l.Name
+	case JobLocationBusy:
+		return "busy"
+	case JobLocationRetry:
+		return SortedSetRetry.String()
+	case JobLocationScheduled:
+		return SortedSetScheduled.String()
+	case JobLocationDead:
+		return SortedSetDead.String()
+	default:
+		return "none"
+	}
+}
+
+// LocateJob reports where a job is now: waiting in its queue, running, or in
+// the retry, scheduled, or dead set. A job found nowhere is reported as
+// JobLocationNone. That does not prove the job finished: a worker that has
+// just fetched it only records it as running on its next heartbeat.
+//
+// The queue is searched with LPOS for the exact payload, so job must be the
+// record as it was pushed, such as the one EnqueueSortedEntryJob returns.
+// Places are checked in the order a job moves through them, so a job that
+// moves on between two checks is still found in the later one.
+func (c *Client) LocateJob(ctx context.Context, job *JobRecord) (JobLocation, error) {
+	if job == nil || job.JID() == "" {
+		return JobLocation{}, ErrJobNotFound
+	}
+	jid, queue := job.JID(), job.Queue()
+
+	if queue != "" {
+		err := c.rdb().LPos(ctx, queuePrefixKey+queue, job.Value(), redis.LPosArgs{}).Err()
+		if err == nil {
+			return JobLocation{Kind: JobLocationQueue, Name: queue}, nil
+		}
+		if !errors.Is(err, redis.Nil) {
+			return JobLocation{}, err
+		}
+	}
+
+	// The work payload is embedded as an escaped string, so filter on the
+	// bare JID and compare it exactly below.
+	busy, err := c.GetBusyData(ctx, jid)
+	if err != nil {
+		return JobLocation{}, err
+	}
+	for _, work := range busy.Jobs {
+		if work.JID() == jid {
+			return JobLocation{Kind: JobLocationBusy, Name: work.ProcessIdentity}, nil
+		}
+	}
+
+	sets := []struct {
+		kind     SortedSetKind
+		location JobLocationKind
+	}{
+		{SortedSetRetry, JobLocationRetry},
+		{SortedSetScheduled, JobLocationScheduled},
+		{SortedSetDead, JobLocationDead},
+	}
+	for _, set := range sets {
+		_, err := c.FindSortedEntry(ctx, set.kind, jid)
+		if err == nil {
+			return JobLocation{Kind: set.location}, nil
+		}
+		if !errors.Is(err, ErrJobNotFound) {
+			return JobLocation{}, err
+		}
+	}
+
+	return JobLocation{}, nil
+}
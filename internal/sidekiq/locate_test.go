@@ -0,0 +1,80 @@
+package sidekiq
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestLocateJob(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx := testContext(t)
+
+	queued := `{"jid":"queued","class":"MyJob","queue":"critical"}`
+	_, _ = mr.SetAdd("queues", "default", "critical")
+	_, _ = mr.Lpush("queue:critical", queued)
+	for i := range 10 {
+		_, _ = mr.Lpush("queue:critical", `{"jid":"other`+strconv.Itoa(i)+`","class":"MyJob"}`)
+	}
+	_, _ = mr.ZAdd("retry", testScoreA, `{"jid":"retrying","class":"MyJob"}`)
+	_, _ = mr.ZAdd("schedule", testScoreA, `{"jid":"later","class":"MyJob"}`)
+	_, _ = mr.ZAdd("dead", testScoreA, `{"jid":"died","class":"MyJob"}`)
+	_, _ = mr.SetAdd("processes", "host1:100:abc")
+	mr.HSet("host1:100:abc", "info", `{"hostname":"host1","pid":100}`)
+	mr.HSet("host1:100:abc:work", "tid1", string(mustMarshalJSON(t, map[string]any{
+		"queue":   "default",
+		"payload": `{"jid":"running","class":"MyJob"}`,
+		"run_at":  1234567800.0,
+	})))
+
+	tests := []struct {
+		name string
+		job  *JobRecord
+		want JobLocation
+	}{
+		{name: "queued", job: NewJobRecord(queued, ""), want: JobLocation{Kind: JobLocationQueue, Name: "critical"}},
+		{name: "other queue", job: NewJobRecord(queued, "default"), want: JobLocation{}},
+		{name: "other payload", job: NewJobRecord(`{"jid":"queued","class":"MyJob","queue":"critical","retry":true}`, ""), want: JobLocation{}},
+		{name: "running", job: NewJobRecord(`{"jid":"running","class":"MyJob","queue":"default"}`, ""), want: JobLocation{Kind: JobLocationBusy, Name: "host1:100:abc"}},
+		{name: "retrying", job: NewJobRecord(`{"jid":"retrying","class":"MyJob","queue":"default"}`, ""), want: JobLocation{Kind: JobLocationRetry}},
+		{name: "scheduled", job: NewJobRecord(`{"jid":"later","class":"MyJob","queue":"default"}`, ""), want: JobLocation{Kind: JobLocationScheduled}},
+		{name: "dead", job: NewJobRecord(`{"jid":"died","class":"MyJob","queue":"default"}`, ""), want: JobLocation{Kind: JobLocationDead}},
+		{name: "finished", job: NewJobRecord(`{"jid":"finished","class":"MyJob","queue":"default"}`, ""), want: JobLocation{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := client.LocateJob(ctx, tt.job)
+			if err != nil {
+				t.Fatalf("LocateJob failed: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("LocateJob(%s) = %+v (%s), want %+v", tt.job.JID(), got, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnqueueSortedEntryJobReturnsPushedPayload(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx := testContext(t)
+
+	_, _ = mr.ZAdd("dead", testScoreA, `{"jid":"died","class":"MyJob","queue":"default","retry_count":3}`)
+	entry, err := client.FindSortedEntry(ctx, SortedSetDead, "died")
+	if err != nil {
+		t.Fatalf("FindSortedEntry failed: %v", err)
+	}
+
+	job, err := client.EnqueueSortedEntryJob(ctx, SortedSetDead, entry)
+	if err != nil {
+		t.Fatalf("EnqueueSortedEntryJob failed: %v", err)
+	}
+	if job.Queue() != "default" {
+		t.Fatalf("queue = %q, want default", job.Queue())
+	}
+	got, err := client.LocateJob(ctx, job)
+	if err != nil {
+		t.Fatalf("LocateJob failed: %v", err)
+	}
+	if want := (JobLocation{Kind: JobLocationQueue, Name: "default"}); got != want {
+		t.Fatalf("LocateJob = %+v, want %+v", got, want)
+	}
+}
@@ -1,6 +1,7 @@
 package sidekiq
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
@@ -49,10 +50,11 @@ const metricsHistogramBuckets = 26
 
 // metricsJobDetailLuaScript fetches job metrics in a single round-trip.
 // KEYS: rollupKey1, ..., rollupKeyN, histKey1, ..., histKeyN
-// ARGV: className, rollupCount, GET, u16, #0, GET, u16, #1, ...
+// ARGV: className, rollupCount, bitfieldCommand, GET, u16, #0, GET, u16, #1, ...
 var metricsJobDetailLuaScript = redis.NewScript(`
 local className = ARGV[1]
 local rollupCount = tonumber(ARGV[2])
+local bitfieldCommand = ARGV[3]
 local msField = className .. "|ms"
 local pField = className .. "|p"
 local fField = className .. "|f"
@@ -68,14 +70,14 @@ end
 
 -- Fetch histogram data (one key per bucket, after rollup keys)
 local histArgs = {}
-for i = 3, #ARGV do
+for i = 4, #ARGV do
     histArgs[#histArgs + 1] = ARGV[i]
 end
 
 for i = rollupCount + 1, #KEYS do
     local key = KEYS[i]
     if #histArgs > 0 then
-        local hist = redis.call('BITFIELD_RO', key, unpack(histArgs))
+        local hist = redis.call(bitfieldCommand, key, unpack(histArgs))
         results[#results + 1] = hist
     end
 end
@@ -83,14 +85,51 @@ end
 return results
 `)
 
+// MetricsHistogramBucket is one of Sidekiq's execution time histogram
+// buckets. A job lands in the first bucket whose bound exceeds its duration,
+// so each bucket holds jobs from the previous bound up to its own.
+type MetricsHistogramBucket struct {
+	Label   string
+	UpperMs int64 // Exclusive upper bound; 0 for the open-ended last bucket
+}
+
+// MetricsHistogramBuckets mirrors Sidekiq::Metrics::Histogram's
+// BUCKET_INTERVALS, fastest first.
+var MetricsHistogramBuckets = []MetricsHistogramBucket{
+	{"20ms", 20}, {"30ms", 30}, {"45ms", 45}, {"65ms", 65}, {"100ms", 100},
+	{"150ms", 150}, {"225ms", 225}, {"335ms", 335}, {"500ms", 500}, {"750ms", 750},
+	{"1.1s", 1100}, {"1.7s", 1700}, {"2.5s", 2500}, {"3.8s", 3800}, {"5.75s", 5750},
+	{"8.5s", 8500}, {"13s", 13000}, {"20s", 20000}, {"30s", 30000}, {"45s", 45000},
+	{"65s", 65000}, {"100s", 100000}, {"150s", 150000}, {"225s", 225000}, {"335s", 335000},
+	{"∞", 0},
+}
+
 // MetricsHistogramLabels defines the histogram bucket labels from Sidekiq.
-var MetricsHistogramLabels = []string{
-	"20ms", "30ms", "45ms", "65ms", "100ms",
-	"150ms", "225ms", "335ms", "500ms", "750ms",
-	"1.1s", "1.7s", "2.5s", "3.8s", "5.75s",
-	"8.5s", "13s", "20s", "30s", "45s",
-	"65s", "100s", "150s", "225s", "335s",
-	"∞",
+var MetricsHistogramLabels = metricsHistogramLabels()
+
+func metricsHistogramLabels() []string {
+	labels := make([]string, len(MetricsHistogramBuckets))
+	for i, bucket := range MetricsHistogramBuckets {
+		labels[i] = bucket.Label
+	}
+	return labels
+}
+
+// MetricsHistogramRange describes the durations bucket i holds, such as
+// "20ms–30ms", "< 20ms" for the first bucket, or "≥ 335s" for the last.
+func MetricsHistogramRange(i int) string {
+	if i < 0 || i >= len(MetricsHistogramBuckets) {
+		return ""
+	}
+	bucket := MetricsHistogramBuckets[i]
+	switch {
+	case i == 0:
+		return "< " + bucket.Label
+	case bucket.UpperMs == 0:
+		return "≥ " + MetricsHistogramBuckets[i-1].Label
+	default:
+		return MetricsHistogramBuckets[i-1].Label + "–" + bucket.Label
+	}
 }
 
 // MetricsJobTotals holds aggregated metrics for a job.
@@ -110,6 +149,15 @@ func (t MetricsJobTotals) Success() int64 {
 	return success
 }
 
+// SuccessRate returns the share of processed jobs that succeeded, in [0, 1].
+// It is 0 when nothing was processed.
+func (t MetricsJobTotals) SuccessRate() float64 {
+	if t.Processed <= 0 {
+		return 0
+	}
+	return min(max(float64(t.Success())/float64(t.Processed), 0), 1)
+}
+
 // AvgSeconds returns the average execution time in seconds.
 func (t MetricsJobTotals) AvgSeconds() float64 {
 	completed := t.Success()
@@ -119,12 +167,96 @@ func (t MetricsJobTotals) AvgSeconds() float64 {
 	return t.Seconds / float64(completed)
 }
 
+// MetricsSort selects the ranking of top jobs. Every order is descending,
+// with ties broken by class name.
+type MetricsSort int
+
+const (
+	// MetricsSortTotal ranks jobs by total execution time.
+	MetricsSortTotal MetricsSort = iota
+	// MetricsSortProcessed ranks jobs by processed count.
+	MetricsSortProcessed
+	// MetricsSortFailed ranks jobs by failure count.
+	MetricsSortFailed
+	// MetricsSortAvg ranks jobs by average execution time.
+	MetricsSortAvg
+	// MetricsSortCount is the number of sort orders.
+	MetricsSortCount
+)
+
+// String returns the sort key name.
+func (s MetricsSort) String() string {
+	switch s {
+	case MetricsSortProcessed:
+		return "processed"
+	case MetricsSortFailed:
+		return "failed"
+	case MetricsSortAvg:
+		return "avg"
+	default:
+		return "ms"
+	}
+}
+
+func (s MetricsSort) value(t MetricsJobTotals) float64 {
+	switch s {
+	case MetricsSortProcessed:
+		return float64(t.Processed)
+	case MetricsSortFailed:
+		return float64(t.Failed)
+	case MetricsSortAvg:
+		return t.AvgSeconds()
+	default:
+		return float64(t.Milliseconds)
+	}
+}
+
+// MetricsTopJob is a job class with its aggregated metrics.
+type MetricsTopJob struct {
+	Class string
+	MetricsJobTotals
+}
+
 // MetricsTopJobsResult contains aggregated metrics for multiple jobs.
+// Jobs holds every matching class; Ranked holds the requested top N in order.
 type MetricsTopJobsResult struct {
 	Granularity MetricsGranularity
 	StartsAt    time.Time
 	EndsAt      time.Time
 	Jobs        map[string]MetricsJobTotals
+	Ranked      []MetricsTopJob
+}
+
+// Totals sums the metrics of every job in the result, for fleet-wide
+// averages and failure rates. It is zero when there are no jobs.
+func (r MetricsTopJobsResult) Totals() MetricsJobTotals {
+	var totals MetricsJobTotals
+	for _, job := range r.Jobs {
+		totals.Processed += job.Processed
+		totals.Failed += job.Failed
+		totals.Milliseconds += job.Milliseconds
+		totals.Seconds += job.Seconds
+	}
+	return totals
+}
+
+// RankMetricsTopJobs orders jobs by sortBy and keeps the first limit entries.
+// A limit of zero or less keeps every job.
+func RankMetricsTopJobs(jobs map[string]MetricsJobTotals, sortBy MetricsSort, limit int) []MetricsTopJob {
+	ranked := make([]MetricsTopJob, 0, len(jobs))
+	for class, totals := range jobs {
+		ranked = append(ranked, MetricsTopJob{Class: class, MetricsJobTotals: totals})
+	}
+	slices.SortFunc(ranked, func(a, b MetricsTopJob) int {
+		if c := cmp.Compare(sortBy.value(b.MetricsJobTotals), sortBy.value(a.MetricsJobTotals)); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Class, b.Class)
+	})
+	if limit > 0 && len(ranked) > limit {
+		ranked = ranked[:limit]
+	}
+	return ranked
 }
 
 // MetricsJobDetailResult contains metrics for a single job.
@@ -136,12 +268,16 @@ type MetricsJobDetailResult struct {
 	Totals      MetricsJobTotals
 	Hist        map[string][]int64
 	BucketCount int // Number of histogram buckets (cached to avoid iteration)
+	// BucketMetrics holds per-bucket totals keyed like Hist.
+	BucketMetrics map[string]MetricsJobTotals
 }
 
-// GetMetricsTopJobs fetches aggregated metrics for all jobs within the period.
-func (c *Client) GetMetricsTopJobs(ctx context.Context, period MetricsPeriod, classFilter string) (MetricsTopJobsResult, error) {
+// GetMetricsTopJobs fetches aggregated metrics for all jobs within the period,
+// ranked by sortBy and limited to limit jobs (zero for all).
+// All bucket hashes are read in a single pipeline and summed client-side.
+func (c *Client) GetMetricsTopJobs(ctx context.Context, period MetricsPeriod, classFilter string, sortBy MetricsSort, limit int) (MetricsTopJobsResult, error) {
 	granularity, count, stride := metricsRollup(period)
-	now := time.Now().UTC()
+	now := c.metricsNow()
 	result := MetricsTopJobsResult{
 		Granularity: granularity,
 		EndsAt:      now,
@@ -156,7 +292,7 @@ func (c *Client) GetMetricsTopJobs(ctx context.Context, period MetricsPeriod, cl
 	keys := make([]string, 0, count*2)
 	cursor := now
 	for range count {
-		keys = append(keys, metricsRollupKeys(cursor, granularity)...)
+		keys = append(keys, metricsRollupKeys(c.metricsPrefix, cursor, granularity)...)
 		cursor = cursor.Add(-stride)
 	}
 	result.StartsAt = cursor.Add(stride)
@@ -166,7 +302,7 @@ func (c *Client) GetMetricsTopJobs(ctx context.Context, period MetricsPeriod, cl
 		filter = strings.ToLower(classFilter)
 	}
 
-	pipe := c.redis.Pipeline()
+	pipe := c.rdb().Pipeline()
 	cmds := make([]*redis.MapStringStringCmd, 0, len(keys))
 	for _, key := range keys {
 		cmds = append(cmds, pipe.HGetAll(ctx, key))
@@ -208,6 +344,7 @@ func (c *Client) GetMetricsTopJobs(ctx context.Context, period MetricsPeriod, cl
 		}
 	}
 
+	result.Ranked = RankMetricsTopJobs(result.Jobs, sortBy, limit)
 	return result, nil
 }
 
@@ -224,11 +361,12 @@ func (c *Client) GetMetricsJobDetail(ctx context.Context, className string, peri
 // getMetricsJobDetailLua fetches job metrics using Lua script with detected version.
 func (c *Client) getMetricsJobDetailLua(ctx context.Context, className string, period MetricsPeriod, version Version) (MetricsJobDetailResult, error) {
 	granularity, count, stride := metricsRollup(period)
-	now := time.Now().UTC()
+	now := c.metricsNow()
 	result := MetricsJobDetailResult{
-		Granularity: granularity,
-		EndsAt:      now,
-		Hist:        make(map[string][]int64),
+		Granularity:   granularity,
+		EndsAt:        now,
+		Hist:          make(map[string][]int64),
+		BucketMetrics: make(map[string]MetricsJobTotals),
 	}
 
 	if count == 0 {
@@ -244,12 +382,12 @@ func (c *Client) getMetricsJobDetailLua(ctx context.Context, className string, p
 
 	for range count {
 		bucketTimes = append(bucketTimes, cursor)
-		rollupKey := metricsRollupKeyForVersion(cursor, granularity, version)
+		rollupKey := metricsRollupKeyForVersion(c.metricsPrefix, cursor, granularity, version)
 		if rollupKey != "" {
 			rollupKeys = append(rollupKeys, rollupKey)
 		}
 		if granularity == MetricsGranularityMinutely {
-			histKey := metricsHistogramKeyForVersion(className, cursor, version)
+			histKey := metricsHistogramKeyForVersion(c.metricsPrefix, className, cursor, version)
 			histKeys = append(histKeys, histKey)
 		}
 		cursor = cursor.Add(-stride)
@@ -262,8 +400,14 @@ func (c *Client) getMetricsJobDetailLua(ctx context.Context, className string, p
 	allKeys = append(allKeys, histKeys...)
 
 	// Build ARGV
-	argv := make([]any, 0, 2+metricsHistogramBuckets*3)
-	argv = append(argv, className, len(rollupKeys))
+	// Servers before Redis 6.2 lack BITFIELD_RO; plain BITFIELD reads the
+	// same values with GET.
+	bitfieldCommand := "BITFIELD_RO"
+	if !c.capabilities(ctx).bitfieldRO {
+		bitfieldCommand = "BITFIELD"
+	}
+	argv := make([]any, 0, 3+metricsHistogramBuckets*3)
+	argv = append(argv, className, len(rollupKeys), bitfieldCommand)
 	if granularity == MetricsGranularityMinutely {
 		for i := range metricsHistogramBuckets {
 			argv = append(argv, "GET", "u16", fmt.Sprintf("#%d", i))
@@ -271,7 +415,7 @@ func (c *Client) getMetricsJobDetailLua(ctx context.Context, className string, p
 	}
 
 	// Execute Lua script
-	rawResult, err := metricsJobDetailLuaScript.Run(ctx, c.redis, allKeys, argv...).Result()
+	rawResult, err := metricsJobDetailLuaScript.Run(ctx, c.rdb(), allKeys, argv...).Result()
 	if err != nil && !errors.Is(err, redis.Nil) {
 		return result, err
 	}
@@ -306,6 +450,12 @@ func (c *Client) getMetricsJobDetailLua(ctx context.Context, className string, p
 		result.Totals.Failed += fTotal
 
 		bucketTimeStr := metricsBucketTime(bucketTime, granularity)
+		result.BucketMetrics[bucketTimeStr] = MetricsJobTotals{
+			Processed:    pTotal,
+			Failed:       fTotal,
+			Milliseconds: msTotal,
+			Seconds:      float64(msTotal) / 1000.0,
+		}
 		if granularity == MetricsGranularityMinutely { //nolint:nestif // Histogram parsing requires nested conditionals
 			histIdx := len(rollupKeys) + i
 			if histIdx < len(results) {
@@ -345,46 +495,54 @@ func metricsRollup(period MetricsPeriod) (MetricsGranularity, int, time.Duration
 	return MetricsGranularityMinutely, minutes, time.Minute
 }
 
-func metricsRollupKeySidekiq8(t time.Time, granularity MetricsGranularity) string {
+// SetMetricsPrefix sets a namespace prepended to every metrics key, for forks
+// and deployments that do not store metrics under Sidekiq's bare "j|" and
+// "h|" keys. An empty prefix restores the standard keys.
+func (c *Client) SetMetricsPrefix(prefix string) {
+	c.metricsPrefix = prefix
+	c.version.Store(0)
+}
+
+func metricsRollupKeySidekiq8(prefix string, t time.Time, granularity MetricsGranularity) string {
 	t = t.UTC()
 	date := t.Format("060102")
 	hour := t.Hour()
 	minute := t.Minute()
 	if granularity == MetricsGranularityHourly {
 		minute /= 10
-		return fmt.Sprintf("j|%s|%d:%d", date, hour, minute)
+		return fmt.Sprintf("%sj|%s|%d:%d", prefix, date, hour, minute)
 	}
-	return fmt.Sprintf("j|%s|%d:%02d", date, hour, minute)
+	return fmt.Sprintf("%sj|%s|%d:%02d", prefix, date, hour, minute)
 }
 
-func metricsRollupKeys(t time.Time, granularity MetricsGranularity) []string {
+func metricsRollupKeys(prefix string, t time.Time, granularity MetricsGranularity) []string {
 	keys := []string{
-		metricsRollupKeySidekiq8(t, granularity),
+		metricsRollupKeySidekiq8(prefix, t, granularity),
 	}
-	if sidekiq7Key := metricsRollupKeySidekiq7(t, granularity); sidekiq7Key != "" && sidekiq7Key != keys[0] {
+	if sidekiq7Key := metricsRollupKeySidekiq7(prefix, t, granularity); sidekiq7Key != "" && sidekiq7Key != keys[0] {
 		keys = append(keys, sidekiq7Key)
 	}
 	return keys
 }
 
-func metricsRollupKeySidekiq7(t time.Time, granularity MetricsGranularity) string {
+func metricsRollupKeySidekiq7(prefix string, t time.Time, granularity MetricsGranularity) string {
 	// Sidekiq 7 only writes minute buckets (no 10-minute rollups).
 	if granularity == MetricsGranularityHourly {
 		return ""
 	}
 	t = t.UTC()
 	date := t.Format("20060102")
-	return fmt.Sprintf("j|%s|%d:%d", date, t.Hour(), t.Minute())
+	return fmt.Sprintf("%sj|%s|%d:%d", prefix, date, t.Hour(), t.Minute())
 }
 
 // metricsRollupKeyForVersion returns the rollup key for a specific Sidekiq version.
-func metricsRollupKeyForVersion(t time.Time, granularity MetricsGranularity, version Version) string {
+func metricsRollupKeyForVersion(prefix string, t time.Time, granularity MetricsGranularity, version Version) string {
 	t = t.UTC()
 	if version == Version7 {
 		if granularity == MetricsGranularityHourly {
 			return "" // Sidekiq 7 doesn't have hourly rollups
 		}
-		return fmt.Sprintf("j|%s|%d:%d", t.Format("20060102"), t.Hour(), t.Minute())
+		return fmt.Sprintf("%sj|%s|%d:%d", prefix, t.Format("20060102"), t.Hour(), t.Minute())
 	}
 	// Sidekiq 8 format
 	date := t.Format("060102")
@@ -392,19 +550,19 @@ func metricsRollupKeyForVersion(t time.Time, granularity MetricsGranularity, ver
 	minute := t.Minute()
 	if granularity == MetricsGranularityHourly {
 		minute /= 10
-		return fmt.Sprintf("j|%s|%d:%d", date, hour, minute)
+		return fmt.Sprintf("%sj|%s|%d:%d", prefix, date, hour, minute)
 	}
-	return fmt.Sprintf("j|%s|%d:%02d", date, hour, minute)
+	return fmt.Sprintf("%sj|%s|%d:%02d", prefix, date, hour, minute)
 }
 
 // metricsHistogramKeyForVersion returns the histogram key for a specific Sidekiq version.
-func metricsHistogramKeyForVersion(className string, t time.Time, version Version) string {
+func metricsHistogramKeyForVersion(prefix, className string, t time.Time, version Version) string {
 	t = t.UTC()
 	if version == Version7 {
-		return fmt.Sprintf("%s-%02d-%02d:%d", className, t.Day(), t.Hour(), t.Minute())
+		return fmt.Sprintf("%s%s-%02d-%02d:%d", prefix, className, t.Day(), t.Hour(), t.Minute())
 	}
 	// Sidekiq 8 format
-	return fmt.Sprintf("h|%s-%d-%d:%d", className, t.Day(), t.Hour(), t.Minute())
+	return fmt.Sprintf("%sh|%s-%d-%d:%d", prefix, className, t.Day(), t.Hour(), t.Minute())
 }
 
 func metricsBucketTime(t time.Time, granularity MetricsGranularity) string {
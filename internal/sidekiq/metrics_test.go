@@ -1,8 +1,14 @@
 package sidekiq
 
 import (
+	"context"
+	"fmt"
+	"slices"
+	"sync/atomic"
 	"testing"
 	"time"
+
+	"github.com/redis/go-redis/v9"
 )
 
 // MetricsJobTotals tests
@@ -45,6 +51,44 @@ func TestMetricsJobTotals_Success(t *testing.T) {
 	}
 }
 
+func TestMetricsJobTotals_SuccessRate(t *testing.T) {
+	tests := []struct {
+		name      string
+		totals    MetricsJobTotals
+		wantValue float64
+	}{
+		{
+			name:      "all succeeded",
+			totals:    MetricsJobTotals{Processed: 40, Failed: 0},
+			wantValue: 1.0,
+		},
+		{
+			name:      "with failures",
+			totals:    MetricsJobTotals{Processed: 100, Failed: 25},
+			wantValue: 0.75,
+		},
+		{
+			name:      "more failed than processed",
+			totals:    MetricsJobTotals{Processed: 5, Failed: 10},
+			wantValue: 0.0,
+		},
+		{
+			name:      "zero processed",
+			totals:    MetricsJobTotals{Processed: 0, Failed: 3},
+			wantValue: 0.0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.totals.SuccessRate()
+			if got != tt.wantValue {
+				t.Errorf("SuccessRate() = %f, want %f", got, tt.wantValue)
+			}
+		})
+	}
+}
+
 func TestMetricsJobTotals_AvgSeconds(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -210,7 +254,7 @@ func TestMetricsRollupKeySidekiq8(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := metricsRollupKeySidekiq8(tt.time, tt.granularity)
+			got := metricsRollupKeySidekiq8("", tt.time, tt.granularity)
 			if got != tt.wantKey {
 				t.Errorf("metricsRollupKeySidekiq8() = %q, want %q", got, tt.wantKey)
 			}
@@ -241,7 +285,7 @@ func TestMetricsRollupKeySidekiq7(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := metricsRollupKeySidekiq7(tt.time, tt.granularity)
+			got := metricsRollupKeySidekiq7("", tt.time, tt.granularity)
 			if got != tt.wantKey {
 				t.Errorf("metricsRollupKeySidekiq7() = %q, want %q", got, tt.wantKey)
 			}
@@ -284,13 +328,19 @@ func TestMetricsRollupKeyForVersion(t *testing.T) {
 		},
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			got := metricsRollupKeyForVersion(fixedTime, tt.granularity, tt.version)
-			if got != tt.wantKey {
-				t.Errorf("metricsRollupKeyForVersion() = %q, want %q", got, tt.wantKey)
-			}
-		})
+	for _, prefix := range []string{"", "myapp:"} {
+		for _, tt := range tests {
+			t.Run(prefix+tt.name, func(t *testing.T) {
+				want := tt.wantKey
+				if want != "" {
+					want = prefix + want
+				}
+				got := metricsRollupKeyForVersion(prefix, fixedTime, tt.granularity, tt.version)
+				if got != want {
+					t.Errorf("metricsRollupKeyForVersion(%q) = %q, want %q", prefix, got, want)
+				}
+			})
+		}
 	}
 }
 
@@ -317,13 +367,15 @@ func TestMetricsHistogramKeyForVersion(t *testing.T) {
 		},
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			got := metricsHistogramKeyForVersion(tt.className, fixedTime, tt.version)
-			if got != tt.wantKey {
-				t.Errorf("metricsHistogramKeyForVersion() = %q, want %q", got, tt.wantKey)
-			}
-		})
+	for _, prefix := range []string{"", "myapp:"} {
+		for _, tt := range tests {
+			t.Run(prefix+tt.name, func(t *testing.T) {
+				got := metricsHistogramKeyForVersion(prefix, tt.className, fixedTime, tt.version)
+				if want := prefix + tt.wantKey; got != want {
+					t.Errorf("metricsHistogramKeyForVersion(%q) = %q, want %q", prefix, got, want)
+				}
+			})
+		}
 	}
 }
 
@@ -521,7 +573,7 @@ func TestGetMetricsTopJobs_Empty(t *testing.T) {
 	_, client := setupTestRedis(t)
 	ctx := testContext(t)
 
-	result, err := client.GetMetricsTopJobs(ctx, MetricsPeriod{Minutes: 60}, "")
+	result, err := client.GetMetricsTopJobs(ctx, MetricsPeriod{Minutes: 60}, "", MetricsSortTotal, 0)
 	if err != nil {
 		t.Fatalf("GetMetricsTopJobs failed: %v", err)
 	}
@@ -543,7 +595,7 @@ func TestGetMetricsTopJobs_WithData(t *testing.T) {
 	now := time.Now().UTC().Truncate(time.Minute)
 
 	// Create Sidekiq 8 format keys for current minute
-	key := metricsRollupKeySidekiq8(now, MetricsGranularityMinutely)
+	key := metricsRollupKeySidekiq8("", now, MetricsGranularityMinutely)
 
 	// Add metrics for two jobs
 	mr.HSet(key, "App::FooJob|ms", "1500")
@@ -554,7 +606,7 @@ func TestGetMetricsTopJobs_WithData(t *testing.T) {
 	mr.HSet(key, "App::BarJob|p", "20")
 	mr.HSet(key, "App::BarJob|f", "5")
 
-	result, err := client.GetMetricsTopJobs(ctx, MetricsPeriod{Minutes: 1}, "")
+	result, err := client.GetMetricsTopJobs(ctx, MetricsPeriod{Minutes: 1}, "", MetricsSortTotal, 0)
 	if err != nil {
 		t.Fatalf("GetMetricsTopJobs failed: %v", err)
 	}
@@ -583,12 +635,53 @@ func TestGetMetricsTopJobs_WithData(t *testing.T) {
 	}
 }
 
+func TestMetricsTopJobsResultTotals(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx := testContext(t)
+
+	now := time.Now().UTC().Truncate(time.Minute)
+	key := metricsRollupKeySidekiq8("", now, MetricsGranularityMinutely)
+	mr.HSet(key, "App::FooJob|ms", "1500")
+	mr.HSet(key, "App::FooJob|p", "10")
+	mr.HSet(key, "App::FooJob|f", "2")
+	mr.HSet(key, "App::BarJob|ms", "3000")
+	mr.HSet(key, "App::BarJob|p", "20")
+	mr.HSet(key, "App::BarJob|f", "5")
+
+	result, err := client.GetMetricsTopJobs(ctx, MetricsPeriod{Minutes: 1}, "", MetricsSortTotal, 0)
+	if err != nil {
+		t.Fatalf("GetMetricsTopJobs failed: %v", err)
+	}
+
+	var want MetricsJobTotals
+	for _, job := range result.Jobs {
+		want.Processed += job.Processed
+		want.Failed += job.Failed
+		want.Milliseconds += job.Milliseconds
+		want.Seconds += job.Seconds
+	}
+	totals := result.Totals()
+	if totals != want {
+		t.Fatalf("Totals() = %+v, want per-class sum %+v", totals, want)
+	}
+	if totals.Processed != 30 || totals.Failed != 7 || totals.Milliseconds != 4500 {
+		t.Fatalf("Totals() = %+v, want 30 processed, 7 failed, 4500ms", totals)
+	}
+	if got := totals.AvgSeconds(); got != 4.5/23 {
+		t.Fatalf("AvgSeconds() = %v, want %v", got, 4.5/23)
+	}
+
+	if empty := (MetricsTopJobsResult{}).Totals(); empty != (MetricsJobTotals{}) {
+		t.Fatalf("empty Totals() = %+v, want zero", empty)
+	}
+}
+
 func TestGetMetricsTopJobs_WithFilter(t *testing.T) {
 	mr, client := setupTestRedis(t)
 	ctx := testContext(t)
 
 	now := time.Now().UTC().Truncate(time.Minute)
-	key := metricsRollupKeySidekiq8(now, MetricsGranularityMinutely)
+	key := metricsRollupKeySidekiq8("", now, MetricsGranularityMinutely)
 
 	// Add metrics for jobs with different names
 	mr.HSet(key, "App::FooJob|p", "10")
@@ -596,7 +689,7 @@ func TestGetMetricsTopJobs_WithFilter(t *testing.T) {
 	mr.HSet(key, "Other::BazJob|p", "30")
 
 	// Filter for jobs containing "app" (case insensitive)
-	result, err := client.GetMetricsTopJobs(ctx, MetricsPeriod{Minutes: 1}, "app")
+	result, err := client.GetMetricsTopJobs(ctx, MetricsPeriod{Minutes: 1}, "app", MetricsSortTotal, 0)
 	if err != nil {
 		t.Fatalf("GetMetricsTopJobs failed: %v", err)
 	}
@@ -623,8 +716,8 @@ func TestGetMetricsTopJobs_MultipleBuckets(t *testing.T) {
 	now := time.Now().UTC().Truncate(time.Minute)
 
 	// Add metrics for two buckets (current and previous minute)
-	key1 := metricsRollupKeySidekiq8(now, MetricsGranularityMinutely)
-	key2 := metricsRollupKeySidekiq8(now.Add(-time.Minute), MetricsGranularityMinutely)
+	key1 := metricsRollupKeySidekiq8("", now, MetricsGranularityMinutely)
+	key2 := metricsRollupKeySidekiq8("", now.Add(-time.Minute), MetricsGranularityMinutely)
 
 	mr.HSet(key1, "App::FooJob|ms", "1000")
 	mr.HSet(key1, "App::FooJob|p", "5")
@@ -632,7 +725,7 @@ func TestGetMetricsTopJobs_MultipleBuckets(t *testing.T) {
 	mr.HSet(key2, "App::FooJob|ms", "2000")
 	mr.HSet(key2, "App::FooJob|p", "10")
 
-	result, err := client.GetMetricsTopJobs(ctx, MetricsPeriod{Minutes: 2}, "")
+	result, err := client.GetMetricsTopJobs(ctx, MetricsPeriod{Minutes: 2}, "", MetricsSortTotal, 0)
 	if err != nil {
 		t.Fatalf("GetMetricsTopJobs failed: %v", err)
 	}
@@ -647,19 +740,110 @@ func TestGetMetricsTopJobs_MultipleBuckets(t *testing.T) {
 	}
 }
 
+// roundTripHook counts standalone commands and pipelines sent to Redis.
+type roundTripHook struct {
+	commands  atomic.Int64
+	pipelines atomic.Int64
+}
+
+func (h *roundTripHook) DialHook(next redis.DialHook) redis.DialHook { return next }
+
+func (h *roundTripHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		h.commands.Add(1)
+		return next(ctx, cmd)
+	}
+}
+
+func (h *roundTripHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		h.pipelines.Add(1)
+		return next(ctx, cmds)
+	}
+}
+
+func TestGetMetricsTopJobs_SingleRoundTrip(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx := testContext(t)
+
+	now := time.Now().UTC().Truncate(time.Minute)
+	newest := metricsRollupKeySidekiq8("", now, MetricsGranularityMinutely)
+	oldest := metricsRollupKeySidekiq8("", now.Add(-479*time.Minute), MetricsGranularityMinutely)
+
+	mr.HSet(newest, "App::FooJob|ms", "1000")
+	mr.HSet(newest, "App::FooJob|p", "5")
+	mr.HSet(newest, "App::FooJob|f", "1")
+	mr.HSet(oldest, "App::FooJob|ms", "2000")
+	mr.HSet(oldest, "App::FooJob|p", "10")
+	mr.HSet(oldest, "App::FooJob|f", "2")
+	mr.HSet(oldest, "Other::BarJob|p", "7")
+
+	// Open the connection first so handshake commands are not counted.
+	if err := client.redis.Ping(ctx).Err(); err != nil {
+		t.Fatalf("Ping failed: %v", err)
+	}
+	hook := &roundTripHook{}
+	client.AddHook(hook)
+
+	result, err := client.GetMetricsTopJobs(ctx, MetricsPeriod{Minutes: 480}, "FOO", MetricsSortTotal, 0)
+	if err != nil {
+		t.Fatalf("GetMetricsTopJobs failed: %v", err)
+	}
+
+	if got := hook.pipelines.Load(); got != 1 {
+		t.Errorf("pipelines = %d, want 1", got)
+	}
+	if got := hook.commands.Load(); got != 0 {
+		t.Errorf("standalone commands = %d, want 0", got)
+	}
+	if result.Granularity != MetricsGranularityMinutely {
+		t.Errorf("Granularity = %v, want MetricsGranularityMinutely", result.Granularity)
+	}
+	if len(result.Jobs) != 1 {
+		t.Fatalf("len(Jobs) = %d, want 1 (filtered)", len(result.Jobs))
+	}
+	want := MetricsJobTotals{Milliseconds: 3000, Seconds: 3, Processed: 15, Failed: 3}
+	if got := result.Jobs["App::FooJob"]; got != want {
+		t.Errorf("FooJob = %+v, want %+v", got, want)
+	}
+}
+
+func BenchmarkGetMetricsTopJobs(b *testing.B) {
+	mr, client := setupTestRedis(b)
+	ctx := context.Background()
+
+	now := time.Now().UTC().Truncate(time.Minute)
+	for i := range 480 {
+		key := metricsRollupKeySidekiq8("", now.Add(-time.Duration(i)*time.Minute), MetricsGranularityMinutely)
+		for j := range 20 {
+			class := fmt.Sprintf("App::Job%02d", j)
+			mr.HSet(key, class+"|ms", "1500")
+			mr.HSet(key, class+"|p", "10")
+			mr.HSet(key, class+"|f", "1")
+		}
+	}
+
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := client.GetMetricsTopJobs(ctx, MetricsPeriod{Minutes: 480}, "", MetricsSortTotal, 0); err != nil {
+			b.Fatalf("GetMetricsTopJobs failed: %v", err)
+		}
+	}
+}
+
 func TestGetMetricsTopJobs_InvalidValues(t *testing.T) {
 	mr, client := setupTestRedis(t)
 	ctx := testContext(t)
 
 	now := time.Now().UTC().Truncate(time.Minute)
-	key := metricsRollupKeySidekiq8(now, MetricsGranularityMinutely)
+	key := metricsRollupKeySidekiq8("", now, MetricsGranularityMinutely)
 
 	// Add invalid metric values (should be skipped)
 	mr.HSet(key, "App::FooJob|ms", "not a number")
 	mr.HSet(key, "App::FooJob|p", "10")
 	mr.HSet(key, "InvalidKey", "123") // No pipe separator
 
-	result, err := client.GetMetricsTopJobs(ctx, MetricsPeriod{Minutes: 1}, "")
+	result, err := client.GetMetricsTopJobs(ctx, MetricsPeriod{Minutes: 1}, "", MetricsSortTotal, 0)
 	if err != nil {
 		t.Fatalf("GetMetricsTopJobs failed: %v", err)
 	}
@@ -673,6 +857,77 @@ func TestGetMetricsTopJobs_InvalidValues(t *testing.T) {
 	}
 }
 
+func TestRankMetricsTopJobs(t *testing.T) {
+	jobs := map[string]MetricsJobTotals{
+		"SlowJob":  {Processed: 2, Failed: 0, Milliseconds: 8000, Seconds: 8},
+		"BusyJob":  {Processed: 100, Failed: 10, Milliseconds: 18000, Seconds: 18},
+		"FlakyJob": {Processed: 20, Failed: 10, Milliseconds: 1000, Seconds: 1},
+		"AlphaJob": {Processed: 20, Failed: 10, Milliseconds: 1000, Seconds: 1},
+	}
+
+	tests := []struct {
+		sortBy MetricsSort
+		limit  int
+		want   []string
+	}{
+		{MetricsSortTotal, 0, []string{"BusyJob", "SlowJob", "AlphaJob", "FlakyJob"}},
+		{MetricsSortProcessed, 0, []string{"BusyJob", "AlphaJob", "FlakyJob", "SlowJob"}},
+		{MetricsSortFailed, 0, []string{"AlphaJob", "BusyJob", "FlakyJob", "SlowJob"}},
+		{MetricsSortAvg, 0, []string{"SlowJob", "BusyJob", "AlphaJob", "FlakyJob"}},
+		{MetricsSortTotal, 2, []string{"BusyJob", "SlowJob"}},
+		{MetricsSortTotal, 10, []string{"BusyJob", "SlowJob", "AlphaJob", "FlakyJob"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/%d", tt.sortBy, tt.limit), func(t *testing.T) {
+			ranked := RankMetricsTopJobs(jobs, tt.sortBy, tt.limit)
+			got := make([]string, len(ranked))
+			for i, job := range ranked {
+				got[i] = job.Class
+				if job.MetricsJobTotals != jobs[job.Class] {
+					t.Errorf("%s totals = %+v, want %+v", job.Class, job.MetricsJobTotals, jobs[job.Class])
+				}
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("order = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetMetricsTopJobs_RankedLimit(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx := testContext(t)
+
+	now := time.Now().UTC().Truncate(time.Minute)
+	key := metricsRollupKeySidekiq8("", now, MetricsGranularityMinutely)
+
+	mr.HSet(key, "App::FooJob|p", "10")
+	mr.HSet(key, "App::FooJob|f", "1")
+	mr.HSet(key, "App::BarJob|p", "20")
+	mr.HSet(key, "App::BarJob|f", "5")
+	mr.HSet(key, "App::BazJob|p", "30")
+	mr.HSet(key, "Other::QuxJob|f", "9")
+
+	result, err := client.GetMetricsTopJobs(ctx, MetricsPeriod{Minutes: 1}, "APP", MetricsSortFailed, 2)
+	if err != nil {
+		t.Fatalf("GetMetricsTopJobs failed: %v", err)
+	}
+
+	if len(result.Jobs) != 3 {
+		t.Fatalf("len(Jobs) = %d, want 3 (every filtered job)", len(result.Jobs))
+	}
+	if len(result.Ranked) != 2 {
+		t.Fatalf("len(Ranked) = %d, want 2", len(result.Ranked))
+	}
+	if result.Ranked[0].Class != "App::BarJob" || result.Ranked[1].Class != "App::FooJob" {
+		t.Errorf("Ranked = %+v, want App::BarJob then App::FooJob", result.Ranked)
+	}
+	if result.Ranked[0].Failed != 5 {
+		t.Errorf("App::BarJob Failed = %d, want 5", result.Ranked[0].Failed)
+	}
+}
+
 func TestGetMetricsJobDetail_Sidekiq8_Minutely(t *testing.T) {
 	t.Skip("Skipped: Minutely granularity uses BITFIELD_RO (histogram data) not supported by miniredis")
 }
@@ -687,7 +942,7 @@ func TestGetMetricsJobDetail_Sidekiq8_Hourly(t *testing.T) {
 	now := time.Now().UTC().Truncate(10 * time.Minute)
 
 	// Test with 24-hour period (10-minute buckets)
-	key := metricsRollupKeySidekiq8(now, MetricsGranularityHourly)
+	key := metricsRollupKeySidekiq8("", now, MetricsGranularityHourly)
 
 	mr.HSet(key, "App::FooJob|ms", "5000")
 	mr.HSet(key, "App::FooJob|p", "50")
@@ -712,6 +967,32 @@ func TestGetMetricsJobDetail_Sidekiq8_Hourly(t *testing.T) {
 	}
 }
 
+func TestGetMetricsJobDetail_MetricsPrefix(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx := testContext(t)
+	client.SetMetricsPrefix("myapp:")
+
+	// Unprefixed Sidekiq 7 keys belong to another app and must be ignored.
+	_ = mr.Set("j|20250101|0:0", "1")
+	_ = mr.Set("myapp:j|250101|0:0", "1")
+	if version := client.DetectVersion(ctx); version != Version8 {
+		t.Fatalf("DetectVersion() = %v, want Version8", version)
+	}
+
+	now := time.Now().UTC().Truncate(10 * time.Minute)
+	key := metricsRollupKeySidekiq8("myapp:", now, MetricsGranularityHourly)
+	mr.HSet(key, "App::FooJob|ms", "5000")
+	mr.HSet(key, "App::FooJob|p", "50")
+
+	result, err := client.GetMetricsJobDetail(ctx, "App::FooJob", MetricsPeriod{Hours: 24})
+	if err != nil {
+		t.Fatalf("GetMetricsJobDetail failed: %v", err)
+	}
+	if result.Totals.Processed != 50 {
+		t.Errorf("Totals.Processed = %d, want 50", result.Totals.Processed)
+	}
+}
+
 func TestGetMetricsJobDetail_Sidekiq7_Minutely(t *testing.T) {
 	t.Skip("Skipped: Minutely granularity uses BITFIELD_RO (histogram data) not supported by miniredis")
 }
@@ -756,7 +1037,7 @@ func TestGetMetricsJobDetail_PartialData_Hourly(t *testing.T) {
 	now := time.Now().UTC().Truncate(10 * time.Minute)
 
 	// Only add data for one bucket (use hourly to avoid BITFIELD_RO)
-	key := metricsRollupKeySidekiq8(now.Add(-20*time.Minute), MetricsGranularityHourly)
+	key := metricsRollupKeySidekiq8("", now.Add(-20*time.Minute), MetricsGranularityHourly)
 
 	mr.HSet(key, "App::FooJob|ms", "1000")
 	mr.HSet(key, "App::FooJob|p", "5")
@@ -777,6 +1058,14 @@ func TestGetMetricsJobDetail_PartialData_Hourly(t *testing.T) {
 	if result.Totals.Failed != 1 {
 		t.Errorf("Totals.Failed = %d, want 1", result.Totals.Failed)
 	}
+
+	if len(result.BucketMetrics) != 144 {
+		t.Errorf("len(BucketMetrics) = %d, want 144", len(result.BucketMetrics))
+	}
+	bucket := result.BucketMetrics[metricsBucketTime(now.Add(-20*time.Minute), MetricsGranularityHourly)]
+	if bucket.Processed != 5 || bucket.Failed != 1 || bucket.Milliseconds != 1000 {
+		t.Errorf("bucket metrics = %+v, want 5 processed, 1 failed, 1000ms", bucket)
+	}
 }
 
 func TestGetMetricsJobDetail_InvalidValues_Hourly(t *testing.T) {
@@ -787,7 +1076,7 @@ func TestGetMetricsJobDetail_InvalidValues_Hourly(t *testing.T) {
 	_ = mr.Set("j|250101|0:0", "1")
 
 	now := time.Now().UTC().Truncate(10 * time.Minute)
-	key := metricsRollupKeySidekiq8(now, MetricsGranularityHourly)
+	key := metricsRollupKeySidekiq8("", now, MetricsGranularityHourly)
 
 	// Add invalid metric values (non-numeric strings)
 	mr.HSet(key, "App::FooJob|ms", "invalid")
@@ -871,7 +1160,7 @@ func TestGetMetricsJobDetail_MultipleBuckets_Hourly(t *testing.T) {
 	// Add data for 3 different 10-minute buckets
 	for i := range 3 {
 		offset := time.Duration(i) * 10 * time.Minute
-		key := metricsRollupKeySidekiq8(now.Add(-offset), MetricsGranularityHourly)
+		key := metricsRollupKeySidekiq8("", now.Add(-offset), MetricsGranularityHourly)
 
 		mr.HSet(key, "App::AggJob|ms", "1000")
 		mr.HSet(key, "App::AggJob|p", "10")
@@ -979,3 +1268,41 @@ func TestGetMetricsJobDetail_BucketTimeConsistency_Hourly(t *testing.T) {
 		t.Errorf("EndsAt - StartsAt = %v, want approximately %v", actualDuration, expectedDuration)
 	}
 }
+
+func TestMetricsHistogramBuckets(t *testing.T) {
+	if len(MetricsHistogramLabels) != len(MetricsHistogramBuckets) {
+		t.Fatalf("len(labels) = %d, want %d", len(MetricsHistogramLabels), len(MetricsHistogramBuckets))
+	}
+
+	last := len(MetricsHistogramBuckets) - 1
+	for i, bucket := range MetricsHistogramBuckets {
+		if MetricsHistogramLabels[i] != bucket.Label {
+			t.Errorf("label %d = %q, want %q", i, MetricsHistogramLabels[i], bucket.Label)
+		}
+		if MetricsHistogramRange(i) == "" {
+			t.Errorf("bucket %d (%s) has no range", i, bucket.Label)
+		}
+		if i == last {
+			if bucket.UpperMs != 0 {
+				t.Errorf("last bucket bound = %d, want open-ended", bucket.UpperMs)
+			}
+			continue
+		}
+		bound, err := time.ParseDuration(bucket.Label)
+		if err != nil || bound != time.Duration(bucket.UpperMs)*time.Millisecond {
+			t.Errorf("bucket %d label %q does not match its %dms bound", i, bucket.Label, bucket.UpperMs)
+		}
+		if i > 0 && bucket.UpperMs <= MetricsHistogramBuckets[i-1].UpperMs {
+			t.Errorf("bucket %d bound %d is not above the previous one", i, bucket.UpperMs)
+		}
+	}
+
+	for i, want := range map[int]string{0: "< 20ms", 1: "20ms–30ms", 10: "750ms–1.1s", last: "≥ 335s"} {
+		if got := MetricsHistogramRange(i); got != want {
+			t.Errorf("MetricsHistogramRange(%d) = %q, want %q", i, got, want)
+		}
+	}
+	if got := MetricsHistogramRange(last + 1); got != "" {
+		t.Errorf("MetricsHistogramRange(%d) = %q, want empty", last+1, got)
+	}
+}
@@ -0,0 +1,47 @@
+package sidekiq
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// SchedulerLagThreshold is how far past due the earliest scheduled job may be
+// before the scheduled poller counts as lagging. Sidekiq polls every few
+// seconds on average, so smaller delays are normal.
+const SchedulerLagThreshold = 30 * time.Second
+
+// ErrNoPollerHeartbeat is returned when no poller heartbeat key is configured
+// or the key holds no timestamp.
+var ErrNoPollerHeartbeat = errors.New("no poller heartbeat")
+
+// SetPollerKey sets the key a scheduled poller writes its last poll time to,
+// as an epoch timestamp. Sidekiq itself writes no such key, so it is empty by
+// default and the poller heartbeat is unknown.
+func (c *Client) SetPollerKey(key string) {
+	c.pollerKey = key
+}
+
+// GetPollerHeartbeat returns when the scheduled poller last ran, read from the
+// key set with SetPollerKey. It returns ErrNoPollerHeartbeat when the key is
+// not configured, absent or not a timestamp.
+func (c *Client) GetPollerHeartbeat(ctx context.Context) (time.Time, error) {
+	if c.pollerKey == "" {
+		return time.Time{}, ErrNoPollerHeartbeat
+	}
+	raw, err := c.rdb().Get(ctx, c.pollerKey).Float64()
+	if errors.Is(err, redis.Nil) || errors.Is(err, strconv.ErrSyntax) {
+		return time.Time{}, ErrNoPollerHeartbeat
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	beat := parseTimestamp(raw)
+	if beat.IsZero() {
+		return time.Time{}, ErrNoPollerHeartbeat
+	}
+	return beat, nil
+}
@@ -0,0 +1,43 @@
+package sidekiq
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestGetPollerHeartbeat(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx := testContext(t)
+
+	client.SetPollerKey("lazykiq:poller")
+	_ = mr.Set("lazykiq:poller", "1700000000.5")
+
+	beat, err := client.GetPollerHeartbeat(ctx)
+	if err != nil {
+		t.Fatalf("GetPollerHeartbeat failed: %v", err)
+	}
+	want := time.Unix(1700000000, int64(500*time.Millisecond))
+	if !beat.Equal(want) {
+		t.Fatalf("beat = %v, want %v", beat, want)
+	}
+}
+
+func TestGetPollerHeartbeat_Unknown(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx := testContext(t)
+
+	if _, err := client.GetPollerHeartbeat(ctx); !errors.Is(err, ErrNoPollerHeartbeat) {
+		t.Fatalf("unconfigured key: err = %v, want ErrNoPollerHeartbeat", err)
+	}
+
+	client.SetPollerKey("lazykiq:poller")
+	if _, err := client.GetPollerHeartbeat(ctx); !errors.Is(err, ErrNoPollerHeartbeat) {
+		t.Fatalf("absent key: err = %v, want ErrNoPollerHeartbeat", err)
+	}
+
+	_ = mr.Set("lazykiq:poller", "yesterday")
+	if _, err := client.GetPollerHeartbeat(ctx); !errors.Is(err, ErrNoPollerHeartbeat) {
+		t.Fatalf("malformed key: err = %v, want ErrNoPollerHeartbeat", err)
+	}
+}
@@ -0,0 +1,179 @@
+package sidekiq
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// previewWriteCommands are the commands that change data. A preview records
+// them instead of sending them; every other command still reaches Redis, so
+// the action sees the current data.
+var previewWriteCommands = map[string]bool{
+	"brpop":            true,
+	"del":              true,
+	"eval":             true,
+	"evalsha":          true,
+	"exec":             true,
+	"expire":           true,
+	"hdel":             true,
+	"hset":             true,
+	"lpush":            true,
+	"lrem":             true,
+	"multi":            true,
+	"rpop":             true,
+	"rpush":            true,
+	"sadd":             true,
+	"set":              true,
+	"srem":             true,
+	"unlink":           true,
+	"zadd":             true,
+	"zincrby":          true,
+	"zpopmin":          true,
+	"zrem":             true,
+	"zremrangebyscore": true,
+}
+
+// PreviewCommands runs action against a copy of the client that records the
+// commands changing data instead of sending them, and returns them formatted
+// as redis-cli input, one command per line. Reads still reach Redis, while
+// every write appears to succeed, so the preview follows the path the action
+// takes when nothing races it. The copy has its own activity log and undo
+// history, so the preview leaves no trace in this client.
+func (c *Client) PreviewCommands(ctx context.Context, action func(context.Context, API) error) ([]string, error) {
+	opts := *c.rdb().Options()
+	rdb := redis.NewClient(&opts)
+	defer func() {
+		_ = rdb.Close()
+	}()
+	hook := &previewHook{}
+	rdb.AddHook(hook)
+
+	preview := &Client{
+		redis:           rdb,
+		displayRedisURL: c.displayRedisURL,
+		leaderKey:       c.leaderKey,
+		pollerKey:       c.pollerKey,
+		discoverQueues:  c.discoverQueues,
+		metricsPrefix:   c.metricsPrefix,
+		deadLimits:      c.deadLimits,
+		connection:      c.connection,
+	}
+	preview.version.Store(c.version.Load())
+	if err := action(ctx, preview); err != nil {
+		return nil, err
+	}
+	return hook.commands, nil
+}
+
+// previewHook records write commands and answers them without sending them.
+type previewHook struct {
+	commands []string
+}
+
+func (h *previewHook) DialHook(next redis.DialHook) redis.DialHook {
+	return next
+}
+
+func (h *previewHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		if !isPreviewWrite(cmd) {
+			return next(ctx, cmd)
+		}
+		h.record(cmd)
+		return nil
+	}
+}
+
+func (h *previewHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		// Reads queued next to writes get empty replies; the client never
+		// mixes them in one pipeline.
+		if !slices.ContainsFunc(cmds, isPreviewWrite) {
+			return next(ctx, cmds)
+		}
+		for _, cmd := range cmds {
+			if isPreviewWrite(cmd) {
+				h.record(cmd)
+			}
+		}
+		return nil
+	}
+}
+
+// record formats cmd and gives it the reply a successful write would get, so
+// checks like "job not found" after ZREM pass.
+func (h *previewHook) record(cmd redis.Cmder) {
+	h.commands = append(h.commands, formatRedisCLICommand(cmd.Args()))
+	switch cmd := cmd.(type) {
+	case *redis.IntCmd:
+		cmd.SetVal(1)
+	case *redis.StatusCmd:
+		cmd.SetVal("OK")
+	case *redis.BoolCmd:
+		cmd.SetVal(true)
+	case *redis.Cmd:
+		cmd.SetVal(int64(1))
+	}
+}
+
+func isPreviewWrite(cmd redis.Cmder) bool {
+	return previewWriteCommands[strings.ToLower(cmd.Name())]
+}
+
+// formatRedisCLICommand renders args as a line redis-cli accepts, with the
+// command name upper-cased and arguments quoted where needed.
+func formatRedisCLICommand(args []any) string {
+	parts := make([]string, len(args))
+	for i, arg := range args {
+		value := fmt.Sprint(arg)
+		if b, ok := arg.([]byte); ok {
+			value = string(b)
+		}
+		if i == 0 {
+			parts[i] = strings.ToUpper(value)
+			continue
+		}
+		parts[i] = quoteRedisCLIArg(value)
+	}
+	return strings.Join(parts, " ")
+}
+
+// quoteRedisCLIArg double-quotes value when it is empty or holds spaces,
+// quotes, backslashes or control bytes, escaping them the way redis-cli
+// parses them.
+func quoteRedisCLIArg(value string) string {
+	if value != "" && !strings.ContainsFunc(value, func(r rune) bool {
+		return r <= ' ' || r == '"' || r == '\'' || r == '\\' || r == 0x7f
+	}) {
+		return value
+	}
+
+	var b strings.Builder
+	b.WriteByte('"')
+	for i := 0; i < len(value); i++ {
+		ch := value[i]
+		switch ch {
+		case '"', '\\':
+			b.WriteByte('\\')
+			b.WriteByte(ch)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			if ch < ' ' || ch == 0x7f {
+				fmt.Fprintf(&b, `\x%02x`, ch)
+			} else {
+				b.WriteByte(ch)
+			}
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
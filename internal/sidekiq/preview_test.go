@@ -0,0 +1,86 @@
+package sidekiq
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestPreviewCommands(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx := testContext(t)
+
+	jobJSON := `{"jid":"dead-1","class":"MyJob","queue":"default","args":[]}`
+	_, _ = mr.ZAdd("dead", testScoreA, jobJSON)
+
+	commands, err := client.PreviewCommands(ctx, func(ctx context.Context, api API) error {
+		return api.DeleteSortedEntry(ctx, SortedSetDead, NewSortedEntry(jobJSON, testScoreA))
+	})
+	if err != nil {
+		t.Fatalf("PreviewCommands failed: %v", err)
+	}
+	want := `ZREM dead "{\"jid\":\"dead-1\",\"class\":\"MyJob\",\"queue\":\"default\",\"args\":[]}"`
+	if len(commands) != 1 || commands[0] != want {
+		t.Fatalf("commands = %q, want [%q]", commands, want)
+	}
+
+	commands, err = client.PreviewCommands(ctx, func(ctx context.Context, api API) error {
+		return api.EnqueueSortedEntryToFront(ctx, SortedSetDead, NewSortedEntry(jobJSON, testScoreA))
+	})
+	if err != nil {
+		t.Fatalf("PreviewCommands failed: %v", err)
+	}
+	wantPrefixes := []string{"ZREM dead ", "MULTI", "SADD queues default", `RPUSH queue:default "{`, "EXEC"}
+	if len(commands) != len(wantPrefixes) {
+		t.Fatalf("commands = %q, want %d commands", commands, len(wantPrefixes))
+	}
+	for i, prefix := range wantPrefixes {
+		if !strings.HasPrefix(commands[i], prefix) {
+			t.Fatalf("commands[%d] = %q, want prefix %q", i, commands[i], prefix)
+		}
+	}
+
+	if members, _ := mr.ZMembers("dead"); len(members) != 1 {
+		t.Fatalf("dead set = %v, want the job untouched", members)
+	}
+	if mr.Exists("queue:default") {
+		t.Fatal("queue:default exists, want nothing enqueued")
+	}
+	if activity := client.Activity(); len(activity) != 0 {
+		t.Fatalf("activity = %v, want none", activity)
+	}
+	if _, ok := client.LastUndo(); ok {
+		t.Fatal("LastUndo ok, want no undo after a preview")
+	}
+}
+
+func TestPreviewCommands_ActionError(t *testing.T) {
+	_, client := setupTestRedis(t)
+	ctx := testContext(t)
+
+	_, err := client.PreviewCommands(ctx, func(ctx context.Context, api API) error {
+		return api.DeleteSortedEntry(ctx, SortedSetDead, nil)
+	})
+	if err == nil {
+		t.Fatal("PreviewCommands succeeded, want the action's error")
+	}
+}
+
+func TestQuoteRedisCLIArg(t *testing.T) {
+	tests := map[string]string{
+		"default":      "default",
+		"":             `""`,
+		"two words":    `"two words"`,
+		`say "hi"`:     `"say \"hi\""`,
+		`back\slash`:   `"back\\slash"`,
+		"line\nbreak":  `"line\nbreak"`,
+		"bell\x07":     `"bell\x07"`,
+		"it's":         `"it's"`,
+		"unicode-ключ": "unicode-ключ",
+	}
+	for value, want := range tests {
+		if got := quoteRedisCLIArg(value); got != want {
+			t.Errorf("quoteRedisCLIArg(%q) = %s, want %s", value, got, want)
+		}
+	}
+}
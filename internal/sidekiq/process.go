@@ -1,6 +1,8 @@
 package sidekiq
 
 import (
+	"bytes"
+	"cmp"
 	"context"
 	"encoding/json"
 	"errors"
@@ -17,6 +19,10 @@ import (
 // DefaultCapsuleName matches Sidekiq's implicit capsule name.
 const DefaultCapsuleName = "default"
 
+// DefaultLeaderKey is the key Sidekiq Enterprise uses to store the identity of
+// the elected leader process.
+const DefaultLeaderKey = "dear-leader"
+
 // Process represents a Sidekiq worker process.
 type Process struct {
 	client      *Client
@@ -25,22 +31,31 @@ type Process struct {
 	PID         int                // Parsed from identity (e.g., 14)
 	Tag         string             // From info.tag (e.g., "myapp")
 	Version     string             // From info.version (e.g., "7.2.1")
+	Labels      []string           // From info.labels (e.g., ["reliable"])
 	Concurrency int                // From info.concurrency
 	Busy        int                // From busy field (converted to int)
 	Beat        time.Time          // From beat field (heartbeat timestamp)
 	Quiet       bool               // From quiet field
-	Status      string             // Running, Pausing, Quiet, Stopping
+	Status      string             // Running, Quieting, Quiet, Stopping
 	Capsules    map[string]Capsule // From info.capsules (Sidekiq 8+)
 	RSS         int64              // From rss field in KB, convert to bytes (*1024)
 	RTTUS       int64              // From rtt_us field (microseconds)
 	StartedAt   time.Time          // From info.started_at (timestamp)
+	DeadMaxJobs int64              // From info.dead_max_jobs, when the version exposes it
+	DeadTimeout time.Duration      // From info.dead_timeout_in_seconds, when exposed
 }
 
 // Process status values.
 const (
-	ProcessStatusRunning  = "running"
-	ProcessStatusPausing  = "pausing"
-	ProcessStatusQuiet    = "quiet"
+	// ProcessStatusRunning is a process fetching new jobs.
+	ProcessStatusRunning = "running"
+	// ProcessStatusQuieting is a process told to quiet (TSTP) that has not
+	// picked up the signal yet, or that stopped fetching but is still
+	// finishing busy jobs.
+	ProcessStatusQuieting = "quieting"
+	// ProcessStatusQuiet is a quiet process with no jobs left in flight.
+	ProcessStatusQuiet = "quiet"
+	// ProcessStatusStopping is a process told to shut down (TERM).
 	ProcessStatusStopping = "stopping"
 )
 
@@ -69,6 +84,7 @@ type Capsule struct {
 	Concurrency int
 	Mode        string
 	Weights     map[string]int
+	Queues      []string // Queue names in declaration order
 }
 
 type processInfo struct {
@@ -84,12 +100,51 @@ type processInfo struct {
 	Identity    string                 `json:"identity"`
 	Version     string                 `json:"version"`
 	Embedded    bool                   `json:"embedded"`
+	// Dead set limits live in worker config; only some setups publish them.
+	DeadMaxJobs          int64   `json:"dead_max_jobs"`
+	DeadTimeoutInSeconds float64 `json:"dead_timeout_in_seconds"`
 }
 
 type capsuleInfo struct {
 	Concurrency int            `json:"concurrency"`
 	Mode        string         `json:"mode"`
-	Weights     map[string]int `json:"weights"`
+	Weights     orderedWeights `json:"weights"`
+}
+
+// orderedWeights decodes a queue weights hash, remembering key order, which
+// Sidekiq uses as the priority order of strict capsules.
+type orderedWeights struct {
+	queues  []string
+	weights map[string]int
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (w *orderedWeights) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &w.weights); err != nil {
+		return err
+	}
+	if len(w.weights) == 0 {
+		return nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+	w.queues = make([]string, 0, len(w.weights))
+	for dec.More() {
+		token, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		var weight int
+		if err := dec.Decode(&weight); err != nil {
+			return err
+		}
+		if name, ok := token.(string); ok && !slices.Contains(w.queues, name) {
+			w.queues = append(w.queues, name)
+		}
+	}
+	return nil
 }
 
 // NewProcess creates a new Process instance for the given identity.
@@ -102,7 +157,7 @@ func (c *Client) NewProcess(identity string) *Process {
 
 // GetProcesses fetches all process identities from Redis, sorted alphabetically.
 func (c *Client) GetProcesses(ctx context.Context) ([]*Process, error) {
-	identities, err := c.redis.SMembers(ctx, "processes").Result()
+	identities, err := c.rdb().SMembers(ctx, "processes").Result()
 	if err != nil && !errors.Is(err, redis.Nil) {
 		return nil, err
 	}
@@ -124,7 +179,7 @@ func (c *Client) GetBusyData(ctx context.Context, filter string) (BusyData, erro
 	var data BusyData
 
 	// Step 1: Get all process identities
-	identities, err := c.redis.SMembers(ctx, "processes").Result()
+	identities, err := c.rdb().SMembers(ctx, "processes").Result()
 	if err != nil && !errors.Is(err, redis.Nil) {
 		return data, err
 	}
@@ -136,7 +191,7 @@ func (c *Client) GetBusyData(ctx context.Context, filter string) (BusyData, erro
 	sort.Strings(identities)
 
 	// Step 2: Pipeline all process metadata fetches
-	processResults, err := c.redis.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+	processResults, err := c.rdb().Pipelined(ctx, func(pipe redis.Pipeliner) error {
 		for _, identity := range identities {
 			pipe.HMGet(ctx, identity, "info", "busy", "beat", "quiet", "rss", "rtt_us")
 		}
@@ -147,7 +202,7 @@ func (c *Client) GetBusyData(ctx context.Context, filter string) (BusyData, erro
 	}
 
 	// Step 3: Pipeline all signal fetches
-	signalResults, err := c.redis.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+	signalResults, err := c.rdb().Pipelined(ctx, func(pipe redis.Pipeliner) error {
 		for _, identity := range identities {
 			pipe.LRange(ctx, identity+"-signals", 0, -1)
 		}
@@ -158,7 +213,7 @@ func (c *Client) GetBusyData(ctx context.Context, filter string) (BusyData, erro
 	}
 
 	// Step 4: Pipeline all work data fetches
-	workResults, err := c.redis.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+	workResults, err := c.rdb().Pipelined(ctx, func(pipe redis.Pipeliner) error {
 		for _, identity := range identities {
 			pipe.HGetAll(ctx, identity+":work")
 		}
@@ -221,7 +276,7 @@ func (p *Process) Refresh(ctx context.Context) error {
 		return errors.New("process client is nil")
 	}
 
-	fields, err := p.client.redis.HMGet(ctx, p.Identity, "info", "busy", "beat", "quiet", "rss", "rtt_us").Result()
+	fields, err := p.client.rdb().HMGet(ctx, p.Identity, "info", "busy", "beat", "quiet", "rss", "rtt_us").Result()
 	if err != nil && !errors.Is(err, redis.Nil) {
 		return err
 	}
@@ -237,7 +292,7 @@ func (p *Process) GetJobs(ctx context.Context, filter string) ([]Job, error) {
 		return nil, errors.New("process client is nil")
 	}
 
-	work, err := p.client.redis.HGetAll(ctx, p.Identity+":work").Result()
+	work, err := p.client.rdb().HGetAll(ctx, p.Identity+":work").Result()
 	if err != nil && !errors.Is(err, redis.Nil) {
 		return nil, err
 	}
@@ -247,24 +302,28 @@ func (p *Process) GetJobs(ctx context.Context, filter string) ([]Job, error) {
 
 // Pause signals the process to stop accepting new jobs.
 func (p *Process) Pause(ctx context.Context) error {
-	return p.signal(ctx, "TSTP")
+	return p.signal(ctx, "TSTP", ActivityPause)
 }
 
 // Stop signals the process to shutdown.
 func (p *Process) Stop(ctx context.Context) error {
-	return p.signal(ctx, "TERM")
+	return p.signal(ctx, "TERM", ActivityStop)
 }
 
-func (p *Process) signal(ctx context.Context, sig string) error {
+func (p *Process) signal(ctx context.Context, sig string, action ActivityAction) (err error) {
 	if p.client == nil {
 		return errors.New("process client is nil")
 	}
 	if p.Identity == "" {
 		return errors.New("process identity is empty")
 	}
+	defer func() { p.client.recordActivity(action, p.Identity, "", "", err) }()
+	if err := p.client.checkWritable(); err != nil {
+		return err
+	}
 
 	key := p.Identity + "-signals"
-	_, err := p.client.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+	_, err = p.client.rdb().TxPipelined(ctx, func(pipe redis.Pipeliner) error {
 		pipe.LPush(ctx, key, sig)
 		pipe.Expire(ctx, key, time.Minute)
 		return nil
@@ -278,9 +337,12 @@ func (p *Process) refreshFromFields(fields []any) {
 	p.PID = 0
 	p.Tag = ""
 	p.Version = ""
+	p.Labels = nil
 	p.Concurrency = 0
 	p.Capsules = nil
 	p.StartedAt = time.Time{}
+	p.DeadMaxJobs = 0
+	p.DeadTimeout = 0
 
 	p.Busy = 0
 	p.Beat = time.Time{}
@@ -323,15 +385,21 @@ func (p *Process) refreshFromFields(fields []any) {
 	p.updateStatus(nil)
 }
 
+// updateStatus derives Status from the pending signals, the quiet flag and the
+// busy count. Sidekiq pops signals off the list as it handles them, so a
+// pending TSTP means the process has not set its quiet flag yet, while TERM
+// wins over both because the process is going away either way.
 func (p *Process) updateStatus(signals []string) {
 	status := ProcessStatusRunning
 	switch {
 	case slices.Contains(signals, "TERM"):
 		status = ProcessStatusStopping
+	case p.Quiet && p.Busy > 0:
+		status = ProcessStatusQuieting
 	case p.Quiet:
 		status = ProcessStatusQuiet
 	case slices.Contains(signals, "TSTP"):
-		status = ProcessStatusPausing
+		status = ProcessStatusQuieting
 	}
 	p.Status = status
 }
@@ -396,13 +464,22 @@ func parseProcessInfo(field any, process *Process) {
 					Concurrency: info.Concurrency,
 					Mode:        capsuleModeFromWeights(weights),
 					Weights:     weights,
+					Queues:      capsuleQueueOrder(queues, weights),
 				},
 			}
 		}
 	}
 	process.Tag = info.Tag
 	process.Version = info.Version
+	process.Labels = slices.DeleteFunc(slices.Clone(info.Labels), func(label string) bool {
+		return label == ""
+	})
+	if len(process.Labels) == 0 {
+		process.Labels = nil
+	}
 	process.StartedAt = parseTimestamp(info.StartedAt)
+	process.DeadMaxJobs = max(info.DeadMaxJobs, 0)
+	process.DeadTimeout = time.Duration(max(info.DeadTimeoutInSeconds, 0) * float64(time.Second))
 }
 
 func parseProcessCapsules(capsules map[string]capsuleInfo) map[string]Capsule {
@@ -415,7 +492,8 @@ func parseProcessCapsules(capsules map[string]capsuleInfo) map[string]Capsule {
 		parsed[name] = Capsule{
 			Concurrency: capsule.Concurrency,
 			Mode:        capsule.Mode,
-			Weights:     maps.Clone(capsule.Weights),
+			Weights:     maps.Clone(capsule.Weights.weights),
+			Queues:      slices.Clone(capsule.Weights.queues),
 		}
 	}
 	if len(parsed) == 0 {
@@ -475,6 +553,97 @@ func capsuleModeFromWeights(weights map[string]int) string {
 	return "weighted"
 }
 
+// capsuleQueueOrder lists declared queues first, then queues that only have
+// a weight, by name.
+func capsuleQueueOrder(queues []string, weights map[string]int) []string {
+	order := make([]string, 0, len(weights))
+	for _, queue := range queues {
+		if !slices.Contains(order, queue) {
+			order = append(order, queue)
+		}
+	}
+	extra := make([]string, 0, len(weights))
+	for queue := range weights {
+		if !slices.Contains(order, queue) {
+			extra = append(extra, queue)
+		}
+	}
+	slices.Sort(extra)
+	return append(order, extra...)
+}
+
+// QueuePoll is a queue's place in a capsule's polling order.
+type QueuePoll struct {
+	Queue  string
+	Weight int
+	// Probability is the chance the queue is checked first on a fetch.
+	Probability float64
+}
+
+// EffectiveMode returns the reported mode, or the one implied by the weights
+// when the process did not report it.
+func (c Capsule) EffectiveMode() string {
+	if c.Mode != "" {
+		return c.Mode
+	}
+	return capsuleModeFromWeights(c.Weights)
+}
+
+// PollingOrder returns the order in which Sidekiq checks the capsule's queues.
+//
+// Strict capsules check queues in declaration order, so the first queue is
+// always checked first. Weighted capsules shuffle a list holding each queue
+// weight times (queues without a weight appear once) on every fetch, so a
+// queue is checked first with probability weight/total; queues are listed by
+// that probability. Random capsules give every queue the same chance.
+func (c Capsule) PollingOrder() []QueuePoll {
+	queues := c.Queues
+	if len(queues) == 0 {
+		queues = queuesByName(c.Weights)
+	}
+	if len(queues) == 0 {
+		return nil
+	}
+
+	polls := make([]QueuePoll, len(queues))
+	switch c.EffectiveMode() {
+	case "strict":
+		for i, queue := range queues {
+			polls[i] = QueuePoll{Queue: queue, Weight: c.Weights[queue]}
+		}
+		polls[0].Probability = 1
+	case "weighted":
+		total := 0
+		for i, queue := range queues {
+			weight := max(c.Weights[queue], 1)
+			polls[i] = QueuePoll{Queue: queue, Weight: weight}
+			total += weight
+		}
+		for i := range polls {
+			polls[i].Probability = float64(polls[i].Weight) / float64(total)
+		}
+		// Stable sort keeps declaration order between equal weights.
+		slices.SortStableFunc(polls, func(a, b QueuePoll) int {
+			return cmp.Compare(b.Weight, a.Weight)
+		})
+	default:
+		for i, queue := range queues {
+			polls[i] = QueuePoll{
+				Queue:       queue,
+				Weight:      c.Weights[queue],
+				Probability: 1 / float64(len(queues)),
+			}
+		}
+	}
+	return polls
+}
+
+func queuesByName(weights map[string]int) []string {
+	queues := slices.Collect(maps.Keys(weights))
+	slices.Sort(queues)
+	return queues
+}
+
 func parseProcessQueues(queues []string, weights json.RawMessage) ([]string, map[string]int) {
 	parsedQueues, queueWeights := parseQueuesField(queues)
 	weightMap := parseQueueWeightsRaw(weights)
@@ -577,3 +746,29 @@ func fieldAt(fields []any, idx int) any {
 	}
 	return nil
 }
+
+// SetLeaderKey overrides the key holding the leader process identity.
+// An empty key restores DefaultLeaderKey.
+func (c *Client) SetLeaderKey(key string) {
+	if key == "" {
+		key = DefaultLeaderKey
+	}
+	c.leaderKey = key
+}
+
+// GetLeader returns the identity of the elected leader process.
+// It returns an empty string when no leader key exists.
+func (c *Client) GetLeader(ctx context.Context) (string, error) {
+	key := c.leaderKey
+	if key == "" {
+		key = DefaultLeaderKey
+	}
+	leader, err := c.rdb().Get(ctx, key).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(leader), nil
+}
@@ -7,6 +7,8 @@ import (
 	"strconv"
 	"testing"
 	"time"
+
+	"github.com/alicebob/miniredis/v2"
 )
 
 func TestParseProcessInfoQueuesAndWeights(t *testing.T) {
@@ -42,6 +44,12 @@ func TestParseProcessInfoQueuesAndWeights(t *testing.T) {
 	if process.Tag != "app" {
 		t.Fatalf("Tag = %q, want %q", process.Tag, "app")
 	}
+	if !reflect.DeepEqual(process.Labels, []string{"alpha"}) {
+		t.Fatalf("Labels = %v, want [alpha]", process.Labels)
+	}
+	if process.Version != "7.0.0" {
+		t.Fatalf("Version = %q, want %q", process.Version, "7.0.0")
+	}
 	if process.Concurrency != 10 {
 		t.Fatalf("Concurrency = %d, want %d", process.Concurrency, 10)
 	}
@@ -400,8 +408,8 @@ func TestGetBusyData_StatusFromSignals(t *testing.T) {
 		statusByID[proc.Identity] = proc.Status
 	}
 
-	if statusByID["host1:100:abc"] != ProcessStatusPausing {
-		t.Fatalf("host1 status = %q, want %q", statusByID["host1:100:abc"], ProcessStatusPausing)
+	if statusByID["host1:100:abc"] != ProcessStatusQuieting {
+		t.Fatalf("host1 status = %q, want %q", statusByID["host1:100:abc"], ProcessStatusQuieting)
 	}
 	if statusByID["host2:200:def"] != ProcessStatusQuiet {
 		t.Fatalf("host2 status = %q, want %q", statusByID["host2:200:def"], ProcessStatusQuiet)
@@ -414,6 +422,36 @@ func TestGetBusyData_StatusFromSignals(t *testing.T) {
 	}
 }
 
+func TestProcessUpdateStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		signals []string
+		quiet   bool
+		busy    int
+		want    string
+	}{
+		{name: "running", want: ProcessStatusRunning},
+		{name: "running busy", busy: 3, want: ProcessStatusRunning},
+		{name: "TSTP pending", signals: []string{"TSTP"}, want: ProcessStatusQuieting},
+		{name: "TSTP pending busy", signals: []string{"TSTP"}, busy: 2, want: ProcessStatusQuieting},
+		{name: "quiet idle", quiet: true, want: ProcessStatusQuiet},
+		{name: "quiet busy", quiet: true, busy: 2, want: ProcessStatusQuieting},
+		{name: "quiet with TSTP pending", signals: []string{"TSTP"}, quiet: true, want: ProcessStatusQuiet},
+		{name: "TERM", signals: []string{"TERM"}, want: ProcessStatusStopping},
+		{name: "TERM busy", signals: []string{"TERM"}, busy: 4, want: ProcessStatusStopping},
+		{name: "TERM after TSTP", signals: []string{"TERM", "TSTP"}, quiet: true, busy: 1, want: ProcessStatusStopping},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &Process{Quiet: tt.quiet, Busy: tt.busy}
+			p.updateStatus(tt.signals)
+			if p.Status != tt.want {
+				t.Fatalf("Status = %q, want %q", p.Status, tt.want)
+			}
+		})
+	}
+}
+
 func TestGetBusyData_Filter(t *testing.T) {
 	mr, client := setupTestRedis(t)
 	ctx := testContext(t)
@@ -779,6 +817,48 @@ func TestGetBusyData_SkipInvalidProcesses(t *testing.T) {
 	}
 }
 
+func TestGetLeader(t *testing.T) {
+	tests := map[string]struct {
+		key   string
+		setup func(mr *miniredis.Miniredis)
+		want  string
+	}{
+		"default key": {
+			setup: func(mr *miniredis.Miniredis) {
+				_ = mr.Set("dear-leader", "host:1:abc")
+			},
+			want: "host:1:abc",
+		},
+		"custom key": {
+			key: "myapp:leader",
+			setup: func(mr *miniredis.Miniredis) {
+				_ = mr.Set("dear-leader", "host:1:abc")
+				_ = mr.Set("myapp:leader", "host:2:def")
+			},
+			want: "host:2:def",
+		},
+		"missing key": {
+			setup: func(*miniredis.Miniredis) {},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			mr, client := setupTestRedis(t)
+			client.SetLeaderKey(tc.key)
+			tc.setup(mr)
+
+			got, err := client.GetLeader(testContext(t))
+			if err != nil {
+				t.Fatalf("GetLeader failed: %v", err)
+			}
+			if got != tc.want {
+				t.Fatalf("GetLeader = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
 func mustMarshalJSON(t *testing.T, value any) []byte {
 	t.Helper()
 
@@ -788,3 +868,133 @@ func mustMarshalJSON(t *testing.T, value any) []byte {
 	}
 	return data
 }
+
+func TestParseProcessInfoLabelsAndVersion(t *testing.T) {
+	t.Parallel()
+
+	var process Process
+	parseProcessInfo(`{"hostname":"host","pid":1,"labels":["reliable","","critical"],"version":"8.0.1"}`, &process)
+	if !reflect.DeepEqual(process.Labels, []string{"reliable", "critical"}) {
+		t.Fatalf("Labels = %v, want [reliable critical]", process.Labels)
+	}
+	if process.Version != "8.0.1" {
+		t.Fatalf("Version = %q, want %q", process.Version, "8.0.1")
+	}
+
+	parseProcessInfo(`{"hostname":"host","pid":1}`, &process)
+	if process.Labels != nil {
+		t.Fatalf("Labels = %v, want nil without labels", process.Labels)
+	}
+}
+
+func TestParseProcessInfoCapsuleQueueOrder(t *testing.T) {
+	t.Parallel()
+
+	var process Process
+	parseProcessInfo(`{"capsules":{"default":{"mode":"strict","weights":{"critical":0,"default":0,"bulk":0}}}}`, &process)
+	if got := process.Capsules[DefaultCapsuleName].Queues; !reflect.DeepEqual(got, []string{"critical", "default", "bulk"}) {
+		t.Fatalf("Queues = %v, want declaration order", got)
+	}
+
+	var legacy Process
+	parseProcessInfo(`{"queues":["critical","default"],"weights":[{"low":0}]}`, &legacy)
+	if got := legacy.Capsules[DefaultCapsuleName].Queues; !reflect.DeepEqual(got, []string{"critical", "default", "low"}) {
+		t.Fatalf("legacy Queues = %v, want declared queues then weighted ones", got)
+	}
+}
+
+func TestCapsulePollingOrder(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		capsule Capsule
+		want    []QueuePoll
+	}{
+		{
+			name: "strict follows declaration order",
+			capsule: Capsule{
+				Mode:    "strict",
+				Weights: map[string]int{"critical": 0, "default": 0, "bulk": 0},
+				Queues:  []string{"critical", "default", "bulk"},
+			},
+			want: []QueuePoll{
+				{Queue: "critical", Probability: 1},
+				{Queue: "default"},
+				{Queue: "bulk"},
+			},
+		},
+		{
+			name: "weighted ranks by probability",
+			capsule: Capsule{
+				Mode:    "weighted",
+				Weights: map[string]int{"low": 1, "critical": 6, "default": 3},
+				Queues:  []string{"low", "critical", "default"},
+			},
+			want: []QueuePoll{
+				{Queue: "critical", Weight: 6, Probability: 0.6},
+				{Queue: "default", Weight: 3, Probability: 0.3},
+				{Queue: "low", Weight: 1, Probability: 0.1},
+			},
+		},
+		{
+			name: "weighted keeps declaration order for ties and counts missing weights once",
+			capsule: Capsule{
+				Mode:    "weighted",
+				Weights: map[string]int{"b": 2, "a": 2, "c": 0},
+				Queues:  []string{"b", "a", "c"},
+			},
+			want: []QueuePoll{
+				{Queue: "b", Weight: 2, Probability: 0.4},
+				{Queue: "a", Weight: 2, Probability: 0.4},
+				{Queue: "c", Weight: 1, Probability: 0.2},
+			},
+		},
+		{
+			name: "random gives equal chances",
+			capsule: Capsule{
+				Mode:    "random",
+				Weights: map[string]int{"a": 1, "b": 1, "c": 1, "d": 1},
+				Queues:  []string{"d", "a", "c", "b"},
+			},
+			want: []QueuePoll{
+				{Queue: "d", Weight: 1, Probability: 0.25},
+				{Queue: "a", Weight: 1, Probability: 0.25},
+				{Queue: "c", Weight: 1, Probability: 0.25},
+				{Queue: "b", Weight: 1, Probability: 0.25},
+			},
+		},
+		{
+			name: "mode and order derived when missing",
+			capsule: Capsule{
+				Weights: map[string]int{"b": 0, "a": 0},
+			},
+			want: []QueuePoll{
+				{Queue: "a", Probability: 1},
+				{Queue: "b"},
+			},
+		},
+		{
+			name:    "empty capsule",
+			capsule: Capsule{Mode: "strict"},
+			want:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tt.capsule.PollingOrder()
+			if len(got) != len(tt.want) {
+				t.Fatalf("PollingOrder() = %+v, want %+v", got, tt.want)
+			}
+			for i := range got {
+				if got[i].Queue != tt.want[i].Queue || got[i].Weight != tt.want[i].Weight ||
+					math.Abs(got[i].Probability-tt.want[i].Probability) > 1e-9 {
+					t.Fatalf("PollingOrder()[%d] = %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
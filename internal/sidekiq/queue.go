@@ -4,18 +4,29 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
-	"sort"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/redis/go-redis/v9"
 )
 
+// ErrMemoryUsageUnsupported is returned by memory estimates when the Redis
+// server does not provide MEMORY USAGE (before Redis 4, or when the command
+// is disabled or not permitted).
+var ErrMemoryUsageUnsupported = errors.New("MEMORY USAGE not supported")
+
+// queueMemoryUsageSamples is the SAMPLES option passed to MEMORY USAGE: how
+// many list elements Redis inspects to estimate the size of a queue. Zero
+// leaves the option out.
+var queueMemoryUsageSamples = 10
+
 // Queue represents a Sidekiq queue.
 // Mirrors the Sidekiq::Queue Ruby class.
 type Queue struct {
 	client *Client
 	name   string
+	orphan bool
 }
 
 // NewQueue creates a new Queue instance for the given queue name.
@@ -27,39 +38,112 @@ func (c *Client) NewQueue(name string) *Queue {
 }
 
 // GetQueues fetches all known queues from Redis, sorted alphabetically.
-// Mirrors Sidekiq::Queue.all.
+// Mirrors Sidekiq::Queue.all. With queue discovery enabled, queue lists
+// missing from the queues set are included and marked as orphans.
 func (c *Client) GetQueues(ctx context.Context) ([]*Queue, error) {
-	names, err := c.redis.SMembers(ctx, "queues").Result()
+	names, err := c.rdb().SMembers(ctx, "queues").Result()
 	if err != nil && !errors.Is(err, redis.Nil) {
 		return nil, err
 	}
 
-	sort.Strings(names)
-
 	queues := make([]*Queue, len(names))
 	for i, name := range names {
 		queues[i] = c.NewQueue(name)
 	}
 
+	if c.discoverQueues {
+		orphans, err := c.discoverOrphanQueues(ctx, names)
+		if err != nil {
+			return nil, err
+		}
+		queues = append(queues, orphans...)
+	}
+
+	slices.SortFunc(queues, func(a, b *Queue) int {
+		return strings.Compare(a.name, b.name)
+	})
 	return queues, nil
 }
 
+// SetDiscoverQueues makes GetQueues also scan queue:* keys for queue lists
+// that were never added to the queues set, as some tools push to a queue
+// without registering it. Scanning walks the whole keyspace, so it is off by
+// default.
+func (c *Client) SetDiscoverQueues(enabled bool) {
+	c.discoverQueues = enabled
+}
+
+// discoverOrphanQueues returns the queue lists found by scanning queue:* keys
+// that are not among known. Keys holding other types and Sidekiq Pro's
+// private super_fetch queues (queue:sq|identity|name) are left out.
+func (c *Client) discoverOrphanQueues(ctx context.Context, known []string) ([]*Queue, error) {
+	keys, err := c.scanKeys(ctx, queuePrefixKey+"*")
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []string
+	for _, key := range keys {
+		name := strings.TrimPrefix(key, queuePrefixKey)
+		if name == "" || strings.Contains(name, "|") || slices.Contains(known, name) {
+			continue
+		}
+		candidates = append(candidates, name)
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	types := make([]*redis.StatusCmd, len(candidates))
+	_, err = c.rdb().Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, name := range candidates {
+			types[i] = pipe.Type(ctx, queuePrefixKey+name)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var orphans []*Queue
+	for i, name := range candidates {
+		if types[i].Val() != "list" {
+			continue
+		}
+		queue := c.NewQueue(name)
+		queue.orphan = true
+		orphans = append(orphans, queue)
+	}
+	return orphans, nil
+}
+
 // Name returns the queue name.
 func (q *Queue) Name() string {
 	return q.name
 }
 
+// Orphan reports whether the queue was discovered by its list alone, without
+// an entry in the queues set.
+func (q *Queue) Orphan() bool {
+	return q.orphan
+}
+
+// QueueKey returns the Redis key of the list holding a queue's jobs.
+func QueueKey(name string) string {
+	return queuePrefixKey + name
+}
+
 // Size returns the current size of the queue.
 // This value is real-time and can change between calls.
 func (q *Queue) Size(ctx context.Context) (int64, error) {
-	return q.client.redis.LLen(ctx, "queue:"+q.name).Result()
+	return q.client.rdb().LLen(ctx, "queue:"+q.name).Result()
 }
 
 // Latency calculates the queue's latency - the difference in seconds
 // since the oldest job in the queue was enqueued.
 // Mirrors Sidekiq::Queue#latency.
 func (q *Queue) Latency(ctx context.Context) (float64, error) {
-	entry, err := q.client.redis.LIndex(ctx, "queue:"+q.name, -1).Result()
+	entry, err := q.client.rdb().LIndex(ctx, "queue:"+q.name, -1).Result()
 	if errors.Is(err, redis.Nil) || entry == "" {
 		return 0.0, nil
 	}
@@ -83,6 +167,77 @@ func (q *Queue) Latency(ctx context.Context) (float64, error) {
 	return latency, nil
 }
 
+// MemoryUsage estimates the bytes Redis uses for the queue's list, sampling
+// queueMemoryUsageSamples jobs. An empty or missing queue uses 0 bytes.
+// Returns ErrMemoryUsageUnsupported when the server lacks MEMORY USAGE.
+func (q *Queue) MemoryUsage(ctx context.Context) (int64, error) {
+	if !q.client.capabilities(ctx).memoryUsage {
+		return 0, ErrMemoryUsageUnsupported
+	}
+	var samples []int
+	if queueMemoryUsageSamples > 0 {
+		samples = append(samples, queueMemoryUsageSamples)
+	}
+	bytes, err := q.client.rdb().MemoryUsage(ctx, "queue:"+q.name, samples...).Result()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, q.client.memoryUsageError(err)
+	}
+	return bytes, nil
+}
+
+// QueuesMemoryUsage estimates the bytes used by every queue's list in one
+// pipelined round trip. Returns ErrMemoryUsageUnsupported when the server
+// lacks MEMORY USAGE.
+func (c *Client) QueuesMemoryUsage(ctx context.Context) (int64, error) {
+	if !c.capabilities(ctx).memoryUsage {
+		return 0, ErrMemoryUsageUnsupported
+	}
+	queues, err := c.GetQueues(ctx)
+	if err != nil || len(queues) == 0 {
+		return 0, err
+	}
+
+	var samples []int
+	if queueMemoryUsageSamples > 0 {
+		samples = append(samples, queueMemoryUsageSamples)
+	}
+	cmds := make([]*redis.IntCmd, len(queues))
+	// Per-command errors are checked below; an empty queue replies nil.
+	_, _ = c.rdb().Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, queue := range queues {
+			cmds[i] = pipe.MemoryUsage(ctx, "queue:"+queue.Name(), samples...)
+		}
+		return nil
+	})
+
+	var total int64
+	for _, cmd := range cmds {
+		bytes, err := cmd.Result()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			return 0, c.memoryUsageError(err)
+		}
+		total += bytes
+	}
+	return total, nil
+}
+
+// memoryUsageError maps servers without MEMORY USAGE to
+// ErrMemoryUsageUnsupported and remembers it, so later estimates skip the
+// command.
+func (c *Client) memoryUsageError(err error) error {
+	if unsupportedCommandError(err) {
+		c.disableMemoryUsage()
+		return ErrMemoryUsageUnsupported
+	}
+	return err
+}
+
 // PositionedEntry wraps a JobRecord with its position in the queue.
 type PositionedEntry struct {
 	*JobRecord // embedded for method promotion
@@ -111,7 +266,7 @@ func (q *Queue) GetJobs(ctx context.Context, start, count int) ([]*PositionedEnt
 
 	// Fetch jobs from Redis (newest jobs at lower indices)
 	end := start + count - 1
-	entries, err := q.client.redis.LRange(ctx, "queue:"+q.name, int64(start), int64(end)).Result()
+	entries, err := q.client.rdb().LRange(ctx, "queue:"+q.name, int64(start), int64(end)).Result()
 	if err != nil {
 		return nil, size, err
 	}
@@ -124,6 +279,20 @@ func (q *Queue) GetJobs(ctx context.Context, start, count int) ([]*PositionedEnt
 	return jobs, size, nil
 }
 
+// NextJob returns the job a worker will fetch next, or nil when the queue is
+// empty. Sidekiq pushes with LPUSH and fetches with BRPOP, so this is the tail
+// of the list, at position 1.
+func (q *Queue) NextJob(ctx context.Context) (*PositionedEntry, error) {
+	entry, err := q.client.rdb().LIndex(ctx, "queue:"+q.name, -1).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return q.newPositionedEntry(entry, 1), nil
+}
+
 // ScanJobsWindow scans queue jobs using a raw payload substring filter and returns one window.
 func (q *Queue) ScanJobsWindow(ctx context.Context, filter string, start, count int) (QueueEntriesWindow, error) {
 	size, err := q.Size(ctx)
@@ -145,7 +314,7 @@ func (q *Queue) ScanJobsWindow(ctx context.Context, filter string, start, count
 
 	for batchStart := 0; batchStart < int(size); batchStart += batchSize {
 		batchEnd := min(batchStart+batchSize-1, int(size)-1)
-		entries, err := q.client.redis.LRange(ctx, "queue:"+q.name, int64(batchStart), int64(batchEnd)).Result()
+		entries, err := q.client.rdb().LRange(ctx, "queue:"+q.name, int64(batchStart), int64(batchEnd)).Result()
 		if err != nil {
 			return QueueEntriesWindow{}, err
 		}
@@ -165,13 +334,76 @@ func (q *Queue) ScanJobsWindow(ctx context.Context, filter string, start, count
 	return window, nil
 }
 
+// QueueAgeSampleSize is the number of jobs AgeDistribution reads from each
+// end of a queue. Larger queues are estimated from the sampled head and tail.
+const QueueAgeSampleSize = 500
+
+// AgeDistribution bins job ages (time since enqueued_at) into buckets.
+// buckets are ascending upper bounds; the result has len(buckets)+1 counts,
+// the last one holding jobs older than the final bound. Jobs without an
+// enqueued_at timestamp are skipped. Queues longer than twice
+// QueueAgeSampleSize are sampled from both ends, so the counts are an
+// estimate rather than exact totals.
+func (q *Queue) AgeDistribution(ctx context.Context, buckets []time.Duration) ([]int64, error) {
+	size, err := q.Size(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make([]int64, len(buckets)+1)
+	if size == 0 {
+		return counts, nil
+	}
+
+	key := "queue:" + q.name
+	var entries []string
+	if size <= 2*QueueAgeSampleSize {
+		entries, err = q.client.rdb().LRange(ctx, key, 0, -1).Result()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		var head, tail *redis.StringSliceCmd
+		_, err = q.client.rdb().Pipelined(ctx, func(pipe redis.Pipeliner) error {
+			head = pipe.LRange(ctx, key, 0, QueueAgeSampleSize-1)
+			tail = pipe.LRange(ctx, key, -QueueAgeSampleSize, -1)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		entries = append(head.Val(), tail.Val()...)
+	}
+
+	now := nowFuncSidekiq()
+	for _, entry := range entries {
+		enqueuedAt := NewJobRecord(entry, q.name).EnqueuedAt()
+		if enqueuedAt.IsZero() {
+			continue
+		}
+		age := max(now.Sub(enqueuedAt), 0)
+		idx, _ := slices.BinarySearch(buckets, age)
+		// An age equal to a bound belongs to the next bucket.
+		if idx < len(buckets) && buckets[idx] == age {
+			idx++
+		}
+		counts[idx]++
+	}
+
+	return counts, nil
+}
+
 // Clear deletes all jobs within this queue and removes it from the queues set.
-func (q *Queue) Clear(ctx context.Context) error {
+func (q *Queue) Clear(ctx context.Context) (err error) {
 	if q.client == nil {
 		return errors.New("queue client is nil")
 	}
+	defer func() { q.client.recordActivity(ActivityClearQueue, q.name, "", "", err) }()
+	if err := q.client.checkWritable(); err != nil {
+		return err
+	}
 
-	_, err := q.client.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+	_, err = q.client.rdb().TxPipelined(ctx, func(pipe redis.Pipeliner) error {
 		pipe.Unlink(ctx, "queue:"+q.name)
 		pipe.SRem(ctx, "queues", q.name)
 		return nil
@@ -179,9 +411,211 @@ func (q *Queue) Clear(ctx context.Context) error {
 	return err
 }
 
+// queueMigrateBatch is how many jobs MigrateQueue moves per round trip.
+const queueMigrateBatch = 100
+
+// popQueueBatch atomically takes up to count jobs from the tail of a queue,
+// where Sidekiq fetches from, and returns them in fetch order. It reads and
+// trims the list in MULTI rather than popping with RPOP's count argument,
+// which needs Redis 6.2.
+func (c *Client) popQueueBatch(ctx context.Context, key string, count int64) ([]string, error) {
+	var batch *redis.StringSliceCmd
+	_, err := c.rdb().TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		batch = pipe.LRange(ctx, key, -count, -1)
+		pipe.LTrim(ctx, key, 0, -count-1)
+		return nil
+	})
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+	values := batch.Val()
+	slices.Reverse(values)
+	return values, nil
+}
+
+// MigrateQueueOptions configures MigrateQueue.
+type MigrateQueueOptions struct {
+	// RemoveSource drops the source queue from the queues set once it is
+	// empty.
+	RemoveSource bool
+	// Progress, when set, is called after each batch with the number of jobs
+	// moved so far.
+	Progress func(moved int64)
+}
+
+// MigrateQueue moves every job from queue from to queue to and returns how
+// many were moved. Jobs are popped from the tail of from in batches, get
+// their queue field rewritten, and are pushed onto to oldest first, so they
+// keep their relative order. Payloads that cannot be parsed are moved as-is.
+// A batch that cannot be pushed is put back onto from. Canceling ctx stops
+// between batches; jobs already moved stay in to.
+func (c *Client) MigrateQueue(ctx context.Context, from, to string, opts MigrateQueueOptions) (moved int64, err error) {
+	defer func() {
+		c.recordActivity(ActivityMigrateQueue, from, "", "to "+to+", "+jobCountDetail(moved), err)
+	}()
+	if err := c.checkWritable(); err != nil {
+		return 0, err
+	}
+	if from == "" || to == "" {
+		return 0, errors.New("queue name is empty")
+	}
+	if from == to {
+		return 0, errors.New("source and target queues are the same")
+	}
+
+	if err := c.rdb().SAdd(ctx, queueSetKey, to).Err(); err != nil {
+		return 0, err
+	}
+	for {
+		if err := ctx.Err(); err != nil {
+			return moved, err
+		}
+		values, err := c.popQueueBatch(ctx, queuePrefixKey+from, queueMigrateBatch)
+		if err != nil {
+			return moved, err
+		}
+		if len(values) == 0 {
+			break
+		}
+
+		rewritten := make([]any, len(values))
+		for i, value := range values {
+			rewritten[i] = rewriteJobQueue(value, to)
+		}
+		if err := c.rdb().LPush(ctx, queuePrefixKey+to, rewritten...).Err(); err != nil {
+			// Nothing reached the target; restore the batch, oldest at the tail.
+			restore := make([]any, len(values))
+			for i, value := range values {
+				restore[len(values)-1-i] = value
+			}
+			c.rdb().RPush(context.WithoutCancel(ctx), queuePrefixKey+from, restore...)
+			return moved, err
+		}
+		moved += int64(len(values))
+		if opts.Progress != nil {
+			opts.Progress(moved)
+		}
+	}
+
+	if opts.RemoveSource {
+		if err := c.rdb().SRem(ctx, queueSetKey, from).Err(); err != nil {
+			return moved, err
+		}
+	}
+	return moved, nil
+}
+
+// DrainQueueToDead moves every job in queue into the dead set and returns how
+// many were moved. Unlike Clear, the jobs are kept for later analysis: they
+// are popped from the tail of the queue in batches and added to the dead set
+// scored at the current time, as a kill would. A batch that cannot be added
+// is put back onto the queue. Once the queue is empty it is removed from the
+// queues set.
+func (c *Client) DrainQueueToDead(ctx context.Context, queue string) (moved int64, err error) {
+	defer func() { c.recordActivity(ActivityDrainQueue, queue, "", jobCountDetail(moved), err) }()
+	if err := c.checkWritable(); err != nil {
+		return 0, err
+	}
+	if queue == "" {
+		return 0, errors.New("queue name is empty")
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return moved, err
+		}
+		values, err := c.popQueueBatch(ctx, queuePrefixKey+queue, queueMigrateBatch)
+		if err != nil {
+			return moved, err
+		}
+		if len(values) == 0 {
+			break
+		}
+
+		deadScore := nowSortedSetScore()
+		members := make([]redis.Z, len(values))
+		for i, value := range values {
+			members[i] = redis.Z{Score: deadScore, Member: value}
+		}
+		if err := c.rdb().ZAdd(ctx, deadSetKey, members...).Err(); err != nil {
+			// Nothing reached the dead set; restore the batch, oldest at the tail.
+			restore := make([]any, len(values))
+			for i, value := range values {
+				restore[len(values)-1-i] = value
+			}
+			c.rdb().RPush(context.WithoutCancel(ctx), queuePrefixKey+queue, restore...)
+			return moved, err
+		}
+		moved += int64(len(values))
+	}
+
+	if err := c.rdb().SRem(ctx, queueSetKey, queue).Err(); err != nil {
+		return moved, err
+	}
+	return moved, nil
+}
+
+// rewriteJobQueue returns the payload with its queue field set to queue, or
+// the payload unchanged when it is not a JSON object.
+func rewriteJobQueue(value, queue string) string {
+	payload := make(map[string]any)
+	if err := safeParseJSON([]byte(value), &payload); err != nil {
+		return value
+	}
+	payload["queue"] = queue
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		return value
+	}
+	return string(encoded)
+}
+
 func (q *Queue) newPositionedEntry(entry string, position int) *PositionedEntry {
 	return &PositionedEntry{
 		JobRecord: NewJobRecord(entry, q.name),
 		Position:  position,
 	}
 }
+
+// GetQueuesHead fetches up to perQueue next-to-run jobs from each named queue
+// in one pipelined round trip and merges them oldest enqueued first. Jobs
+// deeper in a queue than perQueue are not read, so the merged order is only
+// exact for the first perQueue jobs of the busiest queue. Position is the
+// job's position within its own queue.
+func (c *Client) GetQueuesHead(ctx context.Context, names []string, perQueue int) ([]*PositionedEntry, error) {
+	if len(names) == 0 || perQueue <= 0 {
+		return nil, nil
+	}
+
+	cmds := make([]*redis.StringSliceCmd, len(names))
+	_, err := c.rdb().Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, name := range names {
+			// Sidekiq pushes to the head and pops from the tail.
+			cmds[i] = pipe.LRange(ctx, "queue:"+name, int64(-perQueue), -1)
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+
+	var jobs []*PositionedEntry
+	for i, name := range names {
+		queue := c.NewQueue(name)
+		entries := cmds[i].Val()
+		for j, entry := range entries {
+			jobs = append(jobs, queue.newPositionedEntry(entry, len(entries)-j))
+		}
+	}
+
+	slices.SortStableFunc(jobs, func(a, b *PositionedEntry) int {
+		if cmp := a.EnqueuedAt().Compare(b.EnqueuedAt()); cmp != 0 {
+			return cmp
+		}
+		if cmp := strings.Compare(a.Queue(), b.Queue()); cmp != 0 {
+			return cmp
+		}
+		return a.Position - b.Position
+	})
+	return jobs, nil
+}
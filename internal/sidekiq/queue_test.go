@@ -1,9 +1,16 @@
 package sidekiq
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
+
+	"github.com/redis/go-redis/v9"
 )
 
 func TestGetQueues(t *testing.T) {
@@ -64,6 +71,40 @@ func TestGetQueues_MultipleSorted(t *testing.T) {
 	}
 }
 
+func TestGetQueues_DiscoverOrphans(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx := testContext(t)
+
+	_, _ = mr.SetAdd("queues", "default")
+	_, _ = mr.Lpush("queue:default", `{"jid":"a"}`)
+	_, _ = mr.Lpush("queue:x", `{"jid":"b"}`)
+	_, _ = mr.Lpush("queue:sq|host:1:abc|default", `{"jid":"c"}`)
+	_ = mr.Set("queue:lock", "1")
+
+	queues, err := client.GetQueues(ctx)
+	if err != nil {
+		t.Fatalf("GetQueues failed: %v", err)
+	}
+	if len(queues) != 1 {
+		t.Fatalf("without discovery: len(queues) = %d, want 1", len(queues))
+	}
+
+	client.SetDiscoverQueues(true)
+	queues, err = client.GetQueues(ctx)
+	if err != nil {
+		t.Fatalf("GetQueues failed: %v", err)
+	}
+	if len(queues) != 2 {
+		t.Fatalf("len(queues) = %d, want 2", len(queues))
+	}
+	if queues[0].Name() != "default" || queues[0].Orphan() {
+		t.Errorf("queues[0] = %q (orphan %v), want registered default", queues[0].Name(), queues[0].Orphan())
+	}
+	if queues[1].Name() != "x" || !queues[1].Orphan() {
+		t.Errorf("queues[1] = %q (orphan %v), want orphan x", queues[1].Name(), queues[1].Orphan())
+	}
+}
+
 func TestNewQueue(t *testing.T) {
 	client := &Client{}
 
@@ -345,6 +386,52 @@ func TestQueueGetJobs_Basic(t *testing.T) {
 	}
 }
 
+func TestQueueNextJob_MatchesSidekiqPushDirection(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx := testContext(t)
+
+	q := client.NewQueue("default")
+	next, err := q.NextJob(ctx)
+	if err != nil || next != nil {
+		t.Fatalf("NextJob on empty queue = %v, %v; want nil, nil", next, err)
+	}
+
+	// Enqueue through the client, which pushes like Sidekiq does.
+	for i, jid := range []string{"first", "second", "third"} {
+		job := `{"jid":"` + jid + `","class":"TestJob","queue":"default","args":[]}`
+		score := testScoreA + float64(i)
+		mr.ZAdd("schedule", score, job)
+		if err := client.EnqueueSortedEntry(ctx, SortedSetScheduled, NewSortedEntry(job, score)); err != nil {
+			t.Fatalf("EnqueueSortedEntry(%s) failed: %v", jid, err)
+		}
+	}
+
+	jobs, _, err := q.GetJobs(ctx, 0, 10)
+	if err != nil {
+		t.Fatalf("GetJobs failed: %v", err)
+	}
+	if len(jobs) != 3 || jobs[0].Position != 3 || jobs[0].JID() != "third" {
+		t.Fatalf("highest position = %+v, want the most recently enqueued job", jobs[0])
+	}
+
+	next, err = q.NextJob(ctx)
+	if err != nil {
+		t.Fatalf("NextJob failed: %v", err)
+	}
+	if next == nil || next.Position != 1 || next.JID() != "first" {
+		t.Fatalf("NextJob = %+v, want first at position 1", next)
+	}
+	if last := jobs[len(jobs)-1]; last.Position != 1 || last.JID() != next.JID() {
+		t.Fatalf("position 1 in GetJobs = %s, want %s", last.JID(), next.JID())
+	}
+
+	// Workers fetch with BRPOP, which takes the tail of the list.
+	popped, err := client.redis.BRPop(ctx, time.Second, "queue:default").Result()
+	if err != nil || len(popped) != 2 || NewJobRecord(popped[1], "default").JID() != next.JID() {
+		t.Fatalf("BRPOP = %v, %v; want the job NextJob reported", popped, err)
+	}
+}
+
 func TestQueueGetJobs_Pagination(t *testing.T) {
 	mr, client := setupTestRedis(t)
 	ctx := testContext(t)
@@ -544,6 +631,82 @@ func TestQueueScanJobsWindow_EmptyFilterMatchesQueueOrder(t *testing.T) {
 	}
 }
 
+func TestQueueAgeDistribution(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx := testContext(t)
+
+	now := time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+	originalNow := nowFuncSidekiq
+	nowFuncSidekiq = func() time.Time { return now }
+	t.Cleanup(func() { nowFuncSidekiq = originalNow })
+
+	ages := []time.Duration{10 * time.Second, time.Minute, 5 * time.Minute, 2 * time.Hour, 48 * time.Hour}
+	for i, age := range ages {
+		job := map[string]any{
+			"jid":         "job" + strconv.Itoa(i),
+			"class":       "TestJob",
+			"enqueued_at": float64(now.Add(-age).UnixMilli()),
+		}
+		_, _ = mr.Lpush("queue:default", string(mustMarshalJSON(t, job)))
+	}
+	_, _ = mr.Lpush("queue:default", string(mustMarshalJSON(t, map[string]any{"jid": "no-time"})))
+
+	buckets := []time.Duration{time.Minute, time.Hour, 24 * time.Hour}
+	counts, err := client.NewQueue("default").AgeDistribution(ctx, buckets)
+	if err != nil {
+		t.Fatalf("AgeDistribution failed: %v", err)
+	}
+
+	want := []int64{1, 2, 1, 1}
+	if len(counts) != len(want) {
+		t.Fatalf("len(counts) = %d, want %d", len(counts), len(want))
+	}
+	for i := range want {
+		if counts[i] != want[i] {
+			t.Fatalf("counts = %v, want %v", counts, want)
+		}
+	}
+}
+
+func TestQueueAgeDistribution_SamplesLargeQueue(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx := testContext(t)
+
+	now := time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+	originalNow := nowFuncSidekiq
+	nowFuncSidekiq = func() time.Time { return now }
+	t.Cleanup(func() { nowFuncSidekiq = originalNow })
+
+	job := string(mustMarshalJSON(t, map[string]any{
+		"class":       "TestJob",
+		"enqueued_at": float64(now.Add(-time.Second).UnixMilli()),
+	}))
+	for range 2*QueueAgeSampleSize + 10 {
+		_, _ = mr.Lpush("queue:default", job)
+	}
+
+	counts, err := client.NewQueue("default").AgeDistribution(ctx, []time.Duration{time.Minute})
+	if err != nil {
+		t.Fatalf("AgeDistribution failed: %v", err)
+	}
+	if counts[0] != 2*QueueAgeSampleSize || counts[1] != 0 {
+		t.Fatalf("counts = %v, want [%d 0]", counts, 2*QueueAgeSampleSize)
+	}
+}
+
+func TestQueueAgeDistribution_Empty(t *testing.T) {
+	_, client := setupTestRedis(t)
+	ctx := testContext(t)
+
+	counts, err := client.NewQueue("default").AgeDistribution(ctx, []time.Duration{time.Minute})
+	if err != nil {
+		t.Fatalf("AgeDistribution failed: %v", err)
+	}
+	if len(counts) != 2 || counts[0] != 0 || counts[1] != 0 {
+		t.Fatalf("counts = %v, want [0 0]", counts)
+	}
+}
+
 func TestQueueClear_RemovesJobsAndQueueSet(t *testing.T) {
 	mr, client := setupTestRedis(t)
 	ctx := testContext(t)
@@ -588,3 +751,275 @@ func TestQueueClear_NilClient(t *testing.T) {
 		t.Fatalf("Clear error = %q, want %q", err.Error(), "queue client is nil")
 	}
 }
+
+func TestGetQueuesHead(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx := testContext(t)
+
+	// LPUSH puts newer jobs at the head; the tail runs next.
+	for _, job := range []struct {
+		queue, jid string
+		at         int
+	}{
+		{"default", "d1", 10},
+		{"default", "d2", 30},
+		{"default", "d3", 50},
+		{"low", "l1", 20},
+		{"low", "l2", 40},
+	} {
+		_, _ = mr.Lpush("queue:"+job.queue, `{"jid":"`+job.jid+`","class":"TestJob","enqueued_at":`+strconv.Itoa(job.at)+`}`)
+	}
+
+	jobs, err := client.GetQueuesHead(ctx, []string{"default", "low", "missing"}, 2)
+	if err != nil {
+		t.Fatalf("GetQueuesHead failed: %v", err)
+	}
+
+	want := []struct {
+		jid, queue string
+		position   int
+	}{
+		{"d1", "default", 1},
+		{"l1", "low", 1},
+		{"d2", "default", 2},
+		{"l2", "low", 2},
+	}
+	if len(jobs) != len(want) {
+		t.Fatalf("len(jobs) = %d, want %d", len(jobs), len(want))
+	}
+	for i, w := range want {
+		if jobs[i].JID() != w.jid || jobs[i].Queue() != w.queue || jobs[i].Position != w.position {
+			t.Errorf("jobs[%d] = %s/%s#%d, want %s/%s#%d",
+				i, jobs[i].Queue(), jobs[i].JID(), jobs[i].Position, w.queue, w.jid, w.position)
+		}
+	}
+}
+
+func TestQueueMemoryUsage(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx := testContext(t)
+
+	// miniredis rejects the SAMPLES option.
+	originalSamples := queueMemoryUsageSamples
+	queueMemoryUsageSamples = 0
+	t.Cleanup(func() { queueMemoryUsageSamples = originalSamples })
+
+	empty, err := client.NewQueue("missing").MemoryUsage(ctx)
+	if err != nil || empty != 0 {
+		t.Fatalf("MemoryUsage(missing) = %d, %v; want 0, nil", empty, err)
+	}
+
+	_, _ = mr.SetAdd("queues", "default", "mailers", "missing")
+	for range 3 {
+		_, _ = mr.Lpush("queue:default", `{"jid":"a","class":"TestJob","args":[]}`)
+	}
+	_, _ = mr.Lpush("queue:mailers", `{"jid":"b","class":"MailJob","args":[]}`)
+
+	defaultBytes, err := client.NewQueue("default").MemoryUsage(ctx)
+	if err != nil || defaultBytes <= 0 {
+		t.Fatalf("MemoryUsage(default) = %d, %v; want positive", defaultBytes, err)
+	}
+	mailerBytes, err := client.NewQueue("mailers").MemoryUsage(ctx)
+	if err != nil {
+		t.Fatalf("MemoryUsage(mailers) failed: %v", err)
+	}
+
+	total, err := client.QueuesMemoryUsage(ctx)
+	if err != nil {
+		t.Fatalf("QueuesMemoryUsage failed: %v", err)
+	}
+	if total != defaultBytes+mailerBytes {
+		t.Fatalf("QueuesMemoryUsage = %d, want %d", total, defaultBytes+mailerBytes)
+	}
+}
+
+// unsupportedMemoryHook answers MEMORY commands like a server without them
+// and records their arguments.
+type unsupportedMemoryHook struct {
+	args [][]any
+}
+
+func (h *unsupportedMemoryHook) DialHook(next redis.DialHook) redis.DialHook { return next }
+
+func (h *unsupportedMemoryHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		if cmd.Name() != "memory" {
+			return next(ctx, cmd)
+		}
+		h.args = append(h.args, cmd.Args())
+		err := errors.New("ERR unknown command 'MEMORY', with args beginning with: 'USAGE'")
+		cmd.SetErr(err)
+		return err
+	}
+}
+
+func (h *unsupportedMemoryHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		for _, cmd := range cmds {
+			if cmd.Name() == "memory" {
+				h.args = append(h.args, cmd.Args())
+				cmd.SetErr(errors.New("ERR unknown command 'MEMORY'"))
+			}
+		}
+		return nil
+	}
+}
+
+func TestQueueMemoryUsage_Unsupported(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx := testContext(t)
+	_, _ = mr.SetAdd("queues", "default")
+
+	hook := &unsupportedMemoryHook{}
+	client.redis.AddHook(hook)
+
+	if _, err := client.NewQueue("default").MemoryUsage(ctx); !errors.Is(err, ErrMemoryUsageUnsupported) {
+		t.Fatalf("MemoryUsage error = %v, want ErrMemoryUsageUnsupported", err)
+	}
+	if _, err := client.QueuesMemoryUsage(ctx); !errors.Is(err, ErrMemoryUsageUnsupported) {
+		t.Fatalf("QueuesMemoryUsage error = %v, want ErrMemoryUsageUnsupported", err)
+	}
+
+	want := []any{"memory", "usage", "queue:default", "SAMPLES", queueMemoryUsageSamples}
+	if len(hook.args) == 0 || fmt.Sprint(hook.args[0]) != fmt.Sprint(want) {
+		t.Fatalf("MEMORY args = %v, want %v", hook.args, want)
+	}
+}
+
+func TestMigrateQueue_MovesJobsInBatches(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx := testContext(t)
+
+	total := queueMigrateBatch*2 + 5
+	_, _ = mr.SetAdd("queues", "old", "new")
+	_, _ = mr.Lpush("queue:new", `{"jid":"existing","queue":"new"}`)
+	for i := range total {
+		_, _ = mr.Lpush("queue:old", fmt.Sprintf(`{"jid":"j%03d","queue":"old","args":[12345678901234567890]}`, i))
+	}
+	_, _ = mr.Lpush("queue:old", "not json")
+
+	var progress []int64
+	moved, err := client.MigrateQueue(ctx, "old", "new", MigrateQueueOptions{
+		RemoveSource: true,
+		Progress:     func(moved int64) { progress = append(progress, moved) },
+	})
+	if err != nil {
+		t.Fatalf("MigrateQueue failed: %v", err)
+	}
+	if moved != int64(total+1) {
+		t.Fatalf("moved = %d, want %d", moved, total+1)
+	}
+	if want := []int64{100, 200, int64(total + 1)}; !slices.Equal(progress, want) {
+		t.Fatalf("progress = %v, want %v", progress, want)
+	}
+
+	if exists := mr.Exists("queue:old"); exists {
+		t.Fatal("source queue still exists")
+	}
+	if ok, _ := mr.SIsMember("queues", "old"); ok {
+		t.Fatal("queues set still contains the source queue")
+	}
+
+	jobs, err := client.redis.LRange(ctx, "queue:new", 0, -1).Result()
+	if err != nil {
+		t.Fatalf("LRange failed: %v", err)
+	}
+	if len(jobs) != total+2 {
+		t.Fatalf("target size = %d, want %d", len(jobs), total+2)
+	}
+	// The existing job stays next up, followed by the migrated jobs oldest first.
+	if tail := NewJobRecord(jobs[len(jobs)-1], "new"); tail.JID() != "existing" {
+		t.Fatalf("tail job = %q, want existing", tail.JID())
+	}
+	next := NewJobRecord(jobs[len(jobs)-2], "new")
+	if next.JID() != "j000" || next.Queue() != "new" {
+		t.Fatalf("next migrated job = %q in %q, want j000 in new", next.JID(), next.Queue())
+	}
+	if !strings.Contains(jobs[len(jobs)-2], "12345678901234567890") {
+		t.Fatalf("payload lost integer precision: %s", jobs[len(jobs)-2])
+	}
+	if jobs[0] != "not json" {
+		t.Fatalf("head job = %q, want unparseable payload moved as-is", jobs[0])
+	}
+}
+
+func TestMigrateQueue_RejectsSameQueueAndReadOnly(t *testing.T) {
+	_, client := setupTestRedis(t)
+	ctx := testContext(t)
+
+	if _, err := client.MigrateQueue(ctx, "default", "default", MigrateQueueOptions{}); err == nil {
+		t.Fatal("MigrateQueue accepted the same source and target")
+	}
+
+	client.readOnly = true
+	if _, err := client.MigrateQueue(ctx, "old", "new", MigrateQueueOptions{}); !errors.Is(err, ErrReadOnly) {
+		t.Fatalf("MigrateQueue error = %v, want ErrReadOnly", err)
+	}
+}
+
+func TestDrainQueueToDead_MovesJobsToDeadSet(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx := testContext(t)
+
+	total := queueMigrateBatch + 5
+	_, _ = mr.SetAdd("queues", "poisoned", "default")
+	_, _ = mr.ZAdd("dead", 1600000000, `{"jid":"old-dead","queue":"default"}`)
+	for i := range total {
+		_, _ = mr.Lpush("queue:poisoned", fmt.Sprintf(`{"jid":"j%03d","queue":"poisoned"}`, i))
+	}
+
+	before := time.Now()
+	moved, err := client.DrainQueueToDead(ctx, "poisoned")
+	after := time.Now()
+	if err != nil {
+		t.Fatalf("DrainQueueToDead failed: %v", err)
+	}
+	if moved != int64(total) {
+		t.Fatalf("moved = %d, want %d", moved, total)
+	}
+
+	if exists := mr.Exists("queue:poisoned"); exists {
+		t.Fatal("drained queue still exists")
+	}
+	if ok, _ := mr.SIsMember("queues", "poisoned"); ok {
+		t.Fatal("queues set still contains the drained queue")
+	}
+	if ok, _ := mr.SIsMember("queues", "default"); !ok {
+		t.Fatal("queues set lost an unrelated queue")
+	}
+
+	entries, err := client.redis.ZRangeWithScores(ctx, "dead", 0, -1).Result()
+	if err != nil {
+		t.Fatalf("ZRangeWithScores failed: %v", err)
+	}
+	if len(entries) != total+1 {
+		t.Fatalf("dead size = %d, want %d", len(entries), total+1)
+	}
+	for _, entry := range entries {
+		job := NewJobRecord(entry.Member.(string), "")
+		if job.JID() == "old-dead" {
+			continue
+		}
+		if job.Queue() != "poisoned" {
+			t.Fatalf("dead job %q queue = %q, want poisoned", job.JID(), job.Queue())
+		}
+		at := time.Unix(0, int64(entry.Score*float64(time.Second)))
+		if at.Before(before.Add(-time.Second)) || at.After(after.Add(time.Second)) {
+			t.Fatalf("dead job %q scored at %v, want about now", job.JID(), at)
+		}
+	}
+}
+
+func TestDrainQueueToDead_RejectsReadOnly(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx := testContext(t)
+
+	_, _ = mr.Lpush("queue:poisoned", `{"jid":"a","queue":"poisoned"}`)
+	client.readOnly = true
+	if _, err := client.DrainQueueToDead(ctx, "poisoned"); !errors.Is(err, ErrReadOnly) {
+		t.Fatalf("DrainQueueToDead error = %v, want ErrReadOnly", err)
+	}
+	if size, _ := client.redis.LLen(ctx, "queue:poisoned").Result(); size != 1 {
+		t.Fatalf("queue size = %d, want untouched queue", size)
+	}
+}
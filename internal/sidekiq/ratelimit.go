@@ -0,0 +1,202 @@
+package sidekiq
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RateLimiterType names the kind of a Sidekiq Enterprise rate limiter.
+type RateLimiterType string
+
+const (
+	// RateLimiterConcurrent limits how many jobs hold the limiter at once.
+	RateLimiterConcurrent RateLimiterType = "concurrent"
+	// RateLimiterBucket allows a number of operations per interval, refilled
+	// at the start of each interval.
+	RateLimiterBucket RateLimiterType = "bucket"
+	// RateLimiterWindow allows a number of operations in a sliding window.
+	RateLimiterWindow RateLimiterType = "window"
+)
+
+// RateLimiter describes a Sidekiq Enterprise rate limiter and its current usage.
+type RateLimiter struct {
+	Name string
+	Key  string
+	Type RateLimiterType
+	Size int64
+	Used int64
+}
+
+// AtLimit reports whether the limiter currently blocks new operations.
+func (l RateLimiter) AtLimit() bool {
+	return l.Size > 0 && l.Used >= l.Size
+}
+
+// rateLimiterScanCount is the SCAN COUNT hint used when listing limiter keys.
+const rateLimiterScanCount = 100
+
+// rateLimiterParser turns the hash stored under a limiter key into a
+// RateLimiter. It reports false for shapes it does not recognize.
+type rateLimiterParser func(name string, fields map[string]string) (RateLimiter, bool)
+
+// rateLimiterParsers maps limiter key prefixes to their parsers:
+//
+//   - limited:<name> holds a concurrent limiter: size and used.
+//   - rate:<name> holds a bucket limiter (size and tokens left) or a window
+//     limiter (size and count of operations in the window).
+var rateLimiterParsers = map[string]rateLimiterParser{
+	"limited:": parseConcurrentLimiter,
+	"rate:":    parseRateLimiter,
+}
+
+// GetRateLimiters lists the rate limiters Sidekiq Enterprise keeps in Redis,
+// sorted by name. Keys of other types, helper keys, and hashes without a
+// recognizable shape are skipped, so OSS and Pro servers get an empty list.
+func (c *Client) GetRateLimiters(ctx context.Context) ([]RateLimiter, error) {
+	prefixes := make([]string, 0, len(rateLimiterParsers))
+	for prefix := range rateLimiterParsers {
+		prefixes = append(prefixes, prefix)
+	}
+	slices.Sort(prefixes)
+
+	var keys []string
+	for _, prefix := range prefixes {
+		found, err := c.scanKeys(ctx, escapeGlob(prefix)+"*")
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, found...)
+	}
+	if len(keys) == 0 {
+		return []RateLimiter{}, nil
+	}
+
+	hashes, err := c.limiterHashes(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	limiters := make([]RateLimiter, 0, len(hashes))
+	for _, key := range keys {
+		fields, ok := hashes[key]
+		if !ok {
+			continue
+		}
+		for _, prefix := range prefixes {
+			name, found := strings.CutPrefix(key, prefix)
+			if !found || name == "" {
+				continue
+			}
+			if limiter, ok := rateLimiterParsers[prefix](name, fields); ok {
+				limiter.Key = key
+				limiters = append(limiters, limiter)
+			}
+			break
+		}
+	}
+
+	slices.SortFunc(limiters, func(a, b RateLimiter) int {
+		return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.Type, b.Type))
+	})
+	return limiters, nil
+}
+
+// scanKeys returns every key matching the glob pattern.
+func (c *Client) scanKeys(ctx context.Context, match string) ([]string, error) {
+	var keys []string
+	cursor := uint64(0)
+	for {
+		batch, nextCursor, err := c.rdb().Scan(ctx, cursor, match, rateLimiterScanCount).Result()
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, batch...)
+		cursor = nextCursor
+		if cursor == 0 {
+			break
+		}
+	}
+	slices.Sort(keys)
+	return slices.Compact(keys), nil
+}
+
+// limiterHashes reads the fields of every hash among keys. Keys holding other
+// types are left out.
+func (c *Client) limiterHashes(ctx context.Context, keys []string) (map[string]map[string]string, error) {
+	types := make([]*redis.StatusCmd, len(keys))
+	_, err := c.rdb().Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, key := range keys {
+			types[i] = pipe.Type(ctx, key)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var hashKeys []string
+	for i, key := range keys {
+		if types[i].Val() == "hash" {
+			hashKeys = append(hashKeys, key)
+		}
+	}
+	if len(hashKeys) == 0 {
+		return nil, nil
+	}
+
+	fields := make([]*redis.MapStringStringCmd, len(hashKeys))
+	_, err = c.rdb().Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, key := range hashKeys {
+			fields[i] = pipe.HGetAll(ctx, key)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	hashes := make(map[string]map[string]string, len(hashKeys))
+	for i, key := range hashKeys {
+		hashes[key] = fields[i].Val()
+	}
+	return hashes, nil
+}
+
+func parseConcurrentLimiter(name string, fields map[string]string) (RateLimiter, bool) {
+	size, ok := parseLimiterCount(fields["size"])
+	if !ok {
+		return RateLimiter{}, false
+	}
+	used, ok := parseLimiterCount(fields["used"])
+	if !ok {
+		return RateLimiter{}, false
+	}
+	return RateLimiter{Name: name, Type: RateLimiterConcurrent, Size: size, Used: used}, true
+}
+
+func parseRateLimiter(name string, fields map[string]string) (RateLimiter, bool) {
+	size, ok := parseLimiterCount(fields["size"])
+	if !ok {
+		return RateLimiter{}, false
+	}
+	if tokens, ok := parseLimiterCount(fields["tokens"]); ok {
+		return RateLimiter{Name: name, Type: RateLimiterBucket, Size: size, Used: max(size-tokens, 0)}, true
+	}
+	if count, ok := parseLimiterCount(fields["count"]); ok {
+		return RateLimiter{Name: name, Type: RateLimiterWindow, Size: size, Used: count}, true
+	}
+	return RateLimiter{}, false
+}
+
+func parseLimiterCount(raw string) (int64, bool) {
+	value, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || value < 0 {
+		return 0, false
+	}
+	return value, true
+}
@@ -0,0 +1,56 @@
+package sidekiq
+
+import (
+	"testing"
+)
+
+func TestGetRateLimiters(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx := testContext(t)
+
+	mr.HSet("limited:stripe", "size", "10", "used", "10")
+	mr.HSet("rate:github", "size", "5000", "tokens", "4200")
+	mr.HSet("rate:mailgun", "size", "100", "count", "37")
+	// Helper keys and unknown shapes are skipped.
+	_, _ = mr.ZAdd("limited:stripe:locks", 1, "lock-1")
+	mr.HSet("rate:legacy", "interval", "60")
+	mr.HSet("limited:broken", "size", "ten", "used", "1")
+	_ = mr.Set("rate:counter", "12")
+
+	limiters, err := client.GetRateLimiters(ctx)
+	if err != nil {
+		t.Fatalf("GetRateLimiters failed: %v", err)
+	}
+
+	want := []RateLimiter{
+		{Name: "github", Key: "rate:github", Type: RateLimiterBucket, Size: 5000, Used: 800},
+		{Name: "mailgun", Key: "rate:mailgun", Type: RateLimiterWindow, Size: 100, Used: 37},
+		{Name: "stripe", Key: "limited:stripe", Type: RateLimiterConcurrent, Size: 10, Used: 10},
+	}
+	if len(limiters) != len(want) {
+		t.Fatalf("limiters = %+v, want %+v", limiters, want)
+	}
+	for i := range want {
+		if limiters[i] != want[i] {
+			t.Fatalf("limiters[%d] = %+v, want %+v", i, limiters[i], want[i])
+		}
+	}
+	if !limiters[2].AtLimit() || limiters[0].AtLimit() {
+		t.Fatalf("AtLimit = %v/%v, want only stripe at its limit", limiters[0].AtLimit(), limiters[2].AtLimit())
+	}
+}
+
+func TestGetRateLimiters_NoneOnOSS(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx := testContext(t)
+
+	_, _ = mr.SetAdd("queues", "default")
+
+	limiters, err := client.GetRateLimiters(ctx)
+	if err != nil {
+		t.Fatalf("GetRateLimiters failed: %v", err)
+	}
+	if limiters == nil || len(limiters) != 0 {
+		t.Fatalf("limiters = %#v, want an empty list", limiters)
+	}
+}
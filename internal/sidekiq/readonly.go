@@ -0,0 +1,27 @@
+package sidekiq
+
+import "errors"
+
+// ErrReadOnly is returned by mutating client calls in read-only mode.
+var ErrReadOnly = errors.New("read-only mode: refusing to modify Sidekiq data")
+
+// SetReadOnly makes every mutating call (deleting, retrying, killing,
+// enqueuing, clearing queues, signalling processes, raw commands) fail with
+// ErrReadOnly before it reaches Redis. It must be called before the client is
+// shared.
+func (c *Client) SetReadOnly(readOnly bool) {
+	c.readOnly = readOnly
+}
+
+// ReadOnly reports whether the client refuses mutating calls.
+func (c *Client) ReadOnly() bool {
+	return c.readOnly
+}
+
+// checkWritable guards mutating calls.
+func (c *Client) checkWritable() error {
+	if c.readOnly {
+		return ErrReadOnly
+	}
+	return nil
+}
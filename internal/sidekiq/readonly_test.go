@@ -0,0 +1,88 @@
+package sidekiq
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestReadOnlyRefusesMutations(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx := context.Background()
+	client.SetReadOnly(true)
+
+	jobJSON := `{"jid":"ro1","class":"MyJob","queue":"default","args":[],"retry_count":1}`
+	_, _ = mr.ZAdd("retry", testScoreA, jobJSON)
+	_, _ = mr.ZAdd("schedule", testScoreA, jobJSON)
+	_, _ = mr.ZAdd("dead", testScoreA, jobJSON)
+	_, _ = mr.Lpush("queue:default", jobJSON)
+	_, _ = mr.SetAdd("queues", "default")
+	before := mr.Dump()
+
+	entry := NewSortedEntry(jobJSON, testScoreA)
+	job := NewJobRecord(jobJSON, "")
+
+	tests := map[string]func() error{
+		"DeleteSortedEntry":          func() error { return client.DeleteSortedEntry(ctx, SortedSetRetry, entry) },
+		"DeleteAllSortedEntries":     func() error { return client.DeleteAllSortedEntries(ctx, SortedSetDead) },
+		"EnqueueSortedEntry":         func() error { return client.EnqueueSortedEntry(ctx, SortedSetScheduled, entry) },
+		"EnqueueSortedEntryToFront":  func() error { return client.EnqueueSortedEntryToFront(ctx, SortedSetRetry, entry) },
+		"RequeueDeadJob":             func() error { return client.RequeueDeadJob(ctx, entry) },
+		"RetryDeadJobWithDelay":      func() error { return client.RetryDeadJobWithDelay(ctx, entry, time.Minute) },
+		"EnqueueAllSortedEntries":    func() error { return client.EnqueueAllSortedEntries(ctx, SortedSetRetry) },
+		"MoveSortedEntryToDead":      func() error { return client.MoveSortedEntryToDead(ctx, SortedSetRetry, entry) },
+		"MoveAllSortedEntriesToDead": func() error { return client.MoveAllSortedEntriesToDead(ctx, SortedSetRetry) },
+		"DeleteDeadJobsOlderThan": func() error {
+			_, err := client.DeleteDeadJobsOlderThan(ctx, time.Now())
+			return err
+		},
+		"RetryAllDeadJobsToRetry": func() error {
+			_, err := client.RetryAllDeadJobsToRetry(ctx)
+			return err
+		},
+		"EnqueueJobCopies": func() error {
+			_, err := client.EnqueueJobCopies(ctx, job, 1)
+			return err
+		},
+		"Queue.Clear":   func() error { return client.NewQueue("default").Clear(ctx) },
+		"Process.Pause": func() error { return client.NewProcess("host:1:abc").Pause(ctx) },
+		"Process.Stop":  func() error { return client.NewProcess("host:1:abc").Stop(ctx) },
+		"UndoLast":      func() error { return client.UndoLast(ctx) },
+		"Do": func() error {
+			_, err := client.Do(ctx, "DEL", "queue:default")
+			return err
+		},
+	}
+
+	for name, call := range tests {
+		t.Run(name, func(t *testing.T) {
+			if err := call(); !errors.Is(err, ErrReadOnly) {
+				t.Fatalf("%s error = %v, want ErrReadOnly", name, err)
+			}
+		})
+	}
+
+	if after := mr.Dump(); after != before {
+		t.Fatalf("redis changed in read-only mode:\nbefore:\n%s\nafter:\n%s", before, after)
+	}
+}
+
+func TestReadOnlyAllowsReads(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx := context.Background()
+	client.SetReadOnly(true)
+
+	_, _ = mr.ZAdd("dead", testScoreA, `{"jid":"ro1","class":"MyJob","queue":"default"}`)
+
+	entries, total, err := client.GetSortedEntries(ctx, SortedSetDead, SortDefault, 0, 10)
+	if err != nil {
+		t.Fatalf("GetSortedEntries failed: %v", err)
+	}
+	if total != 1 || len(entries) != 1 {
+		t.Fatalf("entries = %d (total %d), want 1", len(entries), total)
+	}
+	if !client.ReadOnly() {
+		t.Fatal("ReadOnly() = false, want true")
+	}
+}
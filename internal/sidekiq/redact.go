@@ -0,0 +1,81 @@
+package sidekiq
+
+import (
+	"maps"
+	"strings"
+	"sync/atomic"
+)
+
+// RedactedValue replaces the value of a redacted argument key.
+const RedactedValue = "[redacted]"
+
+// redactedArgKeys holds lowercased argument key names masked in display
+// output. It is set once at startup and read from fetch goroutines.
+var redactedArgKeys atomic.Pointer[map[string]struct{}]
+
+// SetRedactedArgKeys configures which hash keys in job arguments are masked
+// by DisplayArgs and DisplayItem. Matching is case-insensitive and applies at
+// any nesting depth. Raw payloads returned by Value and Item are not changed.
+func SetRedactedArgKeys(keys []string) {
+	set := make(map[string]struct{}, len(keys))
+	for _, key := range keys {
+		if key = strings.ToLower(strings.TrimSpace(key)); key != "" {
+			set[key] = struct{}{}
+		}
+	}
+	if len(set) == 0 {
+		redactedArgKeys.Store(nil)
+		return
+	}
+	redactedArgKeys.Store(&set)
+}
+
+func currentRedactedArgKeys() map[string]struct{} {
+	if keys := redactedArgKeys.Load(); keys != nil {
+		return *keys
+	}
+	return nil
+}
+
+// redactArgs returns a copy of value with the values of redacted keys
+// replaced. Maps and slices are only copied when keys is non-empty, so the
+// parsed job item is never modified.
+func redactArgs(value any, keys map[string]struct{}) any {
+	if len(keys) == 0 {
+		return value
+	}
+	switch v := value.(type) {
+	case []any:
+		out := make([]any, len(v))
+		for i, item := range v {
+			out[i] = redactArgs(item, keys)
+		}
+		return out
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for key, item := range v {
+			if _, ok := keys[strings.ToLower(key)]; ok {
+				out[key] = RedactedValue
+				continue
+			}
+			out[key] = redactArgs(item, keys)
+		}
+		return out
+	default:
+		return value
+	}
+}
+
+// DisplayItem returns the parsed job data with redacted argument keys
+// masked. It returns Item as-is when no keys are configured.
+func (jr *JobRecord) DisplayItem() map[string]any {
+	item := jr.Item()
+	keys := currentRedactedArgKeys()
+	args, ok := item["args"]
+	if len(keys) == 0 || !ok {
+		return item
+	}
+	out := maps.Clone(item)
+	out["args"] = redactArgs(args, keys)
+	return out
+}
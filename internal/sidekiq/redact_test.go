@@ -0,0 +1,90 @@
+package sidekiq
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestJobRecord_DisplayArgs_Redacted(t *testing.T) {
+	SetRedactedArgKeys([]string{"password", " Token ", "ssn"})
+	t.Cleanup(func() { SetRedactedArgKeys(nil) })
+
+	tests := []struct {
+		name  string
+		value string
+		want  []any
+	}{
+		{
+			name:  "top_level",
+			value: `{"class":"PlainJob","args":[1,{"user":"bob","password":"hunter2"}]}`,
+			want:  []any{float64(1), map[string]any{"user": "bob", "password": RedactedValue}},
+		},
+		{
+			name:  "nested",
+			value: `{"class":"PlainJob","args":[{"account":{"TOKEN":"abc","profile":{"ssn":"123"}},"items":[{"password":"x"}]}]}`,
+			want: []any{map[string]any{
+				"account": map[string]any{"TOKEN": RedactedValue, "profile": map[string]any{"ssn": RedactedValue}},
+				"items":   []any{map[string]any{"password": RedactedValue}},
+			}},
+		},
+		{
+			name:  "redacts_whole_subtree",
+			value: `{"class":"PlainJob","args":[{"token":{"value":"abc"}}]}`,
+			want:  []any{map[string]any{"token": RedactedValue}},
+		},
+		{
+			name:  "active_job",
+			value: `{"class":"ActiveJob::QueueAdapters::SidekiqAdapter::JobWrapper","wrapped":"MyJob","args":[{"arguments":[{"password":"p","_aj_symbol_keys":[]}]}]}`,
+			want:  []any{map[string]any{"password": RedactedValue}},
+		},
+		{
+			name:  "encrypted",
+			value: `{"class":"PlainJob","encrypt":true,"args":[{"token":"t"},"secret"]}`,
+			want:  []any{map[string]any{"token": RedactedValue}, "[encrypted data]"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			record := NewJobRecord(tt.value, "")
+			if got := record.DisplayArgs(); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("DisplayArgs() = %#v, want %#v", got, tt.want)
+			}
+			if record.Value() != tt.value {
+				t.Fatalf("Value() = %q, want raw payload", record.Value())
+			}
+		})
+	}
+}
+
+func TestJobRecord_DisplayItem_Redacted(t *testing.T) {
+	SetRedactedArgKeys([]string{"password"})
+	t.Cleanup(func() { SetRedactedArgKeys(nil) })
+
+	record := NewJobRecord(`{"class":"PlainJob","password":"top","args":[{"nested":{"password":"hunter2"}}]}`, "")
+	item := record.DisplayItem()
+
+	wantArgs := []any{map[string]any{"nested": map[string]any{"password": RedactedValue}}}
+	if !reflect.DeepEqual(item["args"], wantArgs) {
+		t.Fatalf("DisplayItem()[args] = %#v, want %#v", item["args"], wantArgs)
+	}
+	if item["password"] != "top" {
+		t.Fatalf("DisplayItem()[password] = %#v, want only args redacted", item["password"])
+	}
+
+	raw := record.Item()["args"].([]any)[0].(map[string]any)["nested"].(map[string]any)["password"]
+	if raw != "hunter2" {
+		t.Fatalf("Item() was modified: password = %#v", raw)
+	}
+	if !strings.Contains(record.Value(), "hunter2") {
+		t.Fatal("Value() was modified")
+	}
+}
+
+func TestJobRecord_DisplayItem_NoKeys(t *testing.T) {
+	record := NewJobRecord(`{"class":"PlainJob","args":[{"password":"hunter2"}]}`, "")
+	if got := record.DisplayItem(); !reflect.DeepEqual(got, record.Item()) {
+		t.Fatalf("DisplayItem() = %#v, want Item()", got)
+	}
+}
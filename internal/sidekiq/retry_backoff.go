@@ -0,0 +1,48 @@
+package sidekiq
+
+import (
+	"math"
+	"time"
+)
+
+// retryBackoffJitter is the exclusive upper bound of the random factor in
+// Sidekiq's default backoff.
+const retryBackoffJitter = 30
+
+// RetryEstimate is the window in which a retry is expected to fire. Sidekiq
+// adds a random delay to every retry, so only a range can be predicted.
+type RetryEstimate struct {
+	Earliest time.Time
+	Latest   time.Time
+}
+
+// RetryBackoff returns the shortest and longest delay Sidekiq's default
+// backoff, count**4 + 15 + rand(30)*(count+1) seconds, waits before a retry
+// of a job that has failed with retry_count set to count. multiplier scales
+// the delay for apps that tune their backoff; values <= 0 count as 1.
+func RetryBackoff(count int, multiplier float64) (time.Duration, time.Duration) {
+	if multiplier <= 0 {
+		multiplier = 1
+	}
+	count = max(count, 0)
+	base := math.Pow(float64(count), 4) + 15
+	jitter := float64((retryBackoffJitter - 1) * (count + 1))
+	seconds := func(s float64) time.Duration {
+		return time.Duration(s * multiplier * float64(time.Second))
+	}
+	return seconds(base), seconds(base + jitter)
+}
+
+// NextRetryEstimate estimates when the job retries after the one it is
+// waiting for: if the retry at At() fails too, Sidekiq bumps retry_count and
+// backs off from there. It returns false when that failure would exhaust the
+// job's retries and send it to the dead set instead.
+func (se *SortedEntry) NextRetryEstimate(multiplier float64) (RetryEstimate, bool) {
+	count := max(se.RetryCount(), 0) + 1
+	if count >= retryLimit(se.JobRecord) {
+		return RetryEstimate{}, false
+	}
+	shortest, longest := RetryBackoff(count, multiplier)
+	at := se.At()
+	return RetryEstimate{Earliest: at.Add(shortest), Latest: at.Add(longest)}, true
+}
@@ -0,0 +1,50 @@
+package sidekiq
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRetryBackoff(t *testing.T) {
+	tests := []struct {
+		count        int
+		multiplier   float64
+		wantShortest time.Duration
+		wantLongest  time.Duration
+	}{
+		{count: 0, multiplier: 1, wantShortest: 15 * time.Second, wantLongest: 44 * time.Second},
+		{count: 3, multiplier: 1, wantShortest: 96 * time.Second, wantLongest: 212 * time.Second},
+		{count: 10, multiplier: 1, wantShortest: 10015 * time.Second, wantLongest: 10334 * time.Second},
+		{count: 3, multiplier: 2, wantShortest: 192 * time.Second, wantLongest: 424 * time.Second},
+		{count: 3, multiplier: 0, wantShortest: 96 * time.Second, wantLongest: 212 * time.Second},
+		{count: -1, multiplier: 1, wantShortest: 15 * time.Second, wantLongest: 44 * time.Second},
+	}
+	for _, tt := range tests {
+		shortest, longest := RetryBackoff(tt.count, tt.multiplier)
+		if shortest != tt.wantShortest || longest != tt.wantLongest {
+			t.Errorf("RetryBackoff(%d, %v) = %v, %v, want %v, %v",
+				tt.count, tt.multiplier, shortest, longest, tt.wantShortest, tt.wantLongest)
+		}
+	}
+}
+
+func TestSortedEntryNextRetryEstimate(t *testing.T) {
+	at := time.Unix(1700000000, 0)
+	entry := NewSortedEntry(`{"jid":"a","retry":true,"retry_count":2}`, float64(at.Unix()))
+
+	estimate, ok := entry.NextRetryEstimate(1)
+	if !ok {
+		t.Fatal("NextRetryEstimate() ok = false, want true")
+	}
+	if want := at.Add(96 * time.Second); !estimate.Earliest.Equal(want) {
+		t.Fatalf("Earliest = %v, want %v", estimate.Earliest, want)
+	}
+	if want := at.Add(212 * time.Second); !estimate.Latest.Equal(want) {
+		t.Fatalf("Latest = %v, want %v", estimate.Latest, want)
+	}
+
+	exhausted := NewSortedEntry(`{"jid":"b","retry":3,"retry_count":2}`, float64(at.Unix()))
+	if _, ok := exhausted.NextRetryEstimate(1); ok {
+		t.Fatal("NextRetryEstimate() ok = true for a job on its last retry")
+	}
+}
@@ -0,0 +1,132 @@
+package sidekiq
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// DefaultMaxRetries is the retry limit Sidekiq applies to jobs with
+// "retry": true.
+const DefaultMaxRetries = 25
+
+// RetryCountSampleSize is the number of jobs GetRetryCounts reads from a
+// retry set larger than it.
+const RetryCountSampleSize = 1000
+
+// RetryCounts tallies the retry set by attempt.
+type RetryCounts struct {
+	// Counts holds the number of jobs per retry_count, indexed by it. Jobs
+	// without a retry_count are on attempt 0.
+	Counts []int64
+	// LastAttempt is the number of jobs that die on their next failure.
+	LastAttempt int64
+	// Sampled reports that the set was larger than RetryCountSampleSize, so
+	// the counts are estimated from a random sample scaled to the set size.
+	Sampled bool
+}
+
+// Total returns the number of jobs across all attempts.
+func (rc RetryCounts) Total() int64 {
+	var total int64
+	for _, count := range rc.Counts {
+		total += count
+	}
+	return total
+}
+
+// GetRetryCounts tallies jobs in the retry set by retry_count. Sets larger
+// than RetryCountSampleSize are sampled at random rather than loaded whole:
+// the set is ordered by the next retry time, which follows the attempt, so
+// reading either end would skew the counts. Servers without ZRANDMEMBER
+// (before Redis 6.2) are sampled with ZSCAN instead, which walks the set in
+// hash order rather than by score.
+func (c *Client) GetRetryCounts(ctx context.Context) (RetryCounts, error) {
+	size, err := c.rdb().ZCard(ctx, retrySetKey).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return RetryCounts{}, err
+	}
+	if size == 0 {
+		return RetryCounts{}, nil
+	}
+
+	var members []string
+	sampled := size > RetryCountSampleSize
+	switch {
+	case sampled && c.capabilities(ctx).zrandMember:
+		members, err = c.rdb().ZRandMember(ctx, retrySetKey, RetryCountSampleSize).Result()
+		if err != nil && unsupportedCommandError(err) {
+			members, err = c.scanRetrySample(ctx)
+		}
+	case sampled:
+		members, err = c.scanRetrySample(ctx)
+	default:
+		members, err = c.rdb().ZRange(ctx, retrySetKey, 0, -1).Result()
+	}
+	if err != nil {
+		return RetryCounts{}, err
+	}
+
+	var counts []int64
+	var lastAttempt int64
+	for _, member := range members {
+		job := NewJobRecord(member, "")
+		retryCount := max(job.RetryCount(), 0)
+		if retryCount >= len(counts) {
+			counts = append(counts, make([]int64, retryCount-len(counts)+1)...)
+		}
+		counts[retryCount]++
+		// Sidekiq kills a job once retry_count reaches its limit, and
+		// bumps the count before checking it.
+		if retryCount+1 >= retryLimit(job) {
+			lastAttempt++
+		}
+	}
+
+	result := RetryCounts{Counts: counts, LastAttempt: lastAttempt, Sampled: sampled}
+	if sampled && len(members) > 0 {
+		scale := float64(size) / float64(len(members))
+		for i, count := range result.Counts {
+			result.Counts[i] = int64(math.Round(float64(count) * scale))
+		}
+		result.LastAttempt = int64(math.Round(float64(lastAttempt) * scale))
+	}
+	return result, nil
+}
+
+// scanRetrySample reads about RetryCountSampleSize members of the retry set
+// with ZSCAN.
+func (c *Client) scanRetrySample(ctx context.Context) ([]string, error) {
+	members := make([]string, 0, RetryCountSampleSize)
+	var cursor uint64
+	for {
+		// ZSCAN replies with members and scores interleaved.
+		pairs, next, err := c.rdb().ZScan(ctx, retrySetKey, cursor, "", sortedSetScanCount).Result()
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < len(pairs); i += 2 {
+			members = append(members, pairs[i])
+		}
+		cursor = next
+		if cursor == 0 || len(members) >= RetryCountSampleSize {
+			break
+		}
+	}
+	if len(members) > RetryCountSampleSize {
+		members = members[:RetryCountSampleSize]
+	}
+	return members, nil
+}
+
+// retryLimit returns the number of retries job is allowed: the "retry"
+// value when it is a number, DefaultMaxRetries otherwise.
+func retryLimit(job *JobRecord) int {
+	job.ensureParsed()
+	if limit, ok := job.item["retry"].(float64); ok {
+		return int(limit)
+	}
+	return DefaultMaxRetries
+}
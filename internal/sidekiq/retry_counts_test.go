@@ -0,0 +1,123 @@
+package sidekiq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/redis/go-redis/v9"
+)
+
+func TestGetRetryCounts(t *testing.T) {
+	ctx := testContext(t)
+	mr, client := setupTestRedis(t)
+
+	payloads := []string{
+		`{"jid":"a","class":"MailJob","retry":true}`,
+		`{"jid":"b","class":"MailJob","retry":true,"retry_count":0}`,
+		`{"jid":"c","class":"MailJob","retry":true,"retry_count":2}`,
+		`{"jid":"d","class":"MailJob","retry":true,"retry_count":24}`,
+		`{"jid":"e","class":"MailJob","retry":3,"retry_count":2}`,
+	}
+	for i, payload := range payloads {
+		addSortedSetJob(t, mr, retrySetKey, float64(i+1), payload)
+	}
+
+	counts, err := client.GetRetryCounts(ctx)
+	if err != nil {
+		t.Fatalf("GetRetryCounts failed: %v", err)
+	}
+	want := make([]int64, 25)
+	want[0], want[2], want[24] = 2, 2, 1
+	if !slices.Equal(counts.Counts, want) {
+		t.Fatalf("Counts = %v, want %v", counts.Counts, want)
+	}
+	if counts.LastAttempt != 2 {
+		t.Fatalf("LastAttempt = %d, want 2", counts.LastAttempt)
+	}
+	if counts.Sampled {
+		t.Fatal("Sampled = true, want false")
+	}
+	if counts.Total() != 5 {
+		t.Fatalf("Total() = %d, want 5", counts.Total())
+	}
+}
+
+func TestGetRetryCounts_SamplesLargeSets(t *testing.T) {
+	ctx := testContext(t)
+	mr, client := setupTestRedis(t)
+
+	size := 2 * RetryCountSampleSize
+	for i := range size {
+		addSortedSetJob(t, mr, retrySetKey, float64(i), fmt.Sprintf(`{"jid":"j%d","class":"MailJob","retry_count":1}`, i))
+	}
+
+	counts, err := client.GetRetryCounts(ctx)
+	if err != nil {
+		t.Fatalf("GetRetryCounts failed: %v", err)
+	}
+	if !counts.Sampled {
+		t.Fatal("Sampled = false, want true")
+	}
+	if want := []int64{0, int64(size)}; !slices.Equal(counts.Counts, want) {
+		t.Fatalf("Counts = %v, want %v", counts.Counts, want)
+	}
+}
+
+func TestGetRetryCounts_SamplesWithoutZRandMember(t *testing.T) {
+	ctx := testContext(t)
+	mr, client := setupTestRedis(t)
+	client.AddHook(unsupportedZRandMemberHook{})
+
+	size := 2 * RetryCountSampleSize
+	for i := range size {
+		addSortedSetJob(t, mr, retrySetKey, float64(i), fmt.Sprintf(`{"jid":"j%d","class":"MailJob","retry_count":1}`, i))
+	}
+
+	counts, err := client.GetRetryCounts(ctx)
+	if err != nil {
+		t.Fatalf("GetRetryCounts failed: %v", err)
+	}
+	if !counts.Sampled {
+		t.Fatal("Sampled = false, want true")
+	}
+	if want := []int64{0, int64(size)}; !slices.Equal(counts.Counts, want) {
+		t.Fatalf("Counts = %v, want %v", counts.Counts, want)
+	}
+}
+
+// unsupportedZRandMemberHook answers ZRANDMEMBER like a server before Redis
+// 6.2.
+type unsupportedZRandMemberHook struct{}
+
+func (unsupportedZRandMemberHook) DialHook(next redis.DialHook) redis.DialHook { return next }
+
+func (unsupportedZRandMemberHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		if cmd.Name() != "zrandmember" {
+			return next(ctx, cmd)
+		}
+		err := errors.New("ERR unknown command 'ZRANDMEMBER', with args beginning with: 'retry'")
+		cmd.SetErr(err)
+		return err
+	}
+}
+
+func (unsupportedZRandMemberHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return next
+}
+
+func TestGetRetryCounts_Empty(t *testing.T) {
+	ctx := testContext(t)
+	_, client := setupTestRedis(t)
+
+	counts, err := client.GetRetryCounts(ctx)
+	if err != nil {
+		t.Fatalf("GetRetryCounts failed: %v", err)
+	}
+	if counts.Total() != 0 || counts.Sampled {
+		t.Fatalf("counts = %+v, want empty", counts)
+	}
+}
@@ -0,0 +1,38 @@
+package sidekiq
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// GetScheduledTimeline counts scheduled jobs by how soon they run. buckets
+// are ascending upper bounds on the time until a job runs; the result has
+// len(buckets)+1 counts, the last one holding jobs due after the final bound.
+// Jobs already past due are counted in the first bucket. Each bucket is one
+// ZCOUNT over a score range, so the set is never loaded.
+func (c *Client) GetScheduledTimeline(ctx context.Context, buckets []time.Duration) ([]int64, error) {
+	now := nowFuncSidekiq()
+	cmds := make([]*redis.IntCmd, len(buckets)+1)
+	_, err := c.rdb().Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		lower := "-inf"
+		for i, bound := range buckets {
+			upper := "(" + strconv.FormatFloat(sortedSetScore(now.Add(bound)), 'f', -1, 64)
+			cmds[i] = pipe.ZCount(ctx, scheduleSetKey, lower, upper)
+			lower = upper[1:]
+		}
+		cmds[len(buckets)] = pipe.ZCount(ctx, scheduleSetKey, lower, "+inf")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make([]int64, len(cmds))
+	for i, cmd := range cmds {
+		counts[i] = cmd.Val()
+	}
+	return counts, nil
+}
@@ -0,0 +1,50 @@
+package sidekiq
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestGetScheduledTimeline(t *testing.T) {
+	ctx := testContext(t)
+	mr, client := setupTestRedis(t)
+
+	now := time.Unix(1700000000, 0)
+	originalNow := nowFuncSidekiq
+	nowFuncSidekiq = func() time.Time { return now }
+	t.Cleanup(func() { nowFuncSidekiq = originalNow })
+
+	offsets := []time.Duration{
+		-time.Minute,
+		30 * time.Minute,
+		time.Hour, // on the bound, so in the next bucket
+		2 * time.Hour,
+		48 * time.Hour,
+	}
+	for i, offset := range offsets {
+		addSortedSetJob(t, mr, scheduleSetKey, sortedSetScore(now.Add(offset)), fmt.Sprintf(`{"jid":"j%d"}`, i))
+	}
+
+	counts, err := client.GetScheduledTimeline(ctx, []time.Duration{time.Hour, 24 * time.Hour})
+	if err != nil {
+		t.Fatalf("GetScheduledTimeline failed: %v", err)
+	}
+	if want := []int64{2, 2, 1}; !slices.Equal(counts, want) {
+		t.Fatalf("counts = %v, want %v", counts, want)
+	}
+}
+
+func TestGetScheduledTimeline_Empty(t *testing.T) {
+	ctx := testContext(t)
+	_, client := setupTestRedis(t)
+
+	counts, err := client.GetScheduledTimeline(ctx, []time.Duration{time.Hour})
+	if err != nil {
+		t.Fatalf("GetScheduledTimeline failed: %v", err)
+	}
+	if want := []int64{0, 0}; !slices.Equal(counts, want) {
+		t.Fatalf("counts = %v, want %v", counts, want)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"math"
 	"sort"
 	"strconv"
@@ -16,6 +17,10 @@ import (
 const (
 	sortedSetScanCount int64 = 100
 	sortedSetPopBatch  int64 = 100
+
+	// deadToRetryDelay schedules jobs moved from dead to retry a little ahead,
+	// like the first delay Sidekiq picks after a failure.
+	deadToRetryDelay = 15 * time.Second
 )
 
 const (
@@ -51,6 +56,37 @@ func (k SortedSetKind) String() string {
 	}
 }
 
+// Key returns the Redis key of the sorted set, or "" for an unknown kind.
+func (k SortedSetKind) Key() string {
+	spec, err := sortedSetSpecFor(k)
+	if err != nil {
+		return ""
+	}
+	return spec.key
+}
+
+// SortOrder is the order sorted-set entries are listed in, by score.
+type SortOrder int
+
+const (
+	// SortDefault lists a set in its natural order: dead jobs newest first,
+	// retries and scheduled jobs earliest first.
+	SortDefault SortOrder = iota
+	// SortAscending lists the lowest scores, the earliest times, first (ZRANGE).
+	SortAscending
+	// SortDescending lists the highest scores, the latest times, first (ZREVRANGE).
+	SortDescending
+)
+
+// DefaultOrder returns the order SortDefault stands for in the set.
+func (k SortedSetKind) DefaultOrder() SortOrder {
+	spec, err := sortedSetSpecFor(k)
+	if err == nil && spec.reverse {
+		return SortDescending
+	}
+	return SortAscending
+}
+
 type sortedSetSpec struct {
 	key                 string
 	reverse             bool
@@ -58,6 +94,18 @@ type sortedSetSpec struct {
 	canMoveToDead       bool
 }
 
+// reverseFor reports whether the set is listed highest score first in order.
+func (s sortedSetSpec) reverseFor(order SortOrder) bool {
+	switch order {
+	case SortAscending:
+		return false
+	case SortDescending:
+		return true
+	default:
+		return s.reverse
+	}
+}
+
 func sortedSetSpecFor(kind SortedSetKind) (sortedSetSpec, error) {
 	switch kind {
 	case SortedSetRetry:
@@ -112,7 +160,7 @@ func (se *SortedEntry) At() time.Time {
 // getSortedSetJobs fetches jobs from a sorted set with pagination.
 // If reverse is true, returns highest scores first (ZREVRANGE), otherwise lowest first (ZRANGE).
 func (c *Client) getSortedSetJobs(ctx context.Context, key string, start, count int, reverse bool) ([]*SortedEntry, int64, error) {
-	size, err := c.redis.ZCard(ctx, key).Result()
+	size, err := c.rdb().ZCard(ctx, key).Result()
 	if err != nil && !errors.Is(err, redis.Nil) {
 		return nil, 0, err
 	}
@@ -127,9 +175,9 @@ func (c *Client) getSortedSetJobs(ctx context.Context, key string, start, count
 	}
 	var results []redis.Z
 	if reverse {
-		results, err = c.redis.ZRevRangeWithScores(ctx, key, int64(start), end).Result()
+		results, err = c.rdb().ZRevRangeWithScores(ctx, key, int64(start), end).Result()
 	} else {
-		results, err = c.redis.ZRangeWithScores(ctx, key, int64(start), end).Result()
+		results, err = c.rdb().ZRangeWithScores(ctx, key, int64(start), end).Result()
 	}
 	if err != nil {
 		return nil, size, err
@@ -170,7 +218,7 @@ func (c *Client) scanSortedSetWindow(
 	selected := make([]*SortedEntry, 0, max(min(limit, int(sortedSetScanCount)), 0))
 	var cursor uint64
 	for {
-		values, nextCursor, err := c.redis.ZScan(ctx, key, cursor, match, sortedSetScanCount).Result()
+		values, nextCursor, err := c.rdb().ZScan(ctx, key, cursor, match, sortedSetScanCount).Result()
 		if err != nil {
 			return SortedEntriesWindow{}, err
 		}
@@ -220,7 +268,7 @@ func (c *Client) scanSortedSetWindow(
 }
 
 func (c *Client) getSortedSetBounds(ctx context.Context, key string) (*SortedEntry, *SortedEntry, error) {
-	pipe := c.redis.Pipeline()
+	pipe := c.rdb().Pipeline()
 	minCmd := pipe.ZRangeWithScores(ctx, key, 0, 0)
 	maxCmd := pipe.ZRevRangeWithScores(ctx, key, 0, 0)
 
@@ -250,32 +298,39 @@ func (c *Client) getSortedSetBounds(ctx context.Context, key string) (*SortedEnt
 	return NewSortedEntry(minValue, minResults[0].Score), NewSortedEntry(maxValue, maxResults[0].Score), nil
 }
 
-// GetSortedEntries fetches sorted-set jobs with pagination.
+// GetSortedEntries fetches sorted-set jobs in the given order with pagination.
 func (c *Client) GetSortedEntries(
 	ctx context.Context,
 	kind SortedSetKind,
+	order SortOrder,
 	start, count int,
 ) ([]*SortedEntry, int64, error) {
 	spec, err := sortedSetSpecFor(kind)
 	if err != nil {
 		return nil, 0, err
 	}
-	return c.getSortedSetJobs(ctx, spec.key, start, count, spec.reverse)
+	return c.getSortedSetJobs(ctx, spec.key, start, count, spec.reverseFor(order))
 }
 
 // ScanSortedEntries scans sorted-set jobs using a match pattern (no paging).
-func (c *Client) ScanSortedEntries(ctx context.Context, kind SortedSetKind, match string) ([]*SortedEntry, error) {
+func (c *Client) ScanSortedEntries(
+	ctx context.Context,
+	kind SortedSetKind,
+	order SortOrder,
+	match string,
+) ([]*SortedEntry, error) {
 	spec, err := sortedSetSpecFor(kind)
 	if err != nil {
 		return nil, err
 	}
-	return c.scanSortedSetJobs(ctx, spec.key, match, spec.reverse)
+	return c.scanSortedSetJobs(ctx, spec.key, match, spec.reverseFor(order))
 }
 
 // ScanSortedEntriesWindow scans sorted-set jobs using a match pattern and returns one window.
 func (c *Client) ScanSortedEntriesWindow(
 	ctx context.Context,
 	kind SortedSetKind,
+	order SortOrder,
 	match string,
 	start, count int,
 ) (SortedEntriesWindow, error) {
@@ -283,7 +338,101 @@ func (c *Client) ScanSortedEntriesWindow(
 	if err != nil {
 		return SortedEntriesWindow{}, err
 	}
-	return c.scanSortedSetWindow(ctx, spec.key, match, start, count, spec.reverse)
+	return c.scanSortedSetWindow(ctx, spec.key, match, start, count, spec.reverseFor(order))
+}
+
+// GetSortedEntriesInRange fetches the sorted-set jobs whose score falls in
+// [from, to), in the same order as GetSortedEntries. A zero from or to leaves
+// that end of the range open. Scores map to times the same way as
+// SortedEntry.At.
+func (c *Client) GetSortedEntriesInRange(
+	ctx context.Context,
+	kind SortedSetKind,
+	order SortOrder,
+	from, to time.Time,
+) ([]*SortedEntry, error) {
+	spec, err := sortedSetSpecFor(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	scoreRange := &redis.ZRangeBy{Min: "-inf", Max: "+inf"}
+	if !from.IsZero() {
+		scoreRange.Min = strconv.FormatFloat(sortedSetScore(from), 'f', -1, 64)
+	}
+	if !to.IsZero() {
+		scoreRange.Max = "(" + strconv.FormatFloat(sortedSetScore(to), 'f', -1, 64)
+	}
+
+	var results []redis.Z
+	if spec.reverseFor(order) {
+		results, err = c.rdb().ZRevRangeByScoreWithScores(ctx, spec.key, scoreRange).Result()
+	} else {
+		results, err = c.rdb().ZRangeByScoreWithScores(ctx, spec.key, scoreRange).Result()
+	}
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+
+	entries := make([]*SortedEntry, len(results))
+	for i, z := range results {
+		value, _ := z.Member.(string)
+		entries[i] = NewSortedEntry(value, z.Score)
+	}
+	return entries, nil
+}
+
+// ErrJobNotFound is returned when no job with the requested JID exists.
+var ErrJobNotFound = errors.New("job not found")
+
+// ErrInvalidPayload is returned when an action needs to rewrite a job whose
+// payload is not valid JSON. Such jobs can still be removed with
+// ForceDeleteEntry.
+var ErrInvalidPayload = errors.New("job payload is not valid JSON")
+
+// errStopScan ends a sorted-set scan early once a visitor is done.
+var errStopScan = errors.New("stop scan")
+
+// FindSortedEntry returns the sorted-set job with the given JID, or
+// ErrJobNotFound.
+func (c *Client) FindSortedEntry(ctx context.Context, kind SortedSetKind, jid string) (*SortedEntry, error) {
+	spec, err := sortedSetSpecFor(kind)
+	if err != nil {
+		return nil, err
+	}
+	if jid == "" {
+		return nil, ErrJobNotFound
+	}
+
+	var found *SortedEntry
+	match := `*"jid":"` + escapeGlob(jid) + `"*`
+	err = c.scanSortedSetEntries(ctx, spec.key, match, func(entry *SortedEntry) error {
+		if entry.JID() != jid {
+			return nil
+		}
+		found = entry
+		return errStopScan
+	})
+	if err != nil && !errors.Is(err, errStopScan) {
+		return nil, err
+	}
+	if found == nil {
+		return nil, ErrJobNotFound
+	}
+	return found, nil
+}
+
+// escapeGlob escapes Redis glob metacharacters so value matches literally.
+func escapeGlob(value string) string {
+	var b strings.Builder
+	for _, r := range value {
+		switch r {
+		case '*', '?', '[', ']', '\\':
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
 }
 
 // GetSortedEntryBounds fetches the oldest and newest entries for a sorted set.
@@ -299,7 +448,11 @@ func (c *Client) GetSortedEntryBounds(
 }
 
 // DeleteSortedEntry removes one job from a sorted set.
-func (c *Client) DeleteSortedEntry(ctx context.Context, kind SortedSetKind, entry *SortedEntry) error {
+func (c *Client) DeleteSortedEntry(ctx context.Context, kind SortedSetKind, entry *SortedEntry) (err error) {
+	defer func() { c.recordActivity(ActivityDelete, kind.String(), entryJID(entry), "", err) }()
+	if err := c.checkWritable(); err != nil {
+		return err
+	}
 	spec, err := sortedSetSpecFor(kind)
 	if err != nil {
 		return err
@@ -307,8 +460,37 @@ func (c *Client) DeleteSortedEntry(ctx context.Context, kind SortedSetKind, entr
 	return c.deleteSortedEntry(ctx, spec.key, entry)
 }
 
+// ForceDeleteEntry removes the member rawValue from a sorted set as is,
+// without parsing it, so jobs with corrupt payloads can be cleaned up. It
+// returns ErrJobNotFound when the member is no longer in the set.
+func (c *Client) ForceDeleteEntry(ctx context.Context, kind SortedSetKind, rawValue string) (err error) {
+	defer func() { c.recordActivity(ActivityForceDelete, kind.String(), "", "", err) }()
+	if err := c.checkWritable(); err != nil {
+		return err
+	}
+	spec, err := sortedSetSpecFor(kind)
+	if err != nil {
+		return err
+	}
+	if rawValue == "" {
+		return errors.New("sorted entry payload is empty")
+	}
+	removed, err := c.rdb().ZRem(ctx, spec.key, rawValue).Result()
+	if err != nil {
+		return err
+	}
+	if removed == 0 {
+		return ErrJobNotFound
+	}
+	return nil
+}
+
 // MoveSortedEntryToDead moves a supported sorted-set job into the dead set.
-func (c *Client) MoveSortedEntryToDead(ctx context.Context, kind SortedSetKind, entry *SortedEntry) error {
+func (c *Client) MoveSortedEntryToDead(ctx context.Context, kind SortedSetKind, entry *SortedEntry) (err error) {
+	defer func() { c.recordActivity(ActivityKill, kind.String(), entryJID(entry), "", err) }()
+	if err := c.checkWritable(); err != nil {
+		return err
+	}
 	spec, err := sortedSetSpecFor(kind)
 	if err != nil {
 		return err
@@ -316,70 +498,300 @@ func (c *Client) MoveSortedEntryToDead(ctx context.Context, kind SortedSetKind,
 	if !spec.canMoveToDead {
 		return errors.New("sorted set does not support move to dead: " + kind.String())
 	}
-	return c.moveSortedEntryToDead(ctx, spec.key, entry)
+	move, err := c.moveSortedEntryToDead(ctx, spec.key, entry)
+	if err != nil {
+		return err
+	}
+	move.action, move.originKind = ActivityKill, kind
+	c.rememberUndo(move)
+	return nil
 }
 
-func (c *Client) moveSortedEntryToDead(ctx context.Context, key string, entry *SortedEntry) error {
+func (c *Client) moveSortedEntryToDead(ctx context.Context, key string, entry *SortedEntry) (*undoMove, error) {
 	if entry == nil || entry.JobRecord == nil {
-		return errors.New("sorted entry is nil")
+		return nil, errors.New("sorted entry is nil")
 	}
 	value := entry.Value()
 	if value == "" {
-		return errors.New("sorted entry payload is empty")
+		return nil, errors.New("sorted entry payload is empty")
 	}
 
-	_, err := c.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+	deadScore := nowSortedSetScore()
+	_, err := c.rdb().TxPipelined(ctx, func(pipe redis.Pipeliner) error {
 		pipe.ZRem(ctx, key, value)
 		pipe.ZAdd(ctx, deadSetKey, redis.Z{
-			Score:  nowSortedSetScore(),
+			Score:  deadScore,
 			Member: value,
 		})
 		return nil
 	})
-	return err
+	if err != nil {
+		return nil, err
+	}
+	return &undoMove{
+		jid:          entry.JID(),
+		currentKey:   deadSetKey,
+		current:      value,
+		currentScore: deadScore,
+		originKey:    key,
+		origin:       value,
+		originScore:  entry.Score,
+	}, nil
 }
 
 // EnqueueSortedEntry moves a sorted-set job to its queue immediately.
 func (c *Client) EnqueueSortedEntry(ctx context.Context, kind SortedSetKind, entry *SortedEntry) error {
+	_, err := c.EnqueueSortedEntryJob(ctx, kind, entry)
+	return err
+}
+
+// EnqueueSortedEntryJob moves a sorted-set job to its queue immediately and
+// returns the job exactly as it was pushed, so it can be found in the queue
+// by its payload afterwards.
+func (c *Client) EnqueueSortedEntryJob(ctx context.Context, kind SortedSetKind, entry *SortedEntry) (job *JobRecord, err error) {
+	defer func() { c.recordActivity(ActivityEnqueue, kind.String(), entryJID(entry), "", err) }()
+	if err := c.checkWritable(); err != nil {
+		return nil, err
+	}
+	spec, err := sortedSetSpecFor(kind)
+	if err != nil {
+		return nil, err
+	}
+	move, err := c.moveSortedEntryToQueue(ctx, spec.key, entry, spec.decrementRetryCount, false)
+	if err != nil {
+		return nil, err
+	}
+	move.action, move.originKind = ActivityEnqueue, kind
+	c.rememberUndo(move)
+	return NewJobRecord(move.current, strings.TrimPrefix(move.currentKey, queuePrefixKey)), nil
+}
+
+// EnqueueSortedEntryToFront moves a sorted-set job to its queue so it runs
+// next. Sidekiq pushes to the head of a queue and fetches from its tail, so
+// the job is RPUSHed past every job already waiting.
+func (c *Client) EnqueueSortedEntryToFront(ctx context.Context, kind SortedSetKind, entry *SortedEntry) (err error) {
+	defer func() { c.recordActivity(ActivityEnqueue, kind.String(), entryJID(entry), "to front", err) }()
+	if err := c.checkWritable(); err != nil {
+		return err
+	}
 	spec, err := sortedSetSpecFor(kind)
 	if err != nil {
 		return err
 	}
-	return c.moveSortedEntryToQueue(ctx, spec.key, entry, spec.decrementRetryCount)
+	move, err := c.moveSortedEntryToQueue(ctx, spec.key, entry, spec.decrementRetryCount, true)
+	if err != nil {
+		return err
+	}
+	move.action, move.originKind = ActivityEnqueue, kind
+	c.rememberUndo(move)
+	return nil
+}
+
+// RequeueDeadJob moves a dead job to its queue as-is. Unlike
+// EnqueueSortedEntry it keeps retry_count, so the job runs under its original
+// retry context; only enqueued_at is refreshed and error fields are kept.
+func (c *Client) RequeueDeadJob(ctx context.Context, entry *SortedEntry) (err error) {
+	defer func() { c.recordActivity(ActivityRequeue, SortedSetDead.String(), entryJID(entry), "", err) }()
+	if err := c.checkWritable(); err != nil {
+		return err
+	}
+	move, err := c.moveSortedEntryToQueue(ctx, deadSetKey, entry, false, false)
+	if err != nil {
+		return err
+	}
+	move.action, move.originKind = ActivityRequeue, SortedSetDead
+	c.rememberUndo(move)
+	return nil
 }
 
-func (c *Client) moveSortedEntryToQueue(ctx context.Context, key string, entry *SortedEntry, decrementRetryCount bool) error {
+// moveSortedEntryToQueue pushes the job to the head of its queue, where
+// Sidekiq enqueues, or to the tail, where it fetches from, when front is set.
+func (c *Client) moveSortedEntryToQueue(
+	ctx context.Context,
+	key string,
+	entry *SortedEntry,
+	decrementRetryCount bool,
+	front bool,
+) (*undoMove, error) {
 	if entry == nil || entry.JobRecord == nil {
-		return errors.New("sorted entry is nil")
+		return nil, errors.New("sorted entry is nil")
 	}
 	rawValue := entry.Value()
 	if rawValue == "" {
-		return errors.New("sorted entry payload is empty")
+		return nil, errors.New("sorted entry payload is empty")
 	}
 
 	queueName, encoded, err := buildQueuePayload(rawValue, decrementRetryCount, c.DetectVersion(ctx))
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	removed, err := c.redis.ZRem(ctx, key, rawValue).Result()
+	removed, err := c.rdb().ZRem(ctx, key, rawValue).Result()
 	if err != nil && !errors.Is(err, redis.Nil) {
-		return err
+		return nil, err
 	}
 	if removed == 0 {
-		return errors.New("job not found")
+		return nil, errors.New("job not found")
 	}
 
-	_, err = c.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+	_, err = c.rdb().TxPipelined(ctx, func(pipe redis.Pipeliner) error {
 		pipe.SAdd(ctx, queueSetKey, queueName)
-		pipe.LPush(ctx, queuePrefixKey+queueName, encoded)
+		if front {
+			pipe.RPush(ctx, queuePrefixKey+queueName, encoded)
+		} else {
+			pipe.LPush(ctx, queuePrefixKey+queueName, encoded)
+		}
 		return nil
 	})
-	return err
+	if err != nil {
+		return nil, err
+	}
+	return &undoMove{
+		jid:         entry.JID(),
+		currentKey:  queuePrefixKey + queueName,
+		current:     string(encoded),
+		inQueue:     true,
+		originKey:   key,
+		origin:      rawValue,
+		originScore: entry.Score,
+	}, nil
+}
+
+// RetryDeadJobWithDelay moves a dead job to the schedule set to run after delay.
+// The payload gets a fresh enqueued_at like RetryNowDeadJob, which Sidekiq
+// replaces again when the scheduler pushes the job to its queue. The move is
+// atomic: if the job cannot be scheduled, it stays in the dead set.
+func (c *Client) RetryDeadJobWithDelay(ctx context.Context, entry *SortedEntry, delay time.Duration) (err error) {
+	defer func() {
+		c.recordActivity(ActivityRetryLater, SortedSetDead.String(), entryJID(entry), "in "+delay.String(), err)
+	}()
+	if err := c.checkWritable(); err != nil {
+		return err
+	}
+	if entry == nil || entry.JobRecord == nil {
+		return errors.New("sorted entry is nil")
+	}
+	if delay < 0 {
+		return errors.New("retry delay must not be negative")
+	}
+	rawValue := entry.Value()
+	if rawValue == "" {
+		return errors.New("sorted entry payload is empty")
+	}
+
+	_, encoded, err := buildQueuePayload(rawValue, true, c.DetectVersion(ctx))
+	if err != nil {
+		return err
+	}
+
+	moved, err := retryDeadLaterScript.Run(
+		ctx, c.rdb(),
+		[]string{deadSetKey, scheduleSetKey},
+		rawValue,
+		string(encoded),
+		strconv.FormatFloat(sortedSetScore(nowFuncSidekiq().Add(delay)), 'f', -1, 64),
+	).Int()
+	if err != nil {
+		return fmt.Errorf("schedule dead job: %w", err)
+	}
+	if moved == 0 {
+		return errors.New("job not found")
+	}
+	return nil
+}
+
+// retryDeadLaterScript moves a dead job to the schedule set in one step.
+// Redis does not roll back a failed script, so the job is added to the
+// schedule set before it leaves the dead set. Returns 0 when the job is no
+// longer dead.
+var retryDeadLaterScript = redis.NewScript(`
+if not redis.call('ZSCORE', KEYS[1], ARGV[1]) then
+  return 0
+end
+redis.call('ZADD', KEYS[2], ARGV[3], ARGV[2])
+redis.call('ZREM', KEYS[1], ARGV[1])
+return 1
+`)
+
+// DeleteDeadJobsOlderThan removes dead jobs that died before cutoff and
+// returns how many were removed.
+func (c *Client) DeleteDeadJobsOlderThan(ctx context.Context, cutoff time.Time) (removed int64, err error) {
+	defer func() { c.recordActivity(ActivityPrune, SortedSetDead.String(), "", jobCountDetail(removed), err) }()
+	if err := c.checkWritable(); err != nil {
+		return 0, err
+	}
+	maxScore := "(" + strconv.FormatFloat(sortedSetScore(cutoff), 'f', -1, 64)
+	removed, err = c.rdb().ZRemRangeByScore(ctx, deadSetKey, "-inf", maxScore).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return 0, err
+	}
+	return removed, nil
+}
+
+// DeadLimits holds the dead set caps configured in Sidekiq workers
+// (dead_max_jobs and dead_timeout_in_seconds). Zero means unknown.
+type DeadLimits struct {
+	MaxJobs int64
+	Timeout time.Duration
+}
+
+// SetDeadLimits overrides the dead set limits reported by GetDeadLimits.
+// Zero values fall back to what processes publish.
+func (c *Client) SetDeadLimits(limits DeadLimits) {
+	c.deadLimits = limits
+}
+
+// GetDeadLimits returns the dead set limits. Overrides from SetDeadLimits win;
+// missing values are read best-effort from process info, since Sidekiq keeps
+// them in worker config rather than Redis.
+func (c *Client) GetDeadLimits(ctx context.Context) (DeadLimits, error) {
+	limits := c.deadLimits
+	if limits.MaxJobs > 0 && limits.Timeout > 0 {
+		return limits, nil
+	}
+
+	identities, err := c.rdb().SMembers(ctx, "processes").Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return limits, err
+	}
+	if len(identities) == 0 {
+		return limits, nil
+	}
+	sort.Strings(identities)
+
+	results, err := c.rdb().Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, identity := range identities {
+			pipe.HGet(ctx, identity, "info")
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return limits, err
+	}
+
+	for _, result := range results {
+		cmd, ok := result.(*redis.StringCmd)
+		if !ok {
+			continue
+		}
+		var process Process
+		parseProcessInfo(cmd.Val(), &process)
+		if limits.MaxJobs <= 0 {
+			limits.MaxJobs = process.DeadMaxJobs
+		}
+		if limits.Timeout <= 0 {
+			limits.Timeout = process.DeadTimeout
+		}
+	}
+	return limits, nil
 }
 
 // DeleteAllSortedEntries removes all jobs from a sorted set.
-func (c *Client) DeleteAllSortedEntries(ctx context.Context, kind SortedSetKind) error {
+func (c *Client) DeleteAllSortedEntries(ctx context.Context, kind SortedSetKind) (err error) {
+	defer func() { c.recordActivity(ActivityDeleteAll, kind.String(), "", "", err) }()
+	if err := c.checkWritable(); err != nil {
+		return err
+	}
 	spec, err := sortedSetSpecFor(kind)
 	if err != nil {
 		return err
@@ -388,7 +800,11 @@ func (c *Client) DeleteAllSortedEntries(ctx context.Context, kind SortedSetKind)
 }
 
 // EnqueueAllSortedEntries moves all jobs from a sorted set to their queues immediately.
-func (c *Client) EnqueueAllSortedEntries(ctx context.Context, kind SortedSetKind) error {
+func (c *Client) EnqueueAllSortedEntries(ctx context.Context, kind SortedSetKind) (err error) {
+	defer func() { c.recordActivity(ActivityEnqueueAll, kind.String(), "", "", err) }()
+	if err := c.checkWritable(); err != nil {
+		return err
+	}
 	spec, err := sortedSetSpecFor(kind)
 	if err != nil {
 		return err
@@ -396,8 +812,66 @@ func (c *Client) EnqueueAllSortedEntries(ctx context.Context, kind SortedSetKind
 	return c.moveAllSortedEntriesToQueue(ctx, spec.key, spec.decrementRetryCount)
 }
 
+// RetryAllDeadJobsToRetry moves every dead job into the retry set and returns
+// how many were moved. Unlike EnqueueAllSortedEntries on the dead set, jobs are
+// not enqueued immediately: each gets retry_count 0 and a score
+// deadToRetryDelay ahead, so it starts a full new retry cycle. Payloads that
+// cannot be parsed stay in the dead set.
+func (c *Client) RetryAllDeadJobsToRetry(ctx context.Context) (moved int, err error) {
+	defer func() {
+		c.recordActivity(ActivityRetryAllLater, SortedSetDead.String(), "", jobCountDetail(int64(moved)), err)
+	}()
+	if err := c.checkWritable(); err != nil {
+		return 0, err
+	}
+	var skipped []redis.Z
+	defer func() {
+		if len(skipped) > 0 {
+			c.rdb().ZAdd(context.WithoutCancel(ctx), deadSetKey, skipped...)
+		}
+	}()
+
+	for {
+		entries, err := c.rdb().ZPopMin(ctx, deadSetKey, sortedSetPopBatch).Result()
+		if err != nil && !errors.Is(err, redis.Nil) {
+			return moved, err
+		}
+		if len(entries) == 0 {
+			return moved, nil
+		}
+
+		score := sortedSetScore(nowFuncSidekiq().Add(deadToRetryDelay))
+		members := make([]redis.Z, 0, len(entries))
+		originals := make([]redis.Z, 0, len(entries))
+		for _, entry := range entries {
+			rawValue, _ := entry.Member.(string)
+			encoded, err := buildRetryPayload(rawValue)
+			if err != nil {
+				skipped = append(skipped, entry)
+				continue
+			}
+			members = append(members, redis.Z{Score: score, Member: string(encoded)})
+			originals = append(originals, entry)
+		}
+		if len(members) == 0 {
+			continue
+		}
+
+		if err := c.rdb().ZAdd(ctx, retrySetKey, members...).Err(); err != nil {
+			// Nothing reached the retry set; put the batch back where it was.
+			skipped = append(skipped, originals...)
+			return moved, err
+		}
+		moved += len(members)
+	}
+}
+
 // MoveAllSortedEntriesToDead moves all jobs from a supported sorted set into the dead set.
-func (c *Client) MoveAllSortedEntriesToDead(ctx context.Context, kind SortedSetKind) error {
+func (c *Client) MoveAllSortedEntriesToDead(ctx context.Context, kind SortedSetKind) (err error) {
+	defer func() { c.recordActivity(ActivityKillAll, kind.String(), "", "", err) }()
+	if err := c.checkWritable(); err != nil {
+		return err
+	}
 	spec, err := sortedSetSpecFor(kind)
 	if err != nil {
 		return err
@@ -417,7 +891,7 @@ func (c *Client) deleteSortedEntry(ctx context.Context, key string, entry *Sorte
 		return errors.New("sorted entry payload is empty")
 	}
 
-	_, err := c.redis.ZRem(ctx, key, value).Result()
+	_, err := c.rdb().ZRem(ctx, key, value).Result()
 	if err != nil && !errors.Is(err, redis.Nil) {
 		return err
 	}
@@ -425,7 +899,7 @@ func (c *Client) deleteSortedEntry(ctx context.Context, key string, entry *Sorte
 }
 
 func (c *Client) clearSortedSet(ctx context.Context, key string) error {
-	_, err := c.redis.Unlink(ctx, key).Result()
+	_, err := c.rdb().Unlink(ctx, key).Result()
 	if err != nil && !errors.Is(err, redis.Nil) {
 		return err
 	}
@@ -444,7 +918,7 @@ func buildQueuePayload(rawValue string, decrementRetryCount bool, version Versio
 
 	payload := make(map[string]any)
 	if err := safeParseJSON([]byte(rawValue), &payload); err != nil {
-		return "", nil, err
+		return "", nil, fmt.Errorf("%w: %w", ErrInvalidPayload, err)
 	}
 
 	queueName, ok := payload["queue"].(string)
@@ -476,7 +950,7 @@ func buildQueuePayload(rawValue string, decrementRetryCount bool, version Versio
 func (c *Client) moveAllSortedEntriesToQueue(ctx context.Context, key string, decrementRetryCount bool) error {
 	version := c.DetectVersion(ctx)
 	for {
-		entries, err := c.redis.ZPopMin(ctx, key, sortedSetPopBatch).Result()
+		entries, err := c.rdb().ZPopMin(ctx, key, sortedSetPopBatch).Result()
 		if err != nil && !errors.Is(err, redis.Nil) {
 			return err
 		}
@@ -497,7 +971,7 @@ func (c *Client) moveAllSortedEntriesToQueue(ctx context.Context, key string, de
 			})
 		}
 
-		_, err = c.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		_, err = c.rdb().TxPipelined(ctx, func(pipe redis.Pipeliner) error {
 			for _, payload := range payloads {
 				pipe.SAdd(ctx, queueSetKey, payload.queue)
 				pipe.LPush(ctx, queuePrefixKey+payload.queue, payload.body)
@@ -512,7 +986,7 @@ func (c *Client) moveAllSortedEntriesToQueue(ctx context.Context, key string, de
 
 func (c *Client) moveAllSortedEntriesToDead(ctx context.Context, key string) error {
 	for {
-		entries, err := c.redis.ZPopMin(ctx, key, sortedSetPopBatch).Result()
+		entries, err := c.rdb().ZPopMin(ctx, key, sortedSetPopBatch).Result()
 		if err != nil && !errors.Is(err, redis.Nil) {
 			return err
 		}
@@ -520,7 +994,7 @@ func (c *Client) moveAllSortedEntriesToDead(ctx context.Context, key string) err
 			return nil
 		}
 
-		_, err = c.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		_, err = c.rdb().TxPipelined(ctx, func(pipe redis.Pipeliner) error {
 			for _, entry := range entries {
 				rawValue, _ := entry.Member.(string)
 				if rawValue == "" {
@@ -542,7 +1016,11 @@ func (c *Client) moveAllSortedEntriesToDead(ctx context.Context, key string) err
 var nowFuncSidekiq = time.Now
 
 func nowSortedSetScore() float64 {
-	return float64(nowFuncSidekiq().Truncate(time.Microsecond).UnixNano()) / float64(time.Second)
+	return sortedSetScore(nowFuncSidekiq())
+}
+
+func sortedSetScore(t time.Time) float64 {
+	return float64(t.Truncate(time.Microsecond).UnixNano()) / float64(time.Second)
 }
 
 func nowTimestamp(format timestampFormat) json.Number {
@@ -556,6 +1034,21 @@ func nowTimestamp(format timestampFormat) json.Number {
 	return json.Number(strconv.FormatFloat(seconds, 'f', -1, 64))
 }
 
+// buildRetryPayload rewrites a dead job payload for the retry set, resetting
+// retry_count so Sidekiq grants it the full number of retries again.
+func buildRetryPayload(rawValue string) ([]byte, error) {
+	if rawValue == "" {
+		return nil, errors.New("sorted entry payload is empty")
+	}
+
+	payload := make(map[string]any)
+	if err := safeParseJSON([]byte(rawValue), &payload); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPayload, err)
+	}
+	payload["retry_count"] = json.Number("0")
+	return json.Marshal(payload)
+}
+
 func decrementRetryCountField(payload map[string]any) {
 	raw, ok := payload["retry_count"]
 	if !ok || raw == nil {
@@ -584,7 +1077,7 @@ func (c *Client) scanSortedSetEntries(
 
 	var cursor uint64
 	for {
-		values, nextCursor, err := c.redis.ZScan(ctx, key, cursor, match, sortedSetScanCount).Result()
+		values, nextCursor, err := c.rdb().ZScan(ctx, key, cursor, match, sortedSetScanCount).Result()
 		if err != nil {
 			return err
 		}
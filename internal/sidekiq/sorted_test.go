@@ -3,7 +3,10 @@ package sidekiq
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 )
@@ -31,7 +34,7 @@ func TestGetDeadJobs(t *testing.T) {
 	_, _ = mr.ZAdd("dead", testScoreB, job2)
 	_, _ = mr.ZAdd("dead", testScoreC, job3)
 
-	entries, size, err := client.GetSortedEntries(ctx, SortedSetDead, 0, 10)
+	entries, size, err := client.GetSortedEntries(ctx, SortedSetDead, SortDefault, 0, 10)
 	if err != nil {
 		t.Fatalf("GetDeadJobs failed: %v", err)
 	}
@@ -64,7 +67,7 @@ func TestGetDeadJobs(t *testing.T) {
 func TestGetDeadJobs_Empty(t *testing.T) {
 	_, client := setupTestRedis(t)
 
-	entries, size, err := client.GetSortedEntries(context.Background(), SortedSetDead, 0, 10)
+	entries, size, err := client.GetSortedEntries(context.Background(), SortedSetDead, SortDefault, 0, 10)
 	if err != nil {
 		t.Fatalf("GetDeadJobs failed: %v", err)
 	}
@@ -86,7 +89,7 @@ func TestGetDeadJobs_Pagination(t *testing.T) {
 		_, _ = mr.ZAdd("dead", testScoreBase+float64(i)*60, job)
 	}
 
-	entries, size, err := client.GetSortedEntries(ctx, SortedSetDead, 2, 3)
+	entries, size, err := client.GetSortedEntries(ctx, SortedSetDead, SortDefault, 2, 3)
 	if err != nil {
 		t.Fatalf("GetDeadJobs failed: %v", err)
 	}
@@ -118,7 +121,7 @@ func TestGetDeadJobs_CountZero(t *testing.T) {
 		_, _ = mr.ZAdd("dead", testScoreBase+float64(i)*60, job)
 	}
 
-	entries, size, err := client.GetSortedEntries(ctx, SortedSetDead, 0, 0)
+	entries, size, err := client.GetSortedEntries(ctx, SortedSetDead, SortDefault, 0, 0)
 	if err != nil {
 		t.Fatalf("GetDeadJobs failed: %v", err)
 	}
@@ -143,7 +146,7 @@ func TestGetRetryJobs(t *testing.T) {
 	_, _ = mr.ZAdd("retry", testScoreB, job2)
 	_, _ = mr.ZAdd("retry", testScoreC, job3)
 
-	entries, size, err := client.GetSortedEntries(ctx, SortedSetRetry, 0, 10)
+	entries, size, err := client.GetSortedEntries(ctx, SortedSetRetry, SortDefault, 0, 10)
 	if err != nil {
 		t.Fatalf("GetRetryJobs failed: %v", err)
 	}
@@ -166,6 +169,59 @@ func TestGetRetryJobs(t *testing.T) {
 	}
 }
 
+func TestGetSortedEntries_Order(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx := context.Background()
+
+	_, _ = mr.ZAdd("dead", testScoreA, `{"jid":"dead_early"}`)
+	_, _ = mr.ZAdd("dead", testScoreC, `{"jid":"dead_late"}`)
+	_, _ = mr.ZAdd("retry", testScoreA, `{"jid":"retry_early"}`)
+	_, _ = mr.ZAdd("retry", testScoreC, `{"jid":"retry_late"}`)
+
+	tests := []struct {
+		kind  SortedSetKind
+		order SortOrder
+		first string
+	}{
+		{kind: SortedSetDead, order: SortDefault, first: "dead_late"},
+		{kind: SortedSetDead, order: SortAscending, first: "dead_early"},
+		{kind: SortedSetDead, order: SortDescending, first: "dead_late"},
+		{kind: SortedSetRetry, order: SortDefault, first: "retry_early"},
+		{kind: SortedSetRetry, order: SortAscending, first: "retry_early"},
+		{kind: SortedSetRetry, order: SortDescending, first: "retry_late"},
+	}
+	for _, tt := range tests {
+		entries, _, err := client.GetSortedEntries(ctx, tt.kind, tt.order, 0, 1)
+		if err != nil {
+			t.Fatalf("GetSortedEntries(%s, %d) failed: %v", tt.kind, tt.order, err)
+		}
+		if len(entries) != 1 || entries[0].JID() != tt.first {
+			t.Fatalf("GetSortedEntries(%s, %d) = %v, want %s first", tt.kind, tt.order, entries, tt.first)
+		}
+
+		scanned, err := client.ScanSortedEntries(ctx, tt.kind, tt.order, "")
+		if err != nil {
+			t.Fatalf("ScanSortedEntries(%s, %d) failed: %v", tt.kind, tt.order, err)
+		}
+		if len(scanned) != 2 || scanned[0].JID() != tt.first {
+			t.Fatalf("ScanSortedEntries(%s, %d) = %v, want %s first", tt.kind, tt.order, scanned, tt.first)
+		}
+
+		ranged, err := client.GetSortedEntriesInRange(ctx, tt.kind, tt.order, time.Time{}, time.Time{})
+		if err != nil {
+			t.Fatalf("GetSortedEntriesInRange(%s, %d) failed: %v", tt.kind, tt.order, err)
+		}
+		if len(ranged) != 2 || ranged[0].JID() != tt.first {
+			t.Fatalf("GetSortedEntriesInRange(%s, %d) = %v, want %s first", tt.kind, tt.order, ranged, tt.first)
+		}
+	}
+
+	if SortedSetDead.DefaultOrder() != SortDescending || SortedSetScheduled.DefaultOrder() != SortAscending {
+		t.Fatalf("DefaultOrder = %d/%d, want descending for dead and ascending for scheduled",
+			SortedSetDead.DefaultOrder(), SortedSetScheduled.DefaultOrder())
+	}
+}
+
 func TestGetScheduledJobs(t *testing.T) {
 	mr, client := setupTestRedis(t)
 	ctx := context.Background()
@@ -178,7 +234,7 @@ func TestGetScheduledJobs(t *testing.T) {
 	_, _ = mr.ZAdd("schedule", testScoreB, job2)
 	_, _ = mr.ZAdd("schedule", testScoreC, job3)
 
-	entries, size, err := client.GetSortedEntries(ctx, SortedSetScheduled, 0, 10)
+	entries, size, err := client.GetSortedEntries(ctx, SortedSetScheduled, SortDefault, 0, 10)
 	if err != nil {
 		t.Fatalf("GetScheduledJobs failed: %v", err)
 	}
@@ -307,7 +363,7 @@ func TestScanDeadJobs(t *testing.T) {
 	_, _ = mr.ZAdd("dead", testScoreB, job2)
 	_, _ = mr.ZAdd("dead", testScoreC, job3)
 
-	entries, err := client.ScanSortedEntries(ctx, SortedSetDead, "abc")
+	entries, err := client.ScanSortedEntries(ctx, SortedSetDead, SortDefault, "abc")
 	if err != nil {
 		t.Fatalf("ScanDeadJobs failed: %v", err)
 	}
@@ -334,7 +390,7 @@ func TestScanDeadJobs_ExactMatch(t *testing.T) {
 	_, _ = mr.ZAdd("dead", testScoreA, job1)
 	_, _ = mr.ZAdd("dead", testScoreB, job2)
 
-	entries, err := client.ScanSortedEntries(ctx, SortedSetDead, "test123")
+	entries, err := client.ScanSortedEntries(ctx, SortedSetDead, SortDefault, "test123")
 	if err != nil {
 		t.Fatalf("ScanDeadJobs failed: %v", err)
 	}
@@ -360,7 +416,7 @@ func TestScanDeadJobs_Wildcard(t *testing.T) {
 	_, _ = mr.ZAdd("dead", testScoreB, job2)
 	_, _ = mr.ZAdd("dead", testScoreC, job3)
 
-	entries, err := client.ScanSortedEntries(ctx, SortedSetDead, "*prefix*")
+	entries, err := client.ScanSortedEntries(ctx, SortedSetDead, SortDefault, "*prefix*")
 	if err != nil {
 		t.Fatalf("ScanDeadJobs failed: %v", err)
 	}
@@ -384,7 +440,7 @@ func TestScanDeadJobsWindow(t *testing.T) {
 	_, _ = mr.ZAdd("dead", testScoreC, job3)
 	_, _ = mr.ZAdd("dead", testScoreC+60, job4)
 
-	window, err := client.ScanSortedEntriesWindow(ctx, SortedSetDead, "abc", 1, 2)
+	window, err := client.ScanSortedEntriesWindow(ctx, SortedSetDead, SortDefault, "abc", 1, 2)
 	if err != nil {
 		t.Fatalf("ScanDeadJobsWindow failed: %v", err)
 	}
@@ -421,7 +477,7 @@ func TestScanRetryJobs(t *testing.T) {
 	_, _ = mr.ZAdd("retry", testScoreA, job1)
 	_, _ = mr.ZAdd("retry", testScoreB, job3)
 
-	entries, err := client.ScanSortedEntries(ctx, SortedSetRetry, "retry")
+	entries, err := client.ScanSortedEntries(ctx, SortedSetRetry, SortDefault, "retry")
 	if err != nil {
 		t.Fatalf("ScanRetryJobs failed: %v", err)
 	}
@@ -452,7 +508,7 @@ func TestScanRetryJobsWindow(t *testing.T) {
 	_, _ = mr.ZAdd("retry", testScoreB, job3)
 	_, _ = mr.ZAdd("retry", testScoreC+60, job4)
 
-	window, err := client.ScanSortedEntriesWindow(ctx, SortedSetRetry, "retry", 1, 2)
+	window, err := client.ScanSortedEntriesWindow(ctx, SortedSetRetry, SortDefault, "retry", 1, 2)
 	if err != nil {
 		t.Fatalf("ScanRetryJobsWindow failed: %v", err)
 	}
@@ -489,7 +545,7 @@ func TestScanScheduledJobs(t *testing.T) {
 	_, _ = mr.ZAdd("schedule", testScoreC, job3)
 	_, _ = mr.ZAdd("schedule", testScoreA, job1)
 
-	entries, err := client.ScanSortedEntries(ctx, SortedSetScheduled, "sched")
+	entries, err := client.ScanSortedEntries(ctx, SortedSetScheduled, SortDefault, "sched")
 	if err != nil {
 		t.Fatalf("ScanScheduledJobs failed: %v", err)
 	}
@@ -510,7 +566,7 @@ func TestScanJobs_Empty(t *testing.T) {
 	_, client := setupTestRedis(t)
 	ctx := context.Background()
 
-	entries, err := client.ScanSortedEntries(ctx, SortedSetDead, "nonexistent")
+	entries, err := client.ScanSortedEntries(ctx, SortedSetDead, SortDefault, "nonexistent")
 	if err != nil {
 		t.Fatalf("ScanDeadJobs failed: %v", err)
 	}
@@ -530,7 +586,7 @@ func TestScanJobs_NoPattern(t *testing.T) {
 	_, _ = mr.ZAdd("dead", testScoreA, job1)
 	_, _ = mr.ZAdd("dead", testScoreB, job2)
 
-	entries, err := client.ScanSortedEntries(ctx, SortedSetDead, "")
+	entries, err := client.ScanSortedEntries(ctx, SortedSetDead, SortDefault, "")
 	if err != nil {
 		t.Fatalf("ScanDeadJobs failed: %v", err)
 	}
@@ -590,6 +646,32 @@ func TestDeleteRetryJob_RemovesOnly(t *testing.T) {
 	}
 }
 
+func TestForceDeleteEntry_RemovesCorruptMember(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx := context.Background()
+
+	corrupt := `{"jid":"truncated","class":"MyJob","queue":"defa`
+	_, _ = mr.ZAdd("retry", testScoreA, corrupt)
+	_, _ = mr.ZAdd("retry", testScoreB, `{"jid":"intact","class":"MyJob","queue":"default"}`)
+
+	entry := NewSortedEntry(corrupt, testScoreA)
+	if err := client.EnqueueSortedEntry(ctx, SortedSetRetry, entry); !errors.Is(err, ErrInvalidPayload) {
+		t.Fatalf("EnqueueSortedEntry error = %v, want ErrInvalidPayload", err)
+	}
+
+	if err := client.ForceDeleteEntry(ctx, SortedSetRetry, corrupt); err != nil {
+		t.Fatalf("ForceDeleteEntry failed: %v", err)
+	}
+	members, _ := client.redis.ZRange(ctx, "retry", 0, -1).Result()
+	if len(members) != 1 || members[0] == corrupt {
+		t.Fatalf("retry members = %q, want only the intact job", members)
+	}
+
+	if err := client.ForceDeleteEntry(ctx, SortedSetRetry, corrupt); !errors.Is(err, ErrJobNotFound) {
+		t.Fatalf("second ForceDeleteEntry error = %v, want ErrJobNotFound", err)
+	}
+}
+
 func TestKillRetryJob_MovesToDead(t *testing.T) {
 	mr, client := setupTestRedis(t)
 	ctx := context.Background()
@@ -681,6 +763,39 @@ func TestRetryNowRetryJob_Sidekiq7(t *testing.T) {
 	}
 }
 
+func TestEnqueueSortedEntryToFront(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx := context.Background()
+
+	// Sidekiq fetches from the tail of the list, so the tail runs next.
+	_, _ = mr.Lpush("queue:default", `{"jid":"waiting_1","class":"MyJob","queue":"default"}`)
+	_, _ = mr.Lpush("queue:default", `{"jid":"waiting_2","class":"MyJob","queue":"default"}`)
+	jobJSON := `{"jid":"urgent","class":"MyJob","queue":"default","args":[],"retry_count":2}`
+	_, _ = mr.ZAdd("retry", testScoreA, jobJSON)
+	lateJSON := `{"jid":"late","class":"MyJob","queue":"default","args":[],"retry_count":1}`
+	_, _ = mr.ZAdd("retry", testScoreB, lateJSON)
+
+	if err := client.EnqueueSortedEntryToFront(ctx, SortedSetRetry, NewSortedEntry(jobJSON, testScoreA)); err != nil {
+		t.Fatalf("EnqueueSortedEntryToFront failed: %v", err)
+	}
+	if err := client.EnqueueSortedEntry(ctx, SortedSetRetry, NewSortedEntry(lateJSON, testScoreB)); err != nil {
+		t.Fatalf("EnqueueSortedEntry failed: %v", err)
+	}
+
+	values, err := client.redis.LRange(ctx, "queue:default", 0, -1).Result()
+	if err != nil {
+		t.Fatalf("queue lrange failed: %v", err)
+	}
+	jids := make([]string, len(values))
+	for i, value := range values {
+		jids[i] = NewJobRecord(value, "").JID()
+	}
+	want := []string{"late", "waiting_2", "waiting_1", "urgent"}
+	if !slices.Equal(jids, want) {
+		t.Fatalf("queue = %v, want %v (head to tail)", jids, want)
+	}
+}
+
 func TestRetryNowRetryJob_Sidekiq8(t *testing.T) {
 	mr, client := setupTestRedis(t)
 	ctx := context.Background()
@@ -776,6 +891,203 @@ func TestRetryNowDeadJob_MissingQueue(t *testing.T) {
 	}
 }
 
+func TestRequeueDeadJob_PreservesRetryCount(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx := context.Background()
+
+	originalNow := nowFuncSidekiq
+	nowFuncSidekiq = func() time.Time {
+		return time.Unix(1700000000, 0)
+	}
+	t.Cleanup(func() { nowFuncSidekiq = originalNow })
+
+	jobJSON := `{"jid":"dead_asis","class":"MyJob","queue":"default","args":[],"retry_count":3,"error_class":"RuntimeError","error_message":"boom","created_at":1699990000.0,"enqueued_at":1699990000.0}`
+	_, _ = mr.ZAdd("dead", testScoreA, jobJSON)
+
+	entry := NewSortedEntry(jobJSON, testScoreA)
+	if err := client.RequeueDeadJob(ctx, entry); err != nil {
+		t.Fatalf("RequeueDeadJob failed: %v", err)
+	}
+
+	if size, _ := client.redis.ZCard(ctx, "dead").Result(); size != 0 {
+		t.Fatalf("dead size = %d, want 0", size)
+	}
+
+	queued, err := client.redis.LRange(ctx, "queue:default", 0, -1).Result()
+	if err != nil || len(queued) != 1 {
+		t.Fatalf("queue = %v (err %v), want one job", queued, err)
+	}
+
+	var payload map[string]any
+	if err := safeParseJSON([]byte(queued[0]), &payload); err != nil {
+		t.Fatalf("safeParseJSON queued payload: %v", err)
+	}
+	if got := payload["retry_count"]; got != json.Number("3") {
+		t.Fatalf("retry_count = %v, want 3", got)
+	}
+	if got := payload["error_class"]; got != "RuntimeError" {
+		t.Fatalf("error_class = %v, want RuntimeError", got)
+	}
+	if got := payload["enqueued_at"]; got != json.Number("1700000000") {
+		t.Fatalf("enqueued_at = %v, want 1700000000", got)
+	}
+}
+
+func TestRetryDeadJobWithDelay(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx := context.Background()
+
+	originalNow := nowFuncSidekiq
+	nowFuncSidekiq = func() time.Time {
+		return time.Unix(1700000000, 0)
+	}
+	t.Cleanup(func() { nowFuncSidekiq = originalNow })
+
+	jobJSON := `{"jid":"dead_later","class":"MyJob","queue":"default","args":[],"retry_count":3,"created_at":1699990000.0}`
+	_, _ = mr.ZAdd("dead", testScoreA, jobJSON)
+
+	entry := NewSortedEntry(jobJSON, testScoreA)
+	if err := client.RetryDeadJobWithDelay(ctx, entry, 5*time.Minute); err != nil {
+		t.Fatalf("RetryDeadJobWithDelay failed: %v", err)
+	}
+
+	if size, _ := client.redis.ZCard(ctx, "dead").Result(); size != 0 {
+		t.Fatalf("dead size = %d, want 0", size)
+	}
+	if size, _ := client.redis.LLen(ctx, "queue:default").Result(); size != 0 {
+		t.Fatalf("queue size = %d, want 0", size)
+	}
+
+	scheduled, err := client.redis.ZRangeWithScores(ctx, "schedule", 0, -1).Result()
+	if err != nil {
+		t.Fatalf("schedule zrange failed: %v", err)
+	}
+	if len(scheduled) != 1 {
+		t.Fatalf("schedule size = %d, want 1", len(scheduled))
+	}
+	if scheduled[0].Score != 1700000300 {
+		t.Fatalf("schedule score = %v, want %v", scheduled[0].Score, 1700000300)
+	}
+
+	member, _ := scheduled[0].Member.(string)
+	var payload map[string]any
+	if err := safeParseJSON([]byte(member), &payload); err != nil {
+		t.Fatalf("safeParseJSON scheduled payload: %v", err)
+	}
+	if got := payload["retry_count"]; got != json.Number("2") {
+		t.Fatalf("retry_count = %v, want 2", got)
+	}
+	if got := payload["enqueued_at"]; got != json.Number("1700000000") {
+		t.Fatalf("enqueued_at = %v, want 1700000000", got)
+	}
+}
+
+func TestRetryDeadJobWithDelay_ScheduleFailureKeepsDeadJob(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx := context.Background()
+
+	jobJSON := `{"jid":"dead_later","class":"MyJob","queue":"default","args":[],"retry_count":3}`
+	_, _ = mr.ZAdd("dead", testScoreA, jobJSON)
+	if err := mr.Set("schedule", "not a sorted set"); err != nil {
+		t.Fatalf("set schedule: %v", err)
+	}
+
+	entry := NewSortedEntry(jobJSON, testScoreA)
+	err := client.RetryDeadJobWithDelay(ctx, entry, time.Minute)
+	if err == nil || !strings.Contains(err.Error(), "schedule dead job") {
+		t.Fatalf("RetryDeadJobWithDelay error = %v, want schedule failure", err)
+	}
+
+	dead, _ := client.redis.ZRangeWithScores(ctx, "dead", 0, -1).Result()
+	if len(dead) != 1 || dead[0].Member != jobJSON || dead[0].Score != testScoreA {
+		t.Fatalf("dead = %+v, want original job kept", dead)
+	}
+}
+
+func TestRetryDeadJobWithDelay_JobGone(t *testing.T) {
+	_, client := setupTestRedis(t)
+	ctx := context.Background()
+
+	jobJSON := `{"jid":"dead_later","class":"MyJob","queue":"default","args":[],"retry_count":3}`
+
+	err := client.RetryDeadJobWithDelay(ctx, NewSortedEntry(jobJSON, testScoreA), time.Minute)
+	if err == nil || !strings.Contains(err.Error(), "job not found") {
+		t.Fatalf("RetryDeadJobWithDelay error = %v, want job not found", err)
+	}
+
+	if size, _ := client.redis.ZCard(ctx, "schedule").Result(); size != 0 {
+		t.Fatalf("schedule size = %d, want 0", size)
+	}
+}
+
+func TestRetryDeadJobWithDelay_MissingQueue(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx := context.Background()
+
+	jobJSON := `{"jid":"dead_later","class":"MyJob","args":[],"created_at":1700000000.5}`
+	_, _ = mr.ZAdd("dead", testScoreA, jobJSON)
+
+	entry := NewSortedEntry(jobJSON, testScoreA)
+	if err := client.RetryDeadJobWithDelay(ctx, entry, time.Minute); err == nil {
+		t.Fatalf("RetryDeadJobWithDelay should fail without queue")
+	}
+
+	if size, _ := client.redis.ZCard(ctx, "dead").Result(); size != 1 {
+		t.Fatalf("dead size = %d, want 1", size)
+	}
+	if size, _ := client.redis.ZCard(ctx, "schedule").Result(); size != 0 {
+		t.Fatalf("schedule size = %d, want 0", size)
+	}
+}
+
+func TestDeleteDeadJobsOlderThan(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx := context.Background()
+
+	cutoff := time.Unix(1700000000, 0)
+	_, _ = mr.ZAdd("dead", 1699990000.25, `{"jid":"old","class":"MyJob","queue":"default"}`)
+	_, _ = mr.ZAdd("dead", 1699999999.999999, `{"jid":"just_before","class":"MyJob","queue":"default"}`)
+	_, _ = mr.ZAdd("dead", 1700000000, `{"jid":"at_cutoff","class":"MyJob","queue":"default"}`)
+	_, _ = mr.ZAdd("dead", 1700000500.5, `{"jid":"recent","class":"MyJob","queue":"default"}`)
+	_, _ = mr.ZAdd("retry", 1699990000, `{"jid":"retry_old","class":"MyJob","queue":"default"}`)
+
+	removed, err := client.DeleteDeadJobsOlderThan(ctx, cutoff)
+	if err != nil {
+		t.Fatalf("DeleteDeadJobsOlderThan failed: %v", err)
+	}
+	if removed != 2 {
+		t.Fatalf("removed = %d, want 2", removed)
+	}
+
+	members, err := client.redis.ZRange(ctx, "dead", 0, -1).Result()
+	if err != nil {
+		t.Fatalf("dead zrange failed: %v", err)
+	}
+	if len(members) != 2 {
+		t.Fatalf("dead size = %d, want 2", len(members))
+	}
+	for _, member := range members {
+		if strings.Contains(member, `"old"`) || strings.Contains(member, "just_before") {
+			t.Fatalf("unexpected remaining member %s", member)
+		}
+	}
+	if size, _ := client.redis.ZCard(ctx, "retry").Result(); size != 1 {
+		t.Fatalf("retry size = %d, want 1", size)
+	}
+}
+
+func TestDeleteDeadJobsOlderThan_Empty(t *testing.T) {
+	_, client := setupTestRedis(t)
+
+	removed, err := client.DeleteDeadJobsOlderThan(context.Background(), time.Unix(1700000000, 0))
+	if err != nil {
+		t.Fatalf("DeleteDeadJobsOlderThan failed: %v", err)
+	}
+	if removed != 0 {
+		t.Fatalf("removed = %d, want 0", removed)
+	}
+}
+
 func TestRetryNowRetryJob_InvalidJSON(t *testing.T) {
 	mr, client := setupTestRedis(t)
 	ctx := context.Background()
@@ -1072,3 +1384,185 @@ func TestRetryAllDeadJobs(t *testing.T) {
 		t.Fatalf("enqueued_at = %v, want 1700000000.123456", payload["enqueued_at"])
 	}
 }
+
+func TestRetryAllDeadJobsToRetry(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx := context.Background()
+
+	originalNow := nowFuncSidekiq
+	nowFuncSidekiq = func() time.Time {
+		return time.Unix(1700000000, 0)
+	}
+	t.Cleanup(func() { nowFuncSidekiq = originalNow })
+
+	_, _ = mr.ZAdd("dead", testScoreA, `{"jid":"dead_cycle1","class":"MyJob","queue":"default","retry_count":25,"error_class":"RuntimeError","error_message":"boom"}`)
+	_, _ = mr.ZAdd("dead", testScoreB, `{"jid":"dead_cycle2","class":"MyJob","queue":"critical","retry_count":3}`)
+	_, _ = mr.ZAdd("dead", testScoreB+1, `not json`)
+
+	moved, err := client.RetryAllDeadJobsToRetry(ctx)
+	if err != nil {
+		t.Fatalf("RetryAllDeadJobsToRetry failed: %v", err)
+	}
+	if moved != 2 {
+		t.Fatalf("moved = %d, want 2", moved)
+	}
+
+	dead, err := client.redis.ZRangeWithScores(ctx, "dead", 0, -1).Result()
+	if err != nil {
+		t.Fatalf("dead zrange failed: %v", err)
+	}
+	if len(dead) != 1 || dead[0].Member != "not json" || dead[0].Score != testScoreB+1 {
+		t.Fatalf("dead = %v, want only the unparseable entry with its score", dead)
+	}
+	if size, _ := client.redis.LLen(ctx, "queue:default").Result(); size != 0 {
+		t.Fatalf("queue default size = %d, want 0 (jobs must not be enqueued)", size)
+	}
+
+	retries, err := client.redis.ZRangeWithScores(ctx, "retry", 0, -1).Result()
+	if err != nil {
+		t.Fatalf("retry zrange failed: %v", err)
+	}
+	if len(retries) != 2 {
+		t.Fatalf("retry size = %d, want 2", len(retries))
+	}
+	wantScore := float64(1700000000 + int64(deadToRetryDelay/time.Second))
+	for _, entry := range retries {
+		if entry.Score != wantScore {
+			t.Fatalf("retry score = %v, want %v", entry.Score, wantScore)
+		}
+		var payload map[string]any
+		if err := safeParseJSON([]byte(entry.Member.(string)), &payload); err != nil {
+			t.Fatalf("safeParseJSON retry payload: %v", err)
+		}
+		if got := payload["retry_count"]; got != json.Number("0") {
+			t.Fatalf("retry_count = %v, want 0", got)
+		}
+		if payload["jid"] == "dead_cycle1" && payload["error_message"] != "boom" {
+			t.Fatalf("error_message = %v, want boom kept", payload["error_message"])
+		}
+	}
+}
+
+func TestRetryAllDeadJobsToRetry_Empty(t *testing.T) {
+	_, client := setupTestRedis(t)
+
+	moved, err := client.RetryAllDeadJobsToRetry(context.Background())
+	if err != nil {
+		t.Fatalf("RetryAllDeadJobsToRetry failed: %v", err)
+	}
+	if moved != 0 {
+		t.Fatalf("moved = %d, want 0", moved)
+	}
+}
+
+func TestGetDeadLimits(t *testing.T) {
+	tests := map[string]struct {
+		info      string
+		overrides DeadLimits
+		want      DeadLimits
+	}{
+		"unknown": {
+			info: `{"hostname":"host1","pid":100}`,
+		},
+		"from process info": {
+			info: `{"hostname":"host1","pid":100,"dead_max_jobs":10000,"dead_timeout_in_seconds":15552000}`,
+			want: DeadLimits{MaxJobs: 10000, Timeout: 180 * 24 * time.Hour},
+		},
+		"overrides win": {
+			info:      `{"hostname":"host1","pid":100,"dead_max_jobs":10000,"dead_timeout_in_seconds":15552000}`,
+			overrides: DeadLimits{MaxJobs: 500},
+			want:      DeadLimits{MaxJobs: 500, Timeout: 180 * 24 * time.Hour},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			mr, client := setupTestRedis(t)
+			ctx := context.Background()
+
+			_, _ = mr.SAdd("processes", "host1:100:abc", "host2:200:def")
+			mr.HSet("host1:100:abc", "info", tc.info)
+			client.SetDeadLimits(tc.overrides)
+
+			got, err := client.GetDeadLimits(ctx)
+			if err != nil {
+				t.Fatalf("GetDeadLimits failed: %v", err)
+			}
+			if got != tc.want {
+				t.Fatalf("GetDeadLimits = %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFindSortedEntry(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx := context.Background()
+
+	_, _ = mr.ZAdd("retry", testScoreA, `{"jid":"abc123","class":"A","args":["def456"]}`)
+	_, _ = mr.ZAdd("retry", testScoreB, `{"jid":"def456","class":"B","args":[]}`)
+
+	entry, err := client.FindSortedEntry(ctx, SortedSetRetry, "def456")
+	if err != nil {
+		t.Fatalf("FindSortedEntry failed: %v", err)
+	}
+	if entry.JID() != "def456" || entry.Klass() != "B" {
+		t.Fatalf("found %s/%s, want def456/B", entry.JID(), entry.Klass())
+	}
+
+	if _, err := client.FindSortedEntry(ctx, SortedSetRetry, "abc"); !errors.Is(err, ErrJobNotFound) {
+		t.Fatalf("partial JID error = %v, want ErrJobNotFound", err)
+	}
+	if _, err := client.FindSortedEntry(ctx, SortedSetDead, "abc123"); !errors.Is(err, ErrJobNotFound) {
+		t.Fatalf("other set error = %v, want ErrJobNotFound", err)
+	}
+}
+
+func TestGetSortedEntriesInRange(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx := context.Background()
+
+	for _, key := range []string{"dead", "schedule"} {
+		_, _ = mr.ZAdd(key, testScoreA, `{"jid":"a"}`)
+		_, _ = mr.ZAdd(key, testScoreB, `{"jid":"b"}`)
+		_, _ = mr.ZAdd(key, testScoreC, `{"jid":"c"}`)
+	}
+	jids := func(entries []*SortedEntry) []string {
+		out := make([]string, len(entries))
+		for i, entry := range entries {
+			out[i] = entry.JID()
+		}
+		return out
+	}
+
+	tests := []struct {
+		name     string
+		kind     SortedSetKind
+		from, to time.Time
+		want     []string
+	}{
+		// The start is inclusive and the end exclusive.
+		{"inclusive start", SortedSetDead, timeFromScore(testScoreA), timeFromScore(testScoreC), []string{"b", "a"}},
+		{"exclusive end", SortedSetDead, timeFromScore(testScoreB), timeFromScore(testScoreB), []string{}},
+		{"open start", SortedSetDead, time.Time{}, timeFromScore(testScoreB), []string{"a"}},
+		{"open end", SortedSetDead, timeFromScore(testScoreB), time.Time{}, []string{"c", "b"}},
+		{"unbounded", SortedSetDead, time.Time{}, time.Time{}, []string{"c", "b", "a"}},
+		{"ascending set", SortedSetScheduled, timeFromScore(testScoreA), time.Time{}, []string{"a", "b", "c"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries, err := client.GetSortedEntriesInRange(ctx, tt.kind, SortDefault, tt.from, tt.to)
+			if err != nil {
+				t.Fatalf("GetSortedEntriesInRange failed: %v", err)
+			}
+			if got := jids(entries); strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Fatalf("jids = %v, want %v", got, tt.want)
+			}
+			for _, entry := range entries {
+				if !tt.from.IsZero() && entry.At().Before(tt.from) {
+					t.Fatalf("entry %s at %v is before %v", entry.JID(), entry.At(), tt.from)
+				}
+			}
+		})
+	}
+}
@@ -15,6 +15,8 @@ type Stats struct {
 	Retries   int64
 	Scheduled int64
 	Dead      int64
+	// QueueSizes maps each queue to its length; the sizes add up to Enqueued.
+	QueueSizes map[string]int64
 }
 
 // getStatsScript fetches all stats in a single round-trip using Lua.
@@ -37,17 +39,21 @@ for _, proc in ipairs(processes) do
 end
 
 local enqueued = 0
+local sizes = {}
 for _, q in ipairs(queues) do
-    enqueued = enqueued + redis.call('LLEN', 'queue:' .. q)
+    local size = redis.call('LLEN', 'queue:' .. q)
+    enqueued = enqueued + size
+    table.insert(sizes, q)
+    table.insert(sizes, size)
 end
 
-return {processed, failed, retries, scheduled, dead, busy, enqueued}
+return {processed, failed, retries, scheduled, dead, busy, enqueued, sizes}
 `)
 
 // GetStats fetches current Sidekiq statistics from Redis.
 // Uses a Lua script for single round-trip execution.
 func (c *Client) GetStats(ctx context.Context) (Stats, error) {
-	result, err := getStatsScript.Run(ctx, c.redis, nil).Slice()
+	result, err := getStatsScript.Run(ctx, c.rdb(), nil).Slice()
 	if err != nil {
 		return Stats{}, err
 	}
@@ -56,7 +62,7 @@ func (c *Client) GetStats(ctx context.Context) (Stats, error) {
 		return Stats{}, nil
 	}
 
-	return Stats{
+	stats := Stats{
 		Processed: result[0].(int64),
 		Failed:    result[1].(int64),
 		Retries:   result[2].(int64),
@@ -64,5 +70,15 @@ func (c *Client) GetStats(ctx context.Context) (Stats, error) {
 		Dead:      result[4].(int64),
 		Busy:      result[5].(int64),
 		Enqueued:  result[6].(int64),
-	}, nil
+	}
+	if len(result) > 7 {
+		sizes, _ := result[7].([]any)
+		stats.QueueSizes = make(map[string]int64, len(sizes)/2)
+		for i := 0; i+1 < len(sizes); i += 2 {
+			name, _ := sizes[i].(string)
+			size, _ := sizes[i+1].(int64)
+			stats.QueueSizes[name] = size
+		}
+	}
+	return stats, nil
 }
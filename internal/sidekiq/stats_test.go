@@ -56,6 +56,9 @@ func TestGetStats(t *testing.T) {
 		{"Dead", stats.Dead, 1},
 		{"Busy", stats.Busy, 11},
 		{"Enqueued", stats.Enqueued, 6},
+		{"QueueSizes[default]", stats.QueueSizes["default"], 3},
+		{"QueueSizes[critical]", stats.QueueSizes["critical"], 2},
+		{"QueueSizes[low]", stats.QueueSizes["low"], 1},
 	}
 
 	for _, tt := range tests {
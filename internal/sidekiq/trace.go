@@ -0,0 +1,58 @@
+package sidekiq
+
+import "strings"
+
+// TraceID returns the id of the distributed trace the job was enqueued in,
+// or "" when the payload carries none. It reads a W3C traceparent header
+// stored at the top level of the payload, as OpenTelemetry's Sidekiq
+// instrumentation does, or nested under "trace_context" either as a
+// traceparent header or as a bare "trace_id".
+func (jr *JobRecord) TraceID() string {
+	jr.ensureParsed()
+	if id := traceIDFromTraceparent(jr.item["traceparent"]); id != "" {
+		return id
+	}
+	traceContext, ok := jr.item["trace_context"].(map[string]any)
+	if !ok {
+		return ""
+	}
+	if id := traceIDFromTraceparent(traceContext["traceparent"]); id != "" {
+		return id
+	}
+	if id, ok := traceContext["trace_id"].(string); ok && validTraceID(strings.ToLower(id)) {
+		return strings.ToLower(id)
+	}
+	return ""
+}
+
+// traceIDFromTraceparent extracts the trace id from a traceparent header
+// such as "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01".
+func traceIDFromTraceparent(value any) string {
+	header, ok := value.(string)
+	if !ok {
+		return ""
+	}
+	parts := strings.Split(strings.TrimSpace(header), "-")
+	if len(parts) < 4 || len(parts[0]) != 2 || parts[0] == "ff" {
+		return ""
+	}
+	id := strings.ToLower(parts[1])
+	if !validTraceID(id) {
+		return ""
+	}
+	return id
+}
+
+// validTraceID reports whether id is 32 lowercase hex digits and not all
+// zeros, which W3C Trace Context reserves as invalid.
+func validTraceID(id string) bool {
+	if len(id) != 32 || strings.Trim(id, "0") == "" {
+		return false
+	}
+	for _, r := range id {
+		if (r < '0' || r > '9') && (r < 'a' || r > 'f') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,54 @@
+package sidekiq
+
+import "testing"
+
+func TestJobRecordTraceID(t *testing.T) {
+	const id = "4bf92f3577b34da6a3ce929d0e0e4736"
+	tests := map[string]struct {
+		payload string
+		want    string
+	}{
+		"top-level traceparent": {
+			payload: `{"jid":"a","traceparent":"00-` + id + `-00f067aa0ba902b7-01"}`,
+			want:    id,
+		},
+		"nested traceparent": {
+			payload: `{"jid":"a","trace_context":{"traceparent":"00-` + id + `-00f067aa0ba902b7-01","tracestate":"x=1"}}`,
+			want:    id,
+		},
+		"nested trace id": {
+			payload: `{"jid":"a","trace_context":{"trace_id":"4BF92F3577B34DA6A3CE929D0E0E4736","span_id":"00f067aa0ba902b7"}}`,
+			want:    id,
+		},
+		"uppercase traceparent": {
+			payload: `{"jid":"a","traceparent":"00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01"}`,
+			want:    id,
+		},
+		"absent": {
+			payload: `{"jid":"a"}`,
+		},
+		"all zeros": {
+			payload: `{"jid":"a","traceparent":"00-00000000000000000000000000000000-00f067aa0ba902b7-01"}`,
+		},
+		"invalid version": {
+			payload: `{"jid":"a","traceparent":"ff-` + id + `-00f067aa0ba902b7-01"}`,
+		},
+		"short id": {
+			payload: `{"jid":"a","traceparent":"00-4bf92f35-00f067aa0ba902b7-01"}`,
+		},
+		"not hex": {
+			payload: `{"jid":"a","trace_context":{"trace_id":"zzf92f3577b34da6a3ce929d0e0e4736"}}`,
+		},
+		"not a string": {
+			payload: `{"jid":"a","traceparent":42,"trace_context":"00-` + id + `-00f067aa0ba902b7-01"}`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := NewJobRecord(tc.payload, "default").TraceID(); got != tc.want {
+				t.Fatalf("TraceID() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package sidekiq
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	// ErrNothingToUndo is returned by UndoLast when no reversible move was made.
+	ErrNothingToUndo = errors.New("nothing to undo")
+	// ErrUndoStale is returned by UndoLast when the moved job changed since the
+	// move, e.g. a worker already picked it up. Nothing is changed.
+	ErrUndoStale = errors.New("job changed since it was moved; nothing undone")
+)
+
+// undoMove captures a single-job move so UndoLast can replay its inverse: the
+// job is taken out of where the move put it and re-added to its original
+// sorted set with its original payload and score.
+type undoMove struct {
+	action ActivityAction
+	jid    string
+
+	// Where the move put the job: a queue list or a sorted set.
+	currentKey   string
+	current      string
+	currentScore float64
+	inQueue      bool
+
+	originKind  SortedSetKind
+	originKey   string
+	origin      string
+	originScore float64
+}
+
+// undoMoveScript reverts a move only if the job is still exactly where the
+// move put it. Returns 1 when reverted and 0 when the job changed since.
+var undoMoveScript = redis.NewScript(`
+if ARGV[1] == "queue" then
+  if redis.call('LREM', KEYS[1], 1, ARGV[2]) == 0 then
+    return 0
+  end
+else
+  local score = redis.call('ZSCORE', KEYS[1], ARGV[2])
+  if not score or tonumber(score) ~= tonumber(ARGV[3]) then
+    return 0
+  end
+  redis.call('ZREM', KEYS[1], ARGV[2])
+end
+redis.call('ZADD', KEYS[2], ARGV[5], ARGV[4])
+return 1
+`)
+
+// LastUndo describes the move UndoLast would revert, if any.
+func (c *Client) LastUndo() (ActivityEntry, bool) {
+	c.activity.mu.Lock()
+	defer c.activity.mu.Unlock()
+
+	move := c.activity.undo
+	if move == nil {
+		return ActivityEntry{}, false
+	}
+	return ActivityEntry{Action: move.action, Set: move.originKind.String(), JID: move.jid}, true
+}
+
+// UndoLast reverts the most recent single-job move (a retry-now or a kill):
+// the job is removed from the queue or dead set it was moved to and re-added
+// to its original set at its original score. Only one move is remembered, and
+// it is forgotten once undone or found stale.
+func (c *Client) UndoLast(ctx context.Context) (err error) {
+	if err := c.checkWritable(); err != nil {
+		return err
+	}
+
+	c.activity.mu.Lock()
+	move := c.activity.undo
+	c.activity.mu.Unlock()
+	if move == nil {
+		return ErrNothingToUndo
+	}
+	defer func() {
+		c.recordActivity(ActivityUndo, move.originKind.String(), move.jid, "undo "+string(move.action), err)
+	}()
+
+	mode := "set"
+	if move.inQueue {
+		mode = "queue"
+	}
+	reverted, err := undoMoveScript.Run(
+		ctx, c.rdb(),
+		[]string{move.currentKey, move.originKey},
+		mode,
+		move.current,
+		strconv.FormatFloat(move.currentScore, 'f', -1, 64),
+		move.origin,
+		strconv.FormatFloat(move.originScore, 'f', -1, 64),
+	).Int()
+	if err != nil {
+		return err
+	}
+
+	c.forgetUndo(move)
+	if reverted == 0 {
+		return ErrUndoStale
+	}
+	return nil
+}
+
+func (c *Client) rememberUndo(move *undoMove) {
+	c.activity.mu.Lock()
+	defer c.activity.mu.Unlock()
+
+	c.activity.undo = move
+}
+
+// forgetUndo drops move unless a newer move replaced it meanwhile.
+func (c *Client) forgetUndo(move *undoMove) {
+	c.activity.mu.Lock()
+	defer c.activity.mu.Unlock()
+
+	if c.activity.undo == move {
+		c.activity.undo = nil
+	}
+}
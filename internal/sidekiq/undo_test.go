@@ -0,0 +1,165 @@
+package sidekiq
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestUndoLastRevertsKill(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx := context.Background()
+
+	jobJSON := `{"jid":"k1","class":"MyJob","queue":"default","args":[]}`
+	mr.ZAdd("retry", testScoreA, jobJSON)
+
+	if err := client.MoveSortedEntryToDead(ctx, SortedSetRetry, NewSortedEntry(jobJSON, testScoreA)); err != nil {
+		t.Fatalf("MoveSortedEntryToDead failed: %v", err)
+	}
+	last, ok := client.LastUndo()
+	if !ok || last.Action != ActivityKill || last.Set != "retry" || last.JID != "k1" {
+		t.Fatalf("LastUndo() = %+v, %v", last, ok)
+	}
+
+	if err := client.UndoLast(ctx); err != nil {
+		t.Fatalf("UndoLast failed: %v", err)
+	}
+
+	if mr.Exists("dead") {
+		members, _ := mr.ZMembers("dead")
+		if len(members) != 0 {
+			t.Fatalf("dead = %v, want empty", members)
+		}
+	}
+	score, err := mr.ZScore("retry", jobJSON)
+	if err != nil || score != testScoreA {
+		t.Fatalf("retry score = %v (err %v), want %v", score, err, testScoreA)
+	}
+	if _, ok := client.LastUndo(); ok {
+		t.Fatal("LastUndo() still set after undo")
+	}
+	if got := client.Activity(); got[0].Action != ActivityUndo || got[0].Detail != "undo kill" || got[0].Err != nil {
+		t.Fatalf("Activity()[0] = %+v, want undo kill", got[0])
+	}
+	if err := client.UndoLast(ctx); !errors.Is(err, ErrNothingToUndo) {
+		t.Fatalf("second UndoLast error = %v, want ErrNothingToUndo", err)
+	}
+}
+
+func TestUndoLastRevertsRetryNow(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx := context.Background()
+
+	jobJSON := `{"jid":"r1","class":"MyJob","queue":"default","args":[],"retry_count":2}`
+	mr.ZAdd("retry", testScoreA, jobJSON)
+
+	if err := client.EnqueueSortedEntry(ctx, SortedSetRetry, NewSortedEntry(jobJSON, testScoreA)); err != nil {
+		t.Fatalf("EnqueueSortedEntry failed: %v", err)
+	}
+	if err := client.UndoLast(ctx); err != nil {
+		t.Fatalf("UndoLast failed: %v", err)
+	}
+
+	if mr.Exists("queue:default") {
+		if items, _ := mr.List("queue:default"); len(items) != 0 {
+			t.Fatalf("queue:default = %v, want empty", items)
+		}
+	}
+	// The original payload comes back, including the undecremented retry_count.
+	score, err := mr.ZScore("retry", jobJSON)
+	if err != nil || score != testScoreA {
+		t.Fatalf("retry score = %v (err %v), want %v", score, err, testScoreA)
+	}
+}
+
+func TestUndoLastRefusesStaleMoves(t *testing.T) {
+	tests := map[string]struct {
+		move   func(context.Context, *Client, *SortedEntry) error
+		tamper func(*testing.T, *Client)
+	}{
+		"retry now picked up by a worker": {
+			move: func(ctx context.Context, client *Client, entry *SortedEntry) error {
+				return client.EnqueueSortedEntry(ctx, SortedSetRetry, entry)
+			},
+			tamper: func(t *testing.T, client *Client) {
+				t.Helper()
+				if err := client.redis.Del(context.Background(), "queue:default").Err(); err != nil {
+					t.Fatal(err)
+				}
+			},
+		},
+		"kill retried from dead": {
+			move: func(ctx context.Context, client *Client, entry *SortedEntry) error {
+				return client.MoveSortedEntryToDead(ctx, SortedSetRetry, entry)
+			},
+			tamper: func(t *testing.T, client *Client) {
+				t.Helper()
+				ctx := context.Background()
+				members, err := client.redis.ZRange(ctx, "dead", 0, -1).Result()
+				if err != nil || len(members) != 1 {
+					t.Fatalf("dead = %v (err %v)", members, err)
+				}
+				// Killed again later: same payload, newer score.
+				if err := client.redis.ZIncrBy(ctx, "dead", 60, members[0]).Err(); err != nil {
+					t.Fatal(err)
+				}
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mr, client := setupTestRedis(t)
+			ctx := context.Background()
+
+			jobJSON := `{"jid":"s1","class":"MyJob","queue":"default","args":[]}`
+			mr.ZAdd("retry", testScoreA, jobJSON)
+
+			if err := tt.move(ctx, client, NewSortedEntry(jobJSON, testScoreA)); err != nil {
+				t.Fatalf("move failed: %v", err)
+			}
+			tt.tamper(t, client)
+			before := mr.Dump()
+
+			if err := client.UndoLast(ctx); !errors.Is(err, ErrUndoStale) {
+				t.Fatalf("UndoLast error = %v, want ErrUndoStale", err)
+			}
+			if after := mr.Dump(); after != before {
+				t.Fatalf("redis changed by stale undo:\nbefore:\n%s\nafter:\n%s", before, after)
+			}
+			if _, ok := client.LastUndo(); ok {
+				t.Fatal("LastUndo() still set after stale undo")
+			}
+		})
+	}
+}
+
+func TestUndoLastOnlyKeepsMostRecentMove(t *testing.T) {
+	mr, client := setupTestRedis(t)
+	ctx := context.Background()
+
+	originalNow := nowFuncSidekiq
+	nowFuncSidekiq = func() time.Time { return time.Unix(1700000000, 0) }
+	t.Cleanup(func() { nowFuncSidekiq = originalNow })
+
+	jobA := `{"jid":"a","class":"MyJob","queue":"default","args":[]}`
+	jobB := `{"jid":"b","class":"MyJob","queue":"default","args":[]}`
+	mr.ZAdd("retry", testScoreA, jobA)
+	mr.ZAdd("retry", testScoreB, jobB)
+
+	if err := client.MoveSortedEntryToDead(ctx, SortedSetRetry, NewSortedEntry(jobA, testScoreA)); err != nil {
+		t.Fatalf("MoveSortedEntryToDead failed: %v", err)
+	}
+	if err := client.MoveSortedEntryToDead(ctx, SortedSetRetry, NewSortedEntry(jobB, testScoreB)); err != nil {
+		t.Fatalf("MoveSortedEntryToDead failed: %v", err)
+	}
+	if last, _ := client.LastUndo(); last.JID != "b" {
+		t.Fatalf("LastUndo().JID = %q, want b", last.JID)
+	}
+
+	client.ClearActivity()
+	if last, ok := client.LastUndo(); !ok || last.JID != "b" {
+		t.Fatalf("LastUndo() after ClearActivity = %q, %v, want b, true", last.JID, ok)
+	}
+}
@@ -3,6 +3,7 @@ package ui
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	"charm.land/bubbles/v2/key"
@@ -10,6 +11,7 @@ import (
 	"charm.land/lipgloss/v2"
 
 	"github.com/kpumuk/lazykiq/internal/devtools"
+	"github.com/kpumuk/lazykiq/internal/record"
 	"github.com/kpumuk/lazykiq/internal/sidekiq"
 	"github.com/kpumuk/lazykiq/internal/ui/components/contextbar"
 	"github.com/kpumuk/lazykiq/internal/ui/components/errorpopup"
@@ -19,6 +21,8 @@ import (
 	"github.com/kpumuk/lazykiq/internal/ui/dialogs"
 	devtoolsdialog "github.com/kpumuk/lazykiq/internal/ui/dialogs/devtools"
 	helpdialog "github.com/kpumuk/lazykiq/internal/ui/dialogs/help"
+	"github.com/kpumuk/lazykiq/internal/ui/dialogs/inspector"
+	palettedialog "github.com/kpumuk/lazykiq/internal/ui/dialogs/palette"
 	"github.com/kpumuk/lazykiq/internal/ui/requestctx"
 	"github.com/kpumuk/lazykiq/internal/ui/theme"
 	"github.com/kpumuk/lazykiq/internal/ui/views"
@@ -48,8 +52,30 @@ const (
 	viewJobDetail
 	viewMetrics
 	viewJobMetrics
+	viewBatch
+	viewProcessWeights
+	viewActivity
+	viewDeadReasons
+	viewRateLimiters
+	viewJobDiff
+	viewEvents
+	viewDuplicates
+	viewJobWatch
 )
 
+// topLevelViews lists the views reachable with the number keys, in order.
+var topLevelViews = []viewID{
+	viewDashboard,
+	viewBusy,
+	viewQueueDetails,
+	viewRetries,
+	viewScheduled,
+	viewDead,
+	viewErrorsSummary,
+	viewMetrics,
+	viewActivity,
+}
+
 const contextbarDefaultHeight = 5
 
 // App is the main application model.
@@ -59,6 +85,7 @@ type App struct {
 	height                  int
 	ready                   bool
 	viewStack               []viewID
+	staleViews              map[viewID]bool
 	viewOrder               []viewID
 	viewRegistry            map[viewID]views.View
 	metrics                 stats.Model
@@ -72,43 +99,62 @@ type App struct {
 	connectionError         error
 	dangerousActionsEnabled bool
 	devTracker              *devtools.Tracker
+	debugTracker            *devtools.Tracker
 	statsRequest            requestctx.Controller
+	jobRef                  *views.JobRef
+	startJobMetrics         string
+	recorder                *record.Recorder
+	paletteRecent           []string // Command palette IDs, most recent first
+	eventsChannel           string
 }
 
 // New creates a new App instance.
-func New(client sidekiq.API, version string, dangerousActionsEnabled bool, devTracker *devtools.Tracker) App {
+// devTracker enables the dev console and debugTracker enables the Redis
+// inspector; either may be nil.
+func New(client sidekiq.API, version string, dangerousActionsEnabled bool, devTracker, debugTracker *devtools.Tracker) App {
 	styles := theme.NewStyles()
 	keys := DefaultKeyMap()
 	keys.DevTools.SetEnabled(devTracker != nil)
+	keys.Inspector.SetEnabled(debugTracker != nil)
+	keys.Events.SetEnabled(false)
 	brand := "Lazykiq"
 	if version != "" {
 		brand = "Lazykiq v" + version
 	}
-
-	viewOrder := []viewID{
-		viewDashboard,
-		viewBusy,
-		viewQueueDetails,
-		viewRetries,
-		viewScheduled,
-		viewDead,
-		viewErrorsSummary,
-		viewMetrics,
+	// A read-only client refuses every mutation, so views must not offer any.
+	if client != nil && client.ReadOnly() {
+		dangerousActionsEnabled = false
+		brand += " (read-only)"
 	}
+	db := 0
+	if client != nil {
+		db = client.DB()
+	}
+
+	viewOrder := slices.Clone(topLevelViews)
 	viewRegistry := map[viewID]views.View{
-		viewDashboard:     views.NewDashboard(client),
-		viewBusy:          views.NewBusy(client),
-		viewQueueDetails:  views.NewQueueDetails(client),
-		viewQueuesList:    views.NewQueuesList(client),
-		viewProcessesList: views.NewProcessesList(client),
-		viewRetries:       views.NewRetries(client),
-		viewScheduled:     views.NewScheduled(client),
-		viewDead:          views.NewDead(client),
-		viewErrorsSummary: views.NewErrorsSummary(client),
-		viewErrorsDetails: views.NewErrorsDetails(client),
-		viewJobDetail:     views.NewJobDetail(),
-		viewMetrics:       views.NewMetrics(client),
-		viewJobMetrics:    views.NewJobMetrics(client),
+		viewDashboard:      views.NewDashboard(client),
+		viewBusy:           views.NewBusy(client),
+		viewQueueDetails:   views.NewQueueDetails(client),
+		viewQueuesList:     views.NewQueuesList(client),
+		viewProcessesList:  views.NewProcessesList(client),
+		viewRetries:        views.NewRetries(client),
+		viewScheduled:      views.NewScheduled(client),
+		viewDead:           views.NewDead(client),
+		viewErrorsSummary:  views.NewErrorsSummary(client),
+		viewErrorsDetails:  views.NewErrorsDetails(client),
+		viewJobDetail:      views.NewJobDetail(client),
+		viewMetrics:        views.NewMetrics(client),
+		viewJobMetrics:     views.NewJobMetrics(client),
+		viewBatch:          views.NewBatch(client),
+		viewProcessWeights: views.NewProcessWeights(),
+		viewActivity:       views.NewActivity(client),
+		viewDeadReasons:    views.NewDeadReasons(client),
+		viewRateLimiters:   views.NewRateLimiters(client),
+		viewJobDiff:        views.NewJobDiff(),
+		viewEvents:         views.NewEvents(),
+		viewDuplicates:     views.NewDuplicates(client),
+		viewJobWatch:       views.NewJobWatch(client),
 	}
 
 	// Apply styles to views
@@ -144,6 +190,8 @@ func New(client sidekiq.API, version string, dangerousActionsEnabled bool, devTr
 		FilterBlurred:   styles.FilterBlurred,
 		DangerAction:    styles.ContextDangerKey,
 		NeutralAction:   styles.ContextKey,
+		ErrorText:       styles.ErrorBorder,
+		WarningText:     styles.WarningText,
 	}
 	for _, id := range viewOrder {
 		viewRegistry[id] = viewRegistry[id].SetStyles(viewStyles)
@@ -153,6 +201,14 @@ func New(client sidekiq.API, version string, dangerousActionsEnabled bool, devTr
 	viewRegistry[viewErrorsDetails] = viewRegistry[viewErrorsDetails].SetStyles(viewStyles)
 	viewRegistry[viewJobDetail] = viewRegistry[viewJobDetail].SetStyles(viewStyles)
 	viewRegistry[viewJobMetrics] = viewRegistry[viewJobMetrics].SetStyles(viewStyles)
+	viewRegistry[viewBatch] = viewRegistry[viewBatch].SetStyles(viewStyles)
+	viewRegistry[viewProcessWeights] = viewRegistry[viewProcessWeights].SetStyles(viewStyles)
+	viewRegistry[viewDeadReasons] = viewRegistry[viewDeadReasons].SetStyles(viewStyles)
+	viewRegistry[viewRateLimiters] = viewRegistry[viewRateLimiters].SetStyles(viewStyles)
+	viewRegistry[viewJobDiff] = viewRegistry[viewJobDiff].SetStyles(viewStyles)
+	viewRegistry[viewEvents] = viewRegistry[viewEvents].SetStyles(viewStyles)
+	viewRegistry[viewDuplicates] = viewRegistry[viewDuplicates].SetStyles(viewStyles)
+	viewRegistry[viewJobWatch] = viewRegistry[viewJobWatch].SetStyles(viewStyles)
 
 	for _, view := range viewRegistry {
 		if toggle, ok := view.(views.DangerousActionsToggle); ok {
@@ -178,6 +234,7 @@ func New(client sidekiq.API, version string, dangerousActionsEnabled bool, devTr
 				Label: styles.MetricsLabel,
 				Value: styles.MetricsValue,
 			}),
+			stats.WithDB(db),
 		),
 		contextbar: contextbar.New(
 			contextbar.WithStyles(contextbar.Styles{
@@ -222,9 +279,170 @@ func New(client sidekiq.API, version string, dangerousActionsEnabled bool, devTr
 		sidekiq:                 client,
 		dangerousActionsEnabled: dangerousActionsEnabled,
 		devTracker:              devTracker,
+		debugTracker:            debugTracker,
+	}
+}
+
+// SetLatencyThresholds configures queue latency highlighting for all views
+// that display queue latency. It must be called before the program starts.
+func (a *App) SetLatencyThresholds(thresholds views.LatencyThresholds) {
+	for _, view := range a.viewRegistry {
+		if setter, ok := view.(views.LatencyThresholdsSetter); ok {
+			setter.SetLatencyThresholds(thresholds)
+		}
+	}
+}
+
+// SetBeatStale configures the heartbeat age at which processes are flagged as
+// stale. It must be called before the program starts.
+func (a *App) SetBeatStale(threshold time.Duration) {
+	for _, view := range a.viewRegistry {
+		if setter, ok := view.(views.BeatStaleSetter); ok {
+			setter.SetBeatStale(threshold)
+		}
+	}
+}
+
+// SetRetryBackoff configures the multiplier applied to Sidekiq's default
+// retry backoff when estimating later retries. It must be called before the
+// program starts.
+func (a *App) SetRetryBackoff(multiplier float64) {
+	for _, view := range a.viewRegistry {
+		if setter, ok := view.(views.RetryBackoffSetter); ok {
+			setter.SetRetryBackoff(multiplier)
+		}
+	}
+}
+
+// SetLargePayload configures the raw payload size, in bytes, from which jobs
+// are flagged as large. It must be called before the program starts.
+func (a *App) SetLargePayload(threshold int64) {
+	for _, view := range a.viewRegistry {
+		if setter, ok := view.(views.LargePayloadSetter); ok {
+			setter.SetLargePayload(threshold)
+		}
+	}
+}
+
+// SetHideEmptyQueues leaves empty queues out of the queue list on the Queues
+// view. It must be called before the program starts and before RestoreState.
+func (a *App) SetHideEmptyQueues(hide bool) {
+	for _, view := range a.viewRegistry {
+		if setter, ok := view.(views.HideEmptyQueuesSetter); ok {
+			setter.SetHideEmptyQueues(hide)
+		}
+	}
+}
+
+// SetWatchTimeout configures how long a job retried with W is watched. It
+// must be called before the program starts.
+func (a *App) SetWatchTimeout(timeout time.Duration) {
+	for _, view := range a.viewRegistry {
+		if setter, ok := view.(views.WatchTimeoutSetter); ok {
+			setter.SetWatchTimeout(timeout)
+		}
+	}
+}
+
+// SetStrictConfirm makes the most destructive actions require typing the
+// set or queue name to confirm. It must be called before the program starts.
+func (a *App) SetStrictConfirm(strict bool) {
+	for _, view := range a.viewRegistry {
+		if setter, ok := view.(views.StrictConfirmSetter); ok {
+			setter.SetStrictConfirm(strict)
+		}
+	}
+}
+
+// SetAllowEnqueue allows enqueuing copies of a job from job details, which
+// also requires dangerous actions. It must be called before the program
+// starts.
+func (a *App) SetAllowEnqueue(allowed bool) {
+	for _, view := range a.viewRegistry {
+		if setter, ok := view.(views.EnqueueAllowedSetter); ok {
+			setter.SetEnqueueAllowed(allowed)
+		}
 	}
 }
 
+// SetProcessCommand configures the command template the Busy view copies
+// for a process. It must be called before the program starts.
+func (a *App) SetProcessCommand(command views.ProcessCommand) {
+	for _, view := range a.viewRegistry {
+		if setter, ok := view.(views.ProcessCommandSetter); ok {
+			setter.SetProcessCommand(command)
+		}
+	}
+}
+
+// SetTraceURL configures the URL template job details fill in with a job's
+// trace id. It must be called before the program starts.
+func (a *App) SetTraceURL(template views.TraceURL) {
+	for _, view := range a.viewRegistry {
+		if setter, ok := view.(views.TraceURLSetter); ok {
+			setter.SetTraceURL(template)
+		}
+	}
+}
+
+// SetUtilizationThresholds configures the process utilization at which the
+// Busy summary is highlighted. It must be called before the program starts.
+func (a *App) SetUtilizationThresholds(thresholds views.UtilizationThresholds) {
+	for _, view := range a.viewRegistry {
+		if setter, ok := view.(views.UtilizationThresholdsSetter); ok {
+			setter.SetUtilizationThresholds(thresholds)
+		}
+	}
+}
+
+// SetLongRunning configures how long a busy job may run before it is
+// highlighted. It must be called before the program starts.
+func (a *App) SetLongRunning(threshold time.Duration) {
+	for _, view := range a.viewRegistry {
+		if setter, ok := view.(views.LongRunningSetter); ok {
+			setter.SetLongRunning(threshold)
+		}
+	}
+}
+
+// SetDeadActionRules configures the actions the Dead view suggests by error
+// class, ahead of the built-in rules. It must be called before the program
+// starts.
+func (a *App) SetDeadActionRules(rules []views.DeadActionRule) {
+	for _, view := range a.viewRegistry {
+		if setter, ok := view.(views.DeadActionRulesSetter); ok {
+			setter.SetDeadActionRules(rules)
+		}
+	}
+}
+
+// SetArgsDepth configures how deep job details expand nested job arguments.
+// It must be called before the program starts.
+func (a *App) SetArgsDepth(depth int) {
+	for _, view := range a.viewRegistry {
+		if setter, ok := view.(views.ArgsDepthSetter); ok {
+			setter.SetArgsDepth(depth)
+		}
+	}
+}
+
+// SetPaging configures the page size and window pages of lazily loaded
+// views. It must be called before the program starts.
+func (a *App) SetPaging(pageSize, windowPages int) {
+	for _, view := range a.viewRegistry {
+		if setter, ok := view.(views.PagingSetter); ok {
+			setter.SetPaging(pageSize, windowPages)
+		}
+	}
+}
+
+// SetRecorder records every stats poll to recorder. The caller owns the
+// recorder and closes it after the program exits. It must be called before
+// the program starts.
+func (a *App) SetRecorder(recorder *record.Recorder) {
+	a.recorder = recorder
+}
+
 // Init implements tea.Model.
 func (a App) Init() tea.Cmd {
 	activeID := a.activeViewID()
@@ -233,6 +451,9 @@ func (a App) Init() tea.Cmd {
 		a.metrics.Init(),
 		a.fetchStatsCmd(), // Fetch stats immediately
 		tickCmd(),         // Start the ticker for subsequent updates
+		a.openJobRefCmd(),
+		a.openStartJobMetricsCmd(),
+		a.subscribeEventsCmd(),
 	)
 }
 
@@ -266,6 +487,9 @@ func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		cmds = append(cmds, tickCmd())
 
+	case views.RefreshAllMsg:
+		cmds = append(cmds, a.refreshAll())
+
 	case connectionErrorMsg:
 		// Store the connection error
 		a.connectionError = msg.err
@@ -283,6 +507,8 @@ func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case views.ShowJobDetailMsg:
 		if setter, ok := a.viewRegistry[viewJobDetail].(views.JobDetailSetter); ok {
 			setter.SetJob(msg.Job)
+			setter.SetJobSource(msg.Source)
+			setter.SetJobSiblings(msg.Siblings)
 		}
 		cmds = append(cmds, a.pushView(viewJobDetail))
 
@@ -298,6 +524,42 @@ func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		cmds = append(cmds, a.pushView(viewJobMetrics))
 
+	case views.ShowBatchMsg:
+		if setter, ok := a.viewRegistry[viewBatch].(views.BatchSetter); ok {
+			setter.SetBatch(msg.BID)
+		}
+		cmds = append(cmds, a.pushView(viewBatch))
+
+	case views.ShowProcessWeightsMsg:
+		if setter, ok := a.viewRegistry[viewProcessWeights].(views.ProcessWeightsSetter); ok {
+			setter.SetProcess(msg.Process)
+		}
+		cmds = append(cmds, a.pushView(viewProcessWeights))
+
+	case views.ShowJobDiffMsg:
+		if setter, ok := a.viewRegistry[viewJobDiff].(views.JobDiffSetter); ok {
+			setter.SetJobs(msg.A, msg.B)
+		}
+		cmds = append(cmds, a.pushView(viewJobDiff))
+
+	case eventMsg:
+		cmds = append(cmds, a.updateView(viewEvents, views.EventMsg{Event: msg.event}), waitForEventCmd(msg.events))
+
+	case views.ShowDeadReasonsMsg:
+		cmds = append(cmds, a.pushView(viewDeadReasons))
+
+	case views.ShowDuplicatesMsg:
+		cmds = append(cmds, a.pushView(viewDuplicates))
+
+	case views.ShowJobWatchMsg:
+		if setter, ok := a.viewRegistry[viewJobWatch].(views.JobWatchSetter); ok {
+			setter.SetJobWatch(msg.Job, msg.From)
+		}
+		cmds = append(cmds, a.pushView(viewJobWatch))
+
+	case views.ShowRateLimitersMsg:
+		cmds = append(cmds, a.pushView(viewRateLimiters))
+
 	case views.ShowQueuesListMsg:
 		cmds = append(cmds, a.pushView(viewQueuesList))
 
@@ -320,17 +582,39 @@ func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case tea.KeyPressMsg:
 		if a.dialogs.HasDialogs() {
-			if key.Matches(msg, a.keys.Quit) {
+			quit := key.Matches(msg, a.keys.Quit)
+			if focuser, ok := a.dialogs.ActiveModel().(dialogs.InputFocuser); ok && focuser.InputFocused() {
+				quit = msg.String() == "ctrl+c"
+			}
+			if quit {
 				return a, tea.Quit
 			}
+			if isChord(msg) && key.Matches(msg, a.keys.Home) {
+				cmd := a.goHome()
+				a.syncContextbar()
+				return a, cmd
+			}
 			updated, cmd := a.dialogs.Update(msg)
 			a.dialogs = updated
 			return a, cmd
 		}
 		activeID := a.activeViewID()
 
+		// Views capturing raw input get every key except ctrl+c.
+		if focuser, ok := a.viewRegistry[activeID].(views.InputFocuser); ok && focuser.InputFocused() {
+			if msg.String() == "ctrl+c" {
+				return a, tea.Quit
+			}
+			if isChord(msg) && key.Matches(msg, a.keys.Home) {
+				cmds = append(cmds, a.goHome())
+				break
+			}
+			cmds = append(cmds, a.updateView(activeID, msg))
+			break
+		}
+
 		if msg.String() == "esc" && len(a.viewStack) > 1 {
-			a.popView()
+			cmds = append(cmds, a.popView())
 			return a, tea.Batch(cmds...)
 		}
 
@@ -340,8 +624,29 @@ func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return a, tea.Quit
 		case key.Matches(msg, a.keys.Help):
 			return a, a.toggleHelpDialog()
+		case key.Matches(msg, a.keys.Palette) && !a.activeViewBindsKey(msg):
+			return a, a.togglePaletteDialog()
 		case a.devTracker != nil && key.Matches(msg, a.keys.DevTools):
 			return a, a.toggleDevToolsDialog()
+		case a.debugTracker != nil && key.Matches(msg, a.keys.Inspector):
+			return a, a.toggleInspectorDialog()
+
+		case key.Matches(msg, a.keys.Home) && !a.activeViewBindsKey(msg):
+			cmds = append(cmds, a.goHome())
+
+		case key.Matches(msg, a.keys.Events) && !a.activeViewBindsKey(msg):
+			cmds = append(cmds, a.pushView(viewEvents))
+
+		case key.Matches(msg, a.keys.SelectDB):
+			if db := slices.Index(a.keys.SelectDB.Keys(), msg.String()); db >= 0 {
+				cmds = append(cmds, a.selectDBCmd(db))
+			}
+
+		case key.Matches(msg, a.keys.Refresh):
+			cmds = append(cmds, a.updateView(activeID, views.RefreshViewMsg{}))
+
+		case key.Matches(msg, a.keys.RefreshAll) && !a.activeViewBindsKey(msg):
+			cmds = append(cmds, a.refreshAll())
 
 		case key.Matches(msg, a.keys.View1):
 			cmds = append(cmds, a.setActiveView(viewDashboard))
@@ -367,11 +672,20 @@ func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case key.Matches(msg, a.keys.View8):
 			cmds = append(cmds, a.setActiveView(viewMetrics))
 
+		case key.Matches(msg, a.keys.View9):
+			cmds = append(cmds, a.setActiveView(viewActivity))
+
 		default:
 			// Pass to active view
 			cmds = append(cmds, a.updateView(activeID, msg))
 		}
 
+	case palettedialog.ActionMsg:
+		cmds = append(cmds, a.runPaletteCommand(msg.Command))
+
+	case dbSelectedMsg:
+		cmds = append(cmds, a.handleDBSelected(msg))
+
 	case tea.WindowSizeMsg:
 		a.width = msg.Width
 		a.height = msg.Height
@@ -449,7 +763,7 @@ func (a App) View() tea.View {
 		}
 
 		if a.connectionError != nil {
-			a.errorPopup.SetMessage(a.connectionError.Error())
+			a.errorPopup.SetMessage(views.ConnectionErrorText(a.connectionError, a.connectionOptions()))
 			errorPanel := a.errorPopup.View()
 			if errorPanel != "" {
 				panelWidth := lipgloss.Width(errorPanel)
@@ -661,11 +975,44 @@ func (a App) toggleDevToolsDialog() tea.Cmd {
 	}
 }
 
+func (a App) toggleInspectorDialog() tea.Cmd {
+	if a.debugTracker == nil {
+		return nil
+	}
+	if a.dialogs.ActiveDialogID() == inspector.DialogID {
+		return func() tea.Msg { return dialogs.CloseDialogMsg{} }
+	}
+
+	return func() tea.Msg {
+		return dialogs.OpenDialogMsg{
+			Model: inspector.New(
+				inspector.WithStyles(inspector.Styles{
+					Title:          a.styles.ViewTitle,
+					Border:         a.styles.FocusBorder,
+					Text:           a.styles.ViewText,
+					Muted:          a.styles.ViewMuted,
+					TableHeader:    a.styles.TableHeader,
+					TableSelected:  a.styles.TableSelected,
+					TableSeparator: a.styles.TableSeparator,
+					ScrollbarTrack: a.styles.ScrollbarTrack,
+					ScrollbarThumb: a.styles.ScrollbarThumb,
+				}),
+				inspector.WithTracker(a.debugTracker),
+				inspector.WithConnection(a.connectionSummary()),
+			),
+		}
+	}
+}
+
 func (a App) helpSections(active views.View) []helpdialog.Section {
 	sections := []helpdialog.Section{
 		{
 			Title:    "Global",
 			Bindings: a.globalHelpBindings(),
+			Lines: []string{
+				"r refreshes the focused view only.",
+				"R also refreshes the stats bar and views under the focused one when you return to them.",
+			},
 		},
 	}
 
@@ -716,11 +1063,19 @@ func (a App) globalHelpBindings() []key.Binding {
 		a.keys.View6,
 		a.keys.View7,
 		a.keys.View8,
+		a.keys.View9,
 	}
 	if a.devTracker != nil {
 		bindings = append(bindings, a.keys.DevTools)
 	}
-	bindings = append(bindings, a.keys.Help, a.keys.Quit)
+	if a.debugTracker != nil {
+		bindings = append(bindings, a.keys.Inspector)
+	}
+	bindings = append(bindings, a.keys.Refresh, a.keys.RefreshAll, a.keys.SelectDB)
+	if a.eventsChannel != "" {
+		bindings = append(bindings, a.keys.Events)
+	}
+	bindings = append(bindings, a.keys.Home, a.keys.Palette, a.keys.Help, a.keys.Quit)
 	if len(a.viewStack) > 1 {
 		bindings = append(bindings, key.NewBinding(
 			key.WithKeys("esc"),
@@ -763,9 +1118,30 @@ func filterMiniHelpBindings(bindings []key.Binding) []key.Binding {
 	return result
 }
 
+// refreshAll refetches the stats bar and the focused view, and marks the views
+// hidden under it stale. Fetch results are delivered to the focused view only,
+// so hidden views refresh as soon as Esc brings them back into focus.
+func (a *App) refreshAll() tea.Cmd {
+	activeID := a.activeViewID()
+	for _, id := range a.viewStack {
+		if id == activeID {
+			continue
+		}
+		if a.staleViews == nil {
+			a.staleViews = make(map[viewID]bool)
+		}
+		a.staleViews[id] = true
+	}
+	return tea.Batch(
+		a.fetchStatsCmd(),
+		a.updateView(activeID, views.RefreshViewMsg{}),
+	)
+}
+
 // fetchStatsCmd fetches Sidekiq stats and returns a stats.UpdateMsg or connectionErrorMsg.
 func (a *App) fetchStatsCmd() tea.Cmd {
 	ctx := a.statsRequest.Start(devtools.WithTracker(context.Background(), "app.fetchStatsCmd"))
+	recorder := a.recorder
 	return func() tea.Msg {
 		sidekiqStats, err := a.sidekiq.GetStats(ctx)
 		if err != nil {
@@ -775,18 +1151,36 @@ func (a *App) fetchStatsCmd() tea.Cmd {
 			return connectionErrorMsg{err: err}
 		}
 
-		return stats.UpdateMsg{
-			Data: stats.Data{
-				Processed: sidekiqStats.Processed,
-				Failed:    sidekiqStats.Failed,
-				Busy:      sidekiqStats.Busy,
-				Enqueued:  sidekiqStats.Enqueued,
-				Retries:   sidekiqStats.Retries,
-				Scheduled: sidekiqStats.Scheduled,
-				Dead:      sidekiqStats.Dead,
-				UpdatedAt: time.Now(),
-			},
+		data := stats.Data{
+			Processed:  sidekiqStats.Processed,
+			Failed:     sidekiqStats.Failed,
+			Busy:       sidekiqStats.Busy,
+			Enqueued:   sidekiqStats.Enqueued,
+			Retries:    sidekiqStats.Retries,
+			Scheduled:  sidekiqStats.Scheduled,
+			Dead:       sidekiqStats.Dead,
+			QueueSizes: sidekiqStats.QueueSizes,
+			UpdatedAt:  time.Now(),
 		}
+		if recorder != nil {
+			// Errors are kept by the recorder and reported when it is closed.
+			_ = recorder.Record(recordSample(data))
+		}
+		return stats.UpdateMsg{Data: data}
+	}
+}
+
+func recordSample(data stats.Data) record.Sample {
+	return record.Sample{
+		Time:       data.UpdatedAt,
+		Processed:  data.Processed,
+		Failed:     data.Failed,
+		Busy:       data.Busy,
+		Enqueued:   data.Enqueued,
+		Retries:    data.Retries,
+		Scheduled:  data.Scheduled,
+		Dead:       data.Dead,
+		QueueSizes: data.QueueSizes,
 	}
 }
 
@@ -843,6 +1237,7 @@ func (a *App) setActiveView(id viewID) tea.Cmd {
 		}
 	}
 	a.viewStack = []viewID{id}
+	clear(a.staleViews)
 	a.stackbar.SetStack(a.stackNames())
 	if view, ok := a.viewRegistry[id]; ok {
 		return view.Init()
@@ -850,6 +1245,35 @@ func (a *App) setActiveView(id viewID) tea.Cmd {
 	return nil
 }
 
+// goHome returns to the Dashboard from anywhere: it closes open dialogs and
+// disposes every other view on the stack, like switching views with 1.
+func (a *App) goHome() tea.Cmd {
+	var cmds []tea.Cmd
+	for range a.dialogs.Dialogs() {
+		updated, cmd := a.dialogs.Update(dialogs.CloseDialogMsg{})
+		a.dialogs = updated
+		cmds = append(cmds, cmd)
+	}
+	if len(a.viewStack) == 1 && a.viewStack[0] == viewDashboard {
+		return tea.Batch(cmds...)
+	}
+	cmds = append(cmds, a.setActiveView(viewDashboard))
+	return tea.Batch(cmds...)
+}
+
+// SetHomeKeys replaces the keys that return to the Dashboard. Chords with
+// ctrl or alt also work while an input has focus. It must be called before
+// the program starts.
+func (a *App) SetHomeKeys(keys []string) {
+	a.keys.Home = homeBinding(keys)
+}
+
+// isChord reports whether msg is pressed with ctrl or alt, which text inputs
+// do not consume.
+func isChord(msg tea.KeyPressMsg) bool {
+	return msg.Mod&(tea.ModCtrl|tea.ModAlt) != 0
+}
+
 func (a *App) pushView(id viewID) tea.Cmd {
 	if len(a.viewStack) > 0 && a.viewStack[len(a.viewStack)-1] == id {
 		a.stackbar.SetStack(a.stackNames())
@@ -882,11 +1306,20 @@ func (a *App) popTopView() {
 	a.stackbar.SetStack(a.stackNames())
 }
 
-func (a *App) popView() {
+// popView returns to the view below the focused one, refreshing it right away
+// if a refresh-all happened while it was hidden.
+func (a *App) popView() tea.Cmd {
 	a.popTopView()
+	activeID := a.activeViewID()
+	if !a.staleViews[activeID] {
+		return nil
+	}
+	delete(a.staleViews, activeID)
+	return a.updateView(activeID, views.RefreshViewMsg{})
 }
 
 func (a *App) popAndRefresh(id viewID) tea.Cmd {
 	a.popTopView()
+	delete(a.staleViews, id)
 	return a.updateView(id, views.RefreshMsg{})
 }
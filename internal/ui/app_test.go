@@ -1,6 +1,9 @@
 package ui
 
 import (
+	"context"
+	"reflect"
+	"slices"
 	"strings"
 	"testing"
 
@@ -9,7 +12,10 @@ import (
 	"charm.land/lipgloss/v2"
 	"github.com/charmbracelet/x/ansi"
 
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
 	"github.com/kpumuk/lazykiq/internal/ui/dialogs"
+	helpdialog "github.com/kpumuk/lazykiq/internal/ui/dialogs/help"
+	palettedialog "github.com/kpumuk/lazykiq/internal/ui/dialogs/palette"
 	"github.com/kpumuk/lazykiq/internal/ui/views"
 )
 
@@ -132,3 +138,520 @@ func TestPushViewCancelsPreviousActiveViewRequests(t *testing.T) {
 		t.Fatalf("processes cancelations = %d, want 0", processes.cancelations)
 	}
 }
+
+type refreshRecorderView struct {
+	stubView
+	refreshes     int
+	viewRefreshes int
+}
+
+func (v *refreshRecorderView) Update(msg tea.Msg) (views.View, tea.Cmd) {
+	switch msg.(type) {
+	case views.RefreshMsg:
+		v.refreshes++
+	case views.RefreshViewMsg:
+		v.viewRefreshes++
+	}
+	return v, nil
+}
+
+func TestRefreshKeysTargetFocusedView(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		key          tea.KeyPressMsg
+		wantStatsCmd bool
+	}{
+		"refresh view": {key: tea.KeyPressMsg(tea.Key{Code: 'r', Text: "r"})},
+		"refresh all":  {key: tea.KeyPressMsg(tea.Key{Code: 'R', Text: "R"}), wantStatsCmd: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			dashboard := &refreshRecorderView{}
+			busy := &refreshRecorderView{}
+			app := App{
+				keys:      DefaultKeyMap(),
+				viewStack: []viewID{viewDashboard, viewBusy},
+				viewRegistry: map[viewID]views.View{
+					viewDashboard: dashboard,
+					viewBusy:      busy,
+				},
+				dialogs: stubDialogs{},
+			}
+
+			_, cmd := app.Update(tc.key)
+
+			if busy.viewRefreshes != 1 || busy.refreshes != 0 {
+				t.Fatalf("focused view refreshes = %d/%d, want 0/1", busy.refreshes, busy.viewRefreshes)
+			}
+			if dashboard.viewRefreshes != 0 || dashboard.refreshes != 0 {
+				t.Fatalf("hidden view refreshed %d/%d times, want none", dashboard.refreshes, dashboard.viewRefreshes)
+			}
+			if got := cmd != nil; got != tc.wantStatsCmd {
+				t.Fatalf("stats fetch cmd = %v, want %v", got, tc.wantStatsCmd)
+			}
+		})
+	}
+}
+
+func TestRefreshAllRefreshesStackedViewsOnReturn(t *testing.T) {
+	t.Parallel()
+
+	dashboard := &refreshRecorderView{}
+	busy := &refreshRecorderView{}
+	app := App{
+		keys:      DefaultKeyMap(),
+		viewStack: []viewID{viewDashboard, viewBusy},
+		viewRegistry: map[viewID]views.View{
+			viewDashboard: dashboard,
+			viewBusy:      busy,
+		},
+		dialogs: stubDialogs{},
+	}
+
+	model, _ := app.Update(views.RefreshAllMsg{})
+	model, _ = model.Update(tea.KeyPressMsg(tea.Key{Code: tea.KeyEscape}))
+
+	if dashboard.viewRefreshes != 1 {
+		t.Fatalf("stacked view refreshes on return = %d, want 1", dashboard.viewRefreshes)
+	}
+
+	next := model.(App)
+	next.pushView(viewBusy)
+	next.Update(tea.KeyPressMsg(tea.Key{Code: tea.KeyEscape}))
+
+	if dashboard.viewRefreshes != 1 {
+		t.Fatalf("stacked view refreshed again without refresh all: %d", dashboard.viewRefreshes)
+	}
+}
+
+type statefulStubView struct {
+	stubView
+	state views.ViewState
+}
+
+func (v *statefulStubView) SaveState() views.ViewState         { return v.state }
+func (v *statefulStubView) RestoreState(state views.ViewState) { v.state = state }
+
+func TestStateRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	queues := &statefulStubView{state: views.ViewState{Queue: "critical", Filter: "Mailer"}}
+	metrics := &statefulStubView{}
+	jobMetrics := &statefulStubView{state: views.ViewState{Period: "4h"}}
+	app := App{
+		viewStack: []viewID{viewQueueDetails},
+		viewOrder: []viewID{viewDashboard, viewQueueDetails, viewMetrics},
+		viewRegistry: map[viewID]views.View{
+			viewDashboard:    stubView{},
+			viewQueueDetails: queues,
+			viewMetrics:      metrics,
+			viewJobMetrics:   jobMetrics,
+		},
+	}
+
+	state := app.State()
+	if state.View != "queues" {
+		t.Fatalf("view = %q, want queues", state.View)
+	}
+	if _, ok := state.Views["metrics"]; ok {
+		t.Fatal("empty view state should not be persisted")
+	}
+
+	restoredQueues := &statefulStubView{}
+	restoredJobMetrics := &statefulStubView{}
+	restored := App{
+		viewStack: []viewID{viewDashboard},
+		viewOrder: []viewID{viewDashboard, viewQueueDetails, viewMetrics},
+		viewRegistry: map[viewID]views.View{
+			viewDashboard:    stubView{},
+			viewQueueDetails: restoredQueues,
+			viewMetrics:      &statefulStubView{},
+			viewJobMetrics:   restoredJobMetrics,
+		},
+	}
+	restored.RestoreState(state)
+
+	if got := restored.activeViewID(); got != viewQueueDetails {
+		t.Fatalf("active view = %v, want %v", got, viewQueueDetails)
+	}
+	if !reflect.DeepEqual(restoredQueues.state, queues.state) {
+		t.Fatalf("restored state = %+v, want %+v", restoredQueues.state, queues.state)
+	}
+	if !reflect.DeepEqual(restoredJobMetrics.state, jobMetrics.state) {
+		t.Fatalf("restored job metrics state = %+v, want %+v", restoredJobMetrics.state, jobMetrics.state)
+	}
+}
+
+func TestRestoreStateIgnoresUnknownView(t *testing.T) {
+	t.Parallel()
+
+	app := App{
+		viewStack: []viewID{viewDashboard},
+		viewOrder: []viewID{viewDashboard},
+		viewRegistry: map[viewID]views.View{
+			viewDashboard: stubView{},
+		},
+	}
+	app.RestoreState(State{View: "nope"})
+
+	if got := app.activeViewID(); got != viewDashboard {
+		t.Fatalf("active view = %v, want %v", got, viewDashboard)
+	}
+}
+
+func TestOpenJobRefFiltersSortedSetView(t *testing.T) {
+	t.Parallel()
+
+	dead := &statefulStubView{}
+	app := App{
+		viewStack: []viewID{viewDashboard},
+		viewOrder: []viewID{viewDashboard, viewDead},
+		viewRegistry: map[viewID]views.View{
+			viewDashboard: stubView{},
+			viewDead:      dead,
+		},
+	}
+	app.OpenJobRef(views.JobRef{Kind: sidekiq.SortedSetDead, JID: "abc123"})
+
+	if got := app.activeViewID(); got != viewDead {
+		t.Fatalf("active view = %v, want %v", got, viewDead)
+	}
+	if dead.state.Filter != "abc123" {
+		t.Fatalf("filter = %q, want abc123", dead.state.Filter)
+	}
+	if app.openJobRefCmd() == nil {
+		t.Fatal("openJobRefCmd() = nil, want lookup command")
+	}
+}
+
+type queueSetterStubView struct {
+	stubView
+	queue string
+}
+
+func (v *queueSetterStubView) SetQueue(queueName string) { v.queue = queueName }
+
+func TestParseStartView(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value   string
+		want    StartView
+		wantErr string
+	}{
+		"view":     {value: "errors", want: StartView{View: "errors"}},
+		"alias":    {value: "retry", want: StartView{View: "retries"}},
+		"queue":    {value: "queues:default", want: StartView{View: "queues", Queue: "default"}},
+		"job":      {value: "metrics:HardJob", want: StartView{View: "metrics", Job: "HardJob"}},
+		"unknown":  {value: "nope", wantErr: "valid views: dashboard, busy, queues"},
+		"no value": {value: "queues:", wantErr: "missing value"},
+		"no arg":   {value: "dead:x", wantErr: "does not take a value"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseStartView(tc.value)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("ParseStartView(%q) error = %v, want %q", tc.value, err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil || got != tc.want {
+				t.Fatalf("ParseStartView(%q) = %+v, %v, want %+v", tc.value, got, err, tc.want)
+			}
+		})
+	}
+}
+
+func TestSetStartViewOverridesRestoredView(t *testing.T) {
+	t.Parallel()
+
+	queues := &queueSetterStubView{}
+	app := App{
+		viewStack: []viewID{viewDashboard},
+		viewOrder: []viewID{viewDashboard, viewQueueDetails, viewMetrics},
+		viewRegistry: map[viewID]views.View{
+			viewDashboard:    stubView{},
+			viewQueueDetails: queues,
+			viewMetrics:      stubView{},
+		},
+	}
+	app.RestoreState(State{View: "metrics"})
+	app.SetStartView(StartView{View: "queues", Queue: "critical"})
+
+	if got := app.activeViewID(); got != viewQueueDetails {
+		t.Fatalf("active view = %v, want %v", got, viewQueueDetails)
+	}
+	if queues.queue != "critical" {
+		t.Fatalf("queue = %q, want critical", queues.queue)
+	}
+	if app.openStartJobMetricsCmd() != nil {
+		t.Fatal("openStartJobMetricsCmd() != nil without a job class")
+	}
+
+	app.SetStartView(StartView{View: "metrics", Job: "HardJob"})
+	cmd := app.openStartJobMetricsCmd()
+	if cmd == nil {
+		t.Fatal("openStartJobMetricsCmd() = nil, want job metrics command")
+	}
+	if msg, ok := cmd().(views.ShowJobMetricsMsg); !ok || msg.Job != "HardJob" {
+		t.Fatalf("msg = %#v, want job metrics for HardJob", msg)
+	}
+}
+
+func TestQuitKeyTypesIntoSearchingHelpDialog(t *testing.T) {
+	t.Parallel()
+
+	app := App{
+		keys:      DefaultKeyMap(),
+		viewStack: []viewID{viewDashboard},
+		viewRegistry: map[viewID]views.View{
+			viewDashboard: stubView{},
+		},
+		dialogs: dialogs.NewDialogCmp(),
+	}
+	help := helpdialog.New()
+	model, _ := app.Update(dialogs.OpenDialogMsg{Model: help})
+	app = model.(App)
+	model, _ = app.Update(tea.KeyPressMsg(tea.Key{Code: '/', Text: "/"}))
+	app = model.(App)
+
+	_, cmd := app.Update(tea.KeyPressMsg(tea.Key{Code: 'q', Text: "q"}))
+	if cmd != nil {
+		if _, quit := cmd().(tea.QuitMsg); quit {
+			t.Fatal("q quit the app while the help dialog was searching")
+		}
+	}
+	if help.Query() != "q" {
+		t.Fatalf("help query = %q, want q", help.Query())
+	}
+
+	_, cmd = app.Update(tea.KeyPressMsg(tea.Key{Code: 'c', Mod: tea.ModCtrl}))
+	if cmd == nil {
+		t.Fatal("ctrl+c returned no command")
+	}
+	if _, quit := cmd().(tea.QuitMsg); !quit {
+		t.Fatal("ctrl+c did not quit while the help dialog was searching")
+	}
+}
+
+func TestKeyPressForMatchesBindingKeys(t *testing.T) {
+	t.Parallel()
+
+	for _, keystroke := range []string{"D", "g", "[", "~", "enter", "esc", "shift+tab", "ctrl+d", "ctrl+\\", "alt+r", "alt+left", "f12", "ctrl+1"} {
+		if got := keyPressFor(keystroke).String(); got != keystroke {
+			t.Fatalf("keyPressFor(%q).String() = %q", keystroke, got)
+		}
+	}
+}
+
+type paletteStubView struct {
+	stubView
+	keys []string
+}
+
+func (v *paletteStubView) Update(msg tea.Msg) (views.View, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyPressMsg); ok {
+		v.keys = append(v.keys, msg.String())
+	}
+	return v, nil
+}
+
+func (v *paletteStubView) HelpSections() []views.HelpSection {
+	return []views.HelpSection{{
+		Title: "Stub",
+		Bindings: []key.Binding{
+			key.NewBinding(key.WithKeys("D"), key.WithHelp("shift+d", "delete job")),
+			key.NewBinding(key.WithKeys("ctrl+k"), key.WithHelp("ctrl+k", "kill all")),
+		},
+	}}
+}
+
+func TestPaletteRunsPickedCommand(t *testing.T) {
+	t.Parallel()
+
+	view := &paletteStubView{}
+	app := App{
+		keys:      DefaultKeyMap(),
+		viewStack: []viewID{viewDashboard},
+		viewRegistry: map[viewID]views.View{
+			viewDashboard: view,
+		},
+		dialogs: dialogs.NewDialogCmp(),
+	}
+
+	commands := app.paletteCommands(view)
+	if len(commands) == 0 || commands[0].Section != "Stub" || commands[0].Binding.Help().Desc != "delete job" {
+		t.Fatalf("paletteCommands starts with %+v, want the view's bindings", commands)
+	}
+	for _, command := range commands {
+		if command.Binding.Help() == app.keys.Palette.Help() {
+			t.Fatal("paletteCommands lists the palette itself")
+		}
+	}
+
+	// The view binds ctrl+k itself, so it keeps the key.
+	model, cmd := app.Update(tea.KeyPressMsg(tea.Key{Code: 'k', Mod: tea.ModCtrl}))
+	app = model.(App)
+	if cmd != nil || len(view.keys) != 1 || view.keys[0] != "ctrl+k" {
+		t.Fatalf("ctrl+k reached view as %v, want the view's binding", view.keys)
+	}
+	view.keys = nil
+
+	model, cmd = app.Update(palettedialog.ActionMsg{Command: commands[0]})
+	app = model.(App)
+	if cmd == nil {
+		t.Fatal("expected replayed key press")
+	}
+	model, _ = app.Update(cmd())
+	app = model.(App)
+	if len(view.keys) != 1 || view.keys[0] != "D" {
+		t.Fatalf("view received %v, want [D]", view.keys)
+	}
+	if len(app.paletteRecent) != 1 || app.paletteRecent[0] != commands[0].ID() {
+		t.Fatalf("paletteRecent = %q, want the picked command", app.paletteRecent)
+	}
+}
+
+func TestPaletteKeyOpensPalette(t *testing.T) {
+	t.Parallel()
+
+	app := App{
+		keys:      DefaultKeyMap(),
+		viewStack: []viewID{viewDashboard},
+		viewRegistry: map[viewID]views.View{
+			viewDashboard: stubView{},
+		},
+		dialogs: dialogs.NewDialogCmp(),
+	}
+	_, cmd := app.Update(tea.KeyPressMsg(tea.Key{Code: 'k', Mod: tea.ModCtrl}))
+	if cmd == nil {
+		t.Fatal("ctrl+k returned no command")
+	}
+	open, ok := cmd().(dialogs.OpenDialogMsg)
+	if !ok || open.Model.ID() != palettedialog.DialogID {
+		t.Fatal("ctrl+k did not open the command palette")
+	}
+}
+
+type dbClientStub struct {
+	sidekiq.API
+	db int
+}
+
+func (s *dbClientStub) DB() int { return s.db }
+
+func (s *dbClientStub) SelectDB(_ context.Context, db int) error {
+	s.db = db
+	return nil
+}
+
+func TestSelectDBKeyReconnectsAndStartsOver(t *testing.T) {
+	t.Parallel()
+
+	client := &dbClientStub{}
+	busy := &cancelableStubView{name: "Busy"}
+	processes := &cancelableStubView{name: "Processes"}
+	app := App{
+		keys:      DefaultKeyMap(),
+		sidekiq:   client,
+		viewStack: []viewID{viewBusy, viewProcessesList},
+		viewRegistry: map[viewID]views.View{
+			viewBusy:          busy,
+			viewProcessesList: processes,
+		},
+		dialogs: stubDialogs{},
+	}
+
+	model, cmd := app.Update(tea.KeyPressMsg{Code: '3', Mod: tea.ModAlt})
+	if cmd == nil {
+		t.Fatal("alt+3 returned no command")
+	}
+	msg, ok := cmd().(dbSelectedMsg)
+	if !ok || msg.db != 3 || msg.err != nil {
+		t.Fatalf("msg = %#v, want db 3 selected", msg)
+	}
+	if client.db != 3 {
+		t.Fatalf("client db = %d, want 3", client.db)
+	}
+
+	model, _ = model.Update(msg)
+	app = model.(App)
+	if !slices.Equal(app.viewStack, []viewID{viewBusy}) {
+		t.Fatalf("view stack = %v, want only Busy", app.viewStack)
+	}
+	if busy.cancelations == 0 || processes.cancelations == 0 {
+		t.Fatalf("cancelations = %d/%d, want every stacked view canceled", busy.cancelations, processes.cancelations)
+	}
+	app.metrics.SetWidth(120)
+	if !strings.Contains(ansi.Strip(app.metrics.View()), "DB: 3") {
+		t.Fatal("metrics bar does not show the selected db")
+	}
+
+	if _, cmd := app.Update(tea.KeyPressMsg{Code: '3', Mod: tea.ModAlt}); cmd != nil {
+		t.Fatal("selecting the current db returned a command")
+	}
+}
+
+type disposableStubView struct {
+	cancelableStubView
+	disposals int
+	focused   bool
+	keys      int
+}
+
+func (v *disposableStubView) Update(tea.Msg) (views.View, tea.Cmd) {
+	v.keys++
+	return v, nil
+}
+func (v *disposableStubView) Dispose()           { v.disposals++ }
+func (v *disposableStubView) InputFocused() bool { return v.focused }
+
+func TestHomeKeyReturnsToDashboardDisposingViews(t *testing.T) {
+	t.Parallel()
+
+	dashboard := &disposableStubView{cancelableStubView: cancelableStubView{name: "Dashboard"}}
+	busy := &disposableStubView{cancelableStubView: cancelableStubView{name: "Busy"}}
+	processes := &disposableStubView{cancelableStubView: cancelableStubView{name: "Processes"}}
+	app := App{
+		keys:      DefaultKeyMap(),
+		viewStack: []viewID{viewBusy, viewProcessesList},
+		viewRegistry: map[viewID]views.View{
+			viewDashboard:     dashboard,
+			viewBusy:          busy,
+			viewProcessesList: processes,
+		},
+		dialogs: stubDialogs{},
+	}
+
+	model, _ := app.Update(tea.KeyPressMsg{Code: 'H', Text: "H"})
+	app = model.(App)
+	if !slices.Equal(app.viewStack, []viewID{viewDashboard}) {
+		t.Fatalf("view stack = %v, want only Dashboard", app.viewStack)
+	}
+	if busy.disposals != 1 || processes.disposals != 1 || dashboard.disposals != 0 {
+		t.Fatalf("disposals = busy %d, processes %d, dashboard %d; want 1, 1, 0", busy.disposals, processes.disposals, dashboard.disposals)
+	}
+
+	// A focused input takes H as text, but the alt+h chord still goes home.
+	app.SetHomeKeys([]string{"H", "alt+h"})
+	app.viewStack = []viewID{viewBusy}
+	busy.focused = true
+	model, _ = app.Update(tea.KeyPressMsg{Code: 'H', Text: "H"})
+	app = model.(App)
+	if !slices.Equal(app.viewStack, []viewID{viewBusy}) || busy.keys != 1 {
+		t.Fatalf("view stack = %v, busy keys = %d; want H typed into Busy", app.viewStack, busy.keys)
+	}
+	model, _ = app.Update(tea.KeyPressMsg{Code: 'h', Mod: tea.ModAlt})
+	app = model.(App)
+	if !slices.Equal(app.viewStack, []viewID{viewDashboard}) {
+		t.Fatalf("view stack = %v, want only Dashboard after alt+h", app.viewStack)
+	}
+}
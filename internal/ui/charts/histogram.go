@@ -2,9 +2,12 @@
 package charts
 
 import (
+	"math"
 	"slices"
 	"sort"
 	"time"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
 )
 
 // ScatterPoint represents a single point on a scatter plot.
@@ -22,11 +25,13 @@ type ProcessedMetrics struct {
 	MaxCount      int64          // Maximum count for scatter point sizing
 	MaxBucket     int            // Maximum bucket index with data
 	BucketCount   int            // Number of histogram buckets
+	SuccessRates  []float64      // Success rate per time bucket, NaN when nothing was processed
 }
 
 // ProcessHistogramData performs single-pass processing of histogram data.
+// bucketMetrics holds per-bucket totals keyed like hist and feeds SuccessRates.
 // Returns a pointer to all computed values needed for rendering charts.
-func ProcessHistogramData(hist map[string][]int64, bucketCount int) *ProcessedMetrics {
+func ProcessHistogramData(hist map[string][]int64, bucketCount int, bucketMetrics map[string]sidekiq.MetricsJobTotals) *ProcessedMetrics {
 	if len(hist) == 0 || bucketCount == 0 {
 		return &ProcessedMetrics{}
 	}
@@ -35,6 +40,7 @@ func ProcessHistogramData(hist map[string][]int64, bucketCount int) *ProcessedMe
 	type bucketEntry struct {
 		time   time.Time
 		values []int64
+		totals sidekiq.MetricsJobTotals
 	}
 	entries := make([]bucketEntry, 0, len(hist))
 	nonZeroCount := 0
@@ -44,7 +50,7 @@ func ProcessHistogramData(hist map[string][]int64, bucketCount int) *ProcessedMe
 		if err != nil {
 			continue
 		}
-		entries = append(entries, bucketEntry{time: t, values: values})
+		entries = append(entries, bucketEntry{time: t, values: values, totals: bucketMetrics[key]})
 		for _, count := range values {
 			if count > 0 {
 				nonZeroCount++
@@ -61,6 +67,7 @@ func ProcessHistogramData(hist map[string][]int64, bucketCount int) *ProcessedMe
 	result := &ProcessedMetrics{
 		SortedBuckets: make([]time.Time, 0, len(entries)),
 		BucketTotals:  make([]int64, bucketCount),
+		SuccessRates:  make([]float64, 0, len(entries)),
 		ScatterPoints: make([]ScatterPoint, 0, nonZeroCount),
 		BucketCount:   bucketCount,
 		MaxBucket:     -1,
@@ -69,6 +76,12 @@ func ProcessHistogramData(hist map[string][]int64, bucketCount int) *ProcessedMe
 	// Second pass: compute totals and scatter points (now in sorted order)
 	for tIdx, entry := range entries {
 		result.SortedBuckets = append(result.SortedBuckets, entry.time)
+		if entry.totals.Processed > 0 {
+			result.SuccessRates = append(result.SuccessRates, entry.totals.SuccessRate())
+		} else {
+			// A bucket without jobs has no rate; charts leave a gap instead of 0%.
+			result.SuccessRates = append(result.SuccessRates, math.NaN())
+		}
 
 		for bIdx, count := range entry.values {
 			if bIdx >= bucketCount {
@@ -0,0 +1,41 @@
+package charts
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+)
+
+func TestProcessHistogramDataSuccessRates(t *testing.T) {
+	base := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+	key := func(minute int) string {
+		return base.Add(time.Duration(minute) * time.Minute).Format(time.RFC3339)
+	}
+	hist := map[string][]int64{
+		key(2): {0, 1},
+		key(0): {4, 0},
+		key(1): {0, 0},
+	}
+	bucketMetrics := map[string]sidekiq.MetricsJobTotals{
+		key(0): {Processed: 4, Failed: 1},
+		key(1): {},
+		key(2): {Processed: 2, Failed: 5},
+	}
+
+	processed := ProcessHistogramData(hist, 2, bucketMetrics)
+
+	if len(processed.SuccessRates) != len(processed.SortedBuckets) {
+		t.Fatalf("len(SuccessRates) = %d, want %d", len(processed.SuccessRates), len(processed.SortedBuckets))
+	}
+	if got := processed.SuccessRates[0]; got != 0.75 {
+		t.Errorf("rate[0] = %v, want 0.75", got)
+	}
+	if got := processed.SuccessRates[1]; !math.IsNaN(got) {
+		t.Errorf("rate[1] = %v, want NaN gap for an empty bucket", got)
+	}
+	if got := processed.SuccessRates[2]; got != 0 {
+		t.Errorf("rate[2] = %v, want 0 (clamped)", got)
+	}
+}
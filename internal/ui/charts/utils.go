@@ -47,6 +47,16 @@ func RemapSeries(values []int64, target int) []int64 {
 	return out
 }
 
+// BarLength scales value against maxVal to a horizontal bar of at most width
+// cells. Non-zero values get at least one cell so they stay visible.
+func BarLength(value, maxVal int64, width int) int {
+	if value <= 0 || maxVal <= 0 || width <= 0 {
+		return 0
+	}
+	length := int(math.Round(float64(value) * float64(width) / float64(maxVal)))
+	return min(max(length, 1), width)
+}
+
 // BuildValueYAxisLabels creates Y-axis labels for numeric values.
 // Returns a map of row index to label string.
 func BuildValueYAxisLabels(maxVal int64, height int) map[int]string {
@@ -0,0 +1,45 @@
+package charts
+
+import (
+	"testing"
+
+	"github.com/kpumuk/lazykiq/internal/ui/display"
+)
+
+func TestBarLength(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  int64
+		maxVal int64
+		width  int
+		want   int
+	}{
+		{name: "max fills width", value: 40, maxVal: 40, width: 20, want: 20},
+		{name: "half", value: 20, maxVal: 40, width: 20, want: 10},
+		{name: "rounds", value: 3, maxVal: 8, width: 10, want: 4},
+		{name: "tiny stays visible", value: 1, maxVal: 1000, width: 20, want: 1},
+		{name: "zero value", value: 0, maxVal: 40, width: 20, want: 0},
+		{name: "zero width", value: 40, maxVal: 40, width: 0, want: 0},
+		{name: "over max clamps", value: 80, maxVal: 40, width: 20, want: 20},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BarLength(tt.value, tt.maxVal, tt.width); got != tt.want {
+				t.Fatalf("BarLength(%d, %d, %d) = %d, want %d", tt.value, tt.maxVal, tt.width, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildValueYAxisLabelsHonorsNumberFormat(t *testing.T) {
+	t.Cleanup(func() { display.SetNumberFormat(display.DefaultNumberFormat()) })
+
+	if got := BuildValueYAxisLabels(3000, 4)[0]; got != "3.0K" {
+		t.Fatalf("top label = %q, want 3.0K", got)
+	}
+	display.SetNumberFormat(display.NumberFormat{ThousandsSeparator: " ", Abbreviate: false})
+	labels := BuildValueYAxisLabels(3000, 4)
+	if labels[0] != "3 000" || labels[1] != "2 000" || labels[3] != "0" {
+		t.Fatalf("labels = %v, want 3 000/2 000/1 000/0", labels)
+	}
+}
@@ -0,0 +1,174 @@
+// Package errorbanner renders a dismissible error banner over a view that
+// keeps showing its last data.
+package errorbanner
+
+import (
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/kpumuk/lazykiq/internal/ui/components/frame"
+	"github.com/kpumuk/lazykiq/internal/ui/theme"
+)
+
+// Styles holds the styles needed by the error banner.
+type Styles struct {
+	Title   lipgloss.Style
+	Message lipgloss.Style
+	Hint    lipgloss.Style
+	Border  lipgloss.Style
+}
+
+// DefaultStyles returns default styles for the error banner.
+func DefaultStyles() Styles {
+	errorColor := theme.DefaultTheme.Error
+	return Styles{
+		Title:   lipgloss.NewStyle().Foreground(errorColor).Bold(true),
+		Message: lipgloss.NewStyle(),
+		Hint:    lipgloss.NewStyle().Faint(true),
+		Border:  lipgloss.NewStyle().Foreground(errorColor),
+	}
+}
+
+// Model defines state for the error banner component.
+type Model struct {
+	styles  Styles
+	title   string
+	message string
+	hint    string
+	width   int
+}
+
+// Option is used to set options in New.
+type Option func(*Model)
+
+// New creates a new error banner model.
+func New(opts ...Option) Model {
+	m := Model{
+		styles: DefaultStyles(),
+		title:  "Error",
+	}
+
+	for _, opt := range opts {
+		opt(&m)
+	}
+
+	return m
+}
+
+// WithStyles sets the styles.
+func WithStyles(s Styles) Option {
+	return func(m *Model) {
+		m.styles = s
+	}
+}
+
+// WithTitle sets the title shown on the banner border.
+func WithTitle(title string) Option {
+	return func(m *Model) {
+		m.title = title
+	}
+}
+
+// WithHint sets the muted line shown below the message, such as the keys
+// that retry or dismiss.
+func WithHint(hint string) Option {
+	return func(m *Model) {
+		m.hint = hint
+	}
+}
+
+// WithWidth sets the width.
+func WithWidth(w int) Option {
+	return func(m *Model) {
+		m.width = w
+	}
+}
+
+// WithMessage sets the error message.
+func WithMessage(msg string) Option {
+	return func(m *Model) {
+		m.message = msg
+	}
+}
+
+// SetStyles sets the styles.
+func (m *Model) SetStyles(s Styles) {
+	m.styles = s
+}
+
+// SetWidth sets the width.
+func (m *Model) SetWidth(w int) {
+	m.width = w
+}
+
+// SetMessage sets the error message to display.
+func (m *Model) SetMessage(msg string) {
+	m.message = msg
+}
+
+// Dismiss hides the banner until the next error.
+func (m *Model) Dismiss() {
+	m.message = ""
+}
+
+// Message returns the current error message.
+func (m Model) Message() string {
+	return m.message
+}
+
+// Visible returns true if there is an error message to display.
+func (m Model) Visible() bool {
+	return m.message != ""
+}
+
+// View renders the banner: the message on one line, truncated to fit, and
+// the hint below it.
+func (m Model) View() string {
+	if m.message == "" || m.width < 4 {
+		return ""
+	}
+	contentWidth := m.width - 2 - 2 // borders + padding
+
+	message := strings.Join(strings.Fields(m.message), " ")
+	lines := []string{m.styles.Message.Render(ansi.Truncate(message, contentWidth, "…"))}
+	if m.hint != "" {
+		lines = append(lines, m.styles.Hint.Render(ansi.Truncate(m.hint, contentWidth, "…")))
+	}
+
+	state := frame.StyleState{
+		Title:  m.styles.Title,
+		Muted:  m.styles.Hint,
+		Filter: m.styles.Title,
+		Border: m.styles.Border,
+	}
+	return frame.New(
+		frame.WithStyles(frame.Styles{Focused: state, Blurred: state}),
+		frame.WithTitle(m.title),
+		frame.WithTitlePadding(0),
+		frame.WithContent(strings.Join(lines, "\n")),
+		frame.WithSize(m.width, len(lines)+2),
+		frame.WithPadding(1),
+		frame.WithFocused(true),
+	).View()
+}
+
+// Overlay draws the banner over the bottom rows of background, leaving the
+// rest of it visible. It returns background unchanged when there is nothing
+// to show or the banner does not fit.
+func (m Model) Overlay(background string) string {
+	banner := m.View()
+	if banner == "" {
+		return background
+	}
+	backgroundHeight := lipgloss.Height(background)
+	bannerHeight := lipgloss.Height(banner)
+	if bannerHeight > backgroundHeight {
+		return background
+	}
+	return lipgloss.NewCompositor(
+		lipgloss.NewLayer(background),
+		lipgloss.NewLayer(banner).Y(backgroundHeight-bannerHeight).Z(1),
+	).Render()
+}
@@ -0,0 +1,73 @@
+package errorbanner
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestViewHiddenWithoutMessage(t *testing.T) {
+	m := New(WithWidth(40))
+	if m.Visible() || m.View() != "" {
+		t.Fatalf("banner without message rendered %q", m.View())
+	}
+	m.SetMessage("boom")
+	m.SetWidth(2)
+	if m.View() != "" {
+		t.Fatal("banner rendered in a width too narrow for its border")
+	}
+}
+
+func TestViewTruncatesMessage(t *testing.T) {
+	m := New(
+		WithWidth(30),
+		WithHint("r retry · esc dismiss"),
+		WithMessage("read tcp 127.0.0.1:6379:\ni/o timeout while fetching queues"),
+	)
+	lines := strings.Split(ansi.Strip(m.View()), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("banner has %d lines, want 4:\n%s", len(lines), strings.Join(lines, "\n"))
+	}
+	if !strings.Contains(lines[0], "Error") {
+		t.Fatalf("title line = %q", lines[0])
+	}
+	if !strings.Contains(lines[1], "read tcp 127.0.0.1:6379: …") {
+		t.Fatalf("message line = %q", lines[1])
+	}
+	if !strings.Contains(lines[2], "r retry · esc dismiss") {
+		t.Fatalf("hint line = %q", lines[2])
+	}
+	for i, line := range lines {
+		if w := ansi.StringWidth(line); w != 30 {
+			t.Fatalf("line %d is %d cells wide, want 30", i, w)
+		}
+	}
+}
+
+func TestOverlayKeepsBackgroundVisible(t *testing.T) {
+	background := strings.TrimSuffix(strings.Repeat(strings.Repeat("x", 20)+"\n", 6), "\n")
+	m := New(WithWidth(20), WithMessage("boom"))
+
+	lines := strings.Split(ansi.Strip(m.Overlay(background)), "\n")
+	if len(lines) != 6 {
+		t.Fatalf("overlay has %d lines, want 6", len(lines))
+	}
+	for i := range 3 {
+		if lines[i] != strings.Repeat("x", 20) {
+			t.Fatalf("line %d = %q, want the background", i, lines[i])
+		}
+	}
+	if !strings.Contains(lines[4], "boom") {
+		t.Fatalf("line 4 = %q, want the message", lines[4])
+	}
+
+	m.Dismiss()
+	if got := m.Overlay(background); got != background {
+		t.Fatalf("dismissed banner changed the background:\n%s", got)
+	}
+	m.SetMessage("boom")
+	if got := m.Overlay("x\nx"); got != "x\nx" {
+		t.Fatalf("banner taller than the background was drawn:\n%s", got)
+	}
+}
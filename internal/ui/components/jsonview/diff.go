@@ -0,0 +1,222 @@
+package jsonview
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// DiffKind classifies a line of a diff between two values.
+type DiffKind uint8
+
+const (
+	// DiffSame marks a line present in both values.
+	DiffSame DiffKind = iota
+	// DiffAdded marks a line only present in the second value.
+	DiffAdded
+	// DiffRemoved marks a line only present in the first value.
+	DiffRemoved
+	// DiffChanged marks a key whose scalar value differs, shown as "old -> new".
+	DiffChanged
+)
+
+// MaxDiffDepth caps how deep SetDiff descends into nested objects and arrays.
+// Deeper differences are shown as the whole value removed and added.
+const MaxDiffDepth = 8
+
+// diffGutterWidth is the width of the +/-/~ marker column in diff mode.
+const diffGutterWidth = 2
+
+var diffGutters = map[DiffKind]string{
+	DiffSame:    "  ",
+	DiffAdded:   "+ ",
+	DiffRemoved: "- ",
+	DiffChanged: "~ ",
+}
+
+type diffLine struct {
+	kind DiffKind
+	text string
+}
+
+// SetDiff formats a line diff between a and b, descending into objects by
+// key and into arrays by index. Keys are listed in sorted order.
+func (m *Model) SetDiff(a, b any) {
+	m.SetValue(nil)
+
+	lines := diffValue(nil, "", "", a, b, 0)
+	m.lines = make([]string, len(lines))
+	m.kinds = make([]DiffKind, len(lines))
+	for i, line := range lines {
+		m.lines[i] = line.text
+		m.kinds[i] = line.kind
+		m.maxWidth = max(m.maxWidth, ansi.StringWidth(line.text)+diffGutterWidth)
+	}
+	m.tokens = tokenizeJSONLines(strings.Join(m.lines, "\n"))
+	if len(m.tokens) != len(m.lines) {
+		m.tokens = nil
+	}
+}
+
+// DiffCount returns how many lines of the diff have the given kind.
+func (m Model) DiffCount(kind DiffKind) int {
+	count := 0
+	for _, lineKind := range m.kinds {
+		if lineKind == kind {
+			count++
+		}
+	}
+	return count
+}
+
+// LineKind returns the diff kind of the given line, DiffSame outside diff mode.
+func (m Model) LineKind(index int) DiffKind {
+	if index < 0 || index >= len(m.kinds) {
+		return DiffSame
+	}
+	return m.kinds[index]
+}
+
+// renderDiffLine renders a diff line behind its marker. Lines that differ
+// take the style of their kind instead of syntax highlighting.
+func (m Model) renderDiffLine(index, offset, width int) string {
+	kind := m.kinds[index]
+	style := m.diffStyle(kind)
+	gutter := style.Render(ansi.Truncate(diffGutters[kind], width, ""))
+	bodyWidth := width - diffGutterWidth
+	if bodyWidth <= 0 {
+		return gutter
+	}
+
+	styleFor := m.styleForToken
+	if kind != DiffSame {
+		styleFor = func(token) lipgloss.Style { return style }
+	}
+	if len(m.tokens) == len(m.lines) {
+		return gutter + m.renderTokens(m.tokens[index], offset, bodyWidth, styleFor)
+	}
+	return gutter + style.Render(applyHorizontalScroll(m.lines[index], offset, bodyWidth))
+}
+
+func (m Model) diffStyle(kind DiffKind) lipgloss.Style {
+	switch kind {
+	case DiffAdded:
+		return m.styles.Added
+	case DiffRemoved:
+		return m.styles.Removed
+	case DiffChanged:
+		return m.styles.Changed
+	default:
+		return m.styles.Muted
+	}
+}
+
+func diffValue(lines []diffLine, indent, label string, a, b any, depth int) []diffLine {
+	if reflect.DeepEqual(a, b) {
+		return appendDiffValue(lines, DiffSame, indent, label, a)
+	}
+
+	if depth < MaxDiffDepth {
+		switch av := a.(type) {
+		case map[string]any:
+			if bv, ok := b.(map[string]any); ok {
+				return diffObjects(lines, indent, label, av, bv, depth)
+			}
+		case []any:
+			if bv, ok := b.([]any); ok {
+				return diffArrays(lines, indent, label, av, bv, depth)
+			}
+		}
+	}
+
+	if isDiffScalar(a) && isDiffScalar(b) {
+		return append(lines, diffLine{
+			kind: DiffChanged,
+			text: indent + label + compactJSON(a) + " -> " + compactJSON(b),
+		})
+	}
+	lines = appendDiffValue(lines, DiffRemoved, indent, label, a)
+	return appendDiffValue(lines, DiffAdded, indent, label, b)
+}
+
+func diffObjects(lines []diffLine, indent, label string, a, b map[string]any, depth int) []diffLine {
+	keys := make([]string, 0, len(a)+len(b))
+	for key := range a {
+		keys = append(keys, key)
+	}
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+
+	lines = append(lines, diffLine{kind: DiffSame, text: indent + label + "{"})
+	inner := indent + "  "
+	for _, key := range keys {
+		keyLabel := compactJSON(key) + ": "
+		av, inA := a[key]
+		bv, inB := b[key]
+		switch {
+		case inA && inB:
+			lines = diffValue(lines, inner, keyLabel, av, bv, depth+1)
+		case inA:
+			lines = appendDiffValue(lines, DiffRemoved, inner, keyLabel, av)
+		default:
+			lines = appendDiffValue(lines, DiffAdded, inner, keyLabel, bv)
+		}
+	}
+	return append(lines, diffLine{kind: DiffSame, text: indent + "}"})
+}
+
+func diffArrays(lines []diffLine, indent, label string, a, b []any, depth int) []diffLine {
+	lines = append(lines, diffLine{kind: DiffSame, text: indent + label + "["})
+	inner := indent + "  "
+	for i := range max(len(a), len(b)) {
+		switch {
+		case i < len(a) && i < len(b):
+			lines = diffValue(lines, inner, "", a[i], b[i], depth+1)
+		case i < len(a):
+			lines = appendDiffValue(lines, DiffRemoved, inner, "", a[i])
+		default:
+			lines = appendDiffValue(lines, DiffAdded, inner, "", b[i])
+		}
+	}
+	return append(lines, diffLine{kind: DiffSame, text: indent + "]"})
+}
+
+// appendDiffValue adds value formatted as indented JSON, one line per row.
+func appendDiffValue(lines []diffLine, kind DiffKind, indent, label string, value any) []diffLine {
+	formatted, err := json.MarshalIndent(value, indent, "  ")
+	if err != nil {
+		return append(lines, diffLine{kind: kind, text: indent + label + "Error formatting JSON"})
+	}
+	for i, text := range strings.Split(string(formatted), "\n") {
+		if i == 0 {
+			text = indent + label + text
+		}
+		lines = append(lines, diffLine{kind: kind, text: text})
+	}
+	return lines
+}
+
+func isDiffScalar(value any) bool {
+	switch value.(type) {
+	case map[string]any, []any:
+		return false
+	default:
+		return true
+	}
+}
+
+func compactJSON(value any) string {
+	formatted, err := json.Marshal(value)
+	if err != nil {
+		return "?"
+	}
+	return string(formatted)
+}
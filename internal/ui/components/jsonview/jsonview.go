@@ -3,6 +3,7 @@ package jsonview
 
 import (
 	"encoding/json"
+	"strconv"
 	"strings"
 
 	"charm.land/lipgloss/v2"
@@ -22,6 +23,9 @@ type Styles struct {
 	Null        lipgloss.Style
 	Punctuation lipgloss.Style
 	Muted       lipgloss.Style
+	Added       lipgloss.Style
+	Removed     lipgloss.Style
+	Changed     lipgloss.Style
 }
 
 // DefaultStyles returns default styles.
@@ -35,6 +39,9 @@ func DefaultStyles() Styles {
 		Null:        lipgloss.NewStyle(),
 		Punctuation: lipgloss.NewStyle(),
 		Muted:       lipgloss.NewStyle(),
+		Added:       lipgloss.NewStyle(),
+		Removed:     lipgloss.NewStyle(),
+		Changed:     lipgloss.NewStyle(),
 	}
 }
 
@@ -46,6 +53,8 @@ type Model struct {
 
 	lines    []string
 	tokens   [][]token
+	paths    []string
+	kinds    []DiffKind // Set in diff mode, one per line
 	maxWidth int
 }
 
@@ -132,6 +141,8 @@ func (m Model) MaxWidth() int {
 func (m *Model) SetValue(value any) {
 	m.lines = nil
 	m.tokens = nil
+	m.paths = nil
+	m.kinds = nil
 	m.maxWidth = 0
 
 	if value == nil {
@@ -150,6 +161,7 @@ func (m *Model) SetValue(value any) {
 	if len(m.tokens) != len(m.lines) {
 		m.tokens = nil
 	}
+	m.paths = linePaths(m.lines)
 
 	for _, line := range m.lines {
 		if len(line) > m.maxWidth {
@@ -158,6 +170,35 @@ func (m *Model) SetValue(value any) {
 	}
 }
 
+// Path returns the JSON path from the root to the node on the given line,
+// such as args[0].arguments[2]. Closing brackets belong to their container.
+// The root and out-of-range lines return "".
+func (m Model) Path(index int) string {
+	if index < 0 || index >= len(m.paths) {
+		return ""
+	}
+	return m.paths[index]
+}
+
+// StringValue returns the decoded string value on the given line, such as
+// "abc" for `"key": "abc",`. Lines without a string value return false.
+func (m Model) StringValue(index int) (string, bool) {
+	if index < 0 || index >= len(m.tokens) {
+		return "", false
+	}
+	for _, token := range m.tokens[index] {
+		if token.kind != tokenString {
+			continue
+		}
+		var value string
+		if err := json.Unmarshal([]byte(token.value), &value); err != nil {
+			return "", false
+		}
+		return value, true
+	}
+	return "", false
+}
+
 // RenderLine renders a single line with horizontal scroll and syntax highlighting.
 func (m Model) RenderLine(index, offset, width int) string {
 	if width <= 0 {
@@ -166,15 +207,18 @@ func (m Model) RenderLine(index, offset, width int) string {
 	if index < 0 || index >= len(m.lines) {
 		return ""
 	}
+	if m.kinds != nil {
+		return m.renderDiffLine(index, offset, width)
+	}
 	if len(m.tokens) == len(m.lines) {
-		return m.renderTokens(m.tokens[index], offset, width)
+		return m.renderTokens(m.tokens[index], offset, width, m.styleForToken)
 	}
 
 	line := applyHorizontalScroll(m.lines[index], offset, width)
 	return m.styles.Text.Render(line)
 }
 
-func (m Model) renderTokens(tokens []token, offset, width int) string {
+func (m Model) renderTokens(tokens []token, offset, width int, styleFor func(token) lipgloss.Style) string {
 	if width <= 0 {
 		return ""
 	}
@@ -198,7 +242,7 @@ func (m Model) renderTokens(tokens []token, offset, width int) string {
 			stop := mathutil.Clamp(end-tokenStart, 0, tokenWidth)
 			segment := ansi.Cut(token.value, start, stop)
 			if segment != "" {
-				builder.WriteString(m.styleForToken(token).Render(segment))
+				builder.WriteString(styleFor(token).Render(segment))
 			}
 		}
 
@@ -236,6 +280,74 @@ func (m Model) styleForToken(token token) lipgloss.Style {
 	}
 }
 
+type pathFrame struct {
+	path  string
+	array bool
+	index int
+}
+
+// linePaths computes the structural path of every line produced by
+// json.MarshalIndent, tracking open containers as the lines are walked.
+func linePaths(lines []string) []string {
+	paths := make([]string, len(lines))
+	var stack []pathFrame
+
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			continue
+		}
+		if trimmed[0] == '}' || trimmed[0] == ']' {
+			if len(stack) > 0 {
+				paths[i] = stack[len(stack)-1].path
+				stack = stack[:len(stack)-1]
+			}
+			continue
+		}
+
+		path := ""
+		value := trimmed
+		if len(stack) > 0 {
+			parent := &stack[len(stack)-1]
+			if parent.array {
+				path = parent.path + "[" + strconv.Itoa(parent.index) + "]"
+				parent.index++
+			} else {
+				end := parseJSONString(trimmed, 0)
+				var key string
+				if err := json.Unmarshal([]byte(trimmed[:end]), &key); err != nil {
+					key = trimmed[1:max(end-1, 1)]
+				}
+				path = joinKeyPath(parent.path, key)
+				value = strings.TrimSpace(strings.TrimPrefix(trimmed[end:], ":"))
+			}
+		}
+		paths[i] = path
+
+		switch value {
+		case "{":
+			stack = append(stack, pathFrame{path: path})
+		case "[":
+			stack = append(stack, pathFrame{path: path, array: true})
+		}
+	}
+
+	return paths
+}
+
+// joinKeyPath appends an object key as .key, quoting keys that would not
+// read back as a single segment.
+func joinKeyPath(parent, key string) string {
+	segment := key
+	if key == "" || strings.ContainsAny(key, ".[]\" \t") {
+		segment = strconv.Quote(key)
+	}
+	if parent == "" {
+		return segment
+	}
+	return parent + "." + segment
+}
+
 func applyHorizontalScroll(line string, offset, visibleWidth int) string {
 	return display.HorizontalScroll(line, offset, visibleWidth)
 }
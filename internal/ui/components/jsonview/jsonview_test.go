@@ -186,6 +186,92 @@ func equalStrings(a, b []string) bool {
 	return true
 }
 
+func TestPathTracksStructure(t *testing.T) {
+	m := New()
+	m.SetValue(map[string]any{
+		"args": []any{
+			map[string]any{"arguments": []any{1, 2, []any{}}},
+		},
+		"a.b":   map[string]any{"c": true},
+		"empty": map[string]any{},
+	})
+
+	// Keys are sorted by json.MarshalIndent:
+	// {
+	//   "a.b": {
+	//     "c": true
+	//   },
+	//   "args": [
+	//     {
+	//       "arguments": [
+	//         1,
+	//         2,
+	//         []
+	//       ]
+	//     }
+	//   ],
+	//   "empty": {}
+	// }
+	tests := map[string]struct {
+		line int
+		want string
+	}{
+		"root":              {line: 0, want: ""},
+		"quoted key":        {line: 1, want: `"a.b"`},
+		"nested quoted key": {line: 2, want: `"a.b".c`},
+		"closing brace":     {line: 3, want: `"a.b"`},
+		"array":             {line: 4, want: "args"},
+		"array element":     {line: 5, want: "args[0]"},
+		"nested array":      {line: 6, want: "args[0].arguments"},
+		"nested element":    {line: 9, want: "args[0].arguments[2]"},
+		"empty object":      {line: 13, want: "empty"},
+		"root closing":      {line: 14, want: ""},
+		"out of range":      {line: 99, want: ""},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := m.Path(tc.line); got != tc.want {
+				t.Fatalf("Path(%d) = %q, want %q", tc.line, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestStringValue(t *testing.T) {
+	m := New()
+	m.SetValue(map[string]any{
+		"args":  []any{"eJw=", 42},
+		"quote": `say "hi"`,
+	})
+
+	// {
+	//   "args": [
+	//     "eJw=",
+	//     42
+	//   ],
+	//   "quote": "say \"hi\""
+	// }
+	tests := []struct {
+		line int
+		want string
+		ok   bool
+	}{
+		{line: 0},
+		{line: 1},
+		{line: 2, want: "eJw=", ok: true},
+		{line: 3},
+		{line: 5, want: `say "hi"`, ok: true},
+		{line: 99},
+	}
+	for _, tc := range tests {
+		got, ok := m.StringValue(tc.line)
+		if got != tc.want || ok != tc.ok {
+			t.Fatalf("StringValue(%d) = %q, %v; want %q, %v", tc.line, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
 func TestGoldenJSONView(t *testing.T) {
 	payload := samplePayload{
 		Name:   "job",
@@ -215,3 +301,71 @@ func TestGoldenJSONViewHorizontalScroll(t *testing.T) {
 	output := ansi.Strip(renderAll(m, 6, 24))
 	golden.RequireEqual(t, []byte(output))
 }
+
+func TestSetDiff(t *testing.T) {
+	a := map[string]any{
+		"jid":         "a1",
+		"queue":       "default",
+		"retry_count": float64(2),
+		"args":        []any{"x", float64(1)},
+		"error_class": "Timeout",
+	}
+	b := map[string]any{
+		"jid":         "a1",
+		"queue":       "critical",
+		"retry_count": float64(2),
+		"args":        []any{"x", float64(1), true},
+		"retried_at":  float64(1700000000),
+	}
+
+	m := New()
+	m.SetDiff(a, b)
+
+	want := strings.Join([]string{
+		"  {",
+		"    \"args\": [",
+		"      \"x\"",
+		"      1",
+		"+     true",
+		"    ]",
+		"-   \"error_class\": \"Timeout\"",
+		"    \"jid\": \"a1\"",
+		"~   \"queue\": \"default\" -> \"critical\"",
+		"+   \"retried_at\": 1700000000",
+		"    \"retry_count\": 2",
+		"  }",
+	}, "\n")
+	if got := normalizeTrailing(ansi.Strip(renderAll(m, 0, 60))); got != want {
+		t.Fatalf("diff =\n%s\nwant\n%s", got, want)
+	}
+	if m.DiffCount(DiffAdded) != 2 || m.DiffCount(DiffRemoved) != 1 || m.DiffCount(DiffChanged) != 1 {
+		t.Fatalf("counts = +%d -%d ~%d, want +2 -1 ~1",
+			m.DiffCount(DiffAdded), m.DiffCount(DiffRemoved), m.DiffCount(DiffChanged))
+	}
+}
+
+func TestSetDiffCapsDepth(t *testing.T) {
+	nested := func(leaf any) any {
+		value := leaf
+		for range MaxDiffDepth + 2 {
+			value = map[string]any{"n": value}
+		}
+		return value
+	}
+
+	m := New()
+	m.SetDiff(nested("a"), nested("b"))
+
+	if m.DiffCount(DiffChanged) != 0 || m.DiffCount(DiffRemoved) == 0 || m.DiffCount(DiffAdded) == 0 {
+		t.Fatalf("counts = +%d -%d ~%d, want the capped subtree removed and added",
+			m.DiffCount(DiffAdded), m.DiffCount(DiffRemoved), m.DiffCount(DiffChanged))
+	}
+}
+
+func normalizeTrailing(s string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " ")
+	}
+	return strings.Join(lines, "\n")
+}
@@ -55,6 +55,7 @@ type Model struct {
 	spinner          spinner.Model
 	windowPages      int
 	fallbackPageSize int
+	minPageSize      int
 	pageSize         int
 	windowSize       int
 	windowStart      int
@@ -126,6 +127,17 @@ func WithFallbackPageSize(size int) Option {
 	}
 }
 
+// SetPaging updates how many pages to keep in memory and the minimum page
+// size. Pages follow the table height but never drop below minPageSize, which
+// also replaces the fallback page size used until the height is known.
+func (m *Model) SetPaging(windowPages, minPageSize int) {
+	m.windowPages = windowPages
+	m.fallbackPageSize = minPageSize
+	m.minPageSize = minPageSize
+	m.pageSize = max(m.table.ViewportHeight(), m.minPageSize)
+	m.ensurePagingDefaults()
+}
+
 // SetFetcher updates the fetcher.
 func (m *Model) SetFetcher(fetcher Fetcher) {
 	m.fetcher = fetcher
@@ -237,6 +249,9 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 		return m, cmd
 
 	case tea.KeyPressMsg:
+		if m.table.JumpActive() {
+			return m, m.updateJumpEntry(msg)
+		}
 		if handled, cmd := m.handleJump(msg); handled {
 			return m, cmd
 		}
@@ -333,6 +348,51 @@ func (m Model) Range() (int, int, int64) {
 	return start, end, m.totalSize
 }
 
+// JumpToRow selects a 1-based absolute row number, clamped to the total.
+// When the row lies outside the loaded window, the window containing it is
+// requested and the cursor lands on the row once it arrives.
+func (m *Model) JumpToRow(row int) tea.Cmd {
+	if m.totalSize <= 0 {
+		m.table.JumpTo(row)
+		m.syncScrollbar()
+		return nil
+	}
+
+	abs := mathutil.Clamp(row-1, 0, int(m.totalSize)-1)
+	rows := m.table.Rows()
+	if abs >= m.windowStart && abs < m.windowStart+len(rows) {
+		m.table.SetCursor(abs - m.windowStart)
+		m.syncScrollbar()
+		return m.maybePrefetch()
+	}
+
+	windowSize := m.effectiveWindowSize()
+	maxStart := max(int(m.totalSize)-windowSize, 0)
+	start := mathutil.Clamp(abs-windowSize/2, 0, maxStart)
+	cmd := m.RequestWindow(start, CursorKeep)
+	m.anchor = anchorState{
+		abs:          abs,
+		screenOffset: m.table.ViewportHeight() / 2,
+		pending:      true,
+	}
+	return cmd
+}
+
+// updateJumpEntry commits the row number entry against the absolute total
+// instead of the loaded window; other keys edit the entry.
+func (m *Model) updateJumpEntry(msg tea.KeyPressMsg) tea.Cmd {
+	if msg.String() != "enter" {
+		m.table, _ = m.table.Update(msg)
+		return nil
+	}
+	row, ok := m.table.JumpValue()
+	m.table.CancelJump()
+	if !ok {
+		return nil
+	}
+	return m.JumpToRow(row)
+}
+
 func (m *Model) handleJump(msg tea.KeyPressMsg) (bool, tea.Cmd) {
 	if key.Matches(msg, m.table.KeyMap.GotoTop) {
 		return true, m.jumpToStart()
@@ -418,7 +478,7 @@ func (m *Model) ensurePagingDefaults() {
 }
 
 func (m *Model) updatePaging() {
-	pageSize := max(m.table.ViewportHeight(), 1)
+	pageSize := max(m.table.ViewportHeight(), m.minPageSize, 1)
 	if pageSize == m.pageSize {
 		return
 	}
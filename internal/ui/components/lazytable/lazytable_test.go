@@ -2,6 +2,7 @@ package lazytable
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -187,3 +188,79 @@ func TestLazyTableRequestWindowCancelsSupersededFetch(t *testing.T) {
 		t.Fatal("active request did not finish after cancellation")
 	}
 }
+
+func TestLazyTableJumpToRow(t *testing.T) {
+	rows := []table.Row{
+		tableRow("row-1", "one", "two"),
+		tableRow("row-2", "three", "four"),
+		tableRow("row-3", "five", "six"),
+	}
+
+	t.Run("WithinWindow", func(t *testing.T) {
+		m := newTestModel()
+		m.SetSize(10, 5)
+		m.RequestWindow(0, CursorStart)
+		m, _ = m.Update(DataMsg{RequestID: m.RequestID(), Result: FetchResult{Rows: rows, Total: 100, WindowStart: 0}})
+
+		requestID := m.RequestID()
+		m.JumpToRow(3)
+		if got := m.Table().Cursor(); got != 2 {
+			t.Fatalf("cursor = %d, want 2", got)
+		}
+		if m.RequestID() != requestID {
+			t.Fatal("expected no window request for a loaded row")
+		}
+	})
+
+	t.Run("OutsideWindow", func(t *testing.T) {
+		m := newTestModel()
+		m.SetSize(10, 5)
+		m.RequestWindow(0, CursorStart)
+		m, _ = m.Update(DataMsg{RequestID: m.RequestID(), Result: FetchResult{Rows: rows, Total: 100, WindowStart: 0}})
+
+		if cmd := m.JumpToRow(51); cmd == nil {
+			t.Fatal("expected window request for an unloaded row")
+		}
+
+		window := make([]table.Row, 0, 3)
+		for i := range 3 {
+			window = append(window, tableRow(fmt.Sprintf("row-%d", 50+i), "x", "y"))
+		}
+		m, _ = m.Update(DataMsg{RequestID: m.RequestID(), Result: FetchResult{Rows: window, Total: 100, WindowStart: 49}})
+
+		if got := m.Table().Cursor(); got != 1 {
+			t.Fatalf("cursor = %d, want 1 (row 51)", got)
+		}
+	})
+
+	t.Run("ClampsToTotal", func(t *testing.T) {
+		m := newTestModel()
+		m.SetSize(10, 5)
+		m.RequestWindow(0, CursorStart)
+		m, _ = m.Update(DataMsg{RequestID: m.RequestID(), Result: FetchResult{Rows: rows, Total: 3, WindowStart: 0}})
+
+		m.JumpToRow(99)
+		if got := m.Table().Cursor(); got != 2 {
+			t.Fatalf("cursor = %d, want 2", got)
+		}
+	})
+}
+
+func TestLazyTableSetPagingKeepsMinimumPageSize(t *testing.T) {
+	m := newTestModel()
+	m.SetSize(20, 8)
+	small := m.WindowSize()
+	if small >= 3*50 {
+		t.Fatalf("WindowSize() = %d before SetPaging, want the table height times 3", small)
+	}
+
+	m.SetPaging(2, 50)
+	if got := m.WindowSize(); got != 2*50 {
+		t.Fatalf("WindowSize() = %d with a short table, want %d", got, 2*50)
+	}
+
+	m.SetSize(20, 80)
+	if got, want := m.WindowSize(), 2*m.Table().ViewportHeight(); got != want || want <= 2*50 {
+		t.Fatalf("WindowSize() = %d with a tall table, want %d", got, want)
+	}
+}
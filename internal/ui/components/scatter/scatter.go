@@ -17,19 +17,21 @@ import (
 
 // Styles holds the visual styles for the scatter plot.
 type Styles struct {
-	Axis  lipgloss.Style // Style for chart axes
-	Label lipgloss.Style // Style for axis labels
-	Point lipgloss.Style // Style for scatter points
-	Muted lipgloss.Style // Style for secondary text
+	Axis    lipgloss.Style // Style for chart axes
+	Label   lipgloss.Style // Style for axis labels
+	Point   lipgloss.Style // Style for scatter points
+	Muted   lipgloss.Style // Style for secondary text
+	Overlay lipgloss.Style // Style for the overlay line
 }
 
 // DefaultStyles returns sensible default styles.
 func DefaultStyles() Styles {
 	return Styles{
-		Axis:  lipgloss.NewStyle(),
-		Label: lipgloss.NewStyle(),
-		Point: lipgloss.NewStyle(),
-		Muted: lipgloss.NewStyle(),
+		Axis:    lipgloss.NewStyle(),
+		Label:   lipgloss.NewStyle(),
+		Point:   lipgloss.NewStyle(),
+		Muted:   lipgloss.NewStyle(),
+		Overlay: lipgloss.NewStyle(),
 	}
 }
 
@@ -43,6 +45,10 @@ type Model struct {
 	yLabels      []string
 	maxCount     int64
 	maxBucket    int
+	overlay      []float64
+	cursor       int
+	raw          bool
+	offset       int
 	emptyMessage string
 }
 
@@ -53,6 +59,7 @@ type Option func(*Model)
 func New(opts ...Option) Model {
 	m := Model{
 		styles: DefaultStyles(),
+		cursor: -1,
 	}
 	for _, opt := range opts {
 		opt(&m)
@@ -81,6 +88,31 @@ func WithData(points []charts.ScatterPoint, timeBuckets []time.Time, yLabels []s
 	}
 }
 
+// WithOverlay sets a line of values in [0, 1], one per time bucket, drawn
+// across the full height of the plot. NaN values leave gaps in the line.
+func WithOverlay(values []float64) Option {
+	return func(m *Model) { m.overlay = values }
+}
+
+// WithCursor marks the time bucket at index with a vertical line; a negative
+// index hides the cursor.
+func WithCursor(index int) Option {
+	return func(m *Model) { m.cursor = index }
+}
+
+// WithRawResolution gives every time bucket its own column instead of
+// fitting all of them to the width. Buckets that don't fit are scrolled out
+// of view, see WithOffset.
+func WithRawResolution(raw bool) Option {
+	return func(m *Model) { m.raw = raw }
+}
+
+// WithOffset scrolls a raw resolution plot back by offset time buckets; 0
+// shows the most recent ones. Offsets past the oldest bucket are clamped.
+func WithOffset(offset int) Option {
+	return func(m *Model) { m.offset = offset }
+}
+
 // WithEmptyMessage sets the message to display when there's no data.
 func WithEmptyMessage(msg string) Option {
 	return func(m *Model) { m.emptyMessage = msg }
@@ -121,6 +153,27 @@ func (m Model) Height() int {
 	return m.height
 }
 
+// VisibleBuckets returns how many time buckets are shown at once: all of
+// them, unless raw resolution is on and they don't fit the width.
+func (m Model) VisibleBuckets() int {
+	if !m.raw {
+		return len(m.timeBuckets)
+	}
+	graphWidth := max(m.width-(charts.MaxLabelWidthFromSlice(m.axisLabels())+1), 1)
+	return min(len(m.timeBuckets), graphWidth)
+}
+
+// ClampOffset limits offset to the range WithOffset can scroll to.
+func (m Model) ClampOffset(offset int) int {
+	return mathutil.Clamp(offset, 0, len(m.timeBuckets)-m.VisibleBuckets())
+}
+
+// Window returns the range [start, end) of time buckets shown.
+func (m Model) Window() (int, int) {
+	end := len(m.timeBuckets) - m.ClampOffset(m.offset)
+	return end - m.VisibleBuckets(), end
+}
+
 // View renders the scatter plot to a string.
 func (m Model) View() string {
 	if m.width < 2 || m.height < 2 {
@@ -136,10 +189,8 @@ func (m Model) View() string {
 		return empty()
 	}
 
-	labels := m.yLabels
-	if m.maxBucket >= 0 && m.maxBucket < len(labels)-1 {
-		labels = labels[:m.maxBucket+1]
-	}
+	m = m.windowed()
+	labels := m.axisLabels()
 
 	minX := 0.0
 	maxX := float64(max(len(m.timeBuckets)-1, 1))
@@ -168,6 +219,8 @@ func (m Model) View() string {
 		}),
 	)
 	lc.DrawXYAxisAndLabel()
+	m.drawCursor(&lc, maxY)
+	m.drawOverlay(&lc, maxY)
 
 	// Sort points by count for proper rendering order (smaller points first)
 	sortedPoints := make([]charts.ScatterPoint, len(m.points))
@@ -197,6 +250,75 @@ func (m Model) View() string {
 	return strings.Join(chartLines, "\n")
 }
 
+// axisLabels returns the Y axis labels up to the highest bucket with data.
+func (m Model) axisLabels() []string {
+	labels := m.yLabels
+	if m.maxBucket >= 0 && m.maxBucket < len(labels)-1 {
+		labels = labels[:m.maxBucket+1]
+	}
+	return labels
+}
+
+// windowed returns a copy of the model holding only the time buckets in
+// Window, with points, overlay, and cursor shifted to match.
+func (m Model) windowed() Model {
+	start, end := m.Window()
+	if start == 0 && end == len(m.timeBuckets) {
+		return m
+	}
+	points := make([]charts.ScatterPoint, 0, len(m.points))
+	for _, point := range m.points {
+		x := int(point.X)
+		if x < start || x >= end {
+			continue
+		}
+		point.X -= float64(start)
+		points = append(points, point)
+	}
+	m.points = points
+	m.timeBuckets = m.timeBuckets[start:end]
+	if len(m.overlay) > start {
+		m.overlay = m.overlay[start:]
+	} else {
+		m.overlay = nil
+	}
+	if m.cursor >= 0 {
+		m.cursor -= start
+	}
+	return m
+}
+
+// drawCursor draws the selected time bucket as a vertical line beneath the
+// overlay and points.
+func (m Model) drawCursor(lc *linechart.Model, maxY float64) {
+	if m.cursor < 0 || m.cursor >= len(m.timeBuckets) {
+		return
+	}
+	x := float64(m.cursor)
+	lc.DrawBrailleLineWithStyle(canvas.Float64Point{X: x, Y: 0}, canvas.Float64Point{X: x, Y: maxY}, m.styles.Muted)
+}
+
+// drawOverlay plots the overlay line beneath the points.
+func (m Model) drawOverlay(lc *linechart.Model, maxY float64) {
+	var prev *canvas.Float64Point
+	for i, value := range m.overlay {
+		if i >= len(m.timeBuckets) {
+			break
+		}
+		if math.IsNaN(value) {
+			prev = nil
+			continue
+		}
+		point := canvas.Float64Point{X: float64(i), Y: mathutil.Clamp(value, 0, 1) * maxY}
+		from := point
+		if prev != nil {
+			from = *prev
+		}
+		lc.DrawBrailleLineWithStyle(from, point, m.styles.Overlay)
+		prev = &point
+	}
+}
+
 // scatterRune returns a rune representing point density on a scatter plot.
 // Uses logarithmic scaling to show magnitude differences.
 func scatterRune(count, maxCount int64) rune {
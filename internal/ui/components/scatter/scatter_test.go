@@ -1,6 +1,7 @@
 package scatter
 
 import (
+	"math"
 	"strings"
 	"testing"
 	"time"
@@ -111,3 +112,96 @@ func TestGoldenScatter(t *testing.T) {
 	output := ansi.Strip(m.View())
 	golden.RequireEqual(t, []byte(output))
 }
+
+func TestGoldenScatterOverlay(t *testing.T) {
+	buckets := []time.Time{
+		time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 1, 12, 5, 0, 0, time.UTC),
+		time.Date(2024, 1, 1, 12, 10, 0, 0, time.UTC),
+		time.Date(2024, 1, 1, 12, 15, 0, 0, time.UTC),
+		time.Date(2024, 1, 1, 12, 20, 0, 0, time.UTC),
+	}
+	points := []charts.ScatterPoint{
+		{X: 0, Y: 0, Count: 1},
+		{X: 1, Y: 1, Count: 3},
+		{X: 3, Y: 2, Count: 6},
+		{X: 4, Y: 0, Count: 10},
+	}
+
+	m := New(
+		WithSize(40, 8),
+		WithData(points, buckets, []string{"0", "1", "2"}, 10, 2),
+		WithOverlay([]float64{0, 0.5, math.NaN(), 1, 0.25}),
+		WithEmptyMessage("no data"),
+	)
+	output := ansi.Strip(m.View())
+	golden.RequireEqual(t, []byte(output))
+}
+
+func TestGoldenScatterCursor(t *testing.T) {
+	buckets := []time.Time{
+		time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 1, 12, 5, 0, 0, time.UTC),
+		time.Date(2024, 1, 1, 12, 10, 0, 0, time.UTC),
+		time.Date(2024, 1, 1, 12, 15, 0, 0, time.UTC),
+	}
+	points := []charts.ScatterPoint{
+		{X: 0, Y: 0, Count: 1},
+		{X: 1, Y: 1, Count: 3},
+		{X: 3, Y: 0, Count: 10},
+	}
+
+	m := New(
+		WithSize(40, 6),
+		WithData(points, buckets, []string{"0", "1", "2"}, 10, 2),
+		WithCursor(2),
+		WithEmptyMessage("no data"),
+	)
+	output := ansi.Strip(m.View())
+	golden.RequireEqual(t, []byte(output))
+}
+
+func TestRawResolutionWindow(t *testing.T) {
+	base := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	buckets := make([]time.Time, 100)
+	points := make([]charts.ScatterPoint, 0, len(buckets))
+	for i := range buckets {
+		buckets[i] = base.Add(time.Duration(i) * time.Minute)
+		points = append(points, charts.ScatterPoint{X: float64(i), Y: 0, Count: 1})
+	}
+	// The label "0" and the axis leave 18 columns, one per minute.
+	opts := []Option{
+		WithSize(20, 6),
+		WithData(points, buckets, []string{"0"}, 1, 0),
+	}
+
+	fit := New(opts...)
+	if got := fit.VisibleBuckets(); got != len(buckets) {
+		t.Fatalf("fit to width shows %d buckets, want %d", got, len(buckets))
+	}
+
+	tests := map[string]struct {
+		offset    int
+		wantStart int
+		wantEnd   int
+	}{
+		"latest":   {offset: 0, wantStart: 82, wantEnd: 100},
+		"scrolled": {offset: 30, wantStart: 52, wantEnd: 70},
+		"oldest":   {offset: 500, wantStart: 0, wantEnd: 18},
+		"negative": {offset: -5, wantStart: 82, wantEnd: 100},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			m := New(append(opts, WithRawResolution(true), WithOffset(tc.offset))...)
+			start, end := m.Window()
+			if start != tc.wantStart || end != tc.wantEnd {
+				t.Fatalf("window = [%d, %d), want [%d, %d)", start, end, tc.wantStart, tc.wantEnd)
+			}
+			for i, line := range strings.Split(ansi.Strip(m.View()), "\n") {
+				if w := ansi.StringWidth(line); w > 20 {
+					t.Fatalf("line %d: expected width <= 20, got %d", i, w)
+				}
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@
 package stats
 
 import (
+	"strconv"
 	"strings"
 	"time"
 
@@ -21,7 +22,9 @@ type Data struct {
 	Retries   int64
 	Scheduled int64
 	Dead      int64
-	UpdatedAt time.Time
+	// QueueSizes maps queue names to their sizes.
+	QueueSizes map[string]int64
+	UpdatedAt  time.Time
 }
 
 // UpdateMsg is sent when metrics should be updated.
@@ -52,6 +55,7 @@ type Model struct {
 	styles Styles
 	data   Data
 	width  int
+	db     int
 }
 
 // Option is used to set options in New.
@@ -84,6 +88,13 @@ func WithWidth(w int) Option {
 	}
 }
 
+// WithDB sets the Redis database shown in the bar.
+func WithDB(db int) Option {
+	return func(m *Model) {
+		m.db = db
+	}
+}
+
 // WithData sets the initial data.
 func WithData(d Data) Option {
 	return func(m *Model) {
@@ -106,6 +117,12 @@ func (m *Model) SetData(d Data) {
 	m.data = d
 }
 
+// SetDB sets the Redis database shown first in the bar. Database 0, the
+// default, is not shown.
+func (m *Model) SetDB(db int) {
+	m.db = db
+}
+
 // Width returns the current width.
 func (m Model) Width() int {
 	return m.width
@@ -149,6 +166,10 @@ func (m Model) View() string {
 		m.styles.Label.Render("Dead: ") + m.styles.Value.Render(display.ShortNumber(m.data.Dead)),
 	}
 
+	if m.db > 0 {
+		baseMetrics = append([]string{m.styles.Label.Render("DB: ") + m.styles.Value.Render(strconv.Itoa(m.db))}, baseMetrics...)
+	}
+
 	if m.width <= 0 || len(baseMetrics) == 0 {
 		return barStyle.Render("")
 	}
@@ -3,6 +3,7 @@ package table
 
 import (
 	"slices"
+	"strconv"
 	"strings"
 
 	"charm.land/bubbles/v2/key"
@@ -59,6 +60,7 @@ type KeyMap struct {
 	ScrollRight key.Binding
 	Home        key.Binding
 	End         key.Binding
+	JumpToRow   key.Binding
 }
 
 // DefaultKeyMap returns a default set of keybindings.
@@ -104,9 +106,16 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("end", "$"),
 			key.WithHelp("end/$", "scroll to end"),
 		),
+		JumpToRow: key.NewBinding(
+			key.WithKeys(":"),
+			key.WithHelp(":", "jump to row"),
+		),
 	}
 }
 
+// maxJumpDigits bounds the row number entry.
+const maxJumpDigits = 9
+
 // Styles holds the styles needed by the table.
 type Styles struct {
 	Text           lipgloss.Style
@@ -156,6 +165,8 @@ type Model struct {
 	scrollbarTotal    int
 	scrollbarOffset   int
 	scrollbarHeader   []string
+	jumpActive        bool
+	jumpInput         string
 }
 
 // Option is used to set options in New.
@@ -395,11 +406,54 @@ func (m Model) RowCount() int {
 	return len(m.rows)
 }
 
+// JumpActive reports whether the row number entry is open.
+func (m Model) JumpActive() bool {
+	return m.jumpActive
+}
+
+// JumpValue returns the 1-based row number typed into the entry.
+func (m Model) JumpValue() (int, bool) {
+	if m.jumpInput == "" {
+		return 0, false
+	}
+	n, err := strconv.Atoi(m.jumpInput)
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
+
+// StartJump opens the row number entry.
+func (m *Model) StartJump() {
+	m.jumpActive = true
+	m.jumpInput = ""
+}
+
+// CancelJump closes the row number entry without moving the cursor.
+func (m *Model) CancelJump() {
+	m.jumpActive = false
+	m.jumpInput = ""
+}
+
+// JumpTo moves the selection to a 1-based row number, clamped to the rows.
+func (m *Model) JumpTo(row int) {
+	if len(m.rows) == 0 {
+		return
+	}
+	m.SetCursor(row - 1)
+}
+
 // Update handles key messages for navigation and scrolling.
 func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyPressMsg:
+		if m.jumpActive {
+			m.updateJump(msg)
+			return m, nil
+		}
 		switch {
+		case key.Matches(msg, m.KeyMap.JumpToRow):
+			m.StartJump()
 		case key.Matches(msg, m.KeyMap.LineUp):
 			m.MoveUp(1)
 		case key.Matches(msg, m.KeyMap.LineDown):
@@ -425,6 +479,29 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 	return m, nil
 }
 
+// updateJump edits the row number entry. Enter commits, esc cancels, and
+// any other non-digit key is ignored while the entry is open.
+func (m *Model) updateJump(msg tea.KeyPressMsg) {
+	switch msg.String() {
+	case "enter":
+		if row, ok := m.JumpValue(); ok {
+			m.JumpTo(row)
+		}
+		m.CancelJump()
+	case "esc":
+		m.CancelJump()
+	case "backspace":
+		if m.jumpInput != "" {
+			m.jumpInput = m.jumpInput[:len(m.jumpInput)-1]
+		}
+	default:
+		text := msg.Text
+		if len(text) == 1 && text[0] >= '0' && text[0] <= '9' && len(m.jumpInput) < maxJumpDigits {
+			m.jumpInput += text
+		}
+	}
+}
+
 // View renders the table (header + visible rows).
 func (m Model) View() string {
 	headerLines := strings.Split(m.renderHeader(), "\n")
@@ -587,6 +664,10 @@ func (m Model) renderHeader() string {
 	header = applyHorizontalScroll(header, m.xOffset, m.contentWidth())
 	styledHeader := m.styles.Header.Render(header)
 
+	if m.jumpActive {
+		return styledHeader + "\n" + m.renderJumpPrompt()
+	}
+
 	// Separator line (also scrolled)
 	separator := strings.Repeat("─", totalWidth)
 	separator = applyHorizontalScroll(separator, m.xOffset, m.contentWidth())
@@ -594,6 +675,22 @@ func (m Model) renderHeader() string {
 	return styledHeader + "\n" + m.styles.Separator.Render(separator)
 }
 
+// renderJumpPrompt renders the row number entry in place of the separator.
+func (m Model) renderJumpPrompt() string {
+	width := m.contentWidth()
+	prompt := "go to row: " + m.jumpInput + "_"
+	if width > 0 {
+		prompt = ansi.Truncate(prompt, width, "")
+	}
+	rest := ""
+	if pad := width - lipgloss.Width(prompt); pad > 1 {
+		rest = " " + strings.Repeat("─", pad-1)
+	} else if pad == 1 {
+		rest = " "
+	}
+	return m.styles.Header.Render(prompt) + m.styles.Separator.Render(rest)
+}
+
 // renderBody renders all table rows (for scrolling).
 func (m *Model) renderBody() string {
 	if len(m.columns) == 0 {
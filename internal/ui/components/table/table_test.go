@@ -786,3 +786,110 @@ func TestGoldenHeights(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdate_JumpToRow(t *testing.T) {
+	rows := make([]Row, 0, 20)
+	for i := range 20 {
+		rows = append(rows, row(fmt.Sprintf("row-%d", i+1), fmt.Sprintf("%d", i+1)))
+	}
+
+	tests := map[string]struct {
+		keys       []tea.KeyPressMsg
+		wantCursor int
+		wantActive bool
+	}{
+		"Commit": {
+			keys: []tea.KeyPressMsg{
+				{Code: ':', Text: ":"},
+				{Code: '1', Text: "1"},
+				{Code: '2', Text: "2"},
+				{Code: tea.KeyEnter},
+			},
+			wantCursor: 11,
+		},
+		"ClampsToLastRow": {
+			keys: []tea.KeyPressMsg{
+				{Code: ':', Text: ":"},
+				{Code: '9', Text: "9"},
+				{Code: '9', Text: "9"},
+				{Code: tea.KeyEnter},
+			},
+			wantCursor: 19,
+		},
+		"Backspace": {
+			keys: []tea.KeyPressMsg{
+				{Code: ':', Text: ":"},
+				{Code: '5', Text: "5"},
+				{Code: '0', Text: "0"},
+				{Code: tea.KeyBackspace},
+				{Code: tea.KeyEnter},
+			},
+			wantCursor: 4,
+		},
+		"EscCancels": {
+			keys: []tea.KeyPressMsg{
+				{Code: ':', Text: ":"},
+				{Code: '7', Text: "7"},
+				{Code: tea.KeyEsc},
+			},
+			wantCursor: 0,
+		},
+		"IgnoresNonDigits": {
+			keys: []tea.KeyPressMsg{
+				{Code: ':', Text: ":"},
+				{Code: 'j', Text: "j"},
+				{Code: '3', Text: "3"},
+			},
+			wantCursor: 0,
+			wantActive: true,
+		},
+		"EmptyEntryKeepsCursor": {
+			keys: []tea.KeyPressMsg{
+				{Code: ':', Text: ":"},
+				{Code: tea.KeyEnter},
+			},
+			wantCursor: 0,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			m := newTestTable(
+				WithColumns([]Column{{Title: "N", Width: 3}}),
+				WithRows(rows),
+				WithWidth(10),
+				WithHeight(6),
+			)
+			for _, msg := range tc.keys {
+				m, _ = m.Update(msg)
+			}
+			if got := m.Cursor(); got != tc.wantCursor {
+				t.Fatalf("cursor = %d, want %d", got, tc.wantCursor)
+			}
+			if got := m.JumpActive(); got != tc.wantActive {
+				t.Fatalf("JumpActive() = %v, want %v", got, tc.wantActive)
+			}
+		})
+	}
+}
+
+func TestRenderHeader_JumpPrompt(t *testing.T) {
+	m := newTestTable(
+		WithColumns([]Column{{Title: "N", Width: 3}}),
+		WithRows([]Row{row("row-1", "1")}),
+		WithWidth(20),
+		WithHeight(4),
+	)
+	m.StartJump()
+	m, _ = m.Update(tea.KeyPressMsg{Code: '4', Text: "4"})
+
+	header := ansi.Strip(m.renderHeader())
+	if !strings.Contains(header, "go to row: 4_") {
+		t.Fatalf("header = %q, want jump prompt", header)
+	}
+	for i, line := range strings.Split(header, "\n") {
+		if w := ansi.StringWidth(line); w > 20 {
+			t.Fatalf("header line %d width = %d, want <= 20", i, w)
+		}
+	}
+}
@@ -171,7 +171,9 @@ func (m Model) Height() int {
 
 // View renders the timeseries chart to a string.
 func (m Model) View() string {
-	if m.width < 1 || m.height < 1 {
+	// Axes need at least two cells each way, as in the histogram and scatter
+	// charts; smaller sizes show up briefly while the terminal is resized.
+	if m.width < 2 || m.height < 2 {
 		return ""
 	}
 
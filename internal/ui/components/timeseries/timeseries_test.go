@@ -86,6 +86,8 @@ func TestViewDimensions(t *testing.T) {
 	}{
 		"zero width":  {width: 0, height: 5, useSeries: true, wantEmpty: true},
 		"zero height": {width: 10, height: 0, useSeries: true, wantEmpty: true},
+		"one column":  {width: 1, height: 5, useSeries: true, wantEmpty: true},
+		"one row":     {width: 10, height: 1, useSeries: true, wantEmpty: true},
 		"no series":   {width: 20, height: 4, useSeries: false, wantEmpty: false, fullWidth: false},
 		"valid":       {width: 40, height: 6, useSeries: true, wantEmpty: false, fullWidth: true},
 	}
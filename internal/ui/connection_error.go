@@ -0,0 +1,17 @@
+package ui
+
+import "github.com/kpumuk/lazykiq/internal/sidekiq"
+
+func (a App) connectionOptions() sidekiq.ConnectionOptions {
+	if a.sidekiq == nil {
+		return sidekiq.DefaultConnectionOptions()
+	}
+	return a.sidekiq.ConnectionOptions()
+}
+
+func (a App) connectionSummary() string {
+	if a.sidekiq == nil {
+		return ""
+	}
+	return a.sidekiq.ConnectionOptions().String()
+}
@@ -4,6 +4,7 @@ package confirm
 import (
 	"strings"
 
+	"charm.land/bubbles/v2/textinput"
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
 
@@ -65,6 +66,9 @@ type Model struct {
 	col          int
 	padding      int
 	minWidth     int
+	preview      tea.Cmd
+	requiredText string // Text the user must type to confirm; empty for y/n
+	input        textinput.Model
 }
 
 // Option configures the confirmation dialog.
@@ -80,11 +84,15 @@ func New(opts ...Option) *Model {
 		padding:   1,
 		minWidth:  40,
 		selection: SelectionNo,
+		input:     textinput.New(),
 	}
+	m.input.Prompt = ""
+	m.input.Blur()
 
 	for _, opt := range opts {
 		opt(m)
 	}
+	m.applyInputStyles()
 
 	return m
 }
@@ -129,6 +137,14 @@ func WithLabels(yesLabel, noLabel string) Option {
 	}
 }
 
+// WithSelection sets the initially selected button, which enter picks unless
+// the user moves the selection. The "No" button is selected by default.
+func WithSelection(selection Selection) Option {
+	return func(m *Model) {
+		m.selection = selection
+	}
+}
+
 // WithMinWidth sets the minimum dialog width.
 func WithMinWidth(width int) Option {
 	return func(m *Model) {
@@ -136,8 +152,42 @@ func WithMinWidth(width int) Option {
 	}
 }
 
+// WithPreview sets the command ctrl+y runs while the dialog stays open, such
+// as copying the Redis commands the action would run.
+func WithPreview(cmd tea.Cmd) Option {
+	return func(m *Model) {
+		m.preview = cmd
+	}
+}
+
+// WithRequiredText switches the dialog to typed confirmation: the action
+// only runs once the user types text exactly, such as the name of the queue
+// about to be removed, and y/n are typed like any other letter.
+func WithRequiredText(text string) Option {
+	return func(m *Model) {
+		m.requiredText = strings.TrimSpace(text)
+	}
+}
+
 // Init implements dialogs.DialogModel.
-func (m *Model) Init() tea.Cmd { return nil }
+func (m *Model) Init() tea.Cmd {
+	if m.requiredText == "" {
+		return nil
+	}
+	return m.input.Focus()
+}
+
+// InputFocused implements dialogs.InputFocuser. Typed confirmation captures
+// every printable key.
+func (m *Model) InputFocused() bool {
+	return m.requiredText != ""
+}
+
+// Matches reports whether the typed text enables the action. It is always
+// true without a required text.
+func (m *Model) Matches() bool {
+	return m.requiredText == "" || strings.TrimSpace(m.input.Value()) == m.requiredText
+}
 
 // Update handles input and dialog lifecycle.
 func (m *Model) Update(msg tea.Msg) (dialogs.DialogModel, tea.Cmd) {
@@ -148,9 +198,14 @@ func (m *Model) Update(msg tea.Msg) (dialogs.DialogModel, tea.Cmd) {
 		m.applySize()
 		return m, nil
 	case tea.KeyPressMsg:
+		if m.requiredText != "" {
+			return m, m.updateTyped(msg)
+		}
 		switch msg.String() {
 		case "esc":
 			return m, func() tea.Msg { return dialogs.CloseDialogMsg{} }
+		case "ctrl+y":
+			return m, m.preview
 		case "y":
 			return m, tea.Batch(
 				func() tea.Msg { return ActionMsg{Confirmed: true, Target: m.target} },
@@ -182,6 +237,29 @@ func (m *Model) Update(msg tea.Msg) (dialogs.DialogModel, tea.Cmd) {
 	return m, nil
 }
 
+// updateTyped handles keys in typed confirmation mode: enter confirms only
+// when the text matches, esc cancels, and everything else edits the input.
+func (m *Model) updateTyped(msg tea.KeyPressMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc":
+		return func() tea.Msg { return dialogs.CloseDialogMsg{} }
+	case "ctrl+y":
+		return m.preview
+	case "enter":
+		if !m.Matches() {
+			return nil
+		}
+		target := m.target
+		return tea.Batch(
+			func() tea.Msg { return ActionMsg{Confirmed: true, Target: target} },
+			func() tea.Msg { return dialogs.CloseDialogMsg{} },
+		)
+	}
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	return cmd
+}
+
 // View renders the confirmation dialog.
 func (m *Model) View() string {
 	m.applySize()
@@ -193,8 +271,19 @@ func (m *Model) View() string {
 	if message != "" {
 		contentLines = append(contentLines, message, "")
 	}
+	if m.requiredText != "" {
+		contentLines = append(contentLines,
+			m.renderText("Type "+m.requiredText+" to confirm.", contentWidth),
+			lipgloss.NewStyle().Width(contentWidth).MaxWidth(contentWidth).Render(m.input.View()),
+			"",
+		)
+		buttons = m.renderTypedButtons(contentWidth)
+	}
 
 	contentLines = append(contentLines, buttons)
+	if m.preview != nil {
+		contentLines = append(contentLines, centerLine(m.styles.Muted.Render("ctrl+y copy redis commands"), contentWidth))
+	}
 
 	content := strings.Join(contentLines, "\n")
 	box := frame.New(
@@ -252,11 +341,16 @@ func (m *Model) moveSelection(direction int) {
 }
 
 func (m *Model) renderMessage(width int) string {
-	if m.message == "" {
+	return m.renderText(m.message, width)
+}
+
+// renderText centers message within width, wrapping long lines.
+func (m *Model) renderText(message string, width int) string {
+	if message == "" {
 		return ""
 	}
 	style := m.styles.Text.Width(width).MaxWidth(width).Align(lipgloss.Center)
-	lines := strings.Split(m.message, "\n")
+	lines := strings.Split(message, "\n")
 	styled := make([]string, 0, len(lines))
 	for _, line := range lines {
 		if line == "" {
@@ -278,6 +372,16 @@ func (m *Model) renderButtons(width int) string {
 	return centerLine(buttons, width)
 }
 
+// renderTypedButtons shows the confirm label dimmed until the typed text
+// matches; keys do not pick buttons in this mode.
+func (m *Model) renderTypedButtons(width int) string {
+	yes := m.styles.Muted.Render(m.yesLabel)
+	if m.Matches() {
+		yes = m.styles.ButtonYesActive.Render(m.yesLabel)
+	}
+	return centerLine(yes+"  "+m.styles.Muted.Render("esc "+strings.ToLower(m.noLabel)), width)
+}
+
 func (m *Model) renderButton(label string, kind Selection, selected bool) string {
 	style := m.styles.Button
 	if selected {
@@ -315,9 +419,17 @@ func (m *Model) applySize() {
 	contentWidth := max(dialogWidth-2-(m.padding*2), 1)
 	message := m.renderMessage(contentWidth)
 	contentLines := 1
+	if m.preview != nil {
+		contentLines++
+	}
 	if message != "" {
 		contentLines += lipgloss.Height(message) + 1
 	}
+	if m.requiredText != "" {
+		contentLines += lipgloss.Height(m.renderText("Type "+m.requiredText+" to confirm.", contentWidth)) + 2
+		// textinput renders a virtual cursor that adds one extra column.
+		m.input.SetWidth(max(contentWidth-1, 1))
+	}
 
 	dialogHeight := contentLines + 2
 	dialogHeight = max(dialogHeight, 3)
@@ -329,6 +441,15 @@ func (m *Model) applySize() {
 	m.col = max((m.windowWidth-dialogWidth)/2, 0)
 }
 
+func (m *Model) applyInputStyles() {
+	styles := m.input.Styles()
+	styles.Focused.Text = m.styles.Text
+	styles.Focused.Placeholder = m.styles.Muted
+	styles.Blurred.Text = m.styles.Text
+	styles.Blurred.Placeholder = m.styles.Muted
+	m.input.SetStyles(styles)
+}
+
 func centerLine(line string, width int) string {
 	if width <= 0 {
 		return line
@@ -118,8 +118,7 @@ func TestConfirmDialogEnterUsesSelection(t *testing.T) {
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
-			m := New(WithTarget("retry"))
-			m.selection = tc.start
+			m := New(WithTarget("retry"), WithSelection(tc.start))
 			m.Init()
 
 			_, cmd := updateModel(t, m, keyCode(tea.KeyEnter))
@@ -227,6 +226,36 @@ func TestConfirmDialogViewDimensions(t *testing.T) {
 	}
 }
 
+func TestConfirmDialogPreview(t *testing.T) {
+	t.Parallel()
+
+	type previewMsg struct{}
+	m := New(
+		WithTitle("Confirm"),
+		WithMessage("Delete these jobs?"),
+		WithPreview(func() tea.Msg { return previewMsg{} }),
+	)
+	m.Init()
+	m, _ = updateModel(t, m, tea.WindowSizeMsg{Width: 100, Height: 30})
+
+	_, cmd := updateModel(t, m, tea.KeyPressMsg(tea.Key{Code: 'y', Mod: tea.ModCtrl}))
+	msgs := collectMsgs(t, cmd)
+	if len(msgs) != 1 {
+		t.Fatalf("msgs = %v, want only the preview", msgs)
+	}
+	if _, ok := msgs[0].(previewMsg); !ok {
+		t.Fatalf("msg = %T, want previewMsg", msgs[0])
+	}
+
+	output := ansi.Strip(m.View())
+	if !strings.Contains(output, "ctrl+y copy redis commands") {
+		t.Fatalf("View() missing the preview hint:\n%s", output)
+	}
+	if lines := strings.Split(output, "\n"); len(lines) != m.height {
+		t.Fatalf("lines = %d, want %d", len(lines), m.height)
+	}
+}
+
 func TestGoldenConfirmDialog(t *testing.T) {
 	m := New(
 		WithTitle("Confirm"),
@@ -239,3 +268,67 @@ func TestGoldenConfirmDialog(t *testing.T) {
 	output := ansi.Strip(m.View())
 	golden.RequireEqual(t, []byte(output))
 }
+
+func TestConfirmDialogRequiredText(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		typed         string
+		wantConfirmed bool
+	}{
+		"match":        {typed: "critical", wantConfirmed: true},
+		"padded match": {typed: " critical ", wantConfirmed: true},
+		"prefix":       {typed: "crit", wantConfirmed: false},
+		"wrong case":   {typed: "Critical", wantConfirmed: false},
+		"y is typed":   {typed: "y", wantConfirmed: false},
+		"empty":        {typed: "", wantConfirmed: false},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			m := New(WithTarget("queue.migrate"), WithRequiredText("critical"))
+			m.Init()
+			m, _ = updateModel(t, m, tea.WindowSizeMsg{Width: 80, Height: 24})
+			if !m.InputFocused() {
+				t.Fatal("typed confirmation does not capture input")
+			}
+			for _, r := range tc.typed {
+				m, _ = updateModel(t, m, keyText(string(r)))
+			}
+			if got := m.Matches(); got != tc.wantConfirmed {
+				t.Fatalf("Matches() = %v, want %v", got, tc.wantConfirmed)
+			}
+
+			_, cmd := updateModel(t, m, keyCode(tea.KeyEnter))
+			msgs := collectMsgs(t, cmd)
+			if !tc.wantConfirmed {
+				if len(msgs) != 0 {
+					t.Fatalf("enter without a match produced %v, want nothing", msgs)
+				}
+				if view := ansi.Strip(m.View()); !strings.Contains(view, "Type critical to confirm.") {
+					t.Fatalf("view is missing the instruction:\n%s", view)
+				}
+				return
+			}
+			var action *ActionMsg
+			for _, msg := range msgs {
+				if got, ok := msg.(ActionMsg); ok {
+					action = &got
+				}
+			}
+			if action == nil || !action.Confirmed || action.Target != "queue.migrate" {
+				t.Fatalf("enter after a match produced %v, want a confirmed ActionMsg", msgs)
+			}
+		})
+	}
+}
+
+func TestConfirmDialogWithoutRequiredTextMatches(t *testing.T) {
+	t.Parallel()
+
+	m := New(WithRequiredText("  "))
+	if m.InputFocused() || !m.Matches() {
+		t.Fatal("blank required text should keep the y/n dialog")
+	}
+}
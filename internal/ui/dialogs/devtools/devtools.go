@@ -328,7 +328,7 @@ func (m *Model) syncEntries() {
 				entry.Time.Format("15:04:05.000"),
 				entry.Origin,
 				entryTypeLabel(entry.Entry.Kind),
-				formatDuration(entry.Entry.Duration),
+				entryDurationLabel(entry.Entry),
 				entryCommandLabel(entry.Entry),
 			},
 		}
@@ -508,6 +508,14 @@ func normalizeOneLine(value string) string {
 	return replacer.Replace(value)
 }
 
+func entryDurationLabel(entry devtools.Entry) string {
+	// Pipelined commands share the pipeline round trip, shown on the exec marker.
+	if entry.Pipelined {
+		return ""
+	}
+	return formatDuration(entry.Duration)
+}
+
 func formatDuration(d time.Duration) string {
 	if d <= 0 {
 		return ""
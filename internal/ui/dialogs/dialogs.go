@@ -25,6 +25,12 @@ type CloseCallback interface {
 	Close() tea.Cmd
 }
 
+// InputFocuser reports when a dialog is capturing raw text input, so the app
+// does not treat printable keys such as q as global shortcuts.
+type InputFocuser interface {
+	InputFocused() bool
+}
+
 // OpenDialogMsg is sent to open a new dialog.
 type OpenDialogMsg struct {
 	Model DialogModel
@@ -2,6 +2,7 @@
 package filter
 
 import (
+	"regexp"
 	"strings"
 
 	"charm.land/bubbles/v2/textinput"
@@ -15,6 +16,24 @@ import (
 // DialogID identifies the filter dialog.
 const DialogID dialogs.DialogID = "filter"
 
+// RegexPrefix switches a query into regular expression mode when the dialog
+// is created with WithRegex.
+const RegexPrefix = "/re:"
+
+// ParseRegex reports whether query is a regex query and compiles its pattern.
+// Queries without RegexPrefix are returned as plain substring queries.
+func ParseRegex(query string) (*regexp.Regexp, bool, error) {
+	pattern, ok := strings.CutPrefix(query, RegexPrefix)
+	if !ok {
+		return nil, false, nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, true, err
+	}
+	return re, true, nil
+}
+
 // Action describes filter dialog intents.
 type Action int
 
@@ -41,6 +60,7 @@ type Styles struct {
 	Text        lipgloss.Style
 	Placeholder lipgloss.Style
 	Cursor      lipgloss.Style
+	Invalid     lipgloss.Style
 }
 
 // DefaultStyles returns zero-value styles.
@@ -54,6 +74,11 @@ type Model struct {
 	input        textinput.Model
 	inputBox     lipgloss.Style
 	query        string
+	regex        bool
+	regexMode    bool
+	regexErr     error
+	fuzzy        bool
+	fuzzyMode    bool
 	width        int
 	height       int
 	windowWidth  int
@@ -113,11 +138,26 @@ func WithMinWidth(width int) Option {
 	}
 }
 
+// WithRegex enables regex mode for queries starting with RegexPrefix.
+func WithRegex(enabled bool) Option {
+	return func(m *Model) {
+		m.regex = enabled
+	}
+}
+
+// WithFuzzy enables fuzzy mode for queries starting with FuzzyPrefix.
+func WithFuzzy(enabled bool) Option {
+	return func(m *Model) {
+		m.fuzzy = enabled
+	}
+}
+
 // Init focuses the input.
 func (m *Model) Init() tea.Cmd {
 	m.input.SetValue(m.query)
 	m.input.CursorEnd()
 	m.syncPlaceholder()
+	m.validate()
 	return m.input.Focus()
 }
 
@@ -132,6 +172,9 @@ func (m *Model) Update(msg tea.Msg) (dialogs.DialogModel, tea.Cmd) {
 	case tea.KeyPressMsg:
 		switch msg.String() {
 		case "enter":
+			if m.regexErr != nil {
+				return m, nil
+			}
 			next := strings.TrimSpace(m.input.Value())
 			if next == m.query {
 				return m, func() tea.Msg { return dialogs.CloseDialogMsg{} }
@@ -150,36 +193,54 @@ func (m *Model) Update(msg tea.Msg) (dialogs.DialogModel, tea.Cmd) {
 		case "ctrl+u":
 			m.input.SetValue("")
 			m.input.CursorEnd()
+			m.validate()
 			return m, nil
 		}
 
 		var cmd tea.Cmd
 		m.input, cmd = m.input.Update(msg)
+		m.validate()
 		return m, cmd
 	}
 
 	return m, nil
 }
 
+// Invalid reports whether the current input is a regex that fails to compile.
+func (m *Model) Invalid() bool {
+	return m.regexErr != nil
+}
+
 // View renders the filter dialog.
 func (m *Model) View() string {
 	content := m.inputBox.Render(m.input.View())
+	border := m.styles.Border
+	meta := ""
+	if m.regexErr != nil {
+		border = m.styles.Invalid
+		meta = m.styles.Invalid.Render("invalid regex")
+	} else if m.regexMode {
+		meta = m.styles.Placeholder.Render("regex")
+	} else if m.fuzzyMode {
+		meta = m.styles.Placeholder.Render("fuzzy")
+	}
 	box := frame.New(
 		frame.WithStyles(frame.Styles{
 			Focused: frame.StyleState{
 				Title:  m.styles.Title,
 				Muted:  m.styles.Placeholder,
 				Filter: m.styles.Title,
-				Border: m.styles.Border,
+				Border: border,
 			},
 			Blurred: frame.StyleState{
 				Title:  m.styles.Title,
 				Muted:  m.styles.Placeholder,
 				Filter: m.styles.Title,
-				Border: m.styles.Border,
+				Border: border,
 			},
 		}),
 		frame.WithTitle("Filter"),
+		frame.WithMeta(meta),
 		frame.WithTitlePadding(0),
 		frame.WithContent(content),
 		frame.WithPadding(m.padding),
@@ -242,6 +303,16 @@ func (m *Model) applySize() {
 	m.input.SetWidth(max(contentWidth-promptWidth-1, 1))
 }
 
+func (m *Model) validate() {
+	query := strings.TrimSpace(m.input.Value())
+	if m.fuzzy {
+		_, m.fuzzyMode = ParseFuzzy(query)
+	}
+	if m.regex {
+		_, m.regexMode, m.regexErr = ParseRegex(query)
+	}
+}
+
 func (m *Model) syncPlaceholder() {
 	switch {
 	case m.input.Focused():
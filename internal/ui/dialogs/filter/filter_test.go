@@ -313,3 +313,85 @@ func TestGoldenFilterDialog(t *testing.T) {
 	output := ansi.Strip(m.View())
 	golden.RequireEqual(t, []byte(output))
 }
+
+func TestParseRegex(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		query     string
+		wantRegex bool
+		wantErr   bool
+		match     string
+	}{
+		"plain substring": {query: "Cleanup*"},
+		"valid regex":     {query: "/re:^Clean.+Job$", wantRegex: true, match: "CleanupJob"},
+		"invalid regex":   {query: "/re:(", wantRegex: true, wantErr: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			re, isRegex, err := ParseRegex(tc.query)
+			if isRegex != tc.wantRegex {
+				t.Fatalf("isRegex = %v, want %v", isRegex, tc.wantRegex)
+			}
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.match != "" && !re.MatchString(tc.match) {
+				t.Fatalf("regex %q does not match %q", re, tc.match)
+			}
+		})
+	}
+}
+
+func TestFilterDialogInvalidRegexBlocksApply(t *testing.T) {
+	t.Parallel()
+
+	m := New(WithRegex(true))
+	m.Init()
+	m, _ = updateModel(t, m, tea.WindowSizeMsg{Width: 80, Height: 24})
+	for _, r := range "/re:(" {
+		m, _ = updateModel(t, m, tea.KeyPressMsg(tea.Key{Code: r, Text: string(r)}))
+	}
+
+	if !m.Invalid() {
+		t.Fatal("expected invalid regex")
+	}
+	if output := ansi.Strip(m.View()); !strings.Contains(output, "invalid regex") {
+		t.Fatalf("expected invalid indicator, got:\n%s", output)
+	}
+	_, cmd := updateModel(t, m, keyCode(tea.KeyEnter))
+	if msgs := collectMsgs(t, cmd); len(msgs) != 0 {
+		t.Fatalf("messages = %v, want none", msgs)
+	}
+
+	m, _ = updateModel(t, m, tea.KeyPressMsg(tea.Key{Code: ')', Text: ")"}))
+	if m.Invalid() {
+		t.Fatal("expected regex to become valid")
+	}
+	_, cmd = updateModel(t, m, keyCode(tea.KeyEnter))
+	var gotAction *ActionMsg
+	for _, msg := range collectMsgs(t, cmd) {
+		if v, ok := msg.(ActionMsg); ok {
+			gotAction = &v
+		}
+	}
+	if gotAction == nil || gotAction.Query != "/re:()" {
+		t.Fatalf("action = %+v, want apply of /re:()", gotAction)
+	}
+}
+
+func TestFilterDialogRegexDisabledByDefault(t *testing.T) {
+	t.Parallel()
+
+	m := New()
+	m.Init()
+	m.input.SetValue("/re:(")
+	m.input.CursorEnd()
+	m, _ = updateModel(t, m, keyCode(tea.KeyEnd))
+
+	if m.Invalid() {
+		t.Fatal("regex validation should be disabled without WithRegex")
+	}
+}
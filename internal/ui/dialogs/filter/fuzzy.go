@@ -0,0 +1,114 @@
+package filter
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// FuzzyPrefix switches a query into fuzzy mode when the dialog is created with
+// WithFuzzy. Fuzzy queries match job classes by subsequence, e.g. "UsrMlr"
+// matches "UserMailer".
+const FuzzyPrefix = "~"
+
+// Fuzzy scoring weights. Matches on word boundaries and runs of consecutive
+// characters rank higher; characters skipped between matches cost a little.
+const (
+	fuzzyMatchScore       = 1
+	fuzzyConsecutiveBonus = 5
+	fuzzyBoundaryBonus    = 8
+	fuzzyGapPenalty       = 1
+)
+
+// ParseFuzzy reports whether query is a fuzzy query and returns its pattern.
+func ParseFuzzy(query string) (string, bool) {
+	pattern, ok := strings.CutPrefix(query, FuzzyPrefix)
+	if !ok {
+		return "", false
+	}
+	return strings.TrimSpace(pattern), true
+}
+
+// FuzzyScore reports whether every character of pattern appears in text in
+// order, ignoring case, and how well it matches. Higher scores are better.
+func FuzzyScore(pattern, text string) (int, bool) {
+	needle := []rune(strings.ToLower(pattern))
+	if len(needle) == 0 {
+		return 0, true
+	}
+
+	haystack := []rune(text)
+	score := 0
+	matched := 0
+	last := -1
+	for i, r := range haystack {
+		if unicode.ToLower(r) != needle[matched] {
+			continue
+		}
+		score += fuzzyMatchScore
+		if last >= 0 {
+			if i == last+1 {
+				score += fuzzyConsecutiveBonus
+			} else {
+				score -= (i - last - 1) * fuzzyGapPenalty
+			}
+		}
+		if fuzzyBoundary(haystack, i) {
+			score += fuzzyBoundaryBonus
+		}
+		last = i
+		matched++
+		if matched == len(needle) {
+			return score, true
+		}
+	}
+	return 0, false
+}
+
+// fuzzyBoundary reports whether text[i] starts a word: the first character,
+// one after a separator such as "::" or "_", or an upper-case letter or digit
+// following a lower-case letter.
+func fuzzyBoundary(text []rune, i int) bool {
+	if i == 0 {
+		return true
+	}
+	prev, cur := text[i-1], text[i]
+	switch {
+	case !unicode.IsLetter(prev) && !unicode.IsDigit(prev):
+		return true
+	case unicode.IsLower(prev) && (unicode.IsUpper(cur) || unicode.IsDigit(cur)):
+		return true
+	default:
+		return false
+	}
+}
+
+// RankFuzzy keeps the items whose text fuzzy-matches pattern, best match
+// first. Equal scores rank the shorter text first, then keep their original
+// order.
+func RankFuzzy[T any](items []T, pattern string, text func(T) string) []T {
+	type scored struct {
+		item   T
+		score  int
+		length int
+	}
+	matches := make([]scored, 0, len(items))
+	for _, item := range items {
+		value := text(item)
+		if score, ok := FuzzyScore(pattern, value); ok {
+			matches = append(matches, scored{item: item, score: score, length: len(value)})
+		}
+	}
+	slices.SortStableFunc(matches, func(a, b scored) int {
+		if a.score != b.score {
+			return b.score - a.score
+		}
+		return a.length - b.length
+	})
+
+	ranked := make([]T, len(matches))
+	for i, match := range matches {
+		ranked[i] = match.item
+	}
+	return ranked
+}
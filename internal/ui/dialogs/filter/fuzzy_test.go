@@ -0,0 +1,86 @@
+package filter
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestParseFuzzy(t *testing.T) {
+	t.Parallel()
+
+	if pattern, ok := ParseFuzzy("~ UsrMlr "); !ok || pattern != "UsrMlr" {
+		t.Fatalf("ParseFuzzy = %q, %v; want UsrMlr, true", pattern, ok)
+	}
+	if _, ok := ParseFuzzy("UserMailer"); ok {
+		t.Fatal("plain query parsed as fuzzy")
+	}
+}
+
+func TestFuzzyScoreMatches(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		pattern string
+		text    string
+		want    bool
+	}{
+		"abbreviation":     {pattern: "UsrMlr", text: "UserMailer", want: true},
+		"lower case":       {pattern: "usrmlr", text: "UserMailer", want: true},
+		"upper case":       {pattern: "USRMLR", text: "UserMailer", want: true},
+		"namespaced":       {pattern: "admum", text: "Admin::UserMailer", want: true},
+		"out of order":     {pattern: "MlrUsr", text: "UserMailer", want: false},
+		"missing letter":   {pattern: "UsrMlrx", text: "UserMailer", want: false},
+		"empty pattern":    {pattern: "", text: "UserMailer", want: true},
+		"longer than text": {pattern: "UserMailers", text: "UserMailer", want: false},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			if _, ok := FuzzyScore(tc.pattern, tc.text); ok != tc.want {
+				t.Fatalf("FuzzyScore(%q, %q) matched = %v, want %v", tc.pattern, tc.text, ok, tc.want)
+			}
+		})
+	}
+}
+
+func TestRankFuzzy(t *testing.T) {
+	t.Parallel()
+
+	classes := []string{
+		"OrderMailer",
+		"UnsubscribeMailerJob",
+		"Admin::UserMailer",
+		"UserMailer",
+		"UsersListCleanup",
+	}
+
+	got := RankFuzzy(classes, "UsrMlr", func(s string) string { return s })
+	want := []string{"UserMailer", "Admin::UserMailer", "UnsubscribeMailerJob"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("RankFuzzy = %v, want %v", got, want)
+	}
+
+	if got := RankFuzzy(classes, "usrmlr", func(s string) string { return s }); !slices.Equal(got, want) {
+		t.Fatalf("RankFuzzy lower case = %v, want %v", got, want)
+	}
+}
+
+func TestFilterDialogFuzzyIndicator(t *testing.T) {
+	t.Parallel()
+
+	m := New(WithFuzzy(true))
+	m.Init()
+	m, _ = updateModel(t, m, tea.WindowSizeMsg{Width: 80, Height: 24})
+	for _, r := range "~UsrMlr" {
+		m, _ = updateModel(t, m, tea.KeyPressMsg(tea.Key{Code: r, Text: string(r)}))
+	}
+
+	if output := ansi.Strip(m.View()); !strings.Contains(output, "fuzzy") {
+		t.Fatalf("expected fuzzy indicator, got:\n%s", output)
+	}
+}
@@ -2,6 +2,7 @@
 package help
 
 import (
+	"strconv"
 	"strings"
 
 	"charm.land/bubbles/v2/key"
@@ -70,6 +71,8 @@ type Model struct {
 	minWidth     int
 	minHeight    int
 	columnGap    int
+	searching    bool   // Typing edits the query
+	query        string // Filters bindings by key or description
 }
 
 // Option configures the help dialog.
@@ -115,8 +118,21 @@ func (m *Model) Update(msg tea.Msg) (dialogs.DialogModel, tea.Cmd) {
 		m.applySize()
 		return m, nil
 	case tea.KeyPressMsg:
+		if m.searching {
+			m.updateSearch(msg)
+			return m, nil
+		}
 		switch msg.String() {
-		case "?", "esc":
+		case "/":
+			m.searching = true
+			return m, nil
+		case "esc":
+			if m.query != "" {
+				m.setQuery("")
+				return m, nil
+			}
+			return m, func() tea.Msg { return dialogs.CloseDialogMsg{} }
+		case "?":
 			return m, func() tea.Msg { return dialogs.CloseDialogMsg{} }
 		case "up", "k":
 			m.scrollBy(-1)
@@ -142,6 +158,115 @@ func (m *Model) Update(msg tea.Msg) (dialogs.DialogModel, tea.Cmd) {
 	return m, nil
 }
 
+// InputFocused implements dialogs.InputFocuser.
+func (m *Model) InputFocused() bool {
+	return m.searching
+}
+
+// Query returns the current search query.
+func (m *Model) Query() string {
+	return m.query
+}
+
+// updateSearch edits the query while searching. Enter keeps the query and
+// returns to scrolling; esc clears it.
+func (m *Model) updateSearch(msg tea.KeyPressMsg) {
+	switch msg.String() {
+	case "enter":
+		m.searching = false
+	case "esc":
+		m.searching = false
+		m.setQuery("")
+	case "backspace":
+		if runes := []rune(m.query); len(runes) > 0 {
+			m.setQuery(string(runes[:len(runes)-1]))
+		}
+	case "ctrl+u":
+		m.setQuery("")
+	case "up":
+		m.scrollBy(-1)
+	case "down":
+		m.scrollBy(1)
+	case "pgup":
+		m.scrollBy(-m.pageSize())
+	case "pgdown":
+		m.scrollBy(m.pageSize())
+	default:
+		if msg.Text != "" {
+			m.setQuery(m.query + msg.Text)
+		}
+	}
+}
+
+func (m *Model) setQuery(query string) {
+	m.query = query
+	m.yOffset = 0
+}
+
+// searchActive reports whether the search line is shown.
+func (m *Model) searchActive() bool {
+	return m.searching || m.query != ""
+}
+
+// visibleSections returns the sections matching the query. A section whose
+// title matches keeps all of its bindings; otherwise only bindings and lines
+// whose key or description match stay, and empty sections are hidden. Matches
+// drop their column so the few that remain fill both columns from the left.
+func (m *Model) visibleSections() []Section {
+	query := strings.ToLower(strings.TrimSpace(m.query))
+	if query == "" {
+		return m.sections
+	}
+
+	matches := func(text string) bool {
+		return strings.Contains(strings.ToLower(text), query)
+	}
+	sections := make([]Section, 0, len(m.sections))
+	for _, section := range m.sections {
+		if matches(section.Title) {
+			section.Column = ColumnAuto
+			sections = append(sections, section)
+			continue
+		}
+		filtered := Section{Title: section.Title}
+		for _, line := range section.Lines {
+			if matches(ansi.Strip(line)) {
+				filtered.Lines = append(filtered.Lines, line)
+			}
+		}
+		for _, binding := range section.Bindings {
+			help := binding.Help()
+			if matches(help.Key) || matches(help.Desc) {
+				filtered.Bindings = append(filtered.Bindings, binding)
+			}
+		}
+		if len(filtered.Lines) > 0 || countBindings(filtered.Bindings) > 0 {
+			sections = append(sections, filtered)
+		}
+	}
+	return sections
+}
+
+// MatchCount returns how many bindings match the current query.
+func (m *Model) MatchCount() int {
+	count := 0
+	for _, section := range m.visibleSections() {
+		count += countBindings(section.Bindings)
+	}
+	return count
+}
+
+// countBindings counts the bindings renderSections would show.
+func countBindings(bindings []key.Binding) int {
+	count := 0
+	for _, binding := range bindings {
+		if binding.Enabled() && strings.TrimSpace(binding.Help().Key) != "" {
+			count++
+		}
+	}
+	return count
+}
+
 // View renders the help dialog.
 func (m *Model) View() string {
 	if m.width <= 0 || m.height <= 0 {
@@ -164,6 +289,7 @@ func (m *Model) View() string {
 			},
 		}),
 		frame.WithTitle("Help"),
+		frame.WithMeta(m.meta()),
 		frame.WithTitlePadding(0),
 		frame.WithPadding(m.padding),
 		frame.WithSize(m.width, m.height),
@@ -174,6 +300,17 @@ func (m *Model) View() string {
 	return box.View()
 }
 
+func (m *Model) meta() string {
+	if !m.searchActive() {
+		return m.styles.Muted.Render("/ search")
+	}
+	count := m.MatchCount()
+	if count == 1 {
+		return m.styles.Muted.Render("1 match")
+	}
+	return m.styles.Muted.Render(strconv.Itoa(count) + " matches")
+}
+
 // Position returns the dialog position.
 func (m *Model) Position() (int, int) {
 	return m.row, m.col
@@ -209,11 +346,12 @@ func (m *Model) applySize() {
 }
 
 func (m *Model) renderColumnLines(width int) []string {
-	if width <= 0 || len(m.sections) == 0 {
+	sections := m.visibleSections()
+	if width <= 0 || len(sections) == 0 {
 		return nil
 	}
 
-	left, right := splitSections(m.sections)
+	left, right := splitSections(sections)
 	gap := m.columnGap
 	if width <= gap+10 {
 		gap = 2
@@ -253,7 +391,15 @@ func (m *Model) renderContent() string {
 		return ""
 	}
 
+	search := ""
+	if m.searchActive() {
+		search = m.renderSearchLine(contentWidth) + "\n\n"
+	}
+
 	lines := m.renderColumnLines(contentWidth)
+	if len(lines) == 0 && m.query != "" {
+		lines = []string{m.styles.Muted.Render("No matching keys")}
+	}
 	scrollbarWidth := m.scrollbarWidth(len(lines), contentHeight)
 	renderWidth := contentWidth
 	if scrollbarWidth > 0 {
@@ -269,7 +415,7 @@ func (m *Model) renderContent() string {
 	m.clampOffset(len(lines), contentHeight)
 	windowLines := visibleLines(lines, m.yOffset, contentHeight)
 	if scrollbarWidth == 0 {
-		return strings.Join(windowLines, "\n")
+		return search + strings.Join(windowLines, "\n")
 	}
 
 	windowLines = padLinesToHeight(windowLines, contentHeight)
@@ -278,15 +424,29 @@ func (m *Model) renderContent() string {
 		windowLines[i] = padRight(windowLines[i], renderWidth) + scrollbarLines[i]
 	}
 
-	return strings.Join(windowLines, "\n")
+	return search + strings.Join(windowLines, "\n")
+}
+
+func (m *Model) renderSearchLine(width int) string {
+	line := m.styles.Key.Render("/") + " " + m.styles.Desc.Render(m.query)
+	if m.searching {
+		line += m.styles.Key.Render("█")
+	}
+	return padRight(line, width)
 }
 
+// contentHeight is the height available to the sections, below the search
+// line and its spacer when they are shown.
 func (m *Model) contentHeight() int {
 	effectiveHeight := m.height
 	if m.minHeight > 0 {
 		effectiveHeight = max(effectiveHeight, m.minHeight)
 	}
-	return max(effectiveHeight-2, 0)
+	height := max(effectiveHeight-2, 0)
+	if m.searchActive() {
+		height = max(height-2, 0)
+	}
+	return height
 }
 
 func (m *Model) scrollbarWidth(totalLines, visible int) int {
@@ -16,6 +16,10 @@ func keyCode(code rune) tea.KeyPressMsg {
 	return tea.KeyPressMsg(tea.Key{Code: code})
 }
 
+func keyCtrl(r rune) tea.KeyPressMsg {
+	return tea.KeyPressMsg(tea.Key{Code: r, Mod: tea.ModCtrl})
+}
+
 func keyText(text string) tea.KeyPressMsg {
 	var code rune
 	for _, r := range text {
@@ -255,3 +259,94 @@ func TestGoldenHelpDialogScrollbar(t *testing.T) {
 	output := ansi.Strip(m.View())
 	golden.RequireEqual(t, []byte(output))
 }
+
+func typeText(t *testing.T, m *Model, text string) *Model {
+	t.Helper()
+	for _, r := range text {
+		m, _ = updateModel(t, m, keyText(string(r)))
+	}
+	return m
+}
+
+func TestHelpDialogSearchFiltersBindings(t *testing.T) {
+	t.Parallel()
+
+	m := New(WithSections(sampleSections()))
+	m.Init()
+	m, _ = updateModel(t, m, tea.WindowSizeMsg{Width: 100, Height: 30})
+
+	m, _ = updateModel(t, m, keyText("/"))
+	if !m.InputFocused() {
+		t.Fatal("expected / to start searching")
+	}
+	// q and ? are typed into the query instead of closing the dialog.
+	m = typeText(t, m, "Q")
+	if m.Query() != "Q" {
+		t.Fatalf("query = %q, want Q", m.Query())
+	}
+	if got := m.MatchCount(); got != 1 {
+		t.Fatalf("MatchCount = %d, want 1 (q quit)", got)
+	}
+	output := ansi.Strip(m.View())
+	if !strings.Contains(output, "quit") || strings.Contains(output, "Navigation") {
+		t.Fatalf("expected only matching bindings, got:\n%s", output)
+	}
+	if !strings.Contains(output, "1 match") {
+		t.Fatalf("expected match count, got:\n%s", output)
+	}
+
+	// Section titles keep the whole section.
+	m, _ = updateModel(t, m, keyCode(tea.KeyBackspace))
+	m = typeText(t, m, "navig")
+	if got := m.MatchCount(); got != 2 {
+		t.Fatalf("MatchCount = %d, want 2 (Navigation section)", got)
+	}
+
+	m, _ = updateModel(t, m, keyCtrl('u'))
+	m = typeText(t, m, "zzz")
+	if output := ansi.Strip(m.View()); !strings.Contains(output, "No matching keys") || !strings.Contains(output, "0 matches") {
+		t.Fatalf("expected empty result, got:\n%s", output)
+	}
+}
+
+func TestHelpDialogSearchKeys(t *testing.T) {
+	t.Parallel()
+
+	m := New(WithSections(sampleSections()))
+	m.Init()
+
+	m, _ = updateModel(t, m, keyText("/"))
+	m = typeText(t, m, "back")
+	m, _ = updateModel(t, m, keyCode(tea.KeyEnter))
+	if m.InputFocused() || m.Query() != "back" {
+		t.Fatalf("after enter: searching %v, query %q; want kept query", m.InputFocused(), m.Query())
+	}
+
+	// The first esc clears the query, the second closes the dialog.
+	m, cmd := updateModel(t, m, keyCode(tea.KeyEsc))
+	if cmd != nil || m.Query() != "" {
+		t.Fatalf("esc with query: cmd %v, query %q; want cleared query", cmd, m.Query())
+	}
+	_, cmd = updateModel(t, m, keyCode(tea.KeyEsc))
+	if msgs := collectMsgs(t, cmd); len(msgs) != 1 {
+		t.Fatalf("messages = %v, want close", msgs)
+	}
+
+	m, _ = updateModel(t, m, keyText("/"))
+	m = typeText(t, m, "up")
+	m, _ = updateModel(t, m, keyCode(tea.KeyEsc))
+	if m.InputFocused() || m.Query() != "" {
+		t.Fatalf("esc while searching: searching %v, query %q; want reset", m.InputFocused(), m.Query())
+	}
+}
+
+func TestGoldenHelpDialogSearch(t *testing.T) {
+	m := New(WithSections(sampleSections()))
+	m.Init()
+	m, _ = updateModel(t, m, tea.WindowSizeMsg{Width: 100, Height: 30})
+	m, _ = updateModel(t, m, keyText("/"))
+	m = typeText(t, m, "down")
+
+	output := ansi.Strip(m.View())
+	golden.RequireEqual(t, []byte(output))
+}
@@ -0,0 +1,294 @@
+// Package inspector provides a read-only overlay listing recent Redis commands.
+package inspector
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/kpumuk/lazykiq/internal/devtools"
+	"github.com/kpumuk/lazykiq/internal/ui/components/frame"
+	"github.com/kpumuk/lazykiq/internal/ui/components/table"
+	"github.com/kpumuk/lazykiq/internal/ui/dialogs"
+)
+
+// DialogID identifies the Redis inspector dialog.
+const DialogID dialogs.DialogID = "inspector"
+
+const defaultLimit = 100
+
+// Styles holds the styles used by the inspector.
+type Styles struct {
+	Title          lipgloss.Style
+	Border         lipgloss.Style
+	Text           lipgloss.Style
+	Muted          lipgloss.Style
+	TableHeader    lipgloss.Style
+	TableSelected  lipgloss.Style
+	TableSeparator lipgloss.Style
+	ScrollbarTrack lipgloss.Style
+	ScrollbarThumb lipgloss.Style
+}
+
+// DefaultStyles returns zero-value styles.
+func DefaultStyles() Styles {
+	return Styles{}
+}
+
+var commandColumns = []table.Column{
+	{Title: "Time", Width: 12},
+	{Title: "Origin", Width: 28},
+	{Title: "Type", Width: 8},
+	{Title: "RTT", Width: 8, Align: table.AlignRight},
+	{Title: "Command", Width: 0},
+}
+
+// Model defines state for the Redis inspector.
+type Model struct {
+	styles       Styles
+	tracker      *devtools.Tracker
+	limit        int
+	table        table.Model
+	summary      string
+	connection   string
+	width        int
+	height       int
+	windowWidth  int
+	windowHeight int
+	row          int
+	col          int
+	padding      int
+	minWidth     int
+	minHeight    int
+}
+
+// Option configures the inspector.
+type Option func(*Model)
+
+// New creates a new inspector model.
+func New(opts ...Option) *Model {
+	m := &Model{
+		styles:    DefaultStyles(),
+		limit:     defaultLimit,
+		padding:   1,
+		minWidth:  64,
+		minHeight: 10,
+		table: table.New(
+			table.WithColumns(commandColumns),
+			table.WithEmptyMessage("No commands recorded."),
+		),
+	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	m.applyStyles()
+	m.applySize()
+	return m
+}
+
+// WithStyles sets the styles.
+func WithStyles(s Styles) Option {
+	return func(m *Model) { m.styles = s }
+}
+
+// WithTracker sets the tracker the commands are read from.
+func WithTracker(tracker *devtools.Tracker) Option {
+	return func(m *Model) { m.tracker = tracker }
+}
+
+// WithLimit sets how many recent commands are listed.
+func WithLimit(limit int) Option {
+	return func(m *Model) {
+		if limit > 0 {
+			m.limit = limit
+		}
+	}
+}
+
+// WithConnection sets a line describing the Redis connection settings,
+// shown above the command list.
+func WithConnection(connection string) Option {
+	return func(m *Model) { m.connection = connection }
+}
+
+// Init implements dialogs.DialogModel.
+func (m *Model) Init() tea.Cmd { return nil }
+
+// Update handles input and dialog lifecycle.
+func (m *Model) Update(msg tea.Msg) (dialogs.DialogModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.windowWidth = msg.Width
+		m.windowHeight = msg.Height
+		m.applySize()
+		return m, nil
+	case tea.KeyPressMsg:
+		switch msg.String() {
+		case "ctrl+\\", "esc":
+			return m, func() tea.Msg { return dialogs.CloseDialogMsg{} }
+		}
+		updated, cmd := m.table.Update(msg)
+		m.table = updated
+		return m, cmd
+	}
+
+	return m, nil
+}
+
+// View renders the inspector.
+func (m *Model) View() string {
+	if m.width <= 0 || m.height <= 0 {
+		return ""
+	}
+
+	m.syncEntries()
+
+	contentWidth := max(m.width-2-(m.padding*2), 0)
+	contentHeight := max(m.height-2, 1)
+	tableHeight := contentHeight
+	if m.connection != "" {
+		tableHeight = max(contentHeight-1, 1)
+	}
+	m.table.SetSize(contentWidth, tableHeight)
+
+	tableView := m.table.View()
+	if pad := tableHeight - lipgloss.Height(tableView); pad > 0 {
+		tableView += strings.Repeat("\n", pad)
+	}
+	if m.connection != "" {
+		connection := m.styles.Muted.Render(ansi.Truncate(m.connection, contentWidth, "…"))
+		tableView = connection + "\n" + tableView
+	}
+
+	box := frame.New(
+		frame.WithStyles(frame.Styles{
+			Focused: frame.StyleState{
+				Title:  m.styles.Title,
+				Muted:  m.styles.Muted,
+				Filter: m.styles.Muted,
+				Border: m.styles.Border,
+			},
+			Blurred: frame.StyleState{
+				Title:  m.styles.Title,
+				Muted:  m.styles.Muted,
+				Filter: m.styles.Muted,
+				Border: m.styles.Border,
+			},
+		}),
+		frame.WithTitle("Redis Inspector"),
+		frame.WithTitlePadding(0),
+		frame.WithMeta(m.summary),
+		frame.WithContent(tableView),
+		frame.WithPadding(m.padding),
+		frame.WithSize(m.width, m.height),
+		frame.WithMinHeight(5),
+		frame.WithFocused(true),
+	)
+	return box.View()
+}
+
+// Position returns the dialog position.
+func (m *Model) Position() (int, int) {
+	return m.row, m.col
+}
+
+// ID returns the dialog ID.
+func (m *Model) ID() dialogs.DialogID {
+	return DialogID
+}
+
+func (m *Model) applyStyles() {
+	m.table.SetStyles(table.Styles{
+		Text:           m.styles.Text,
+		Muted:          m.styles.Muted,
+		Header:         m.styles.TableHeader,
+		Selected:       m.styles.TableSelected,
+		Separator:      m.styles.TableSeparator,
+		ScrollbarTrack: m.styles.ScrollbarTrack,
+		ScrollbarThumb: m.styles.ScrollbarThumb,
+	})
+}
+
+func (m *Model) applySize() {
+	if m.windowWidth == 0 || m.windowHeight == 0 {
+		return
+	}
+
+	dialogWidth := max((m.windowWidth*4)/5, m.minWidth)
+	dialogWidth = min(dialogWidth, m.windowWidth-4)
+	if dialogWidth < 10 {
+		dialogWidth = max(m.windowWidth-2, 10)
+	}
+
+	dialogHeight := max((m.windowHeight*2)/3, m.minHeight)
+	dialogHeight = min(dialogHeight, m.windowHeight-4)
+	if dialogHeight < 5 {
+		dialogHeight = max(m.windowHeight-2, 5)
+	}
+
+	m.width = dialogWidth
+	m.height = dialogHeight
+	m.row = max((m.windowHeight-dialogHeight)/2, 0)
+	m.col = max((m.windowWidth-dialogWidth)/2, 0)
+}
+
+func (m *Model) syncEntries() {
+	entries := m.tracker.RecentCommands(m.limit)
+	prevRows := m.table.Rows()
+	wasAtEnd := len(prevRows) == 0 || m.table.Cursor() >= len(prevRows)-1
+
+	rows := make([]table.Row, 0, len(entries))
+	// Pipelined commands carry the whole pipeline's round-trip time, so they
+	// would skew avg/max once per command; only standalone commands count.
+	var total, slowest time.Duration
+	timed := 0
+	for _, entry := range entries {
+		if !entry.Entry.Pipelined {
+			timed++
+			total += entry.Entry.Duration
+			slowest = max(slowest, entry.Entry.Duration)
+		}
+		rows = append(rows, table.Row{
+			ID: strconv.FormatUint(entry.Seq, 10),
+			Cells: []string{
+				entry.Time.Format("15:04:05.000"),
+				entry.Origin,
+				typeLabel(entry.Entry),
+				devtools.FormatDuration(entry.Entry.Duration),
+				entry.Entry.Command,
+			},
+		})
+	}
+	m.table.SetRows(rows)
+	if wasAtEnd && len(rows) > 0 {
+		m.table.MoveDown(len(rows))
+	}
+
+	m.summary = ""
+	switch {
+	case timed > 0:
+		avg := total / time.Duration(timed)
+		m.summary = fmt.Sprintf(
+			"%d cmds · avg %s · max %s",
+			len(entries),
+			devtools.FormatDuration(avg),
+			devtools.FormatDuration(slowest),
+		)
+	case len(entries) > 0:
+		m.summary = fmt.Sprintf("%d cmds", len(entries))
+	}
+}
+
+func typeLabel(entry devtools.Entry) string {
+	if entry.Pipelined {
+		return "pipeline"
+	}
+	return "command"
+}
@@ -0,0 +1,224 @@
+package inspector
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/charmbracelet/x/exp/golden"
+
+	"github.com/kpumuk/lazykiq/internal/devtools"
+	"github.com/kpumuk/lazykiq/internal/ui/dialogs"
+)
+
+func updateModel(t *testing.T, m *Model, msg tea.Msg) (*Model, tea.Cmd) {
+	t.Helper()
+	next, cmd := m.Update(msg)
+	updated, ok := next.(*Model)
+	if !ok {
+		t.Fatalf("Update returned %T, want *Model", next)
+	}
+	return updated, cmd
+}
+
+func seedTracker() *devtools.Tracker {
+	base := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	tracker := devtools.NewTracker()
+	tracker.AppendLog(devtools.LogEntry{
+		Time:   base,
+		Origin: "queues.fetchDataCmd",
+		Entry: devtools.Entry{
+			Kind:     devtools.EntryCommand,
+			Command:  "smembers queues",
+			Duration: 2 * time.Millisecond,
+		},
+	})
+	tracker.AppendLog(devtools.LogEntry{
+		Time:   base.Add(10 * time.Millisecond),
+		Origin: "queues.fetchDataCmd",
+		Entry:  devtools.Entry{Kind: devtools.EntryPipelineBegin},
+	})
+	tracker.AppendLog(devtools.LogEntry{
+		Time:   base.Add(15 * time.Millisecond),
+		Origin: "queues.fetchDataCmd",
+		Entry: devtools.Entry{
+			Kind:      devtools.EntryCommand,
+			Command:   "llen queue:default",
+			Duration:  6 * time.Millisecond,
+			Pipelined: true,
+		},
+	})
+	tracker.AppendLog(devtools.LogEntry{
+		Time:   base.Add(15 * time.Millisecond),
+		Origin: "queues.fetchDataCmd",
+		Entry:  devtools.Entry{Kind: devtools.EntryPipelineExec, Duration: 6 * time.Millisecond},
+	})
+	tracker.AppendLog(devtools.LogEntry{
+		Time:   base.Add(20 * time.Millisecond),
+		Origin: "console",
+		Entry:  devtools.Entry{Kind: devtools.EntryResult, Command: "ok"},
+	})
+	return tracker
+}
+
+func TestInspectorCloseKeys(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		msg tea.Msg
+	}{
+		"ctrl+backslash": {msg: tea.KeyPressMsg(tea.Key{Code: '\\', Mod: tea.ModCtrl})},
+		"esc":            {msg: tea.KeyPressMsg(tea.Key{Code: tea.KeyEsc})},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			m := New()
+
+			_, cmd := updateModel(t, m, tc.msg)
+			if cmd == nil {
+				t.Fatal("expected close command")
+			}
+			if _, ok := cmd().(dialogs.CloseDialogMsg); !ok {
+				t.Fatalf("message type = %T, want dialogs.CloseDialogMsg", cmd())
+			}
+		})
+	}
+}
+
+func TestInspectorListsOnlyCommands(t *testing.T) {
+	t.Parallel()
+
+	m := New(WithTracker(seedTracker()))
+	m.syncEntries()
+
+	rows := m.table.Rows()
+	if len(rows) != 2 {
+		t.Fatalf("rows = %d, want 2", len(rows))
+	}
+	if got := rows[1].Cells[2]; got != "pipeline" {
+		t.Fatalf("type = %q, want pipeline", got)
+	}
+	if !strings.Contains(m.summary, "2 cmds") || !strings.Contains(m.summary, "max 2ms") {
+		t.Fatalf("summary = %q, want command count and max latency", m.summary)
+	}
+}
+
+func TestInspectorSummaryIgnoresPipelineRoundTrips(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		standalone bool
+		want       string
+	}{
+		"mixed":          {standalone: true, want: "51 cmds · avg 1ms · max 1ms"},
+		"pipelines only": {want: "50 cmds"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tracker := devtools.NewTracker()
+			if tc.standalone {
+				tracker.AppendLog(devtools.LogEntry{
+					Origin: "queues.fetchDataCmd",
+					Entry:  devtools.Entry{Kind: devtools.EntryCommand, Command: "smembers queues", Duration: time.Millisecond},
+				})
+			}
+			for range 50 {
+				tracker.AppendLog(devtools.LogEntry{
+					Origin: "queues.fetchDataCmd",
+					Entry: devtools.Entry{
+						Kind:      devtools.EntryCommand,
+						Command:   "llen queue:default",
+						Duration:  40 * time.Millisecond,
+						Pipelined: true,
+					},
+				})
+			}
+
+			m := New(WithTracker(tracker))
+			m.syncEntries()
+
+			if m.summary != tc.want {
+				t.Fatalf("summary = %q, want %q", m.summary, tc.want)
+			}
+		})
+	}
+}
+
+func TestInspectorLimit(t *testing.T) {
+	t.Parallel()
+
+	m := New(WithTracker(seedTracker()), WithLimit(1))
+	m.syncEntries()
+
+	rows := m.table.Rows()
+	if len(rows) != 1 {
+		t.Fatalf("rows = %d, want 1", len(rows))
+	}
+	if got := rows[0].Cells[4]; got != "llen queue:default" {
+		t.Fatalf("command = %q, want most recent command", got)
+	}
+}
+
+func TestInspectorNilTracker(t *testing.T) {
+	t.Parallel()
+
+	m := New()
+	m, _ = updateModel(t, m, tea.WindowSizeMsg{Width: 80, Height: 20})
+
+	output := ansi.Strip(m.View())
+	if !strings.Contains(output, "No commands recorded.") {
+		t.Fatalf("expected empty message, got:\n%s", output)
+	}
+}
+
+func TestInspectorViewDimensions(t *testing.T) {
+	t.Parallel()
+
+	m := New(WithTracker(seedTracker()))
+	m, _ = updateModel(t, m, tea.WindowSizeMsg{Width: 100, Height: 30})
+
+	output := ansi.Strip(m.View())
+	lines := strings.Split(output, "\n")
+	if len(lines) != m.height {
+		t.Fatalf("lines = %d, want %d", len(lines), m.height)
+	}
+	for i, line := range lines {
+		if w := ansi.StringWidth(line); w != m.width {
+			t.Fatalf("line %d width = %d, want %d", i, w, m.width)
+		}
+	}
+}
+
+func TestInspectorConnectionLine(t *testing.T) {
+	t.Parallel()
+
+	m := New(WithTracker(seedTracker()), WithConnection("pool 4 · dial 2s · read 2s · write 2s"))
+	m, _ = updateModel(t, m, tea.WindowSizeMsg{Width: 100, Height: 30})
+
+	output := ansi.Strip(m.View())
+	lines := strings.Split(output, "\n")
+	if len(lines) != m.height {
+		t.Fatalf("lines = %d, want %d", len(lines), m.height)
+	}
+	if !strings.Contains(lines[1], "pool 4 · dial 2s · read 2s · write 2s") {
+		t.Fatalf("first content line = %q, want connection settings", lines[1])
+	}
+	if !strings.Contains(lines[2], "Time") {
+		t.Fatalf("second content line = %q, want table header", lines[2])
+	}
+}
+
+func TestGoldenInspectorDialog(t *testing.T) {
+	m := New(WithTracker(seedTracker()))
+	m, _ = updateModel(t, m, tea.WindowSizeMsg{Width: 100, Height: 20})
+
+	output := ansi.Strip(m.View())
+	golden.RequireEqual(t, []byte(output))
+}
@@ -0,0 +1,402 @@
+// Package palette provides a command palette dialog that runs key bindings
+// picked by fuzzy search.
+package palette
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/kpumuk/lazykiq/internal/mathutil"
+	"github.com/kpumuk/lazykiq/internal/ui/components/frame"
+	"github.com/kpumuk/lazykiq/internal/ui/dialogs"
+	"github.com/kpumuk/lazykiq/internal/ui/dialogs/filter"
+)
+
+// DialogID identifies the command palette dialog.
+const DialogID dialogs.DialogID = "palette"
+
+// Command is a key binding offered by the palette, grouped under a section
+// such as the view that provides it.
+type Command struct {
+	Section string
+	Binding key.Binding
+}
+
+// ID identifies the command across palette openings, for recently-used
+// ordering.
+func (c Command) ID() string {
+	help := c.Binding.Help()
+	return help.Key + "\x00" + help.Desc
+}
+
+// ActionMsg reports the command picked in the palette. It is sent after the
+// dialog closes, so the binding can be replayed as a key press.
+type ActionMsg struct {
+	Command Command
+}
+
+// Styles holds the styles used by the command palette.
+type Styles struct {
+	Title    lipgloss.Style
+	Border   lipgloss.Style
+	Key      lipgloss.Style
+	Desc     lipgloss.Style
+	Muted    lipgloss.Style
+	Selected lipgloss.Style
+}
+
+// DefaultStyles returns zero-value styles.
+func DefaultStyles() Styles {
+	return Styles{}
+}
+
+// Model defines state for the command palette.
+type Model struct {
+	styles       Styles
+	commands     []Command
+	recent       []string // Command IDs, most recent first
+	query        string
+	matches      []int // Indexes into commands, best first
+	cursor       int
+	yOffset      int
+	width        int
+	height       int
+	windowWidth  int
+	windowHeight int
+	row          int
+	col          int
+	padding      int
+	minWidth     int
+	minHeight    int
+}
+
+// Option configures the command palette.
+type Option func(*Model)
+
+// New creates a new command palette model.
+func New(opts ...Option) *Model {
+	m := &Model{
+		styles:    DefaultStyles(),
+		padding:   1,
+		minWidth:  50,
+		minHeight: 10,
+	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	m.filter()
+	m.applySize()
+	return m
+}
+
+// WithStyles sets the styles.
+func WithStyles(s Styles) Option {
+	return func(m *Model) { m.styles = s }
+}
+
+// WithCommands sets the commands to choose from. Disabled bindings and
+// bindings without help are skipped.
+func WithCommands(commands []Command) Option {
+	return func(m *Model) {
+		m.commands = m.commands[:0]
+		for _, command := range commands {
+			if command.Binding.Enabled() && strings.TrimSpace(command.Binding.Help().Key) != "" {
+				m.commands = append(m.commands, command)
+			}
+		}
+	}
+}
+
+// WithRecent sets the IDs of recently used commands, most recent first. They
+// are listed first and win ties between equally good matches.
+func WithRecent(ids []string) Option {
+	return func(m *Model) { m.recent = ids }
+}
+
+// Init implements dialogs.DialogModel.
+func (m *Model) Init() tea.Cmd { return nil }
+
+// Update handles input and dialog lifecycle.
+func (m *Model) Update(msg tea.Msg) (dialogs.DialogModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.windowWidth = msg.Width
+		m.windowHeight = msg.Height
+		m.applySize()
+		return m, nil
+	case tea.KeyPressMsg:
+		switch msg.String() {
+		case "esc", "ctrl+k":
+			return m, func() tea.Msg { return dialogs.CloseDialogMsg{} }
+		case "enter":
+			command, ok := m.Selected()
+			if !ok {
+				return m, nil
+			}
+			return m, tea.Sequence(
+				func() tea.Msg { return dialogs.CloseDialogMsg{} },
+				func() tea.Msg { return ActionMsg{Command: command} },
+			)
+		case "up", "ctrl+p":
+			m.moveCursor(-1)
+		case "down", "ctrl+n":
+			m.moveCursor(1)
+		case "pgup":
+			m.moveCursor(-m.listHeight())
+		case "pgdown":
+			m.moveCursor(m.listHeight())
+		case "backspace":
+			if runes := []rune(m.query); len(runes) > 0 {
+				m.setQuery(string(runes[:len(runes)-1]))
+			}
+		case "ctrl+u":
+			m.setQuery("")
+		default:
+			if msg.Text != "" {
+				m.setQuery(m.query + msg.Text)
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// InputFocused implements dialogs.InputFocuser.
+func (m *Model) InputFocused() bool {
+	return true
+}
+
+// Query returns the current search query.
+func (m *Model) Query() string {
+	return m.query
+}
+
+// Matches returns the commands matching the query, best first.
+func (m *Model) Matches() []Command {
+	matches := make([]Command, 0, len(m.matches))
+	for _, idx := range m.matches {
+		matches = append(matches, m.commands[idx])
+	}
+	return matches
+}
+
+// Selected returns the highlighted command.
+func (m *Model) Selected() (Command, bool) {
+	if m.cursor < 0 || m.cursor >= len(m.matches) {
+		return Command{}, false
+	}
+	return m.commands[m.matches[m.cursor]], true
+}
+
+func (m *Model) setQuery(query string) {
+	m.query = query
+	m.filter()
+}
+
+// filter ranks the commands against the query. Without a query recently used
+// commands come first and the rest keep their order; with one, commands are
+// ordered by fuzzy score, then by how recently they were used.
+func (m *Model) filter() {
+	type match struct {
+		idx    int
+		score  int
+		recent int
+	}
+
+	query := strings.TrimSpace(m.query)
+	matches := make([]match, 0, len(m.commands))
+	for idx, command := range m.commands {
+		score, ok := commandScore(query, command)
+		if !ok {
+			continue
+		}
+		recent := slices.Index(m.recent, command.ID())
+		if recent < 0 {
+			recent = len(m.recent)
+		}
+		matches = append(matches, match{idx: idx, score: score, recent: recent})
+	}
+	slices.SortStableFunc(matches, func(a, b match) int {
+		if a.score != b.score {
+			return b.score - a.score
+		}
+		return a.recent - b.recent
+	})
+
+	m.matches = m.matches[:0]
+	for _, match := range matches {
+		m.matches = append(m.matches, match.idx)
+	}
+	m.cursor = 0
+	m.yOffset = 0
+}
+
+// commandScore fuzzy matches query against the command description, and
+// against its section and key so "dead delete" or "ctrl+d" find it too.
+func commandScore(query string, command Command) (int, bool) {
+	help := command.Binding.Help()
+	best, found := 0, false
+	for _, text := range []string{help.Desc, command.Section + " " + help.Desc, help.Key} {
+		if score, ok := filter.FuzzyScore(query, text); ok && (!found || score > best) {
+			best, found = score, true
+		}
+	}
+	return best, found
+}
+
+func (m *Model) moveCursor(delta int) {
+	if len(m.matches) == 0 {
+		return
+	}
+	m.cursor = mathutil.Clamp(m.cursor+delta, 0, len(m.matches)-1)
+	height := m.listHeight()
+	if m.cursor < m.yOffset {
+		m.yOffset = m.cursor
+	} else if height > 0 && m.cursor >= m.yOffset+height {
+		m.yOffset = m.cursor - height + 1
+	}
+}
+
+// View renders the command palette.
+func (m *Model) View() string {
+	if m.width <= 0 || m.height <= 0 {
+		return ""
+	}
+
+	box := frame.New(
+		frame.WithStyles(frame.Styles{
+			Focused: frame.StyleState{
+				Title:  m.styles.Title,
+				Muted:  m.styles.Muted,
+				Filter: m.styles.Muted,
+				Border: m.styles.Border,
+			},
+			Blurred: frame.StyleState{
+				Title:  m.styles.Title,
+				Muted:  m.styles.Muted,
+				Filter: m.styles.Muted,
+				Border: m.styles.Border,
+			},
+		}),
+		frame.WithTitle("Commands"),
+		frame.WithMeta(m.meta()),
+		frame.WithTitlePadding(0),
+		frame.WithPadding(m.padding),
+		frame.WithSize(m.width, m.height),
+		frame.WithMinHeight(5),
+		frame.WithFocused(true),
+	)
+	box.SetContent(m.renderContent())
+	return box.View()
+}
+
+func (m *Model) meta() string {
+	if len(m.matches) == 1 {
+		return m.styles.Muted.Render("1 command")
+	}
+	return m.styles.Muted.Render(strconv.Itoa(len(m.matches)) + " commands")
+}
+
+// Position returns the dialog position.
+func (m *Model) Position() (int, int) {
+	return m.row, m.col
+}
+
+// ID returns the dialog ID.
+func (m *Model) ID() dialogs.DialogID {
+	return DialogID
+}
+
+func (m *Model) applySize() {
+	if m.windowWidth == 0 || m.windowHeight == 0 {
+		return
+	}
+
+	dialogWidth := max(m.windowWidth/2, m.minWidth)
+	dialogWidth = min(dialogWidth, m.windowWidth-4)
+	if dialogWidth < 10 {
+		dialogWidth = max(m.windowWidth-2, 10)
+	}
+
+	dialogHeight := max(m.windowHeight/2, m.minHeight)
+	dialogHeight = min(dialogHeight, m.windowHeight-4)
+	if dialogHeight < 5 {
+		dialogHeight = max(m.windowHeight-2, 5)
+	}
+
+	m.width = dialogWidth
+	m.height = dialogHeight
+	m.row = max((m.windowHeight-dialogHeight)/2, 0)
+	// Sit in the upper third so the list grows downwards from the query.
+	m.row = min(m.row, max(m.windowHeight/3-2, 0))
+	m.col = max((m.windowWidth-dialogWidth)/2, 0)
+	m.moveCursor(0)
+}
+
+func (m *Model) contentWidth() int {
+	return max(m.width-2-(m.padding*2), 1)
+}
+
+// listHeight is the number of commands shown below the query line and its
+// spacer.
+func (m *Model) listHeight() int {
+	return max(m.height-2-2, 0)
+}
+
+func (m *Model) renderContent() string {
+	width := m.contentWidth()
+	lines := []string{
+		padRight(m.styles.Key.Render(">")+" "+m.styles.Desc.Render(m.query)+m.styles.Key.Render("█"), width),
+		"",
+	}
+	if len(m.matches) == 0 {
+		lines = append(lines, m.styles.Muted.Render("No matching commands"))
+		return strings.Join(lines, "\n")
+	}
+
+	keyWidth := 0
+	sectionWidth := 0
+	for _, idx := range m.matches {
+		command := m.commands[idx]
+		keyWidth = max(keyWidth, ansi.StringWidth(command.Binding.Help().Key))
+		sectionWidth = max(sectionWidth, ansi.StringWidth(command.Section))
+	}
+	sectionWidth = min(sectionWidth, width/3)
+
+	end := min(m.yOffset+m.listHeight(), len(m.matches))
+	for i := m.yOffset; i < end; i++ {
+		command := m.commands[m.matches[i]]
+		help := command.Binding.Help()
+		keyText := padRight(help.Key, keyWidth)
+		section := ansi.Truncate(command.Section, sectionWidth, "…")
+		descWidth := max(width-keyWidth-sectionWidth-2, 0)
+		desc := padRight(ansi.Truncate(help.Desc, descWidth, "…"), descWidth)
+		section = strings.Repeat(" ", sectionWidth-ansi.StringWidth(section)) + section
+		if i == m.cursor {
+			lines = append(lines, m.styles.Selected.Render(padRight(keyText+" "+desc+" "+section, width)))
+			continue
+		}
+		line := m.styles.Key.Render(keyText) + " " + m.styles.Desc.Render(desc) + " " + m.styles.Muted.Render(section)
+		lines = append(lines, padRight(line, width))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func padRight(value string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	stringWidth := ansi.StringWidth(value)
+	if stringWidth >= width {
+		return ansi.Truncate(value, width, "")
+	}
+	return value + strings.Repeat(" ", width-stringWidth)
+}
@@ -0,0 +1,168 @@
+package palette
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/charmbracelet/x/exp/golden"
+
+	"github.com/kpumuk/lazykiq/internal/ui/dialogs"
+)
+
+func keyCode(code rune) tea.KeyPressMsg {
+	return tea.KeyPressMsg(tea.Key{Code: code})
+}
+
+func keyText(text string) tea.KeyPressMsg {
+	var code rune
+	for _, r := range text {
+		code = r
+		break
+	}
+	return tea.KeyPressMsg(tea.Key{Text: text, Code: code})
+}
+
+func updateModel(t *testing.T, m *Model, msg tea.Msg) (*Model, tea.Cmd) {
+	t.Helper()
+	next, cmd := m.Update(msg)
+	updated, ok := next.(*Model)
+	if !ok {
+		t.Fatalf("Update returned %T, want *Model", next)
+	}
+	return updated, cmd
+}
+
+// sequenceMsgs runs the commands of a tea.Sequence in order.
+func sequenceMsgs(t *testing.T, cmd tea.Cmd) []tea.Msg {
+	t.Helper()
+	if cmd == nil {
+		return nil
+	}
+	msg := cmd()
+	value := reflect.ValueOf(msg)
+	if value.Kind() != reflect.Slice {
+		return []tea.Msg{msg}
+	}
+	var out []tea.Msg
+	for i := range value.Len() {
+		if c, ok := value.Index(i).Interface().(tea.Cmd); ok && c != nil {
+			out = append(out, c())
+		}
+	}
+	return out
+}
+
+func typeText(t *testing.T, m *Model, text string) *Model {
+	t.Helper()
+	for _, r := range text {
+		m, _ = updateModel(t, m, keyText(string(r)))
+	}
+	return m
+}
+
+func sampleCommands() []Command {
+	return []Command{
+		{Section: "Dead", Binding: key.NewBinding(key.WithKeys("D"), key.WithHelp("shift+d", "delete job"))},
+		{Section: "Dead", Binding: key.NewBinding(key.WithKeys("R"), key.WithHelp("shift+r", "retry now"))},
+		{Section: "Dead", Binding: key.NewBinding(key.WithKeys("t"), key.WithHelp("t", "filter by time range"))},
+		{Section: "Global", Binding: key.NewBinding(key.WithKeys("1"), key.WithHelp("1", "dashboard"))},
+		{Section: "Global", Binding: key.NewBinding(key.WithKeys("x"), key.WithHelp("x", "hidden"), key.WithDisabled())},
+	}
+}
+
+func descs(commands []Command) []string {
+	out := make([]string, 0, len(commands))
+	for _, command := range commands {
+		out = append(out, command.Binding.Help().Desc)
+	}
+	return out
+}
+
+func TestPaletteFuzzyFilters(t *testing.T) {
+	t.Parallel()
+
+	m := New(WithCommands(sampleCommands()))
+	m, _ = updateModel(t, m, tea.WindowSizeMsg{Width: 100, Height: 30})
+	if got := descs(m.Matches()); !reflect.DeepEqual(got, []string{"delete job", "retry now", "filter by time range", "dashboard"}) {
+		t.Fatalf("Matches() = %q, want every enabled command in order", got)
+	}
+
+	m = typeText(t, m, "rtn")
+	if got := descs(m.Matches()); len(got) == 0 || got[0] != "retry now" {
+		t.Fatalf("Matches() = %q, want retry now first", got)
+	}
+
+	m, _ = updateModel(t, m, tea.KeyPressMsg(tea.Key{Code: 'u', Mod: tea.ModCtrl}))
+	m = typeText(t, m, "dead dl")
+	if got := descs(m.Matches()); len(got) == 0 || got[0] != "delete job" {
+		t.Fatalf("Matches() = %q, want section matches", got)
+	}
+
+	m, _ = updateModel(t, m, tea.KeyPressMsg(tea.Key{Code: 'u', Mod: tea.ModCtrl}))
+	m = typeText(t, m, "zzz")
+	if output := ansi.Strip(m.View()); len(m.Matches()) != 0 || !strings.Contains(output, "No matching commands") {
+		t.Fatalf("expected no matches, got %q", descs(m.Matches()))
+	}
+}
+
+func TestPaletteRecentCommandsComeFirst(t *testing.T) {
+	t.Parallel()
+
+	commands := sampleCommands()
+	m := New(WithCommands(commands), WithRecent([]string{commands[3].ID(), commands[1].ID()}))
+	if got := descs(m.Matches()); !reflect.DeepEqual(got, []string{"dashboard", "retry now", "delete job", "filter by time range"}) {
+		t.Fatalf("Matches() = %q, want recent commands first", got)
+	}
+}
+
+func TestPaletteEnterRunsSelectedCommand(t *testing.T) {
+	t.Parallel()
+
+	m := New(WithCommands(sampleCommands()))
+	m, _ = updateModel(t, m, keyCode(tea.KeyDown))
+	m, cmd := updateModel(t, m, keyCode(tea.KeyEnter))
+
+	msgs := sequenceMsgs(t, cmd)
+	if len(msgs) != 2 {
+		t.Fatalf("messages = %v, want close then action", msgs)
+	}
+	if _, ok := msgs[0].(dialogs.CloseDialogMsg); !ok {
+		t.Fatalf("first message = %T, want CloseDialogMsg", msgs[0])
+	}
+	action, ok := msgs[1].(ActionMsg)
+	if !ok || action.Command.Binding.Help().Desc != "retry now" {
+		t.Fatalf("second message = %#v, want retry now action", msgs[1])
+	}
+
+	// q is typed into the query rather than quitting.
+	if !m.InputFocused() {
+		t.Fatal("palette must capture typed input")
+	}
+}
+
+func TestPaletteEscCloses(t *testing.T) {
+	t.Parallel()
+
+	m := New(WithCommands(sampleCommands()))
+	_, cmd := updateModel(t, m, keyCode(tea.KeyEsc))
+	if cmd == nil {
+		t.Fatal("expected close command")
+	}
+	if _, ok := cmd().(dialogs.CloseDialogMsg); !ok {
+		t.Fatal("esc did not close the palette")
+	}
+}
+
+func TestGoldenPaletteDialog(t *testing.T) {
+	m := New(WithCommands(sampleCommands()))
+	m.Init()
+	m, _ = updateModel(t, m, tea.WindowSizeMsg{Width: 100, Height: 30})
+	m = typeText(t, m, "de")
+
+	output := ansi.Strip(m.View())
+	golden.RequireEqual(t, []byte(output))
+}
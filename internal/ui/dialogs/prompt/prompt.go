@@ -0,0 +1,286 @@
+// Package prompt provides a single-line text input dialog component.
+package prompt
+
+import (
+	"strings"
+
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/kpumuk/lazykiq/internal/ui/components/frame"
+	"github.com/kpumuk/lazykiq/internal/ui/dialogs"
+)
+
+// DialogID identifies the prompt dialog.
+const DialogID dialogs.DialogID = "prompt"
+
+// ActionMsg reports a submitted prompt value.
+type ActionMsg struct {
+	Target string
+	Value  string
+}
+
+// Validator checks a trimmed input value and returns an error when it cannot
+// be submitted.
+type Validator func(value string) error
+
+// Styles holds the styles used by the prompt dialog.
+type Styles struct {
+	Title       lipgloss.Style
+	Border      lipgloss.Style
+	Prompt      lipgloss.Style
+	Text        lipgloss.Style
+	Placeholder lipgloss.Style
+	Cursor      lipgloss.Style
+	Invalid     lipgloss.Style
+}
+
+// DefaultStyles returns zero-value styles.
+func DefaultStyles() Styles {
+	return Styles{}
+}
+
+// Model defines state for the prompt dialog component.
+type Model struct {
+	styles       Styles
+	input        textinput.Model
+	inputBox     lipgloss.Style
+	title        string
+	target       string
+	validator    Validator
+	err          error
+	width        int
+	height       int
+	windowWidth  int
+	windowHeight int
+	row          int
+	col          int
+	padding      int
+	minWidth     int
+}
+
+// Option configures the prompt dialog.
+type Option func(*Model)
+
+// New creates a new prompt dialog model.
+func New(opts ...Option) *Model {
+	m := &Model{
+		styles:   DefaultStyles(),
+		input:    textinput.New(),
+		title:    "Input",
+		padding:  1,
+		minWidth: 38,
+	}
+
+	m.input.Prompt = ""
+	m.input.Blur()
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	m.applyStyles()
+	m.applySize()
+
+	return m
+}
+
+// WithStyles sets the styles.
+func WithStyles(s Styles) Option {
+	return func(m *Model) {
+		m.styles = s
+		m.applyStyles()
+	}
+}
+
+// WithTitle sets the dialog title.
+func WithTitle(title string) Option {
+	return func(m *Model) {
+		m.title = title
+	}
+}
+
+// WithValue sets the initial input value.
+func WithValue(value string) Option {
+	return func(m *Model) {
+		m.input.SetValue(value)
+	}
+}
+
+// WithPlaceholder sets the placeholder shown for empty input.
+func WithPlaceholder(placeholder string) Option {
+	return func(m *Model) {
+		m.input.Placeholder = placeholder
+	}
+}
+
+// WithTarget sets the target echoed back in ActionMsg.
+func WithTarget(target string) Option {
+	return func(m *Model) {
+		m.target = target
+	}
+}
+
+// WithValidator sets the function used to validate input before submitting.
+func WithValidator(validator Validator) Option {
+	return func(m *Model) {
+		m.validator = validator
+	}
+}
+
+// WithMinWidth sets the minimum dialog width.
+func WithMinWidth(width int) Option {
+	return func(m *Model) {
+		m.minWidth = width
+	}
+}
+
+// Init focuses the input.
+func (m *Model) Init() tea.Cmd {
+	m.input.CursorEnd()
+	m.validate()
+	return m.input.Focus()
+}
+
+// Update handles input and dialog lifecycle.
+func (m *Model) Update(msg tea.Msg) (dialogs.DialogModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.windowWidth = msg.Width
+		m.windowHeight = msg.Height
+		m.applySize()
+		return m, nil
+	case tea.KeyPressMsg:
+		switch msg.String() {
+		case "enter":
+			if m.err != nil {
+				return m, nil
+			}
+			value := m.Value()
+			target := m.target
+			return m, tea.Batch(
+				func() tea.Msg { return ActionMsg{Target: target, Value: value} },
+				func() tea.Msg { return dialogs.CloseDialogMsg{} },
+			)
+		case "esc":
+			return m, func() tea.Msg { return dialogs.CloseDialogMsg{} }
+		case "ctrl+u":
+			m.input.SetValue("")
+			m.input.CursorEnd()
+			m.validate()
+			return m, nil
+		}
+
+		var cmd tea.Cmd
+		m.input, cmd = m.input.Update(msg)
+		m.validate()
+		return m, cmd
+	}
+
+	return m, nil
+}
+
+// Value returns the trimmed input value.
+func (m *Model) Value() string {
+	return strings.TrimSpace(m.input.Value())
+}
+
+// Err returns the current validation error, if any.
+func (m *Model) Err() error {
+	return m.err
+}
+
+// View renders the prompt dialog.
+func (m *Model) View() string {
+	content := m.inputBox.Render(m.input.View())
+	border := m.styles.Border
+	meta := ""
+	if m.err != nil {
+		border = m.styles.Invalid
+		meta = m.styles.Invalid.Render(m.err.Error())
+	}
+	state := frame.StyleState{
+		Title:  m.styles.Title,
+		Muted:  m.styles.Placeholder,
+		Filter: m.styles.Title,
+		Border: border,
+	}
+	box := frame.New(
+		frame.WithStyles(frame.Styles{Focused: state, Blurred: state}),
+		frame.WithTitle(m.title),
+		frame.WithMeta(meta),
+		frame.WithTitlePadding(0),
+		frame.WithContent(content),
+		frame.WithPadding(m.padding),
+		frame.WithSize(m.width, m.height),
+		frame.WithMinHeight(3),
+		frame.WithFocused(true),
+	)
+	return box.View()
+}
+
+// Position returns the dialog position.
+func (m *Model) Position() (int, int) {
+	return m.row, m.col
+}
+
+// ID returns the dialog ID.
+func (m *Model) ID() dialogs.DialogID {
+	return DialogID
+}
+
+func (m *Model) applyStyles() {
+	styles := m.input.Styles()
+	styles.Focused.Prompt = m.styles.Prompt
+	styles.Focused.Text = m.styles.Text
+	styles.Focused.Placeholder = m.styles.Placeholder
+	styles.Blurred.Prompt = m.styles.Prompt
+	styles.Blurred.Text = m.styles.Text
+	styles.Blurred.Placeholder = m.styles.Placeholder
+	if cursorColor := m.styles.Cursor.GetForeground(); !isNoColor(cursorColor) {
+		styles.Cursor.Color = cursorColor
+	}
+	m.input.SetStyles(styles)
+}
+
+func (m *Model) applySize() {
+	if m.windowWidth == 0 || m.windowHeight == 0 {
+		return
+	}
+
+	dialogWidth := max(m.windowWidth/2, m.minWidth)
+	dialogWidth = min(dialogWidth, m.windowWidth-4)
+	if dialogWidth < 10 {
+		dialogWidth = max(m.windowWidth-2, 10)
+	}
+
+	dialogHeight := 3
+	if m.windowHeight < dialogHeight {
+		dialogHeight = max(m.windowHeight, 3)
+	}
+
+	m.width = dialogWidth
+	m.height = dialogHeight
+	m.row = max((m.windowHeight-dialogHeight)/2, 0)
+	m.col = max((m.windowWidth-dialogWidth)/2, 0)
+
+	contentWidth := max(dialogWidth-2-(m.padding*2), 1)
+	m.inputBox = lipgloss.NewStyle().Width(contentWidth).MaxWidth(contentWidth)
+	promptWidth := lipgloss.Width(m.input.Prompt)
+	// textinput renders a virtual cursor that adds one extra column.
+	m.input.SetWidth(max(contentWidth-promptWidth-1, 1))
+}
+
+func (m *Model) validate() {
+	if m.validator == nil {
+		m.err = nil
+		return
+	}
+	m.err = m.validator(m.Value())
+}
+
+func isNoColor(c any) bool {
+	_, ok := c.(lipgloss.NoColor)
+	return ok
+}
@@ -0,0 +1,122 @@
+package prompt
+
+import (
+	"errors"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/charmbracelet/x/exp/golden"
+
+	"github.com/kpumuk/lazykiq/internal/ui/dialogs"
+)
+
+func keyCode(code rune) tea.KeyPressMsg {
+	return tea.KeyPressMsg(tea.Key{Code: code})
+}
+
+func updateModel(t *testing.T, m *Model, msg tea.Msg) (*Model, tea.Cmd) {
+	t.Helper()
+	next, cmd := m.Update(msg)
+	updated, ok := next.(*Model)
+	if !ok {
+		t.Fatalf("Update returned %T, want *Model", next)
+	}
+	return updated, cmd
+}
+
+func collectMsgs(t *testing.T, cmd tea.Cmd) []tea.Msg {
+	t.Helper()
+	if cmd == nil {
+		return nil
+	}
+	msg := cmd()
+	if msg == nil {
+		return nil
+	}
+	switch m := msg.(type) {
+	case tea.BatchMsg:
+		var out []tea.Msg
+		for _, c := range m {
+			out = append(out, collectMsgs(t, c)...)
+		}
+		return out
+	default:
+		return []tea.Msg{m}
+	}
+}
+
+func requireEmpty(value string) error {
+	if value == "" {
+		return errors.New("required")
+	}
+	return nil
+}
+
+func TestPromptDialogEnterSubmitsValue(t *testing.T) {
+	t.Parallel()
+
+	m := New(WithTarget("dead.delay"), WithValue(" 5m "))
+	m.Init()
+
+	_, cmd := updateModel(t, m, keyCode(tea.KeyEnter))
+	msgs := collectMsgs(t, cmd)
+	if len(msgs) != 2 {
+		t.Fatalf("expected 2 msgs, got %d", len(msgs))
+	}
+	action, ok := msgs[0].(ActionMsg)
+	if !ok {
+		t.Fatalf("expected ActionMsg, got %T", msgs[0])
+	}
+	if action.Target != "dead.delay" || action.Value != "5m" {
+		t.Fatalf("action = %+v, want target dead.delay value 5m", action)
+	}
+	if _, ok := msgs[1].(dialogs.CloseDialogMsg); !ok {
+		t.Fatalf("expected CloseDialogMsg, got %T", msgs[1])
+	}
+}
+
+func TestPromptDialogInvalidBlocksSubmit(t *testing.T) {
+	t.Parallel()
+
+	m := New(WithValidator(requireEmpty))
+	m.Init()
+	if m.Err() == nil {
+		t.Fatal("expected validation error for empty input")
+	}
+
+	m, cmd := updateModel(t, m, keyCode(tea.KeyEnter))
+	if cmd != nil {
+		t.Fatal("expected enter to be ignored while input is invalid")
+	}
+
+	m, _ = updateModel(t, m, tea.KeyPressMsg(tea.Key{Code: 'x', Text: "x"}))
+	if err := m.Err(); err != nil {
+		t.Fatalf("Err() = %v, want nil", err)
+	}
+}
+
+func TestPromptDialogEscCloses(t *testing.T) {
+	t.Parallel()
+
+	m := New(WithValue("5m"))
+	m.Init()
+
+	_, cmd := updateModel(t, m, keyCode(tea.KeyEscape))
+	msgs := collectMsgs(t, cmd)
+	if len(msgs) != 1 {
+		t.Fatalf("expected 1 msg, got %d", len(msgs))
+	}
+	if _, ok := msgs[0].(dialogs.CloseDialogMsg); !ok {
+		t.Fatalf("expected CloseDialogMsg, got %T", msgs[0])
+	}
+}
+
+func TestGoldenPromptDialog(t *testing.T) {
+	m := New(WithTitle("Retry later"), WithValue("15m"))
+	m.Init()
+	m, _ = updateModel(t, m, tea.WindowSizeMsg{Width: 80, Height: 24})
+
+	output := ansi.Strip(m.View())
+	golden.RequireEqual(t, []byte(output))
+}
@@ -0,0 +1,222 @@
+// Package text provides a read-only, scrollable text dialog.
+package text
+
+import (
+	"strconv"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/kpumuk/lazykiq/internal/mathutil"
+	"github.com/kpumuk/lazykiq/internal/ui/components/frame"
+	"github.com/kpumuk/lazykiq/internal/ui/dialogs"
+)
+
+// DialogID identifies the text dialog.
+const DialogID dialogs.DialogID = "text"
+
+// Styles holds the styles used by the text dialog.
+type Styles struct {
+	Title  lipgloss.Style
+	Border lipgloss.Style
+	Text   lipgloss.Style
+	Muted  lipgloss.Style
+}
+
+// DefaultStyles returns zero-value styles.
+func DefaultStyles() Styles {
+	return Styles{}
+}
+
+// Model defines state for the text dialog.
+type Model struct {
+	styles       Styles
+	title        string
+	note         string
+	lines        []string
+	yOffset      int
+	width        int
+	height       int
+	windowWidth  int
+	windowHeight int
+	row          int
+	col          int
+	padding      int
+	minWidth     int
+}
+
+// Option configures the text dialog.
+type Option func(*Model)
+
+// New creates a new text dialog model.
+func New(opts ...Option) *Model {
+	m := &Model{
+		styles:   DefaultStyles(),
+		title:    "Text",
+		padding:  1,
+		minWidth: 40,
+	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	return m
+}
+
+// WithStyles sets the styles.
+func WithStyles(s Styles) Option {
+	return func(m *Model) {
+		m.styles = s
+	}
+}
+
+// WithTitle sets the dialog title.
+func WithTitle(title string) Option {
+	return func(m *Model) {
+		m.title = strings.TrimSpace(title)
+	}
+}
+
+// WithNote sets a muted line shown above the text, such as what the text is.
+func WithNote(note string) Option {
+	return func(m *Model) {
+		m.note = strings.TrimSpace(note)
+	}
+}
+
+// WithText sets the text. Lines are shown as-is and cut at the dialog width.
+func WithText(text string) Option {
+	return func(m *Model) {
+		m.lines = strings.Split(strings.TrimRight(text, "\n"), "\n")
+	}
+}
+
+// Init implements dialogs.DialogModel.
+func (m *Model) Init() tea.Cmd { return nil }
+
+// Update handles scrolling and closing.
+func (m *Model) Update(msg tea.Msg) (dialogs.DialogModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.windowWidth = msg.Width
+		m.windowHeight = msg.Height
+		m.applySize()
+		return m, nil
+	case tea.KeyPressMsg:
+		switch msg.String() {
+		case "esc", "enter":
+			return m, func() tea.Msg { return dialogs.CloseDialogMsg{} }
+		case "up", "k":
+			m.scroll(-1)
+		case "down", "j":
+			m.scroll(1)
+		case "pgup", "[":
+			m.scroll(-m.visibleLines())
+		case "pgdown", "]":
+			m.scroll(m.visibleLines())
+		case "g", "home":
+			m.yOffset = 0
+		case "G", "end":
+			m.yOffset = m.maxYOffset()
+		}
+	}
+
+	return m, nil
+}
+
+// View renders the text dialog.
+func (m *Model) View() string {
+	m.applySize()
+	contentWidth := max(m.width-2-(m.padding*2), 1)
+
+	contentLines := make([]string, 0, m.visibleLines()+2)
+	if m.note != "" {
+		contentLines = append(contentLines, m.styles.Muted.Render(ansi.Truncate(m.note, contentWidth, "…")), "")
+	}
+	end := min(m.yOffset+m.visibleLines(), len(m.lines))
+	for _, line := range m.lines[m.yOffset:end] {
+		line = strings.ReplaceAll(line, "\t", "  ")
+		contentLines = append(contentLines, m.styles.Text.Render(ansi.Truncate(line, contentWidth, "…")))
+	}
+
+	meta := ""
+	if m.maxYOffset() > 0 {
+		meta = m.styles.Muted.Render(strconv.Itoa(m.yOffset+1) + "-" + strconv.Itoa(end) + "/" + strconv.Itoa(len(m.lines)))
+	}
+
+	styleState := frame.StyleState{
+		Title:  m.styles.Title,
+		Muted:  m.styles.Muted,
+		Filter: m.styles.Title,
+		Border: m.styles.Border,
+	}
+	box := frame.New(
+		frame.WithStyles(frame.Styles{Focused: styleState, Blurred: styleState}),
+		frame.WithTitle(m.title),
+		frame.WithTitlePadding(0),
+		frame.WithMeta(meta),
+		frame.WithContent(strings.Join(contentLines, "\n")),
+		frame.WithPadding(m.padding),
+		frame.WithSize(m.width, m.height),
+		frame.WithMinHeight(3),
+		frame.WithFocused(true),
+	)
+	return box.View()
+}
+
+// Position returns the dialog position.
+func (m *Model) Position() (int, int) {
+	return m.row, m.col
+}
+
+// ID returns the dialog ID.
+func (m *Model) ID() dialogs.DialogID {
+	return DialogID
+}
+
+func (m *Model) scroll(delta int) {
+	m.yOffset = mathutil.Clamp(m.yOffset+delta, 0, m.maxYOffset())
+}
+
+// visibleLines is how many text lines fit below the note.
+func (m *Model) visibleLines() int {
+	lines := m.height - 2
+	if m.note != "" {
+		lines -= 2
+	}
+	return max(lines, 1)
+}
+
+func (m *Model) maxYOffset() int {
+	return max(len(m.lines)-m.visibleLines(), 0)
+}
+
+func (m *Model) applySize() {
+	if m.windowWidth == 0 || m.windowHeight == 0 {
+		return
+	}
+
+	widest := 0
+	for _, line := range m.lines {
+		widest = max(widest, ansi.StringWidth(line))
+	}
+	dialogWidth := max(widest+2+(m.padding*2), m.minWidth)
+	dialogWidth = min(dialogWidth, max(m.windowWidth*3/4, m.minWidth), m.windowWidth-4)
+	dialogWidth = max(dialogWidth, 10)
+
+	contentLines := len(m.lines)
+	if m.note != "" {
+		contentLines += 2
+	}
+	dialogHeight := min(contentLines+2, max(m.windowHeight*3/4, 3))
+	dialogHeight = max(dialogHeight, 3)
+
+	m.width = dialogWidth
+	m.height = dialogHeight
+	m.row = max((m.windowHeight-dialogHeight)/2, 0)
+	m.col = max((m.windowWidth-dialogWidth)/2, 0)
+	m.yOffset = mathutil.Clamp(m.yOffset, 0, m.maxYOffset())
+}
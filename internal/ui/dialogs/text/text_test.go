@@ -0,0 +1,90 @@
+package text
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/charmbracelet/x/exp/golden"
+
+	"github.com/kpumuk/lazykiq/internal/ui/dialogs"
+)
+
+func keyText(text string) tea.KeyPressMsg {
+	var code rune
+	for _, r := range text {
+		code = r
+		break
+	}
+	return tea.KeyPressMsg(tea.Key{Text: text, Code: code})
+}
+
+func updateModel(t *testing.T, m *Model, msg tea.Msg) (*Model, tea.Cmd) {
+	t.Helper()
+	next, cmd := m.Update(msg)
+	updated, ok := next.(*Model)
+	if !ok {
+		t.Fatalf("Update returned %T, want *Model", next)
+	}
+	return updated, cmd
+}
+
+func TestTextDialogCloses(t *testing.T) {
+	t.Parallel()
+
+	for _, key := range []tea.KeyPressMsg{
+		tea.KeyPressMsg(tea.Key{Code: tea.KeyEscape}),
+		tea.KeyPressMsg(tea.Key{Code: tea.KeyEnter}),
+	} {
+		m := New(WithText("hello"))
+		_, cmd := updateModel(t, m, key)
+		if cmd == nil {
+			t.Fatalf("%s returned no command", key)
+		}
+		if _, ok := cmd().(dialogs.CloseDialogMsg); !ok {
+			t.Fatalf("%s did not close the dialog", key)
+		}
+	}
+}
+
+func TestTextDialogScrolls(t *testing.T) {
+	t.Parallel()
+
+	lines := make([]string, 50)
+	for i := range lines {
+		lines[i] = fmt.Sprintf("line %02d", i+1)
+	}
+	m := New(WithText(strings.Join(lines, "\n")))
+	m, _ = updateModel(t, m, tea.WindowSizeMsg{Width: 80, Height: 20})
+
+	m, _ = updateModel(t, m, keyText("j"))
+	if m.yOffset != 1 {
+		t.Fatalf("yOffset after j = %d, want 1", m.yOffset)
+	}
+	m, _ = updateModel(t, m, keyText("G"))
+	if m.yOffset != m.maxYOffset() || m.yOffset == 0 {
+		t.Fatalf("yOffset after G = %d, want %d", m.yOffset, m.maxYOffset())
+	}
+	if output := ansi.Strip(m.View()); !strings.Contains(output, "line 50") {
+		t.Fatalf("last line not shown after G:\n%s", output)
+	}
+	m, _ = updateModel(t, m, keyText("g"))
+	if m.yOffset != 0 {
+		t.Fatalf("yOffset after g = %d, want 0", m.yOffset)
+	}
+}
+
+func TestGoldenTextDialog(t *testing.T) {
+	m := New(
+		WithTitle("Decoded"),
+		WithNote("base64 + zlib, 24 bytes, JSON"),
+		WithText("{\n  \"tenant\": 42,\n  \"tags\": [\"a\", \"b\"]\n}"),
+	)
+	m.Init()
+	m, _ = updateModel(t, m, tea.WindowSizeMsg{Width: 100, Height: 30})
+
+	output := ansi.Strip(m.View())
+	golden.RequireEqual(t, []byte(output))
+}
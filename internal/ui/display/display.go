@@ -4,6 +4,8 @@ package display
 import (
 	"encoding/json"
 	"fmt"
+	"math"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -33,6 +35,29 @@ func Duration(seconds int64) string {
 	}
 }
 
+// ParseDuration parses durations like time.ParseDuration and also accepts a
+// leading day component, so "30d", "1d12h", and the output of Duration parse.
+func ParseDuration(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	daysText, rest, ok := strings.Cut(value, "d")
+	if !ok {
+		return time.ParseDuration(value)
+	}
+	days, err := strconv.ParseInt(daysText, 10, 64)
+	if err != nil || days < 0 || days > int64(math.MaxInt64/(24*time.Hour)) {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+	total := time.Duration(days) * 24 * time.Hour
+	if rest == "" {
+		return total, nil
+	}
+	remainder, err := time.ParseDuration(rest)
+	if err != nil || remainder < 0 || remainder > math.MaxInt64-total {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+	return total + remainder, nil
+}
+
 var nowFunc = time.Now
 
 // DurationSince formats elapsed time since the given timestamp.
@@ -60,6 +85,30 @@ func Bytes(bytes int64) string {
 	return fmt.Sprintf("%.1f %cB", float64(bytes)/float64(div), "KMGTPE"[exp])
 }
 
+// ParseBytes parses sizes like "512", "100KB", or "1.5 MB", the inverse of
+// Bytes. Units are powers of 1024 and case-insensitive; a bare number is in
+// bytes.
+func ParseBytes(value string) (int64, error) {
+	text := strings.ToUpper(strings.TrimSpace(value))
+	number := strings.TrimRight(text, "KMGTPEB ")
+	unit := strings.TrimSpace(text[len(number):])
+	multiplier := int64(1)
+	if unit != "" && unit != "B" {
+		exp := strings.IndexByte("KMGTPE", unit[0])
+		if exp < 0 || (unit[1:] != "" && unit[1:] != "B") {
+			return 0, fmt.Errorf("invalid size %q", value)
+		}
+		for range exp + 1 {
+			multiplier *= 1024
+		}
+	}
+	amount, err := strconv.ParseFloat(number, 64)
+	if err != nil || amount < 0 || amount*float64(multiplier) > math.MaxInt64 {
+		return 0, fmt.Errorf("invalid size %q", value)
+	}
+	return int64(amount * float64(multiplier)), nil
+}
+
 // Args formats job arguments as JSON without outer brackets.
 func Args(args []any) string {
 	if len(args) == 0 {
@@ -79,8 +128,119 @@ func Args(args []any) string {
 	return strings.Join(parts, ", ")
 }
 
-// ShortNumber formats a number with K/M suffixes for readability.
+// ArgRow is one row of an argument tree. Value is empty when the row is a
+// map or array whose entries follow at Depth+1.
+type ArgRow struct {
+	Depth int
+	Key   string
+	Value string
+}
+
+// ArgsTree expands job arguments into key/value rows for detail panels.
+// Top-level arguments are keyed by index at depth 0; maps (sorted by key) and
+// arrays are expanded while their entries stay within maxDepth and shown as
+// "{…}" or "[…]" beyond it. A maxDepth below 1 is treated as 1.
+func ArgsTree(args []any, maxDepth int) []ArgRow {
+	maxDepth = max(maxDepth, 1)
+	rows := make([]ArgRow, 0, len(args))
+	for i, arg := range args {
+		rows = appendArgRows(rows, 0, strconv.Itoa(i), arg, maxDepth)
+	}
+	return rows
+}
+
+func appendArgRows(rows []ArgRow, depth int, key string, value any, maxDepth int) []ArgRow {
+	switch v := value.(type) {
+	case map[string]any:
+		if len(v) == 0 {
+			return append(rows, ArgRow{Depth: depth, Key: key, Value: "{}"})
+		}
+		if depth >= maxDepth {
+			return append(rows, ArgRow{Depth: depth, Key: key, Value: "{…}"})
+		}
+		rows = append(rows, ArgRow{Depth: depth, Key: key})
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+		for _, k := range keys {
+			rows = appendArgRows(rows, depth+1, k, v[k], maxDepth)
+		}
+		return rows
+	case []any:
+		if len(v) == 0 {
+			return append(rows, ArgRow{Depth: depth, Key: key, Value: "[]"})
+		}
+		if depth >= maxDepth {
+			return append(rows, ArgRow{Depth: depth, Key: key, Value: "[…]"})
+		}
+		rows = append(rows, ArgRow{Depth: depth, Key: key})
+		for i, item := range v {
+			rows = appendArgRows(rows, depth+1, strconv.Itoa(i), item, maxDepth)
+		}
+		return rows
+	default:
+		return append(rows, ArgRow{Depth: depth, Key: key, Value: Args([]any{v})})
+	}
+}
+
+// NumberFormat controls how counts are shown across the UI.
+type NumberFormat struct {
+	// ThousandsSeparator groups digits in Number and Float; empty disables
+	// grouping.
+	ThousandsSeparator string
+	// Abbreviate makes ShortNumber and CompactNumber use K/M/B suffixes.
+	// When false they print the full number, grouped like Number.
+	Abbreviate bool
+}
+
+// DefaultNumberFormat groups thousands with commas and abbreviates counts
+// in context bars and chart axes.
+func DefaultNumberFormat() NumberFormat {
+	return NumberFormat{ThousandsSeparator: ",", Abbreviate: true}
+}
+
+var numberFormat = DefaultNumberFormat()
+
+// SetNumberFormat changes how every formatter in this package prints
+// numbers. It is not safe for concurrent use and must be called before the
+// program starts.
+func SetNumberFormat(format NumberFormat) {
+	numberFormat = format
+}
+
+// thousandsSeparatorNames maps separator names accepted on the command line
+// to the separator itself.
+var thousandsSeparatorNames = map[string]string{
+	"comma":      ",",
+	"space":      " ",
+	"apostrophe": "'",
+	"underscore": "_",
+	"none":       "",
+}
+
+// ParseThousandsSeparator parses a separator given by name ("comma",
+// "space", "apostrophe", "underscore", or "none") or as the character
+// itself. A period is rejected because it would read as a decimal point.
+func ParseThousandsSeparator(value string) (string, error) {
+	if separator, ok := thousandsSeparatorNames[strings.ToLower(strings.TrimSpace(value))]; ok {
+		return separator, nil
+	}
+	for _, separator := range thousandsSeparatorNames {
+		if value == separator && value != "" {
+			return separator, nil
+		}
+	}
+	return "", fmt.Errorf("invalid thousands separator %q", value)
+}
+
+// ShortNumber formats a number with K/M suffixes for readability, or in full
+// when abbreviations are turned off.
 func ShortNumber(n int64) string {
+	if !numberFormat.Abbreviate {
+		return Number(n)
+	}
 	switch {
 	case n >= 1_000_000_000:
 		return fmt.Sprintf("%.1fB", float64(n)/1_000_000_000)
@@ -125,20 +285,25 @@ func addThousandsSeparators(s string) string {
 		return s
 	}
 
-	// Insert commas from right to left
+	// Insert separators from right to left
+	separator := numberFormat.ThousandsSeparator
 	var result strings.Builder
-	result.Grow(len(s) + (len(s)-1)/3)
+	result.Grow(len(s) + (len(s)-1)/3*len(separator))
 	for i, c := range s {
 		if i > 0 && (len(s)-i)%3 == 0 {
-			result.WriteByte(',')
+			result.WriteString(separator)
 		}
 		result.WriteRune(c)
 	}
 	return result.String()
 }
 
-// CompactNumber formats a number into a compact 4-char max string (e.g., 999, 9.9K, 120K).
+// CompactNumber formats a number into a compact 4-char max string (e.g., 999, 9.9K, 120K),
+// or in full when abbreviations are turned off.
 func CompactNumber(n int64) string {
+	if !numberFormat.Abbreviate {
+		return Number(n)
+	}
 	switch {
 	case n < 1_000:
 		return strconv.FormatInt(n, 10)
@@ -2,6 +2,7 @@ package display
 
 import (
 	"errors"
+	"slices"
 	"testing"
 	"time"
 )
@@ -37,6 +38,84 @@ func TestDuration(t *testing.T) {
 	}
 }
 
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{name: "go duration", value: "90m", want: 90 * time.Minute},
+		{name: "days", value: "30d", want: 30 * 24 * time.Hour},
+		{name: "days-hours", value: "1d12h", want: 36 * time.Hour},
+		{name: "duration output", value: Duration(90061), want: 25 * time.Hour},
+		{name: "spaces", value: " 2d ", want: 48 * time.Hour},
+		{name: "empty", value: "", wantErr: true},
+		{name: "bad days", value: "xd", wantErr: true},
+		{name: "negative days", value: "-1d", wantErr: true},
+		{name: "bad remainder", value: "1dx", wantErr: true},
+		{name: "days overflow", value: "106752d", wantErr: true},
+		{name: "remainder overflow", value: "106751d99999h", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDuration(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseDuration(%q) expected error", tt.value)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseDuration(%q) error = %v", tt.value, err)
+			}
+			if got != tt.want {
+				t.Fatalf("ParseDuration(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseBytes(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    int64
+		wantErr bool
+	}{
+		{name: "bare bytes", value: "512", want: 512},
+		{name: "bytes unit", value: "512B", want: 512},
+		{name: "kilobytes", value: "100KB", want: 100 * 1024},
+		{name: "short unit", value: "2m", want: 2 * 1024 * 1024},
+		{name: "bytes output", value: Bytes(1536), want: 1536},
+		{name: "spaces", value: " 1.5 MB ", want: 1536 * 1024},
+		{name: "zero", value: "0", want: 0},
+		{name: "empty", value: "", wantErr: true},
+		{name: "bad unit", value: "10XB", wantErr: true},
+		{name: "negative", value: "-1KB", wantErr: true},
+		{name: "overflow", value: "9000000EB", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseBytes(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseBytes(%q) expected error", tt.value)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseBytes(%q) error = %v", tt.value, err)
+			}
+			if got != tt.want {
+				t.Fatalf("ParseBytes(%q) = %d, want %d", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestDurationSince(t *testing.T) {
 	fixedNow := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
 	restoreNow := nowFunc
@@ -119,6 +198,86 @@ func TestArgs(t *testing.T) {
 	}
 }
 
+func TestArgsTree(t *testing.T) {
+	args := []any{
+		42,
+		map[string]any{
+			"user": map[string]any{
+				"id":    7,
+				"roles": []any{"admin"},
+				"prefs": map[string]any{"theme": "dark"},
+			},
+			"dry_run": true,
+			"tags":    []any{},
+		},
+		[]any{"a", map[string]any{"b": nil}},
+	}
+
+	tests := []struct {
+		name     string
+		maxDepth int
+		want     []ArgRow
+	}{
+		{
+			name:     "expanded",
+			maxDepth: 3,
+			want: []ArgRow{
+				{Depth: 0, Key: "0", Value: "42"},
+				{Depth: 0, Key: "1"},
+				{Depth: 1, Key: "dry_run", Value: "true"},
+				{Depth: 1, Key: "tags", Value: "[]"},
+				{Depth: 1, Key: "user"},
+				{Depth: 2, Key: "id", Value: "7"},
+				{Depth: 2, Key: "prefs"},
+				{Depth: 3, Key: "theme", Value: `"dark"`},
+				{Depth: 2, Key: "roles"},
+				{Depth: 3, Key: "0", Value: `"admin"`},
+				{Depth: 0, Key: "2"},
+				{Depth: 1, Key: "0", Value: `"a"`},
+				{Depth: 1, Key: "1"},
+				{Depth: 2, Key: "b", Value: "null"},
+			},
+		},
+		{
+			name:     "truncated",
+			maxDepth: 1,
+			want: []ArgRow{
+				{Depth: 0, Key: "0", Value: "42"},
+				{Depth: 0, Key: "1"},
+				{Depth: 1, Key: "dry_run", Value: "true"},
+				{Depth: 1, Key: "tags", Value: "[]"},
+				{Depth: 1, Key: "user", Value: "{…}"},
+				{Depth: 0, Key: "2"},
+				{Depth: 1, Key: "0", Value: `"a"`},
+				{Depth: 1, Key: "1", Value: "{…}"},
+			},
+		},
+		{
+			name:     "depth below one",
+			maxDepth: 0,
+			want: []ArgRow{
+				{Depth: 0, Key: "0", Value: "42"},
+				{Depth: 0, Key: "1"},
+				{Depth: 1, Key: "dry_run", Value: "true"},
+				{Depth: 1, Key: "tags", Value: "[]"},
+				{Depth: 1, Key: "user", Value: "{…}"},
+				{Depth: 0, Key: "2"},
+				{Depth: 1, Key: "0", Value: `"a"`},
+				{Depth: 1, Key: "1", Value: "{…}"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ArgsTree(args, tt.maxDepth)
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("ArgsTree() =\n%v\nwant\n%v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestShortNumber(t *testing.T) {
 	tests := []struct {
 		name string
@@ -220,3 +379,88 @@ func TestFloat(t *testing.T) {
 		})
 	}
 }
+
+func TestNumberFormatSeparator(t *testing.T) {
+	t.Cleanup(func() { SetNumberFormat(DefaultNumberFormat()) })
+
+	tests := []struct {
+		name      string
+		separator string
+		n         int64
+		want      string
+	}{
+		{name: "space-plain", separator: " ", n: 999, want: "999"},
+		{name: "space-kilo", separator: " ", n: 1500, want: "1 500"},
+		{name: "space-mega", separator: " ", n: 1_234_567, want: "1 234 567"},
+		{name: "space-negative", separator: " ", n: -1_234_567, want: "-1 234 567"},
+		{name: "apostrophe-giga", separator: "'", n: 1_000_000_000, want: "1'000'000'000"},
+		{name: "none-kilo", separator: "", n: 1500, want: "1500"},
+		{name: "none-giga", separator: "", n: 12_345_678_901, want: "12345678901"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetNumberFormat(NumberFormat{ThousandsSeparator: tt.separator, Abbreviate: true})
+			if got := Number(tt.n); got != tt.want {
+				t.Fatalf("Number(%d) = %q, want %q", tt.n, got, tt.want)
+			}
+		})
+	}
+
+	SetNumberFormat(NumberFormat{ThousandsSeparator: " ", Abbreviate: true})
+	if got := Float(1_234_567.891, 2); got != "1 234 567.89" {
+		t.Fatalf("Float = %q, want 1 234 567.89", got)
+	}
+}
+
+func TestNumberFormatFullNumbers(t *testing.T) {
+	t.Cleanup(func() { SetNumberFormat(DefaultNumberFormat()) })
+	SetNumberFormat(NumberFormat{ThousandsSeparator: "_", Abbreviate: false})
+
+	if got := ShortNumber(1_500_000); got != "1_500_000" {
+		t.Fatalf("ShortNumber = %q, want 1_500_000", got)
+	}
+	if got := CompactNumber(12_345); got != "12_345" {
+		t.Fatalf("CompactNumber = %q, want 12_345", got)
+	}
+	if got := ShortNumber(999); got != "999" {
+		t.Fatalf("ShortNumber(999) = %q, want 999", got)
+	}
+}
+
+func TestParseThousandsSeparator(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{value: "comma", want: ","},
+		{value: "Space", want: " "},
+		{value: "none", want: ""},
+		{value: "apostrophe", want: "'"},
+		{value: "_", want: "_"},
+		{value: " ", want: " "},
+		{value: ",", want: ","},
+		{value: ".", wantErr: true},
+		{value: "dot", wantErr: true},
+		{value: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseThousandsSeparator(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseThousandsSeparator(%q) = %q, want error", tt.value, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseThousandsSeparator(%q) failed: %v", tt.value, err)
+			}
+			if got != tt.want {
+				t.Fatalf("ParseThousandsSeparator(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
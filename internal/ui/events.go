@@ -0,0 +1,50 @@
+package ui
+
+import (
+	"context"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/views"
+)
+
+// eventMsg carries an event from the events subscription along with the
+// subscription itself, so the next event can be awaited.
+type eventMsg struct {
+	event  sidekiq.Event
+	events <-chan sidekiq.Event
+}
+
+// SetEventsChannel subscribes to a Redis pub/sub channel whose messages are
+// shown in the Events view (ctrl+e). It must be called before the program
+// starts; an empty channel leaves the view off.
+func (a *App) SetEventsChannel(channel string) {
+	a.eventsChannel = channel
+	a.keys.Events.SetEnabled(channel != "")
+	if setter, ok := a.viewRegistry[viewEvents].(views.EventsChannelSetter); ok {
+		setter.SetEventsChannel(channel)
+	}
+}
+
+// subscribeEventsCmd starts the events subscription. It lives as long as
+// the program and is independent of the refresh tick: the client keeps
+// reconnecting when the connection drops.
+func (a App) subscribeEventsCmd() tea.Cmd {
+	if a.eventsChannel == "" || a.sidekiq == nil {
+		return nil
+	}
+	events := a.sidekiq.SubscribeEvents(context.Background(), a.eventsChannel)
+	return waitForEventCmd(events)
+}
+
+// waitForEventCmd waits for the next event on the subscription.
+func waitForEventCmd(events <-chan sidekiq.Event) tea.Cmd {
+	return func() tea.Msg {
+		event, ok := <-events
+		if !ok {
+			return nil
+		}
+		return eventMsg{event: event, events: events}
+	}
+}
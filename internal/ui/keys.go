@@ -1,22 +1,34 @@
 package ui
 
-import "charm.land/bubbles/v2/key"
+import (
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+)
 
 // KeyMap defines all global keybindings.
 type KeyMap struct {
-	Quit     key.Binding
-	View1    key.Binding
-	View2    key.Binding
-	View3    key.Binding
-	View4    key.Binding
-	View5    key.Binding
-	View6    key.Binding
-	View7    key.Binding
-	View8    key.Binding
-	Tab      key.Binding
-	ShiftTab key.Binding
-	Help     key.Binding
-	DevTools key.Binding
+	Quit       key.Binding
+	View1      key.Binding
+	View2      key.Binding
+	View3      key.Binding
+	View4      key.Binding
+	View5      key.Binding
+	View6      key.Binding
+	View7      key.Binding
+	View8      key.Binding
+	View9      key.Binding
+	Tab        key.Binding
+	ShiftTab   key.Binding
+	Refresh    key.Binding
+	RefreshAll key.Binding
+	Help       key.Binding
+	Palette    key.Binding
+	DevTools   key.Binding
+	Inspector  key.Binding
+	SelectDB   key.Binding
+	Events     key.Binding
+	Home       key.Binding
 }
 
 // DefaultKeyMap returns the default keybindings.
@@ -58,6 +70,10 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("8"),
 			key.WithHelp("8", "metrics"),
 		),
+		View9: key.NewBinding(
+			key.WithKeys("9"),
+			key.WithHelp("9", "activity"),
+		),
 		Tab: key.NewBinding(
 			key.WithKeys("tab"),
 			key.WithHelp("tab", "next panel"),
@@ -66,26 +82,67 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("shift+tab"),
 			key.WithHelp("shift+tab", "prev panel"),
 		),
+		Refresh: key.NewBinding(
+			key.WithKeys("r"),
+			key.WithHelp("r", "refresh view"),
+		),
+		// Retries, Scheduled, and Dead bind R; the view's binding wins there.
+		RefreshAll: key.NewBinding(
+			key.WithKeys("R"),
+			key.WithHelp("R", "refresh all"),
+		),
 		Help: key.NewBinding(
 			key.WithKeys("?"),
 			key.WithHelp("?", "help"),
 		),
+		// Retries binds ctrl+k to kill all; the view's binding wins there.
+		Palette: key.NewBinding(
+			key.WithKeys("ctrl+k"),
+			key.WithHelp("ctrl+k", "command palette"),
+		),
 		DevTools: key.NewBinding(
 			key.WithKeys("f12", "~"),
 			key.WithHelp("f12/~", "dev tools"),
 		),
+		Inspector: key.NewBinding(
+			key.WithKeys("ctrl+\\"),
+			key.WithHelp("ctrl+\\", "redis inspector"),
+		),
+		// ctrl+0-9 is not used here because Busy and Queues bind it. Each
+		// key selects the database at its position.
+		SelectDB: key.NewBinding(
+			key.WithKeys("alt+0", "alt+1", "alt+2", "alt+3", "alt+4", "alt+5", "alt+6", "alt+7", "alt+8", "alt+9"),
+			key.WithHelp("alt+0-9", "switch redis db"),
+		),
+		Events: key.NewBinding(
+			key.WithKeys("ctrl+e"),
+			key.WithHelp("ctrl+e", "events"),
+		),
+		// ~ is not used here because it toggles the dev tools.
+		Home: homeBinding(DefaultHomeKeys),
 	}
 }
 
+// DefaultHomeKeys are the keys that return to the Dashboard. The alt+h chord
+// also works while typing into a filter or another input.
+var DefaultHomeKeys = []string{"H", "alt+h"}
+
+func homeBinding(keys []string) key.Binding {
+	return key.NewBinding(
+		key.WithKeys(keys...),
+		key.WithHelp(strings.Join(keys, "/"), "home (dashboard)"),
+	)
+}
+
 // ShortHelp returns keybindings to show in the mini help view.
 func (k KeyMap) ShortHelp() []key.Binding {
-	return []key.Binding{k.View1, k.View2, k.View3, k.View4, k.View5, k.View6, k.View7, k.View8, k.Help, k.Quit, k.DevTools}
+	return []key.Binding{k.View1, k.View2, k.View3, k.View4, k.View5, k.View6, k.View7, k.View8, k.View9, k.Help, k.Quit, k.DevTools, k.Inspector}
 }
 
 // FullHelp returns keybindings for the expanded help view.
 func (k KeyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
-		{k.View1, k.View2, k.View3, k.View4, k.View5, k.View6, k.View7, k.View8},
-		{k.Tab, k.ShiftTab, k.Help, k.Quit, k.DevTools},
+		{k.View1, k.View2, k.View3, k.View4, k.View5, k.View6, k.View7, k.View8, k.View9},
+		{k.Tab, k.ShiftTab, k.Refresh, k.RefreshAll, k.Palette, k.SelectDB, k.Events, k.Home, k.Help, k.Quit, k.DevTools, k.Inspector},
 	}
 }
@@ -0,0 +1,173 @@
+package ui
+
+import (
+	"slices"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/kpumuk/lazykiq/internal/ui/dialogs"
+	palettedialog "github.com/kpumuk/lazykiq/internal/ui/dialogs/palette"
+	"github.com/kpumuk/lazykiq/internal/ui/views"
+)
+
+// paletteRecentLimit caps how many recently used commands the palette
+// remembers.
+const paletteRecentLimit = 10
+
+// namedKeys maps the key names used in bindings to key codes, for keys that
+// are not a single printable character.
+var namedKeys = map[string]rune{
+	"enter":     tea.KeyEnter,
+	"esc":       tea.KeyEscape,
+	"tab":       tea.KeyTab,
+	"space":     tea.KeySpace,
+	"backspace": tea.KeyBackspace,
+	"delete":    tea.KeyDelete,
+	"up":        tea.KeyUp,
+	"down":      tea.KeyDown,
+	"left":      tea.KeyLeft,
+	"right":     tea.KeyRight,
+	"home":      tea.KeyHome,
+	"end":       tea.KeyEnd,
+	"pgup":      tea.KeyPgUp,
+	"pgdown":    tea.KeyPgDown,
+	"f1":        tea.KeyF1,
+	"f2":        tea.KeyF2,
+	"f3":        tea.KeyF3,
+	"f4":        tea.KeyF4,
+	"f5":        tea.KeyF5,
+	"f6":        tea.KeyF6,
+	"f7":        tea.KeyF7,
+	"f8":        tea.KeyF8,
+	"f9":        tea.KeyF9,
+	"f10":       tea.KeyF10,
+	"f11":       tea.KeyF11,
+	"f12":       tea.KeyF12,
+}
+
+func (a App) togglePaletteDialog() tea.Cmd {
+	if a.dialogs.ActiveDialogID() == palettedialog.DialogID {
+		return func() tea.Msg { return dialogs.CloseDialogMsg{} }
+	}
+	commands := a.paletteCommands(a.viewRegistry[a.activeViewID()])
+	recent := slices.Clone(a.paletteRecent)
+	return func() tea.Msg {
+		return dialogs.OpenDialogMsg{
+			Model: palettedialog.New(
+				palettedialog.WithStyles(palettedialog.Styles{
+					Title:    a.styles.ViewTitle,
+					Border:   a.styles.FocusBorder,
+					Key:      a.styles.ContextKey,
+					Desc:     a.styles.ViewText,
+					Muted:    a.styles.ViewMuted,
+					Selected: a.styles.TableSelected,
+				}),
+				palettedialog.WithCommands(commands),
+				palettedialog.WithRecent(recent),
+			),
+		}
+	}
+}
+
+// paletteCommands lists the bindings of the active view's help sections,
+// which include its dangerous actions when they are enabled, followed by the
+// global bindings. The palette's own binding is left out.
+func (a App) paletteCommands(active views.View) []palettedialog.Command {
+	var commands []palettedialog.Command
+	seen := map[string]bool{}
+	add := func(section string, bindings []key.Binding) {
+		for _, binding := range bindings {
+			if len(binding.Keys()) == 0 || binding.Help() == a.keys.Palette.Help() {
+				continue
+			}
+			command := palettedialog.Command{Section: section, Binding: binding}
+			if seen[command.ID()] {
+				continue
+			}
+			seen[command.ID()] = true
+			commands = append(commands, command)
+		}
+	}
+
+	if provider, ok := active.(views.HelpProvider); ok {
+		for _, section := range provider.HelpSections() {
+			add(section.Title, section.Bindings)
+		}
+	}
+	add("Global", a.globalHelpBindings())
+	return commands
+}
+
+// activeViewBindsKey reports whether the active view handles msg itself, so
+// a global binding on the same key does not shadow it.
+func (a App) activeViewBindsKey(msg tea.KeyPressMsg) bool {
+	provider, ok := a.viewRegistry[a.activeViewID()].(views.HelpProvider)
+	if !ok {
+		return false
+	}
+	for _, section := range provider.HelpSections() {
+		for _, binding := range section.Bindings {
+			if key.Matches(msg, binding) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// runPaletteCommand remembers the command as recently used and replays its
+// first key, as if the user had pressed it.
+func (a *App) runPaletteCommand(command palettedialog.Command) tea.Cmd {
+	id := command.ID()
+	recent := slices.DeleteFunc(slices.Clone(a.paletteRecent), func(other string) bool { return other == id })
+	a.paletteRecent = append([]string{id}, recent...)
+	if len(a.paletteRecent) > paletteRecentLimit {
+		a.paletteRecent = a.paletteRecent[:paletteRecentLimit]
+	}
+
+	keys := command.Binding.Keys()
+	if len(keys) == 0 {
+		return nil
+	}
+	msg := keyPressFor(keys[0])
+	return func() tea.Msg { return msg }
+}
+
+// keyPressFor builds the key press whose String() is keystroke, such as
+// "ctrl+d", "D", "alt+left", or "enter".
+func keyPressFor(keystroke string) tea.KeyPressMsg {
+	var mod tea.KeyMod
+	name := keystroke
+	for {
+		prefix, rest, ok := strings.Cut(name, "+")
+		if !ok || rest == "" {
+			break
+		}
+		switch prefix {
+		case "ctrl":
+			mod |= tea.ModCtrl
+		case "alt":
+			mod |= tea.ModAlt
+		case "shift":
+			mod |= tea.ModShift
+		default:
+			return tea.KeyPressMsg{}
+		}
+		name = rest
+	}
+
+	if code, ok := namedKeys[name]; ok {
+		return tea.KeyPressMsg{Code: code, Mod: mod}
+	}
+	runes := []rune(name)
+	if len(runes) != 1 {
+		return tea.KeyPressMsg{}
+	}
+	msg := tea.KeyPressMsg{Code: runes[0], Mod: mod}
+	if mod == 0 {
+		msg.Text = name
+	}
+	return msg
+}
@@ -0,0 +1,49 @@
+package ui
+
+import (
+	"context"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/kpumuk/lazykiq/internal/devtools"
+	"github.com/kpumuk/lazykiq/internal/ui/views"
+)
+
+// dbSelectedMsg reports the outcome of switching the Redis database.
+type dbSelectedMsg struct {
+	db  int
+	err error
+}
+
+// selectDBCmd reconnects the client to database db.
+func (a *App) selectDBCmd(db int) tea.Cmd {
+	if a.sidekiq == nil || db == a.sidekiq.DB() {
+		return nil
+	}
+	client := a.sidekiq
+	return func() tea.Msg {
+		ctx := devtools.WithTracker(context.Background(), "app.selectDBCmd")
+		return dbSelectedMsg{db: db, err: client.SelectDB(ctx, db)}
+	}
+}
+
+// handleDBSelected shows the new database in the metrics bar and starts the
+// current top-level view over, since everything on screen came from the old
+// database. The events subscription ended with the old connection, so it is
+// started again on the new one.
+func (a *App) handleDBSelected(msg dbSelectedMsg) tea.Cmd {
+	if msg.err != nil {
+		a.connectionError = msg.err
+		return nil
+	}
+	a.metrics.SetDB(msg.db)
+
+	base := a.viewStack[0]
+	for _, id := range a.viewStack {
+		a.cancelViewRequests(id)
+		if disposable, ok := a.viewRegistry[id].(views.Disposable); ok {
+			disposable.Dispose()
+		}
+	}
+	return tea.Batch(a.setActiveView(base), a.fetchStatsCmd(), a.subscribeEventsCmd())
+}
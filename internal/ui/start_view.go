@@ -0,0 +1,99 @@
+package ui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/kpumuk/lazykiq/internal/ui/views"
+)
+
+// StartView is the view lazykiq starts on, as given to --view.
+type StartView struct {
+	// View is a top-level view name, such as "errors".
+	View string
+	// Queue preselects a queue on the queues view.
+	Queue string
+	// Job opens the metrics of a job class on the metrics view.
+	Job string
+}
+
+// startViewAliases maps alternative spellings to view names.
+var startViewAliases = map[string]string{
+	"retry": "retries",
+}
+
+// StartViewNames lists the view names accepted by ParseStartView, in
+// navigation order.
+func StartViewNames() []string {
+	names := make([]string, 0, len(topLevelViews))
+	for _, id := range topLevelViews {
+		names = append(names, viewStateKeys[id])
+	}
+	return names
+}
+
+// ParseStartView parses a --view value: a view name, "queues:<queue>" to also
+// select a queue, or "metrics:<job class>" to open a job's metrics.
+func ParseStartView(value string) (StartView, error) {
+	name, arg, hasArg := strings.Cut(strings.TrimSpace(value), ":")
+	name = strings.ToLower(name)
+	if alias, ok := startViewAliases[name]; ok {
+		name = alias
+	}
+
+	names := StartViewNames()
+	if !slices.Contains(names, name) {
+		return StartView{}, fmt.Errorf("unknown view %q, valid views: %s", name, strings.Join(names, ", "))
+	}
+
+	start := StartView{View: name}
+	if !hasArg {
+		return start, nil
+	}
+	if arg == "" {
+		return StartView{}, fmt.Errorf("missing value after %q", name+":")
+	}
+	switch name {
+	case "queues":
+		start.Queue = arg
+	case "metrics":
+		start.Job = arg
+	default:
+		return StartView{}, fmt.Errorf("view %q does not take a value, only queues:<queue> and metrics:<job class> do", name)
+	}
+	return start, nil
+}
+
+// SetStartView starts on the given view, overriding the one restored from
+// state. Like RestoreState, it must be called before the program starts.
+func (a *App) SetStartView(start StartView) {
+	for _, id := range a.viewOrder {
+		if viewStateKeys[id] != start.View {
+			continue
+		}
+		a.viewStack = []viewID{id}
+		a.stackbar.SetStack(a.stackNames())
+		break
+	}
+	if start.Queue != "" {
+		if setter, ok := a.viewRegistry[viewQueueDetails].(views.QueueDetailsSetter); ok {
+			setter.SetQueue(start.Queue)
+		}
+	}
+	a.startJobMetrics = start.Job
+}
+
+// openStartJobMetricsCmd opens the job metrics requested with SetStartView on
+// top of the metrics view.
+func (a App) openStartJobMetricsCmd() tea.Cmd {
+	if a.startJobMetrics == "" {
+		return nil
+	}
+	job := a.startJobMetrics
+	return func() tea.Msg {
+		return views.ShowJobMetricsMsg{Job: job}
+	}
+}
@@ -0,0 +1,129 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/kpumuk/lazykiq/internal/devtools"
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+
+	"github.com/kpumuk/lazykiq/internal/ui/views"
+)
+
+// State captures the UI state persisted across restarts.
+type State struct {
+	View  string                     `json:"view,omitempty"`
+	Views map[string]views.ViewState `json:"views,omitempty"`
+}
+
+// viewStateKeys are stable identifiers for top-level views in persisted state.
+var viewStateKeys = map[viewID]string{
+	viewDashboard:     "dashboard",
+	viewBusy:          "busy",
+	viewQueueDetails:  "queues",
+	viewRetries:       "retries",
+	viewScheduled:     "scheduled",
+	viewDead:          "dead",
+	viewErrorsSummary: "errors",
+	viewMetrics:       "metrics",
+	viewJobMetrics:    "job_metrics",
+	viewActivity:      "activity",
+}
+
+// persistedViews lists views whose state is saved: the top-level views
+// followed by stacked views that keep settings between openings.
+func (a App) persistedViews() []viewID {
+	return append(slices.Clone(a.viewOrder), viewJobMetrics)
+}
+
+// State returns the current UI state for persistence.
+func (a App) State() State {
+	state := State{}
+	if len(a.viewStack) > 0 {
+		state.View = viewStateKeys[a.viewStack[0]]
+	}
+	for _, id := range a.persistedViews() {
+		persister, ok := a.viewRegistry[id].(views.StatePersister)
+		if !ok {
+			continue
+		}
+		viewState := persister.SaveState()
+		if viewState.IsZero() {
+			continue
+		}
+		if state.Views == nil {
+			state.Views = make(map[string]views.ViewState)
+		}
+		state.Views[viewStateKeys[id]] = viewState
+	}
+	return state
+}
+
+// RestoreState applies previously persisted UI state. It must be called
+// before the program starts so the restored view initializes with it.
+// Unknown view keys are ignored.
+func (a *App) RestoreState(state State) {
+	for _, id := range a.persistedViews() {
+		persister, ok := a.viewRegistry[id].(views.StatePersister)
+		if !ok {
+			continue
+		}
+		if viewState, ok := state.Views[viewStateKeys[id]]; ok {
+			persister.RestoreState(viewState)
+		}
+	}
+	for _, id := range a.viewOrder {
+		if state.View != "" && viewStateKeys[id] == state.View {
+			a.viewStack = []viewID{id}
+			a.stackbar.SetStack(a.stackNames())
+			break
+		}
+	}
+}
+
+// jobRefViews maps sorted sets to the views listing them.
+var jobRefViews = map[sidekiq.SortedSetKind]viewID{
+	sidekiq.SortedSetRetry:     viewRetries,
+	sidekiq.SortedSetScheduled: viewScheduled,
+	sidekiq.SortedSetDead:      viewDead,
+}
+
+// OpenJobRef starts on the view listing the referenced job, filtered by its
+// JID, and opens the job details once it is found. Like RestoreState, it must
+// be called before the program starts.
+func (a *App) OpenJobRef(ref views.JobRef) {
+	id, ok := jobRefViews[ref.Kind]
+	if !ok {
+		return
+	}
+	if persister, ok := a.viewRegistry[id].(views.StatePersister); ok {
+		persister.RestoreState(views.ViewState{Filter: ref.JID})
+	}
+	a.viewStack = []viewID{id}
+	a.stackbar.SetStack(a.stackNames())
+	a.jobRef = &ref
+}
+
+// openJobRefCmd looks up the job requested with OpenJobRef. A job that no
+// longer exists leaves the filtered list on screen.
+func (a App) openJobRefCmd() tea.Cmd {
+	if a.jobRef == nil {
+		return nil
+	}
+	ref := *a.jobRef
+	client := a.sidekiq
+	ctx := devtools.WithTracker(context.Background(), "app.openJobRefCmd")
+	return func() tea.Msg {
+		entry, err := client.FindSortedEntry(ctx, ref.Kind, ref.JID)
+		if errors.Is(err, sidekiq.ErrJobNotFound) {
+			return nil
+		}
+		if err != nil {
+			return connectionErrorMsg{err: err}
+		}
+		return views.ShowJobDetailMsg{Job: entry.JobRecord, Source: views.SortedJobSource(ref.Kind, entry)}
+	}
+}
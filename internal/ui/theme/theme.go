@@ -28,6 +28,7 @@ type Theme struct {
 	TableSelectedBg compat.CompleteAdaptiveColor
 	Success         compat.CompleteAdaptiveColor
 	Error           compat.CompleteAdaptiveColor
+	Warning         compat.CompleteAdaptiveColor
 	Filter          compat.CompleteAdaptiveColor
 	DangerBg        compat.CompleteAdaptiveColor
 
@@ -110,6 +111,10 @@ var DefaultTheme = Theme{
 		Light: compat.CompleteColor{TrueColor: lipgloss.Color("#FF0000"), ANSI256: lipgloss.Color("196"), ANSI: lipgloss.Color("9")},
 		Dark:  compat.CompleteColor{TrueColor: lipgloss.Color("#FF0000"), ANSI256: lipgloss.Color("196"), ANSI: lipgloss.Color("9")},
 	},
+	Warning: compat.CompleteAdaptiveColor{
+		Light: compat.CompleteColor{TrueColor: lipgloss.Color("#E67700"), ANSI256: lipgloss.Color("172"), ANSI: lipgloss.Color("3")},
+		Dark:  compat.CompleteColor{TrueColor: lipgloss.Color("#FCC419"), ANSI256: lipgloss.Color("220"), ANSI: lipgloss.Color("11")},
+	},
 	Filter: compat.CompleteAdaptiveColor{
 		Light: compat.CompleteColor{TrueColor: lipgloss.Color("#C026D3"), ANSI256: lipgloss.Color("165"), ANSI: lipgloss.Color("13")},
 		Dark:  compat.CompleteColor{TrueColor: lipgloss.Color("#E879F9"), ANSI256: lipgloss.Color("171"), ANSI: lipgloss.Color("13")},
@@ -243,6 +248,7 @@ type Styles struct {
 	// Errors
 	ErrorTitle  lipgloss.Style
 	ErrorBorder lipgloss.Style
+	WarningText lipgloss.Style
 
 	// Frame title filter
 	FilterFocused lipgloss.Style
@@ -400,6 +406,9 @@ func NewStyles() Styles {
 		ErrorBorder: lipgloss.NewStyle().
 			Foreground(t.Error),
 
+		WarningText: lipgloss.NewStyle().
+			Foreground(t.Warning),
+
 		FilterFocused: lipgloss.NewStyle().
 			Foreground(t.MetricsText).
 			Background(t.Filter),
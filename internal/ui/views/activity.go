@@ -0,0 +1,318 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/kpumuk/lazykiq/internal/devtools"
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/components/frame"
+	"github.com/kpumuk/lazykiq/internal/ui/components/table"
+	"github.com/kpumuk/lazykiq/internal/ui/dialogs"
+	confirmdialog "github.com/kpumuk/lazykiq/internal/ui/dialogs/confirm"
+	"github.com/kpumuk/lazykiq/internal/ui/display"
+)
+
+// activityUndoTarget identifies the undo confirmation dialog.
+const activityUndoTarget = "activity.undo"
+
+// activityUndoneMsg reports that UndoLast finished; the outcome is in the log.
+type activityUndoneMsg struct{}
+
+// Activity lists the changes lazykiq itself made during this session, newest
+// first. It reads the client's in-memory log and never queries Redis.
+type Activity struct {
+	client      sidekiq.API
+	width       int
+	height      int
+	styles      Styles
+	entries     []sidekiq.ActivityEntry
+	table       table.Model
+	frameStyles frame.Styles
+
+	dangerousActionsEnabled bool
+}
+
+// NewActivity creates a new Activity view.
+func NewActivity(client sidekiq.API) *Activity {
+	return &Activity{
+		client: client,
+		table: table.New(
+			table.WithColumns(activityColumns),
+			table.WithEmptyMessage("No changes made in this session"),
+		),
+	}
+}
+
+var activityColumns = []table.Column{
+	{Title: "Time", Width: 8},
+	{Title: "Action", Width: 15},
+	{Title: "Set", Width: 15},
+	{Title: "JID", Width: 24},
+	{Title: "Detail", Width: 12},
+	{Title: "Undo", Width: 22},
+	{Title: "Result", Width: 30},
+}
+
+// Init implements View.
+func (a *Activity) Init() tea.Cmd {
+	a.reload()
+	return nil
+}
+
+// Update implements View.
+func (a *Activity) Update(msg tea.Msg) (View, tea.Cmd) {
+	switch msg := msg.(type) {
+	case RefreshMsg, RefreshViewMsg, activityUndoneMsg:
+		a.reload()
+		return a, nil
+
+	case confirmdialog.ActionMsg:
+		if !a.dangerousActionsEnabled || !msg.Confirmed || msg.Target != activityUndoTarget {
+			return a, nil
+		}
+		return a, a.undoCmd()
+
+	case tea.KeyPressMsg:
+		if a.table.JumpActive() {
+			a.table, _ = a.table.Update(msg)
+			return a, nil
+		}
+		if msg.String() == "ctrl+l" {
+			if a.client != nil {
+				a.client.ClearActivity()
+			}
+			a.reload()
+			return a, nil
+		}
+		if a.dangerousActionsEnabled && msg.String() == "u" {
+			return a, a.openUndoDialog()
+		}
+		a.table, _ = a.table.Update(msg)
+	}
+	return a, nil
+}
+
+// View implements View.
+func (a *Activity) View() string {
+	box := frame.New(
+		frame.WithStyles(a.frameStyles),
+		frame.WithTitle("Activity"),
+		frame.WithTitlePadding(0),
+		frame.WithMeta(a.styles.Muted.Render(display.Number(int64(len(a.entries)))+" changes")),
+		frame.WithContent(a.table.View()),
+		frame.WithPadding(1),
+		frame.WithSize(a.width, a.height),
+		frame.WithMinHeight(5),
+		frame.WithFocused(true),
+	)
+	return box.View()
+}
+
+// Name implements View.
+func (a *Activity) Name() string {
+	return "Activity"
+}
+
+// ShortHelp implements View.
+func (a *Activity) ShortHelp() []key.Binding {
+	return nil
+}
+
+// ContextItems implements ContextProvider.
+func (a *Activity) ContextItems() []ContextItem {
+	failed := 0
+	for _, entry := range a.entries {
+		if entry.Err != nil {
+			failed++
+		}
+	}
+	last := "-"
+	if len(a.entries) > 0 {
+		last = a.entries[0].Time.Format("15:04:05")
+	}
+	items := []ContextItem{
+		{Label: "Changes", Value: display.Number(int64(len(a.entries)))},
+		{Label: "Failed", Value: strconv.Itoa(failed)},
+		{Label: "Last", Value: last},
+		{Label: "Kept", Value: "last " + strconv.Itoa(sidekiq.MaxActivityEntries)},
+	}
+	if a.dangerousActionsEnabled {
+		undo := "-"
+		if move, ok := a.lastUndo(); ok {
+			undo = string(move.Action) + " " + move.JID
+		}
+		items = append(items, ContextItem{Label: "Undo", Value: undo})
+	}
+	return items
+}
+
+// HintBindings implements HintProvider.
+func (a *Activity) HintBindings() []key.Binding {
+	return []key.Binding{
+		helpBinding([]string{"ctrl+l"}, "ctrl+l", "clear log"),
+	}
+}
+
+// MutationBindings implements MutationHintProvider.
+func (a *Activity) MutationBindings() []key.Binding {
+	if !a.dangerousActionsEnabled {
+		return nil
+	}
+	return []key.Binding{
+		helpBinding([]string{"u"}, "u", "undo last move"),
+	}
+}
+
+// HelpSections implements HelpProvider.
+func (a *Activity) HelpSections() []HelpSection {
+	sections := []HelpSection{{
+		Title: "Activity",
+		Bindings: []key.Binding{
+			helpBinding([]string{"ctrl+l"}, "ctrl+l", "clear activity log"),
+		},
+	}}
+	if a.dangerousActionsEnabled {
+		sections = append(sections, HelpSection{
+			Title: "Dangerous Actions",
+			Bindings: []key.Binding{
+				helpBinding([]string{"u"}, "u", "undo last retry now, requeue, or kill"),
+			},
+		})
+	}
+	return sections
+}
+
+// TableHelp implements TableHelpProvider.
+func (a *Activity) TableHelp() []key.Binding {
+	return tableHelpBindings(a.table.KeyMap)
+}
+
+// SetSize implements View.
+func (a *Activity) SetSize(width, height int) View {
+	a.width = width
+	a.height = height
+	tableWidth, tableHeight := framedTableSize(width, height)
+	a.table.SetSize(tableWidth, tableHeight)
+	return a
+}
+
+// SetStyles implements View.
+func (a *Activity) SetStyles(styles Styles) View {
+	a.styles = styles
+	a.frameStyles = frameStylesFromTheme(styles)
+	a.table.SetStyles(tableStylesFromTheme(styles))
+	a.updateTableRows()
+	return a
+}
+
+// SetDangerousActionsEnabled toggles mutational actions for the view.
+func (a *Activity) SetDangerousActionsEnabled(enabled bool) {
+	a.dangerousActionsEnabled = enabled
+}
+
+// InputFocused implements InputFocuser.
+func (a *Activity) InputFocused() bool {
+	return a.table.JumpActive()
+}
+
+func (a *Activity) reload() {
+	a.entries = nil
+	if a.client != nil {
+		a.entries = a.client.Activity()
+	}
+	a.updateTableRows()
+}
+
+func (a *Activity) lastUndo() (sidekiq.ActivityEntry, bool) {
+	if a.client == nil {
+		return sidekiq.ActivityEntry{}, false
+	}
+	return a.client.LastUndo()
+}
+
+func (a *Activity) openUndoDialog() tea.Cmd {
+	move, ok := a.lastUndo()
+	if !ok {
+		return nil
+	}
+	return func() tea.Msg {
+		return dialogs.OpenDialogMsg{
+			Model: newConfirmDialog(
+				a.styles,
+				"Undo "+string(move.Action),
+				fmt.Sprintf(
+					"Undo the %s of job %s?\n\nThe job goes back to %s with its original payload and score.\nNothing is changed if the job was modified since.",
+					move.Action,
+					a.styles.Text.Bold(true).Render(move.JID),
+					move.Set,
+				),
+				activityUndoTarget,
+				a.styles.DangerAction,
+			),
+		}
+	}
+}
+
+func (a *Activity) undoCmd() tea.Cmd {
+	return func() tea.Msg {
+		ctx := devtools.WithTracker(context.Background(), "activity.undoCmd")
+		// Success and failure alike are recorded in the activity log.
+		_ = a.client.UndoLast(ctx)
+		return activityUndoneMsg{}
+	}
+}
+
+func (a *Activity) updateTableRows() {
+	rows := make([]table.Row, len(a.entries))
+	for i, entry := range a.entries {
+		jid := entry.JID
+		if jid == "" {
+			jid = "-"
+		}
+		detail := entry.Detail
+		if detail == "" {
+			detail = "-"
+		}
+		result := "ok"
+		if entry.Err != nil {
+			result = a.styles.ErrorText.Render(entry.Err.Error())
+		}
+		rows[i] = table.Row{
+			ID: strconv.Itoa(len(a.entries) - i),
+			Cells: []string{
+				entry.Time.Format("15:04:05"),
+				string(entry.Action),
+				entry.Set,
+				jid,
+				detail,
+				activityUndoHint(entry),
+				result,
+			},
+		}
+	}
+	a.table.SetRows(rows)
+}
+
+// activityUndoHint tells how to reverse a successful change, or "-" when it
+// cannot be undone from lazykiq.
+func activityUndoHint(entry sidekiq.ActivityEntry) string {
+	if entry.Err != nil {
+		return "-"
+	}
+	switch entry.Action {
+	case sidekiq.ActivityKill:
+		return "R in Dead"
+	case sidekiq.ActivityKillAll:
+		// The bulk keys would also touch jobs that were there before.
+		return "R per job in Dead"
+	case sidekiq.ActivityRetryAllLater:
+		return "K per job in Retries"
+	default:
+		return "-"
+	}
+}
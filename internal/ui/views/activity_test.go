@@ -0,0 +1,137 @@
+package views
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/dialogs"
+	confirmdialog "github.com/kpumuk/lazykiq/internal/ui/dialogs/confirm"
+)
+
+type activityClientStub struct {
+	sidekiq.API
+	entries []sidekiq.ActivityEntry
+	undo    *sidekiq.ActivityEntry
+	undone  int
+}
+
+func (s *activityClientStub) Activity() []sidekiq.ActivityEntry { return s.entries }
+
+func (s *activityClientStub) ClearActivity() { s.entries = nil }
+
+func (s *activityClientStub) LastUndo() (sidekiq.ActivityEntry, bool) {
+	if s.undo == nil {
+		return sidekiq.ActivityEntry{}, false
+	}
+	return *s.undo, true
+}
+
+func (s *activityClientStub) UndoLast(context.Context) error {
+	s.undone++
+	s.entries = append([]sidekiq.ActivityEntry{{Action: sidekiq.ActivityUndo, Set: s.undo.Set, JID: s.undo.JID}}, s.entries...)
+	s.undo = nil
+	return nil
+}
+
+func TestActivityRowsAndClear(t *testing.T) {
+	at := time.Date(2026, 1, 2, 3, 4, 5, 0, time.Local)
+	client := &activityClientStub{entries: []sidekiq.ActivityEntry{
+		{Time: at, Action: sidekiq.ActivityKill, Set: "retry", JID: "a"},
+		{Time: at, Action: sidekiq.ActivityDeleteAll, Set: "dead", Err: errors.New("boom")},
+	}}
+	view := NewActivity(client)
+	view.Init()
+
+	rows := view.table.Rows()
+	if len(rows) != 2 {
+		t.Fatalf("rows = %d, want 2", len(rows))
+	}
+	want := []string{"03:04:05", "kill", "retry", "a", "-", "R in Dead", "ok"}
+	for i, cell := range want {
+		if got := rows[0].Cells[i]; got != cell {
+			t.Fatalf("row 0 cell %d = %q, want %q", i, got, cell)
+		}
+	}
+	if got := rows[1].Cells[6]; got != "boom" {
+		t.Fatalf("failed result = %q, want error message", got)
+	}
+	if got := contextValue(view.ContextItems(), "Failed"); got != "1" {
+		t.Fatalf("Failed = %q, want 1", got)
+	}
+
+	view.Update(tea.KeyPressMsg{Code: 'l', Mod: tea.ModCtrl})
+	if len(client.entries) != 0 || len(view.table.Rows()) != 0 {
+		t.Fatalf("log not cleared: client %d, rows %d", len(client.entries), len(view.table.Rows()))
+	}
+}
+
+func TestActivityUndoHint(t *testing.T) {
+	tests := map[string]struct {
+		entry sidekiq.ActivityEntry
+		want  string
+	}{
+		"kill":          {entry: sidekiq.ActivityEntry{Action: sidekiq.ActivityKill}, want: "R in Dead"},
+		"failed kill":   {entry: sidekiq.ActivityEntry{Action: sidekiq.ActivityKill, Err: errors.New("x")}, want: "-"},
+		"delete":        {entry: sidekiq.ActivityEntry{Action: sidekiq.ActivityDelete}, want: "-"},
+		"retry cycle":   {entry: sidekiq.ActivityEntry{Action: sidekiq.ActivityRetryAllLater}, want: "K per job in Retries"},
+		"enqueue every": {entry: sidekiq.ActivityEntry{Action: sidekiq.ActivityEnqueueAll}, want: "-"},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := activityUndoHint(tc.entry); got != tc.want {
+				t.Fatalf("activityUndoHint() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestActivityUndoRequiresDangerousActionsAndConfirmation(t *testing.T) {
+	client := &activityClientStub{undo: &sidekiq.ActivityEntry{Action: sidekiq.ActivityKill, Set: "retry", JID: "k1"}}
+	view := NewActivity(client)
+	view.Init()
+
+	if _, cmd := view.Update(tea.KeyPressMsg{Code: 'u', Text: "u"}); cmd != nil {
+		t.Fatal("u opened a dialog without dangerous actions")
+	}
+	if got := contextValue(view.ContextItems(), "Undo"); got != "" {
+		t.Fatalf("Undo context = %q, want hidden", got)
+	}
+
+	view.SetDangerousActionsEnabled(true)
+	if got := contextValue(view.ContextItems(), "Undo"); got != "kill k1" {
+		t.Fatalf("Undo context = %q, want kill k1", got)
+	}
+	_, cmd := view.Update(tea.KeyPressMsg{Code: 'u', Text: "u"})
+	if cmd == nil {
+		t.Fatal("u did not open a confirmation")
+	}
+	if _, ok := cmd().(dialogs.OpenDialogMsg); !ok {
+		t.Fatal("u did not return OpenDialogMsg")
+	}
+
+	if _, cmd := view.Update(confirmdialog.ActionMsg{Target: activityUndoTarget}); cmd != nil {
+		t.Fatal("declined undo returned a command")
+	}
+	_, cmd = view.Update(confirmdialog.ActionMsg{Target: activityUndoTarget, Confirmed: true})
+	if cmd == nil {
+		t.Fatal("confirmed undo returned no command")
+	}
+	view.Update(cmd())
+	if client.undone != 1 {
+		t.Fatalf("UndoLast calls = %d, want 1", client.undone)
+	}
+	if rows := view.table.Rows(); len(rows) != 1 || rows[0].Cells[1] != "undo" {
+		t.Fatalf("rows after undo = %+v, want the undo entry", rows)
+	}
+	if got := contextValue(view.ContextItems(), "Undo"); got != "-" {
+		t.Fatalf("Undo context = %q, want -", got)
+	}
+	if _, cmd := view.Update(tea.KeyPressMsg{Code: 'u', Text: "u"}); cmd != nil {
+		t.Fatal("u opened a dialog with nothing to undo")
+	}
+}
@@ -0,0 +1,290 @@
+package views
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/kpumuk/lazykiq/internal/devtools"
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/components/frame"
+	"github.com/kpumuk/lazykiq/internal/ui/components/table"
+	"github.com/kpumuk/lazykiq/internal/ui/display"
+	"github.com/kpumuk/lazykiq/internal/ui/requestctx"
+)
+
+// batchDataMsg carries batch status. A nil batch means it was not found.
+type batchDataMsg struct {
+	bid   string
+	batch *sidekiq.Batch
+}
+
+// Batch shows the progress, callbacks and failed jobs of a Sidekiq Pro batch.
+type Batch struct {
+	client       sidekiq.API
+	width        int
+	height       int
+	styles       Styles
+	bid          string
+	batch        *sidekiq.Batch
+	ready        bool
+	table        table.Model
+	frameStyles  frame.Styles
+	fetchRequest requestctx.Controller
+}
+
+// NewBatch creates a new Batch view.
+func NewBatch(client sidekiq.API) *Batch {
+	return &Batch{
+		client: client,
+		table: table.New(
+			table.WithColumns(batchColumns),
+			table.WithEmptyMessage("No callbacks or failed jobs"),
+		),
+	}
+}
+
+var batchColumns = []table.Column{
+	{Title: "Kind", Width: 18},
+	{Title: "Detail", Width: 60},
+}
+
+// Init implements View.
+func (b *Batch) Init() tea.Cmd {
+	if b.bid == "" {
+		return nil
+	}
+	return b.fetchCmd()
+}
+
+// Update implements View.
+func (b *Batch) Update(msg tea.Msg) (View, tea.Cmd) {
+	switch msg := msg.(type) {
+	case batchDataMsg:
+		if msg.bid != b.bid {
+			return b, nil
+		}
+		b.batch = msg.batch
+		b.ready = true
+		b.updateTableRows()
+		return b, nil
+
+	case RefreshMsg, RefreshViewMsg:
+		if b.bid == "" {
+			return b, nil
+		}
+		return b, b.fetchCmd()
+
+	case tea.KeyPressMsg:
+		if b.table.JumpActive() {
+			b.table, _ = b.table.Update(msg)
+			return b, nil
+		}
+		switch msg.String() {
+		case "c":
+			if jid, ok := b.selectedFailedJID(); ok {
+				return b, copyTextCmd(jid)
+			}
+			return b, nil
+		}
+
+		b.table, _ = b.table.Update(msg)
+		return b, nil
+	}
+
+	return b, nil
+}
+
+// View implements View.
+func (b *Batch) View() string {
+	if !b.ready {
+		return renderStatusMessage("Batch", "Loading...", b.styles, b.width, b.height)
+	}
+	if b.batch == nil {
+		return renderStatusMessage("Batch", "Batch not found", b.styles, b.width, b.height)
+	}
+
+	box := frame.New(
+		frame.WithStyles(b.frameStyles),
+		frame.WithTitle("Batch "+b.bid),
+		frame.WithTitlePadding(0),
+		frame.WithMeta(b.progressMeta()),
+		frame.WithContent(b.table.View()),
+		frame.WithPadding(1),
+		frame.WithSize(b.width, b.height),
+		frame.WithMinHeight(5),
+		frame.WithFocused(true),
+	)
+	return box.View()
+}
+
+// Name implements View.
+func (b *Batch) Name() string {
+	if b.bid != "" {
+		return "Batch: " + b.bid
+	}
+	return "Batch"
+}
+
+// ShortHelp implements View.
+func (b *Batch) ShortHelp() []key.Binding {
+	return nil
+}
+
+// ContextItems implements ContextProvider.
+func (b *Batch) ContextItems() []ContextItem {
+	description := "-"
+	created := "-"
+	progress := "-"
+	failures := "-"
+	if b.batch != nil {
+		if b.batch.Description != "" {
+			description = b.batch.Description
+		}
+		if !b.batch.CreatedAt.IsZero() {
+			created = display.DurationSince(b.batch.CreatedAt) + " ago"
+		}
+		progress = fmt.Sprintf(
+			"%s/%s (%s pending)",
+			display.Number(b.batch.Completed()),
+			display.Number(b.batch.Total),
+			display.Number(b.batch.Pending),
+		)
+		failures = display.Number(b.batch.Failures)
+	}
+
+	return []ContextItem{
+		{Label: "BID", Value: b.bid},
+		{Label: "Description", Value: description},
+		{Label: "Created", Value: created},
+		{Label: "Progress", Value: progress},
+		{Label: "Failures", Value: failures},
+	}
+}
+
+// HintBindings implements HintProvider.
+func (b *Batch) HintBindings() []key.Binding {
+	return []key.Binding{
+		helpBinding([]string{"c"}, "c", "copy jid"),
+	}
+}
+
+// HelpSections implements HelpProvider.
+func (b *Batch) HelpSections() []HelpSection {
+	return []HelpSection{{
+		Title: "Batch",
+		Bindings: []key.Binding{
+			helpBinding([]string{"c"}, "c", "copy failed jid"),
+		},
+	}}
+}
+
+// TableHelp implements TableHelpProvider.
+func (b *Batch) TableHelp() []key.Binding {
+	return tableHelpBindings(b.table.KeyMap)
+}
+
+// SetSize implements View.
+func (b *Batch) SetSize(width, height int) View {
+	b.width = width
+	b.height = height
+	tableWidth, tableHeight := framedTableSize(width, height)
+	b.table.SetSize(tableWidth, tableHeight)
+	return b
+}
+
+// SetStyles implements View.
+func (b *Batch) SetStyles(styles Styles) View {
+	b.styles = styles
+	b.frameStyles = frameStylesFromTheme(styles)
+	b.table.SetStyles(tableStylesFromTheme(styles))
+	return b
+}
+
+// InputFocused implements InputFocuser.
+func (b *Batch) InputFocused() bool {
+	return b.table.JumpActive()
+}
+
+// SetBatch sets the batch ID to display.
+func (b *Batch) SetBatch(bid string) {
+	b.fetchRequest.Cancel()
+	b.bid = bid
+	b.batch = nil
+	b.ready = false
+	b.table.SetRows(nil)
+	b.table.SetCursor(0)
+}
+
+// Dispose clears cached data when the view is removed from the stack.
+func (b *Batch) Dispose() {
+	b.SetBatch("")
+}
+
+// CancelRequests stops in-flight fetches when the view is hidden.
+func (b *Batch) CancelRequests() {
+	b.fetchRequest.Cancel()
+}
+
+func (b *Batch) fetchCmd() tea.Cmd {
+	bid := b.bid
+	client := b.client
+	ctx := b.fetchRequest.Start(devtools.WithTracker(context.Background(), "batch.fetchCmd"))
+	return func() tea.Msg {
+		batch, err := client.GetBatch(ctx, bid)
+		if err != nil {
+			if requestctx.IsCanceled(err) {
+				return nil
+			}
+			if errors.Is(err, sidekiq.ErrBatchNotFound) {
+				return batchDataMsg{bid: bid}
+			}
+			return ConnectionErrorMsg{Err: err}
+		}
+		return batchDataMsg{bid: bid, batch: batch}
+	}
+}
+
+func (b *Batch) updateTableRows() {
+	if b.batch == nil {
+		b.table.SetRows(nil)
+		return
+	}
+
+	rows := make([]table.Row, 0, len(b.batch.Callbacks)+len(b.batch.FailedJIDs))
+	for i, callback := range b.batch.Callbacks {
+		rows = append(rows, table.Row{
+			ID:    fmt.Sprintf("callback:%d", i),
+			Cells: []string{b.styles.Muted.Render("on " + callback.Event), callback.Target},
+		})
+	}
+	for _, jid := range b.batch.FailedJIDs {
+		rows = append(rows, table.Row{
+			ID:    jid,
+			Cells: []string{"failed", jid},
+		})
+	}
+	b.table.SetRows(rows)
+}
+
+func (b *Batch) selectedFailedJID() (string, bool) {
+	if b.batch == nil {
+		return "", false
+	}
+	idx := b.table.Cursor() - len(b.batch.Callbacks)
+	if idx < 0 || idx >= len(b.batch.FailedJIDs) {
+		return "", false
+	}
+	return b.batch.FailedJIDs[idx], true
+}
+
+func (b *Batch) progressMeta() string {
+	if b.batch == nil || b.batch.Total <= 0 {
+		return ""
+	}
+	percent := b.batch.Completed() * 100 / b.batch.Total
+	return b.styles.MetricLabel.Render("DONE: ") + b.styles.MetricValue.Render(fmt.Sprintf("%d%%", percent))
+}
@@ -0,0 +1,144 @@
+package views
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+)
+
+type batchClientStub struct {
+	sidekiq.API
+	batches map[string]*sidekiq.Batch
+}
+
+func (s *batchClientStub) GetBatch(_ context.Context, bid string) (*sidekiq.Batch, error) {
+	batch, ok := s.batches[bid]
+	if !ok {
+		return nil, sidekiq.ErrBatchNotFound
+	}
+	return batch, nil
+}
+
+func TestBatchView(t *testing.T) {
+	client := &batchClientStub{batches: map[string]*sidekiq.Batch{
+		"abc123": {
+			BID:         "abc123",
+			Description: "Nightly import",
+			Total:       10,
+			Pending:     3,
+			Failures:    1,
+			FailedJIDs:  []string{"jid-failed"},
+			Callbacks:   []sidekiq.BatchCallback{{Event: "complete", Target: "ImportCallback"}},
+		},
+	}}
+
+	tests := map[string]struct {
+		bid       string
+		wantText  []string
+		wantRows  int
+		wantItems string
+	}{
+		"found": {
+			bid:       "abc123",
+			wantText:  []string{"Batch abc123", "DONE: 70%", "on complete", "ImportCallback", "jid-failed"},
+			wantRows:  2,
+			wantItems: "7/10 (3 pending)",
+		},
+		"not found": {
+			bid:       "missing",
+			wantText:  []string{"Batch not found"},
+			wantItems: "-",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			view := NewBatch(client)
+			view.SetStyles(Styles{})
+			view.SetSize(100, 12)
+			view.SetBatch(tc.bid)
+
+			updated, _ := view.Update(view.Init()())
+			batch := updated.(*Batch)
+
+			output := ansi.Strip(batch.View())
+			for _, want := range tc.wantText {
+				if !strings.Contains(output, want) {
+					t.Fatalf("View() missing %q:\n%s", want, output)
+				}
+			}
+			if got := len(batch.table.Rows()); got != tc.wantRows {
+				t.Fatalf("rows = %d, want %d", got, tc.wantRows)
+			}
+			if got := batch.ContextItems()[3].Value; got != tc.wantItems {
+				t.Fatalf("progress = %q, want %q", got, tc.wantItems)
+			}
+		})
+	}
+}
+
+func TestBatchViewSelectedFailedJID(t *testing.T) {
+	view := NewBatch(nil)
+	view.SetStyles(Styles{})
+	view.SetSize(100, 12)
+	view.SetBatch("abc123")
+	view.Update(batchDataMsg{bid: "abc123", batch: &sidekiq.Batch{
+		BID:        "abc123",
+		FailedJIDs: []string{"jid-a", "jid-b"},
+		Callbacks:  []sidekiq.BatchCallback{{Event: "success", Target: "Notify"}},
+	}})
+
+	if _, ok := view.selectedFailedJID(); ok {
+		t.Fatal("callback row should not resolve to a failed jid")
+	}
+	view.table.SetCursor(2)
+	if jid, ok := view.selectedFailedJID(); !ok || jid != "jid-b" {
+		t.Fatalf("selectedFailedJID() = %q, %v; want jid-b", jid, ok)
+	}
+}
+
+func TestBatchViewIgnoresStaleData(t *testing.T) {
+	view := NewBatch(nil)
+	view.SetBatch("new")
+	view.Update(batchDataMsg{bid: "old", batch: &sidekiq.Batch{BID: "old"}})
+	if view.ready {
+		t.Fatal("stale batch data should be ignored")
+	}
+}
+
+func TestJobDetailOpenBatch(t *testing.T) {
+	tests := map[string]struct {
+		payload string
+		wantBID string
+	}{
+		"with bid":    {payload: `{"jid":"j1","bid":"abc123"}`, wantBID: "abc123"},
+		"without bid": {payload: `{"jid":"j1"}`},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			view := NewJobDetail(nil)
+			view.SetJob(sidekiq.NewJobRecord(tc.payload, "default"))
+
+			_, cmd := view.Update(tea.KeyPressMsg{Code: 'b', Text: "b"})
+			if tc.wantBID == "" {
+				if cmd != nil {
+					t.Fatal("expected no command without a bid")
+				}
+				return
+			}
+			if cmd == nil {
+				t.Fatal("expected ShowBatchMsg command")
+			}
+			msg, ok := cmd().(ShowBatchMsg)
+			if !ok || msg.BID != tc.wantBID {
+				t.Fatalf("msg = %#v, want ShowBatchMsg{BID: %q}", cmd(), tc.wantBID)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package views
+
+import (
+	"time"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/display"
+)
+
+// DefaultBeatStale is the heartbeat age at which a process is flagged. Sidekiq
+// expires process keys about a minute after the last beat, so a process with
+// an older beat is likely a zombie entry.
+const DefaultBeatStale = time.Minute
+
+// BeatStaleSetter is implemented by views that flag processes with a stale
+// heartbeat.
+type BeatStaleSetter interface {
+	SetBeatStale(threshold time.Duration)
+}
+
+// beatBadge flags a process whose heartbeat is at least threshold old, or
+// missing. It returns "" for a fresh beat or when threshold is 0.
+func beatBadge(styles Styles, proc sidekiq.Process, threshold time.Duration, now time.Time) string {
+	if threshold <= 0 {
+		return ""
+	}
+	if proc.Beat.IsZero() {
+		return " " + styles.WarningText.Render("no heartbeat")
+	}
+	age := now.Sub(proc.Beat)
+	if age < threshold {
+		return ""
+	}
+	return " " + styles.WarningText.Render("beat "+display.Duration(int64(age/time.Second))+" ago")
+}
@@ -7,6 +7,7 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"charm.land/bubbles/v2/key"
 	tea "charm.land/bubbletea/v2"
@@ -18,13 +19,15 @@ import (
 	"github.com/kpumuk/lazykiq/internal/ui/components/table"
 	"github.com/kpumuk/lazykiq/internal/ui/dialogs"
 	filterdialog "github.com/kpumuk/lazykiq/internal/ui/dialogs/filter"
+	promptdialog "github.com/kpumuk/lazykiq/internal/ui/dialogs/prompt"
 	"github.com/kpumuk/lazykiq/internal/ui/display"
 	"github.com/kpumuk/lazykiq/internal/ui/requestctx"
 )
 
 // busyDataMsg carries busy data from the fetch command to the Busy view.
 type busyDataMsg struct {
-	data sidekiq.BusyData
+	data   sidekiq.BusyData
+	leader string
 }
 
 // Busy shows active workers/processes.
@@ -33,31 +36,60 @@ type Busy struct {
 	width           int
 	height          int
 	styles          Styles
-	data            sidekiq.BusyData
+	fetched         sidekiq.BusyData
+	data            sidekiq.BusyData // fetched narrowed by tagFilter
+	leader          string
+	beatStale       time.Duration
+	longRunning     time.Duration
+	utilization     UtilizationThresholds
+	processCommand  ProcessCommand
+	longestFirst    bool
 	filteredJobs    []sidekiq.Job // jobs filtered by selectedProcess
 	rowJobIndex     []int         // table row -> filtered job index (-1 for process rows)
 	table           table.Model
 	ready           bool
 	selectedProcess int // -1 = all, 0-8 = specific process index
 	treeMode        bool
+	groupByQueue    bool
 	filter          string
+	tagFilter       string
 	filterStyle     filterdialog.Styles
 	fetchRequest    requestctx.Controller
+	note            string // Brief notice shown until the next key press
+	classAverages   map[string]busyClassAverage
+	averagesPending bool
+	averagesRequest requestctx.Controller
 }
 
-const processGlyph = "⚙"
+const (
+	processGlyph = "⚙"
+	queueGlyph   = "≡"
+	leaderBadge  = "leader"
+
+	busyTagFilterTarget = "busy.tags"
+)
 
 // NewBusy creates a new Busy view.
 func NewBusy(client sidekiq.API) *Busy {
-	return &Busy{
+	b := &Busy{
 		client:          client,
 		selectedProcess: -1, // Show all jobs by default
+		beatStale:       DefaultBeatStale,
+		longRunning:     DefaultLongRunning,
+		utilization:     DefaultUtilizationThresholds(),
 		treeMode:        false,
 		table: table.New(
 			table.WithColumns(jobColumnsFlat),
 			table.WithEmptyMessage("No active jobs"),
 		),
 	}
+	// "g" toggles queue grouping here, so go to start moves to "home" and
+	// horizontal scroll to start keeps only "0".
+	b.table.KeyMap.GotoTop.SetKeys("home")
+	b.table.KeyMap.GotoTop.SetHelp("home", "go to start")
+	b.table.KeyMap.Home.SetKeys("0")
+	b.table.KeyMap.Home.SetHelp("0", "scroll to start")
+	return b
 }
 
 // Init implements View.
@@ -70,12 +102,19 @@ func (b *Busy) Init() tea.Cmd {
 func (b *Busy) Update(msg tea.Msg) (View, tea.Cmd) {
 	switch msg := msg.(type) {
 	case busyDataMsg:
-		b.data = msg.data
+		b.fetched = msg.data
+		b.data = filterBusyByTag(b.fetched, b.tagFilter)
+		b.leader = msg.leader
 		b.ready = true
 		b.updateTableRows()
+		return b, b.fetchClassAveragesCmd(time.Now())
+
+	case busyClassAveragesMsg:
+		b.storeClassAverages(msg)
+		b.updateTableRows()
 		return b, nil
 
-	case RefreshMsg:
+	case RefreshMsg, RefreshViewMsg:
 		return b, b.fetchDataCmd()
 
 	case filterdialog.ActionMsg:
@@ -89,12 +128,29 @@ func (b *Busy) Update(msg tea.Msg) (View, tea.Cmd) {
 		b.table.SetCursor(0)
 		return b, b.fetchDataCmd()
 
+	case promptdialog.ActionMsg:
+		if msg.Target != busyTagFilterTarget || msg.Value == b.tagFilter {
+			return b, nil
+		}
+		b.setTagFilter(msg.Value)
+		return b, nil
+
 	case tea.KeyPressMsg:
+		if b.table.JumpActive() {
+			b.table, _ = b.table.Update(msg)
+			return b, nil
+		}
+		b.note = ""
 		key := msg.String()
 		switch key {
 		case "/":
 			return b, b.openFilterDialog()
+		case "T":
+			return b, b.openTagFilterDialog()
 		case "ctrl+u":
+			if b.tagFilter != "" {
+				b.setTagFilter("")
+			}
 			if b.filter != "" {
 				b.filter = ""
 				b.table.SetCursor(0)
@@ -129,10 +185,22 @@ func (b *Busy) Update(msg tea.Msg) (View, tea.Cmd) {
 				}
 			}
 			return b, nil
+		case "i":
+			return b, b.copyProcessIdentity()
+		case "I":
+			return b, b.copyProcessCommand()
 		case "t":
 			b.treeMode = !b.treeMode
 			b.updateTableRows()
 			return b, nil
+		case "g":
+			b.groupByQueue = !b.groupByQueue
+			b.updateTableRows()
+			return b, nil
+		case "o":
+			b.longestFirst = !b.longestFirst
+			b.updateTableRows()
+			return b, nil
 		}
 
 		b.table, _ = b.table.Update(msg)
@@ -183,9 +251,13 @@ func (b *Busy) HintBindings() []key.Binding {
 	return []key.Binding{
 		helpBinding([]string{"/"}, "/", "filter"),
 		helpBinding([]string{"ctrl+u"}, "ctrl+u", "reset filter"),
+		helpBinding([]string{"T"}, "T", "tag filter"),
 		helpBinding([]string{"s"}, "s", "select process"),
 		helpBinding([]string{"ctrl+0"}, "ctrl+0", "all processes"),
 		helpBinding([]string{"t"}, "t", "toggle tree"),
+		helpBinding([]string{"g"}, "g", b.groupingHint()),
+		helpBinding([]string{"o"}, "o", "sort by age"),
+		helpBinding([]string{"I"}, "I", b.processCommandHint()),
 		helpBinding([]string{"enter"}, "enter", "job detail"),
 	}
 }
@@ -196,10 +268,15 @@ func (b *Busy) HelpSections() []HelpSection {
 		Title: "Busy",
 		Bindings: []key.Binding{
 			helpBinding([]string{"/"}, "/", "filter"),
-			helpBinding([]string{"ctrl+u"}, "ctrl+u", "clear filter"),
+			helpBinding([]string{"ctrl+u"}, "ctrl+u", "clear filters"),
+			helpBinding([]string{"T"}, "shift+t", "filter processes by tag/label"),
 			helpBinding([]string{"s"}, "s", "select process"),
 			helpBinding([]string{"t"}, "t", "toggle tree"),
+			helpBinding([]string{"g"}, "g", "group by queue/process"),
+			helpBinding([]string{"o"}, "o", "toggle longest running first"),
 			helpBinding([]string{"c"}, "c", "copy jid"),
+			helpBinding([]string{"i"}, "i", "copy process identity"),
+			helpBinding([]string{"I"}, "shift+i", b.processCommandHint()),
 			helpBinding([]string{"enter"}, "enter", "job detail"),
 			helpBinding([]string{"ctrl+1"}, "ctrl+1-9", "select process"),
 			helpBinding([]string{"ctrl+0"}, "ctrl+0", "all processes"),
@@ -208,6 +285,24 @@ func (b *Busy) HelpSections() []HelpSection {
 	return sections
 }
 
+// ContextItems implements ContextProvider.
+func (b *Busy) ContextItems() []ContextItem {
+	items := []ContextItem{{Label: "Long running", Value: b.longRunningValue()}}
+	if b.longestFirst {
+		items = append(items, ContextItem{Label: "Order", Value: "longest running first"})
+	}
+	if b.tagFilter != "" {
+		items = append(items,
+			ContextItem{Label: "Tags", Value: b.tagFilter},
+			ContextItem{Label: "Processes", Value: fmt.Sprintf("%d/%d", len(b.data.Processes), len(b.fetched.Processes))},
+		)
+	}
+	if b.note != "" {
+		items = append(items, ContextItem{Label: "Note", Value: b.styles.Muted.Render(b.note)})
+	}
+	return items
+}
+
 // TableHelp implements TableHelpProvider.
 func (b *Busy) TableHelp() []key.Binding {
 	return tableHelpBindings(b.table.KeyMap)
@@ -230,6 +325,12 @@ func (b *Busy) Dispose() {
 // CancelRequests stops in-flight fetches when the view is hidden.
 func (b *Busy) CancelRequests() {
 	b.fetchRequest.Cancel()
+	b.cancelClassAverages()
+}
+
+// InputFocused implements InputFocuser.
+func (b *Busy) InputFocused() bool {
+	return b.table.JumpActive()
 }
 
 // SetStyles implements View.
@@ -240,6 +341,26 @@ func (b *Busy) SetStyles(styles Styles) View {
 	return b
 }
 
+// SetLongRunning implements LongRunningSetter.
+func (b *Busy) SetLongRunning(threshold time.Duration) {
+	b.longRunning = threshold
+}
+
+// SetBeatStale implements BeatStaleSetter.
+func (b *Busy) SetBeatStale(threshold time.Duration) {
+	b.beatStale = threshold
+}
+
+// SetUtilizationThresholds implements UtilizationThresholdsSetter.
+func (b *Busy) SetUtilizationThresholds(thresholds UtilizationThresholds) {
+	b.utilization = thresholds
+}
+
+// SetProcessCommand implements ProcessCommandSetter.
+func (b *Busy) SetProcessCommand(command ProcessCommand) {
+	b.processCommand = command
+}
+
 // SetProcessIdentity updates the selected process by identity.
 func (b *Busy) SetProcessIdentity(identity string) {
 	if identity == "" {
@@ -273,18 +394,32 @@ func (b *Busy) fetchDataCmd() tea.Cmd {
 			}
 			return ConnectionErrorMsg{Err: err}
 		}
-		return busyDataMsg{data: data}
+		// The leader badge is advisory, so a failed lookup only hides it.
+		leader, err := b.client.GetLeader(ctx)
+		if err != nil {
+			if requestctx.IsCanceled(err) {
+				return nil
+			}
+			leader = ""
+		}
+		return busyDataMsg{data: data, leader: leader}
 	}
 }
 
 func (b *Busy) reset() {
 	b.fetchRequest.Cancel()
+	b.cancelClassAverages()
+	b.classAverages = nil
 	b.ready = false
 	b.data = sidekiq.BusyData{}
+	b.leader = ""
 	b.filteredJobs = nil
 	b.rowJobIndex = nil
 	b.selectedProcess = -1
 	b.filter = ""
+	b.tagFilter = ""
+	b.fetched = sidekiq.BusyData{}
+	b.note = ""
 	b.table.SetRows(nil)
 	b.table.SetCursor(0)
 }
@@ -302,6 +437,77 @@ func (b *Busy) selectedIdentity() string {
 	return ""
 }
 
+// cursorProcess returns the process under the cursor: the process row itself
+// in tree mode, or the process running the highlighted job. Without either it
+// falls back to the selected process, then to the only process.
+func (b *Busy) cursorProcess() (sidekiq.Process, bool) {
+	identity := ""
+	if idx := b.table.Cursor(); idx >= 0 && idx < len(b.rowJobIndex) {
+		if jobIdx := b.rowJobIndex[idx]; jobIdx >= 0 && jobIdx < len(b.filteredJobs) {
+			identity = b.filteredJobs[jobIdx].ProcessIdentity
+		} else if b.treeMode && !b.groupByQueue {
+			identity = b.table.SelectedRow().ID
+		}
+	}
+	if identity == "" {
+		identity = b.selectedIdentity()
+	}
+	if identity == "" {
+		if len(b.data.Processes) == 1 {
+			return b.data.Processes[0], true
+		}
+		return sidekiq.Process{}, false
+	}
+	for _, proc := range b.data.Processes {
+		if proc.Identity == identity {
+			return proc, true
+		}
+	}
+	// The job's process may have stopped beating; its identity still names
+	// the host.
+	return sidekiq.Process{Identity: identity}, true
+}
+
+// copyProcessIdentity copies the full identity of the process under the
+// cursor.
+func (b *Busy) copyProcessIdentity() tea.Cmd {
+	proc, ok := b.cursorProcess()
+	if !ok {
+		b.note = "no process to copy"
+		return nil
+	}
+	b.note = "copied " + proc.Identity
+	return copyTextCmd(proc.Identity)
+}
+
+// copyProcessCommand copies the --process-command template rendered for the
+// process under the cursor, or its hostname when no template is configured.
+func (b *Busy) copyProcessCommand() tea.Cmd {
+	proc, ok := b.cursorProcess()
+	if !ok {
+		b.note = "no process to copy"
+		return nil
+	}
+	text, _ := processHostPID(proc)
+	if b.processCommand != "" {
+		command, err := b.processCommand.Render(proc)
+		if err != nil {
+			b.note = "cannot build command: " + err.Error()
+			return nil
+		}
+		text = command
+	}
+	b.note = "copied " + text
+	return copyTextCmd(text)
+}
+
+func (b *Busy) processCommandHint() string {
+	if b.processCommand != "" {
+		return "copy command"
+	}
+	return "copy hostname"
+}
+
 func (b *Busy) handleProcessSelectKey(key string) bool {
 	if !strings.HasPrefix(key, "ctrl+") {
 		return false
@@ -346,6 +552,16 @@ var jobColumnsTree = []table.Column{
 	{Title: "Args", Width: 60},
 }
 
+var jobColumnsQueue = []table.Column{
+	{Title: "Queue", Width: 14},
+	{Title: "Process", Width: 14},
+	{Title: "TID", Width: 6},
+	{Title: "JID", Width: 24},
+	{Title: "Age", Width: 6, Align: table.AlignRight},
+	{Title: "Class", Width: 24},
+	{Title: "Args", Width: 60},
+}
+
 var jobColumnsFlat = []table.Column{
 	{Title: "Process", Width: 14},
 	{Title: "TID", Width: 6},
@@ -370,6 +586,10 @@ func (b *Busy) updateTableRows() {
 	} else {
 		b.table.SetEmptyMessage("No active jobs")
 	}
+	if b.groupByQueue {
+		b.updateTableRowsByQueue()
+		return
+	}
 	if b.treeMode {
 		b.updateTableRowsTree()
 		return
@@ -380,6 +600,7 @@ func (b *Busy) updateTableRows() {
 
 func (b *Busy) updateTableRowsTree() {
 	b.table.SetColumns(jobColumnsTree)
+	now := time.Now()
 
 	selectedIdentity := b.selectedIdentity()
 	jobsByProcess := b.jobsByProcess(selectedIdentity)
@@ -421,7 +642,7 @@ func (b *Busy) updateTableRowsTree() {
 					treeCell,
 					job.JID(),
 					b.styles.QueueText.Render(job.Queue()),
-					display.DurationSince(job.RunAt),
+					b.ageCell(job, now),
 					job.DisplayClass(),
 					display.Args(job.DisplayArgs()),
 				},
@@ -438,8 +659,107 @@ func (b *Busy) updateTableRowsTree() {
 	b.updateTableSize()
 }
 
+// updateTableRowsByQueue renders queue header rows with their in-flight jobs,
+// busiest queues first.
+func (b *Busy) updateTableRowsByQueue() {
+	b.table.SetColumns(jobColumnsQueue)
+	now := time.Now()
+
+	groups := busyQueueGroups(b.orderedJobs(), b.selectedIdentity())
+	glyphWidth := lipgloss.Width(queueGlyph)
+
+	b.filteredJobs = make([]sidekiq.Job, 0, len(b.data.Jobs))
+	rows := make([]table.Row, 0, len(b.data.Jobs)+len(groups))
+	rowJobIndex := make([]int, 0, len(b.data.Jobs)+len(groups))
+	fullRows := make(map[int]string, len(groups))
+	selectionSpans := make(map[int]table.SelectionSpan, len(b.data.Jobs)+len(groups))
+	for _, group := range groups {
+		rows = append(rows, table.Row{ID: "queue:" + group.queue, Cells: make([]string, len(jobColumnsQueue))})
+		selectionSpans[len(rows)-1] = table.SelectionSpan{Start: glyphWidth + 1, End: -1}
+		fullRows[len(rows)-1] = b.renderQueueRow(group)
+		rowJobIndex = append(rowJobIndex, -1)
+
+		for j, job := range group.jobs {
+			branch := "├─ "
+			if j == len(group.jobs)-1 {
+				branch = "└─ "
+			}
+
+			b.filteredJobs = append(b.filteredJobs, job)
+			jobIndex := len(b.filteredJobs) - 1
+
+			rows = append(rows, table.Row{
+				ID: job.JID(),
+				Cells: []string{
+					b.styles.Muted.Render(branch),
+					shortProcessIdentity(job.ProcessIdentity),
+					job.ThreadID,
+					job.JID(),
+					b.ageCell(job, now),
+					job.DisplayClass(),
+					display.Args(job.DisplayArgs()),
+				},
+			})
+			selectionSpans[len(rows)-1] = table.SelectionSpan{
+				Start: lipgloss.Width(branch),
+				End:   -1,
+			}
+			rowJobIndex = append(rowJobIndex, jobIndex)
+		}
+	}
+	b.rowJobIndex = rowJobIndex
+	b.table.SetRowsWithMeta(rows, fullRows, selectionSpans)
+	b.updateTableSize()
+}
+
+type busyQueueGroup struct {
+	queue string
+	jobs  []sidekiq.Job
+}
+
+// busyQueueGroups groups jobs by queue, sorted by in-flight count descending
+// and then by queue name.
+func busyQueueGroups(jobs []sidekiq.Job, selectedIdentity string) []busyQueueGroup {
+	indexByQueue := make(map[string]int)
+	groups := make([]busyQueueGroup, 0)
+	for _, job := range jobs {
+		if selectedIdentity != "" && job.ProcessIdentity != selectedIdentity {
+			continue
+		}
+		queue := job.Queue()
+		idx, ok := indexByQueue[queue]
+		if !ok {
+			idx = len(groups)
+			indexByQueue[queue] = idx
+			groups = append(groups, busyQueueGroup{queue: queue})
+		}
+		groups[idx].jobs = append(groups[idx].jobs, job)
+	}
+	slices.SortStableFunc(groups, func(a, b busyQueueGroup) int {
+		if len(a.jobs) != len(b.jobs) {
+			return len(b.jobs) - len(a.jobs)
+		}
+		return strings.Compare(a.queue, b.queue)
+	})
+	return groups
+}
+
+func (b *Busy) renderQueueRow(group busyQueueGroup) string {
+	return b.styles.Muted.Render(queueGlyph) + " " +
+		b.styles.QueueText.Render(group.queue) +
+		b.styles.Muted.Render(fmt.Sprintf("  %d in flight", len(group.jobs)))
+}
+
+func (b *Busy) groupingHint() string {
+	if b.groupByQueue {
+		return "group by process"
+	}
+	return "group by queue"
+}
+
 func (b *Busy) updateTableRowsFlat() {
 	b.table.SetColumns(jobColumnsFlat)
+	now := time.Now()
 
 	selectedIdentity := b.selectedIdentity()
 
@@ -447,7 +767,7 @@ func (b *Busy) updateTableRowsFlat() {
 	rows := make([]table.Row, 0, len(b.data.Jobs))
 	rowJobIndex := make([]int, 0, len(b.data.Jobs))
 	selectionSpans := make(map[int]table.SelectionSpan, len(b.data.Jobs))
-	for _, job := range b.data.Jobs {
+	for _, job := range b.orderedJobs() {
 		if selectedIdentity != "" && job.ProcessIdentity != selectedIdentity {
 			continue
 		}
@@ -462,7 +782,7 @@ func (b *Busy) updateTableRowsFlat() {
 				job.ThreadID,
 				job.JID(),
 				b.styles.QueueText.Render(job.Queue()),
-				display.DurationSince(job.RunAt),
+				b.ageCell(job, now),
 				job.DisplayClass(),
 				display.Args(job.DisplayArgs()),
 			},
@@ -487,30 +807,62 @@ func (b *Busy) openFilterDialog() tea.Cmd {
 	}
 }
 
-// renderJobsBox renders the bordered box containing the jobs table.
-func (b *Busy) renderJobsBox() string {
-	// Calculate stats for meta
-	processCount := len(b.data.Processes)
-	totalThreads := 0
-	busyThreads := 0
-	totalRSS := int64(0)
+func (b *Busy) openTagFilterDialog() tea.Cmd {
+	return func() tea.Msg {
+		return dialogs.OpenDialogMsg{
+			Model: newPromptDialog(b.styles, "Filter by tag or label", b.tagFilter, busyTagFilterTarget, nil),
+		}
+	}
+}
 
-	for _, proc := range b.data.Processes {
-		totalThreads += proc.Concurrency
-		busyThreads += proc.Busy
-		totalRSS += proc.RSS
+// setTagFilter narrows the fetched processes by tag without refetching.
+func (b *Busy) setTagFilter(tagFilter string) {
+	b.tagFilter = tagFilter
+	b.data = filterBusyByTag(b.fetched, tagFilter)
+	// Process hotkeys index the filtered list, so drop the selection.
+	b.selectedProcess = -1
+	b.table.SetCursor(0)
+	b.updateTableRows()
+}
+
+// filterBusyByTag keeps processes whose tag or any label contains needle
+// (case-insensitive) and the jobs running on them. Processes without a tag
+// or labels never match a non-empty needle.
+func filterBusyByTag(data sidekiq.BusyData, needle string) sidekiq.BusyData {
+	if needle == "" {
+		return data
+	}
+	needle = strings.ToLower(needle)
+
+	keep := make(map[string]struct{}, len(data.Processes))
+	filtered := sidekiq.BusyData{Processes: make([]sidekiq.Process, 0, len(data.Processes))}
+	for _, proc := range data.Processes {
+		if !processMatchesTag(proc, needle) {
+			continue
+		}
+		keep[proc.Identity] = struct{}{}
+		filtered.Processes = append(filtered.Processes, proc)
+	}
+	for _, job := range data.Jobs {
+		if _, ok := keep[job.ProcessIdentity]; ok {
+			filtered.Jobs = append(filtered.Jobs, job)
+		}
 	}
+	return filtered
+}
 
-	percentage := 0
-	if totalThreads > 0 {
-		percentage = (busyThreads * 100) / totalThreads
+func processMatchesTag(proc sidekiq.Process, needle string) bool {
+	if proc.Tag != "" && strings.Contains(strings.ToLower(proc.Tag), needle) {
+		return true
 	}
+	return slices.ContainsFunc(proc.Labels, func(label string) bool {
+		return strings.Contains(strings.ToLower(label), needle)
+	})
+}
 
-	// Build meta: PRC, THR, RSS info
-	sep := b.styles.Muted.Render(" • ")
-	meta := b.styles.MetricLabel.Render("PRC: ") + b.styles.MetricValue.Render(strconv.Itoa(processCount)) +
-		sep + b.styles.MetricLabel.Render("THR: ") + b.styles.MetricValue.Render(fmt.Sprintf("%d/%d (%d%%)", busyThreads, totalThreads, percentage)) +
-		sep + b.styles.MetricLabel.Render("RSS: ") + b.styles.MetricValue.Render(display.Bytes(totalRSS))
+// renderJobsBox renders the bordered box containing the jobs table.
+func (b *Busy) renderJobsBox() string {
+	meta := b.summaryMeta()
 
 	// Calculate box height
 	boxHeight := b.height
@@ -521,6 +873,9 @@ func (b *Busy) renderJobsBox() string {
 		proc := b.data.Processes[b.selectedProcess]
 		title = fmt.Sprintf("Active Jobs on %s:%s", proc.Hostname, formatPID(proc.PID))
 	}
+	if b.groupByQueue {
+		title += " by Queue"
+	}
 
 	// Get table content
 	content := b.table.View()
@@ -582,7 +937,7 @@ func processIdentity(proc sidekiq.Process) string {
 
 func (b *Busy) jobsByProcess(selectedIdentity string) map[string][]sidekiq.Job {
 	jobsByProcess := make(map[string][]sidekiq.Job, len(b.data.Processes))
-	for _, job := range b.data.Jobs {
+	for _, job := range b.orderedJobs() {
 		if selectedIdentity != "" && job.ProcessIdentity != selectedIdentity {
 			continue
 		}
@@ -619,6 +974,7 @@ func (b *Busy) processListLines() []string {
 		}
 	}
 
+	now := time.Now()
 	lines := make([]string, 0, len(b.data.Processes))
 	nameStyle := b.styles.Text.Bold(true).Width(maxNameLen)
 	for i, proc := range b.data.Processes {
@@ -638,17 +994,43 @@ func (b *Busy) processListLines() []string {
 		started := display.DurationSince(proc.StartedAt)
 		stats := b.styles.Muted.Render(fmt.Sprintf("  %*s  %*s", maxBusyLen, busy, maxStartedLen, started))
 
+		// Append after the stats so the columns stay aligned across rows.
+		if b.isLeader(proc) {
+			stats += b.leaderBadge()
+		}
+		stats += processStatusBadge(b.styles, proc)
+		stats += beatBadge(b.styles, proc, b.beatStale, now)
+
 		lines = append(lines, name+stats)
 	}
 
 	return lines
 }
 
+func (b *Busy) isLeader(proc sidekiq.Process) bool {
+	return b.leader != "" && proc.Identity == b.leader
+}
+
+func (b *Busy) leaderBadge() string {
+	return " " + b.styles.NavKey.Bold(true).Render(leaderBadge)
+}
+
 func (b *Busy) renderProcessRow(proc sidekiq.Process, maxBusyLen, maxStartedLen, maxRSSLen int) string {
 	name := b.styles.Muted.Render(processGlyph) + " " + b.styles.Text.Render(processIdentity(proc))
 	if proc.Tag != "" {
 		name += b.styles.Text.Render(" [" + proc.Tag + "]")
 	}
+	if proc.Version != "" {
+		name += b.styles.Muted.Render(" v" + proc.Version)
+	}
+	for _, label := range proc.Labels {
+		name += " " + b.styles.NavKey.Render(label)
+	}
+	if b.isLeader(proc) {
+		name += b.leaderBadge()
+	}
+	name += processStatusBadge(b.styles, proc)
+	name += beatBadge(b.styles, proc, b.beatStale, time.Now())
 	busy := fmt.Sprintf("%d/%d", proc.Busy, proc.Concurrency)
 	started := display.DurationSince(proc.StartedAt)
 	rss := display.Bytes(proc.RSS)
@@ -0,0 +1,120 @@
+package views
+
+import (
+	"context"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/kpumuk/lazykiq/internal/devtools"
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/requestctx"
+)
+
+const (
+	// busyClassAveragePeriod is the metrics period average run times are read
+	// from.
+	busyClassAveragePeriod = "1h"
+	// busyClassAverageTTL is how long a class average is reused before it is
+	// fetched again.
+	busyClassAverageTTL = 5 * time.Minute
+	// busySlowRatio and busyAnomalyRatio are how many times its class average
+	// a job may run before its age turns yellow and red.
+	busySlowRatio    = 2
+	busyAnomalyRatio = 10
+)
+
+// busyClassAverage is the cached average run time of a job class. A zero
+// average means the class has no metrics for the period.
+type busyClassAverage struct {
+	seconds   float64
+	fetchedAt time.Time
+}
+
+// busyClassAveragesMsg carries freshly fetched class averages internally.
+type busyClassAveragesMsg struct {
+	averages  map[string]float64
+	fetchedAt time.Time
+}
+
+// fetchClassAveragesCmd fetches the average run time of job classes on the
+// screen that are missing from the cache or stale. It runs apart from the
+// busy data refresh, one batch at a time, so a slow metrics read never delays
+// the job list.
+func (b *Busy) fetchClassAveragesCmd(now time.Time) tea.Cmd {
+	if b.averagesPending || b.client == nil {
+		return nil
+	}
+	var classes []string
+	seen := make(map[string]bool)
+	for _, job := range b.data.Jobs {
+		if job.JobRecord == nil {
+			continue
+		}
+		class := job.DisplayClass()
+		if class == "" || seen[class] {
+			continue
+		}
+		seen[class] = true
+		if cached, ok := b.classAverages[class]; ok && now.Sub(cached.fetchedAt) < busyClassAverageTTL {
+			continue
+		}
+		classes = append(classes, class)
+	}
+	if len(classes) == 0 {
+		return nil
+	}
+
+	b.averagesPending = true
+	client := b.client
+	period := sidekiq.MetricsPeriods[busyClassAveragePeriod]
+	ctx := b.averagesRequest.Start(devtools.WithTracker(context.Background(), "busy.fetchClassAveragesCmd"))
+	return func() tea.Msg {
+		averages := make(map[string]float64, len(classes))
+		for _, class := range classes {
+			result, err := client.GetMetricsJobDetail(ctx, class, period)
+			if err != nil {
+				if requestctx.IsCanceled(err) {
+					return nil
+				}
+				// Averages only tint the age column, so a failed read
+				// falls back to the long-running threshold.
+				averages[class] = 0
+				continue
+			}
+			averages[class] = result.Totals.AvgSeconds()
+		}
+		return busyClassAveragesMsg{averages: averages, fetchedAt: now}
+	}
+}
+
+// storeClassAverages caches fetched class averages.
+func (b *Busy) storeClassAverages(msg busyClassAveragesMsg) {
+	b.averagesPending = false
+	if b.classAverages == nil {
+		b.classAverages = make(map[string]busyClassAverage, len(msg.averages))
+	}
+	for class, seconds := range msg.averages {
+		b.classAverages[class] = busyClassAverage{seconds: seconds, fetchedAt: msg.fetchedAt}
+	}
+}
+
+// cancelClassAverages stops a pending averages fetch so the next refresh can
+// start another.
+func (b *Busy) cancelClassAverages() {
+	b.averagesRequest.Cancel()
+	b.averagesPending = false
+}
+
+// classAverage returns the cached average run time of job's class, or 0 when
+// it is unknown.
+func (b *Busy) classAverage(job sidekiq.Job) time.Duration {
+	if job.JobRecord == nil {
+		return 0
+	}
+	cached, ok := b.classAverages[job.DisplayClass()]
+	if !ok || cached.seconds <= 0 {
+		return 0
+	}
+	return time.Duration(cached.seconds * float64(time.Second))
+}
@@ -0,0 +1,81 @@
+package views
+
+import (
+	"slices"
+	"time"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/display"
+)
+
+// DefaultLongRunning is how long a busy job may run before the Busy view
+// highlights it.
+const DefaultLongRunning = time.Minute
+
+// LongRunningSetter is implemented by views that highlight long-running jobs.
+type LongRunningSetter interface {
+	SetLongRunning(threshold time.Duration)
+}
+
+// isLongRunning reports whether job has run for at least threshold. Jobs
+// without a start time and a zero threshold are never flagged.
+func isLongRunning(job sidekiq.Job, threshold time.Duration, now time.Time) bool {
+	return threshold > 0 && !job.RunAt.IsZero() && now.Sub(job.RunAt) >= threshold
+}
+
+// sortLongestRunning returns jobs ordered by start time, longest running
+// first, with jobs missing a start time last.
+func sortLongestRunning(jobs []sidekiq.Job) []sidekiq.Job {
+	sorted := slices.Clone(jobs)
+	slices.SortStableFunc(sorted, func(a, b sidekiq.Job) int {
+		switch {
+		case a.RunAt.IsZero() && b.RunAt.IsZero():
+			return 0
+		case a.RunAt.IsZero():
+			return 1
+		case b.RunAt.IsZero():
+			return -1
+		}
+		return a.RunAt.Compare(b.RunAt)
+	})
+	return sorted
+}
+
+// orderedJobs returns the jobs in display order.
+func (b *Busy) orderedJobs() []sidekiq.Job {
+	if b.longestFirst {
+		return sortLongestRunning(b.data.Jobs)
+	}
+	return b.data.Jobs
+}
+
+// ageCell renders how long job has been running. When its class has metrics
+// the age is green, yellow from busySlowRatio times the class average, and
+// red from busyAnomalyRatio times it; otherwise it is highlighted once it
+// passes the long-running threshold.
+func (b *Busy) ageCell(job sidekiq.Job, now time.Time) string {
+	age := display.DurationSince(job.RunAt)
+	if average := b.classAverage(job); average > 0 && !job.RunAt.IsZero() {
+		elapsed := now.Sub(job.RunAt)
+		switch {
+		case elapsed >= busyAnomalyRatio*average:
+			return b.styles.ErrorText.Render(age)
+		case elapsed >= busySlowRatio*average:
+			return b.styles.WarningText.Render(age)
+		default:
+			return b.styles.ChartSuccess.Render(age)
+		}
+	}
+	if isLongRunning(job, b.longRunning, now) {
+		return b.styles.WarningText.Render(age)
+	}
+	return age
+}
+
+// longRunningValue describes the long-running threshold for the context bar.
+func (b *Busy) longRunningValue() string {
+	if b.longRunning <= 0 {
+		return "off"
+	}
+	return "≥ " + display.Duration(int64(b.longRunning/time.Second))
+}
@@ -0,0 +1,97 @@
+package views
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/display"
+)
+
+// Default utilization thresholds, in percent of concurrency in use.
+const (
+	DefaultUtilizationWarn     = 80
+	DefaultUtilizationCritical = 95
+)
+
+// UtilizationThresholds holds the percentages of concurrency in use at which
+// the Busy summary is highlighted. A zero limit disables that level.
+type UtilizationThresholds struct {
+	Warn     int
+	Critical int
+}
+
+// DefaultUtilizationThresholds returns the thresholds used when none are
+// configured.
+func DefaultUtilizationThresholds() UtilizationThresholds {
+	return UtilizationThresholds{Warn: DefaultUtilizationWarn, Critical: DefaultUtilizationCritical}
+}
+
+// UtilizationThresholdsSetter is implemented by views that highlight
+// process utilization.
+type UtilizationThresholdsSetter interface {
+	SetUtilizationThresholds(thresholds UtilizationThresholds)
+}
+
+// level classifies a utilization percentage against the thresholds, using
+// the same levels as queue latency.
+func (t UtilizationThresholds) level(percent int) latencyLevel {
+	switch {
+	case t.Critical > 0 && percent >= t.Critical:
+		return latencyLevelCritical
+	case t.Warn > 0 && percent >= t.Warn:
+		return latencyLevelWarn
+	default:
+		return latencyLevelNormal
+	}
+}
+
+// busySummary totals the processes shown in the Busy view.
+type busySummary struct {
+	processes   int
+	concurrency int
+	busy        int
+	rss         int64
+}
+
+func summarizeProcesses(processes []sidekiq.Process) busySummary {
+	summary := busySummary{processes: len(processes)}
+	for _, proc := range processes {
+		summary.concurrency += proc.Concurrency
+		summary.busy += proc.Busy
+		summary.rss += proc.RSS
+	}
+	return summary
+}
+
+// utilization returns the percentage of concurrency in use, rounded down,
+// and false when the processes report no concurrency.
+func (s busySummary) utilization() (int, bool) {
+	if s.concurrency <= 0 {
+		return 0, false
+	}
+	return s.busy * 100 / s.concurrency, true
+}
+
+// summaryMeta renders the process totals shown in the Active Jobs header:
+// process count, busy threads out of total concurrency with utilization
+// highlighted past the thresholds, and aggregate RSS.
+func (b *Busy) summaryMeta() string {
+	summary := summarizeProcesses(b.data.Processes)
+	sep := b.styles.Muted.Render(" • ")
+	meta := b.styles.MetricLabel.Render("PRC: ") + b.styles.MetricValue.Render(strconv.Itoa(summary.processes))
+	if summary.processes == 0 {
+		return meta + sep + b.styles.Muted.Render("no processes running")
+	}
+
+	threads := fmt.Sprintf("%d/%d", summary.busy, summary.concurrency)
+	if percent, ok := summary.utilization(); ok {
+		style := latencyStyle(b.styles, b.utilization.level(percent), b.styles.MetricValue)
+		threads = b.styles.MetricValue.Render(threads+" ") + style.Render(fmt.Sprintf("(%d%%)", percent))
+	} else {
+		threads = b.styles.MetricValue.Render(threads)
+	}
+	return meta +
+		sep + b.styles.MetricLabel.Render("THR: ") + threads +
+		sep + b.styles.MetricLabel.Render("RSS: ") + b.styles.MetricValue.Render(display.Bytes(summary.rss))
+}
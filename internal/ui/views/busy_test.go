@@ -0,0 +1,514 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	promptdialog "github.com/kpumuk/lazykiq/internal/ui/dialogs/prompt"
+)
+
+func busyJob(jid, queue, process string) sidekiq.Job {
+	return sidekiq.Job{
+		JobRecord:       sidekiq.NewJobRecord(fmt.Sprintf(`{"jid":%q,"class":"Worker"}`, jid), queue),
+		ProcessIdentity: process,
+		ThreadID:        "t1",
+	}
+}
+
+func TestBusyQueueGroupsSortByInFlight(t *testing.T) {
+	jobs := []sidekiq.Job{
+		busyJob("a", "low", "host:1:abc"),
+		busyJob("b", "default", "host:1:abc"),
+		busyJob("c", "critical", "host:2:def"),
+		busyJob("d", "critical", "host:1:abc"),
+		busyJob("e", "default", "host:2:def"),
+		busyJob("f", "critical", "host:2:def"),
+	}
+
+	tests := map[string]struct {
+		selected string
+		want     []string
+		counts   []int
+	}{
+		"all processes": {
+			want:   []string{"critical", "default", "low"},
+			counts: []int{3, 2, 1},
+		},
+		"selected process": {
+			selected: "host:2:def",
+			want:     []string{"critical", "default"},
+			counts:   []int{2, 1},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			groups := busyQueueGroups(jobs, tc.selected)
+			if len(groups) != len(tc.want) {
+				t.Fatalf("groups = %d, want %d", len(groups), len(tc.want))
+			}
+			for i, group := range groups {
+				if group.queue != tc.want[i] || len(group.jobs) != tc.counts[i] {
+					t.Fatalf("group %d = %s (%d), want %s (%d)", i, group.queue, len(group.jobs), tc.want[i], tc.counts[i])
+				}
+			}
+		})
+	}
+}
+
+func TestBusyGroupToggleKeepsSelectedJob(t *testing.T) {
+	view := NewBusy(nil)
+	view.SetStyles(Styles{})
+	view.SetSize(120, 20)
+	view.Update(busyDataMsg{data: sidekiq.BusyData{
+		Jobs: []sidekiq.Job{
+			busyJob("a", "low", "host:1:abc"),
+			busyJob("b", "critical", "host:1:abc"),
+			busyJob("c", "critical", "host:1:abc"),
+		},
+	}})
+
+	view.table.SetCursor(0)
+	if got := view.table.SelectedRow().ID; got != "a" {
+		t.Fatalf("selected = %q, want a", got)
+	}
+
+	view.Update(tea.KeyPressMsg{Code: 'g', Text: "g"})
+	if !view.groupByQueue {
+		t.Fatal("expected queue grouping")
+	}
+	if got := view.table.SelectedRow().ID; got != "a" {
+		t.Fatalf("selected after grouping = %q, want a", got)
+	}
+	if got := view.table.Rows()[0].ID; got != "queue:critical" {
+		t.Fatalf("first row = %q, want busiest queue header", got)
+	}
+
+	view.Update(tea.KeyPressMsg{Code: 'g', Text: "g"})
+	if got := view.table.SelectedRow().ID; got != "a" {
+		t.Fatalf("selected after ungrouping = %q, want a", got)
+	}
+}
+
+func TestBusyHomeGoesToTop(t *testing.T) {
+	view := NewBusy(nil)
+	view.SetStyles(Styles{})
+	view.SetSize(120, 20)
+	view.Update(busyDataMsg{data: sidekiq.BusyData{
+		Jobs: []sidekiq.Job{
+			busyJob("a", "low", "host:1:abc"),
+			busyJob("b", "critical", "host:1:abc"),
+		},
+	}})
+
+	view.table.SetCursor(1)
+	view.Update(tea.KeyPressMsg{Code: tea.KeyHome})
+	if got := view.table.Cursor(); got != 0 {
+		t.Fatalf("cursor after home = %d, want 0", got)
+	}
+	if view.groupByQueue {
+		t.Fatal("home should not toggle queue grouping")
+	}
+}
+
+func TestBusyLeaderBadge(t *testing.T) {
+	processes := []sidekiq.Process{
+		{Identity: "host:1:abc", Hostname: "host", PID: 1, Concurrency: 5},
+		{Identity: "host:2:def", Hostname: "host", PID: 2, Concurrency: 5},
+	}
+
+	tests := map[string]struct {
+		leader string
+		want   []bool
+	}{
+		"matching leader": {leader: "host:2:def", want: []bool{false, true}},
+		"no leader key":   {want: []bool{false, false}},
+		"unknown leader":  {leader: "other:9:xyz", want: []bool{false, false}},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			view := NewBusy(nil)
+			view.SetStyles(Styles{})
+			view.SetSize(120, 20)
+			view.Update(busyDataMsg{data: sidekiq.BusyData{Processes: processes}, leader: tc.leader})
+
+			lines := view.HeaderLines()
+			for i, want := range tc.want {
+				if got := strings.Contains(lines[i], leaderBadge); got != want {
+					t.Fatalf("line %d leader badge = %v, want %v: %q", i, got, want, lines[i])
+				}
+			}
+		})
+	}
+}
+
+func TestBusyBeatStale(t *testing.T) {
+	now := time.Now()
+	processes := []sidekiq.Process{
+		{Identity: "host:1:abc", Hostname: "host", PID: 1, Concurrency: 5, Beat: now.Add(-5 * time.Second)},
+		{Identity: "host:2:def", Hostname: "host", PID: 2, Concurrency: 5, Beat: now.Add(-5 * time.Minute)},
+		{Identity: "host:3:ghi", Hostname: "host", PID: 3, Concurrency: 5},
+	}
+
+	tests := map[string]struct {
+		threshold time.Duration
+		want      []string
+	}{
+		"default":  {threshold: DefaultBeatStale, want: []string{"", "beat 5m", "no heartbeat"}},
+		"disabled": {want: []string{"", "", ""}},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			view := NewBusy(nil)
+			view.SetStyles(Styles{})
+			view.SetBeatStale(tc.threshold)
+			view.SetSize(120, 20)
+			view.Update(busyDataMsg{data: sidekiq.BusyData{Processes: processes}})
+
+			lines := view.HeaderLines()
+			for i, want := range tc.want {
+				hasBadge := strings.Contains(lines[i], "beat")
+				if want == "" && hasBadge {
+					t.Fatalf("line %d: unexpected beat badge: %q", i, lines[i])
+				}
+				if want != "" && !strings.Contains(lines[i], want) {
+					t.Fatalf("line %d: missing %q: %q", i, want, lines[i])
+				}
+			}
+		})
+	}
+}
+
+func TestBusyLongRunning(t *testing.T) {
+	now := time.Now()
+	jobs := []sidekiq.Job{
+		busyJob("fresh", "default", "host:1:abc"),
+		busyJob("unknown", "default", "host:1:abc"),
+		busyJob("slow", "default", "host:1:abc"),
+		busyJob("slowest", "default", "host:1:abc"),
+	}
+	jobs[0].RunAt = now.Add(-5 * time.Second)
+	jobs[2].RunAt = now.Add(-2 * time.Minute)
+	jobs[3].RunAt = now.Add(-time.Hour)
+
+	for i, want := range []bool{false, false, true, true} {
+		if got := isLongRunning(jobs[i], DefaultLongRunning, now); got != want {
+			t.Fatalf("isLongRunning(%s) = %v, want %v", jobs[i].JID(), got, want)
+		}
+	}
+	if isLongRunning(jobs[3], 0, now) {
+		t.Fatal("isLongRunning with a zero threshold = true, want false")
+	}
+
+	view := NewBusy(nil)
+	view.SetStyles(Styles{})
+	view.SetSize(120, 20)
+	view.Update(busyDataMsg{data: sidekiq.BusyData{
+		Processes: []sidekiq.Process{{Identity: "host:1:abc", Hostname: "host", PID: 1, Concurrency: 5}},
+		Jobs:      jobs,
+	}})
+	if got := contextValue(view.ContextItems(), "Long running"); got != "≥ 1m0s" {
+		t.Fatalf("Long running = %q, want ≥ 1m0s", got)
+	}
+
+	view.Update(tea.KeyPressMsg{Code: 'o', Text: "o"})
+	got := make([]string, len(view.filteredJobs))
+	for i, job := range view.filteredJobs {
+		got[i] = job.JID()
+	}
+	if want := []string{"slowest", "slow", "fresh", "unknown"}; !slices.Equal(got, want) {
+		t.Fatalf("longest first = %v, want %v", got, want)
+	}
+	if got := contextValue(view.ContextItems(), "Order"); got != "longest running first" {
+		t.Fatalf("Order = %q, want longest running first", got)
+	}
+
+	view.SetLongRunning(0)
+	if got := contextValue(view.ContextItems(), "Long running"); got != "off" {
+		t.Fatalf("Long running = %q, want off", got)
+	}
+}
+
+func TestBusyProcessRowShowsVersionAndLabels(t *testing.T) {
+	view := NewBusy(nil)
+	view.SetStyles(Styles{})
+	view.SetSize(160, 20)
+	view.Update(busyDataMsg{data: sidekiq.BusyData{Processes: []sidekiq.Process{{
+		Identity:    "host:1:abc",
+		Hostname:    "host",
+		PID:         1,
+		Tag:         "app",
+		Version:     "7.2.1",
+		Labels:      []string{"reliable", "critical"},
+		Concurrency: 5,
+	}}}})
+	view.Update(tea.KeyPressMsg{Code: 't', Text: "t"})
+
+	output := ansi.Strip(view.View())
+	if !strings.Contains(output, "[app] v7.2.1 reliable critical") {
+		t.Fatalf("View() missing version and labels:\n%s", output)
+	}
+}
+
+type busyMetricsStub struct {
+	sidekiq.API
+	averages map[string]float64 // class -> average seconds
+	classes  []string
+}
+
+func (s *busyMetricsStub) GetMetricsJobDetail(_ context.Context, class string, _ sidekiq.MetricsPeriod) (sidekiq.MetricsJobDetailResult, error) {
+	s.classes = append(s.classes, class)
+	avg, ok := s.averages[class]
+	if !ok {
+		return sidekiq.MetricsJobDetailResult{}, nil
+	}
+	return sidekiq.MetricsJobDetailResult{
+		Totals: sidekiq.MetricsJobTotals{Processed: 10, Seconds: avg * 10},
+	}, nil
+}
+
+func TestBusyAgeComparedToClassAverage(t *testing.T) {
+	now := time.Now()
+	job := func(jid, class string, elapsed time.Duration) sidekiq.Job {
+		return sidekiq.Job{
+			JobRecord:       sidekiq.NewJobRecord(fmt.Sprintf(`{"jid":%q,"class":%q}`, jid, class), "default"),
+			ProcessIdentity: "host:1:abc",
+			RunAt:           now.Add(-elapsed),
+		}
+	}
+	jobs := []sidekiq.Job{
+		job("ok", "FastJob", 3*time.Second),
+		job("slow", "FastJob", 30*time.Second),
+		job("anomaly", "FastJob", 2*time.Minute),
+		job("unknown", "NewJob", 2*time.Minute),
+	}
+
+	client := &busyMetricsStub{averages: map[string]float64{"FastJob": 10}}
+	view := NewBusy(client)
+	tag := func(prefix string) lipgloss.Style {
+		return lipgloss.NewStyle().Transform(func(s string) string { return prefix + s })
+	}
+	view.SetStyles(Styles{ChartSuccess: tag("green:"), WarningText: tag("yellow:"), ErrorText: tag("red:")})
+	view.SetSize(120, 20)
+
+	data := busyDataMsg{data: sidekiq.BusyData{
+		Processes: []sidekiq.Process{{Identity: "host:1:abc", Hostname: "host", PID: 1, Concurrency: 5}},
+		Jobs:      jobs,
+	}}
+	_, cmd := view.Update(data)
+	if cmd == nil {
+		t.Fatal("expected class averages to be fetched")
+	}
+	// Before the averages arrive, the long-running threshold applies.
+	if got := view.ageCell(jobs[2], now); got != "yellow:2m0s" {
+		t.Fatalf("age before averages = %q, want the long-running highlight", got)
+	}
+
+	view.Update(cmd())
+	if !slices.Equal(client.classes, []string{"FastJob", "NewJob"}) {
+		t.Fatalf("fetched classes = %v, want each class once", client.classes)
+	}
+	for i, want := range []string{"green:3s", "yellow:30s", "red:2m0s", "yellow:2m0s"} {
+		if got := view.ageCell(jobs[i], now); got != want {
+			t.Fatalf("age of %s = %q, want %q", jobs[i].JID(), got, want)
+		}
+	}
+
+	// Cached classes are not fetched again on refresh.
+	if _, cmd := view.Update(data); cmd != nil {
+		t.Fatal("expected cached class averages to be reused")
+	}
+}
+
+func TestBusyShowsProcessStatus(t *testing.T) {
+	view := NewBusy(nil)
+	view.SetStyles(Styles{})
+	view.SetSize(160, 30)
+	view.Update(busyDataMsg{data: sidekiq.BusyData{Processes: []sidekiq.Process{
+		{Identity: "a:1:a", Hostname: "a", PID: 1, Concurrency: 5, Status: sidekiq.ProcessStatusRunning},
+		{Identity: "b:2:b", Hostname: "b", PID: 2, Concurrency: 5, Busy: 3, Quiet: true, Status: sidekiq.ProcessStatusQuieting},
+		{Identity: "c:3:c", Hostname: "c", PID: 3, Concurrency: 5, Quiet: true, Status: sidekiq.ProcessStatusQuiet},
+		{Identity: "d:4:d", Hostname: "d", PID: 4, Concurrency: 5, Busy: 1, Status: sidekiq.ProcessStatusStopping},
+	}}})
+
+	for _, mode := range []string{"header", "tree"} {
+		output := ansi.Strip(strings.Join(view.HeaderLines(), "\n"))
+		if mode == "tree" {
+			view.Update(tea.KeyPressMsg{Code: 't', Text: "t"})
+			output = ansi.Strip(view.View())
+		}
+		for _, want := range []string{"quieting (3 in-flight)", " quiet", "stopping (1 in-flight)"} {
+			if !strings.Contains(output, want) {
+				t.Fatalf("%s view missing %q:\n%s", mode, want, output)
+			}
+		}
+		if strings.Contains(output, "running") {
+			t.Fatalf("%s view flags a running process:\n%s", mode, output)
+		}
+	}
+}
+
+func TestBusyTagFilter(t *testing.T) {
+	processes := []sidekiq.Process{
+		{Identity: "web:1:a", Hostname: "web", PID: 1, Tag: "web", Concurrency: 5},
+		{Identity: "worker:2:b", Hostname: "worker", PID: 2, Tag: "jobs", Labels: []string{"critical"}, Concurrency: 5},
+		{Identity: "bare:3:c", Hostname: "bare", PID: 3, Concurrency: 5},
+	}
+	jobs := []sidekiq.Job{
+		busyJob("j1", "default", "web:1:a"),
+		busyJob("j2", "critical", "worker:2:b"),
+		busyJob("j3", "default", "bare:3:c"),
+	}
+
+	view := NewBusy(nil)
+	view.SetStyles(Styles{})
+	view.SetSize(120, 20)
+	view.Update(busyDataMsg{data: sidekiq.BusyData{Processes: processes, Jobs: jobs}})
+
+	if got := contextValue(view.ContextItems(), "Tags"); got != "" {
+		t.Fatalf("Tags = %q, want none without a tag filter", got)
+	}
+
+	tests := map[string]struct {
+		query    string
+		wantJIDs []string
+		wantPRC  string
+	}{
+		"tag":             {query: "WEB", wantJIDs: []string{"j1"}, wantPRC: "1/3"},
+		"label":           {query: "crit", wantJIDs: []string{"j2"}, wantPRC: "1/3"},
+		"empty tags skip": {query: "bare", wantPRC: "0/3"},
+		"no match":        {query: "nope", wantPRC: "0/3"},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			view.Update(promptdialog.ActionMsg{Target: busyTagFilterTarget, Value: tc.query})
+
+			var jids []string
+			for _, job := range view.filteredJobs {
+				jids = append(jids, job.JID())
+			}
+			if !slices.Equal(jids, tc.wantJIDs) {
+				t.Fatalf("jobs = %v, want %v", jids, tc.wantJIDs)
+			}
+			if got := contextValue(view.ContextItems(), "Processes"); got != tc.wantPRC {
+				t.Fatalf("Processes = %q, want %s", got, tc.wantPRC)
+			}
+		})
+	}
+
+	view.Update(tea.KeyPressMsg{Code: 'u', Mod: tea.ModCtrl})
+	if view.tagFilter != "" || len(view.filteredJobs) != 3 {
+		t.Fatalf("ctrl+u left tag filter %q with %d jobs", view.tagFilter, len(view.filteredJobs))
+	}
+}
+
+func TestBusyCopiesProcessDetails(t *testing.T) {
+	view := NewBusy(nil)
+	view.SetStyles(Styles{})
+	view.SetSize(120, 20)
+	copyIdentity := tea.KeyPressMsg{Code: 'i', Text: "i"}
+	copyCommand := tea.KeyPressMsg{Code: 'I', Text: "I"}
+
+	view.Update(copyIdentity)
+	if view.note != "no process to copy" {
+		t.Fatalf("note without data = %q", view.note)
+	}
+
+	view.Update(busyDataMsg{data: sidekiq.BusyData{
+		Processes: []sidekiq.Process{
+			{Identity: "web-1:14:abc", Hostname: "web-1", PID: 14, Tag: "app"},
+			{Identity: "web-2:15:def", Hostname: "web-2", PID: 15},
+		},
+		Jobs: []sidekiq.Job{
+			busyJob("a", "default", "web-2:15:def"),
+			busyJob("b", "default", "gone:main"),
+		},
+	}})
+
+	view.Update(copyIdentity)
+	if view.note != "copied web-2:15:def" {
+		t.Fatalf("note = %q, want the highlighted job's process", view.note)
+	}
+	view.Update(copyCommand)
+	if view.note != "copied web-2" {
+		t.Fatalf("note = %q, want the hostname without a template", view.note)
+	}
+
+	view.SetProcessCommand("ssh {hostname} kill {pid}")
+	view.Update(copyCommand)
+	if view.note != "copied ssh web-2 kill 15" {
+		t.Fatalf("note = %q", view.note)
+	}
+
+	view.table.SetCursor(1)
+	view.Update(copyCommand)
+	if !strings.HasPrefix(view.note, "cannot build command: pid is unknown") {
+		t.Fatalf("note = %q, want a missing pid error", view.note)
+	}
+
+	view.treeMode = true
+	view.updateTableRows()
+	view.table.SetCursor(0)
+	view.Update(copyIdentity)
+	if view.note != "copied web-1:14:abc" {
+		t.Fatalf("note = %q, want the process row", view.note)
+	}
+}
+
+func TestBusySummary(t *testing.T) {
+	view := NewBusy(nil)
+	view.SetStyles(Styles{})
+	view.SetSize(120, 20)
+
+	view.Update(busyDataMsg{})
+	if output := ansi.Strip(view.View()); !strings.Contains(output, "PRC: 0 • no processes running") {
+		t.Fatalf("summary without processes is missing:\n%s", output)
+	}
+
+	processes := []sidekiq.Process{
+		{Identity: "host:1:abc", Hostname: "host", PID: 1, Concurrency: 5, Busy: 5, RSS: 200 * 1024 * 1024},
+		{Identity: "host:2:def", Hostname: "host", PID: 2, Concurrency: 5, Busy: 4, RSS: 300 * 1024 * 1024},
+	}
+	view.Update(busyDataMsg{data: sidekiq.BusyData{Processes: processes}})
+	output := ansi.Strip(view.View())
+	for _, want := range []string{"PRC: 2", "THR: 9/10 (90%)", "RSS: 500.0 MB"} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("summary is missing %q:\n%s", want, output)
+		}
+	}
+
+	if percent, ok := summarizeProcesses([]sidekiq.Process{{Identity: "host:3:ghi"}}).utilization(); ok {
+		t.Fatalf("utilization without concurrency = %d, want none", percent)
+	}
+}
+
+func TestUtilizationThresholdsLevel(t *testing.T) {
+	thresholds := UtilizationThresholds{Warn: 80, Critical: 95}
+	tests := map[int]latencyLevel{
+		0:   latencyLevelNormal,
+		79:  latencyLevelNormal,
+		80:  latencyLevelWarn,
+		94:  latencyLevelWarn,
+		95:  latencyLevelCritical,
+		100: latencyLevelCritical,
+	}
+	for percent, want := range tests {
+		if got := thresholds.level(percent); got != want {
+			t.Fatalf("level(%d) = %v, want %v", percent, got, want)
+		}
+	}
+	if got := (UtilizationThresholds{}).level(100); got != latencyLevelNormal {
+		t.Fatalf("disabled thresholds level(100) = %v, want normal", got)
+	}
+}
@@ -0,0 +1,64 @@
+package views
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/display"
+)
+
+// clockSkew is the estimated offset of the workers' clocks from ours.
+type clockSkew struct {
+	offset time.Duration
+	known  bool
+}
+
+// estimateClockSkew asks the client for the current skew. Missing
+// heartbeats leave the skew unknown rather than failing the fetch.
+func estimateClockSkew(ctx context.Context, client sidekiq.API) (clockSkew, error) {
+	offset, err := client.EstimateClockSkew(ctx)
+	if errors.Is(err, sidekiq.ErrNoHeartbeats) {
+		return clockSkew{}, nil
+	}
+	if err != nil {
+		return clockSkew{}, err
+	}
+	return clockSkew{offset: offset, known: true}, nil
+}
+
+// exceeded reports whether metrics buckets are shifted to match the workers.
+func (s clockSkew) exceeded() bool {
+	return s.known && (s.offset >= sidekiq.ClockSkewThreshold || s.offset <= -sidekiq.ClockSkewThreshold)
+}
+
+// contextValue formats the skew for the context bar, highlighted once it
+// exceeds the threshold.
+func (s clockSkew) contextValue(styles Styles) string {
+	if !s.known {
+		return "-"
+	}
+	value := s.String()
+	if s.exceeded() {
+		return styles.WarningText.Render(value)
+	}
+	return value
+}
+
+// warning returns frame meta noting a large skew, or "" otherwise.
+func (s clockSkew) warning(styles Styles) string {
+	if !s.exceeded() {
+		return ""
+	}
+	return styles.WarningText.Render(" clock skew " + s.String())
+}
+
+// String formats the offset as a signed duration, e.g. "+5m0s".
+func (s clockSkew) String() string {
+	seconds := int64(s.offset.Round(time.Second) / time.Second)
+	if seconds < 0 {
+		return "-" + display.Duration(-seconds)
+	}
+	return "+" + display.Duration(seconds)
+}
@@ -1,13 +1,25 @@
 package views
 
 import (
+	"context"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
+	"github.com/atotto/clipboard"
 
+	"github.com/kpumuk/lazykiq/internal/devtools"
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
 	confirmdialog "github.com/kpumuk/lazykiq/internal/ui/dialogs/confirm"
 )
 
-func newConfirmDialog(styles Styles, title, message, target string, yesStyle lipgloss.Style) *confirmdialog.Model {
-	return confirmdialog.New(
+func newConfirmDialog(
+	styles Styles,
+	title, message, target string,
+	yesStyle lipgloss.Style,
+	opts ...confirmdialog.Option,
+) *confirmdialog.Model {
+	return confirmdialog.New(append([]confirmdialog.Option{
 		confirmdialog.WithStyles(confirmdialog.Styles{
 			Title:           styles.Title,
 			Border:          styles.FocusBorder,
@@ -20,5 +32,32 @@ func newConfirmDialog(styles Styles, title, message, target string, yesStyle lip
 		confirmdialog.WithTitle(title),
 		confirmdialog.WithMessage(message),
 		confirmdialog.WithTarget(target),
-	)
+	}, opts...)...)
+}
+
+// strictConfirmOption makes a confirmation require typing name when strict
+// confirmation (--strict-confirm) is on; otherwise y/n is enough.
+func strictConfirmOption(strict bool, name string) confirmdialog.Option {
+	if !strict {
+		name = ""
+	}
+	return confirmdialog.WithRequiredText(name)
+}
+
+// copyCommandsOption makes ctrl+y in a confirmation dialog copy the redis-cli
+// commands action would run, one per line, without running them.
+func copyCommandsOption(
+	client sidekiq.API,
+	tracker string,
+	action func(context.Context, sidekiq.API) error,
+) confirmdialog.Option {
+	return confirmdialog.WithPreview(func() tea.Msg {
+		ctx := devtools.WithTracker(context.Background(), tracker)
+		commands, err := client.PreviewCommands(ctx, action)
+		if err != nil {
+			return ConnectionErrorMsg{Err: err}
+		}
+		_ = clipboard.WriteAll(strings.Join(commands, "\n"))
+		return nil
+	})
 }
@@ -0,0 +1,29 @@
+package views
+
+import (
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+)
+
+// ConnectionErrorText turns network timeouts into a message naming the flag
+// that controls them; other errors are returned as is.
+func ConnectionErrorText(err error, opts sidekiq.ConnectionOptions) string {
+	var opErr *net.OpError
+	if !errors.As(err, &opErr) || !opErr.Timeout() {
+		return err.Error()
+	}
+
+	switch opErr.Op {
+	case "dial":
+		return fmt.Sprintf("Could not connect to Redis within %s (--dial-timeout).", opts.DialTimeout)
+	case "read":
+		return fmt.Sprintf("Redis did not reply within %s (--read-timeout). Raise --read-timeout if Redis is slow.", opts.ReadTimeout)
+	case "write":
+		return fmt.Sprintf("Could not send a command to Redis within %s (--write-timeout).", opts.WriteTimeout)
+	default:
+		return err.Error()
+	}
+}
@@ -0,0 +1,41 @@
+package views
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+)
+
+func TestConnectionErrorText(t *testing.T) {
+	opts := sidekiq.ConnectionOptions{DialTimeout: time.Second, ReadTimeout: 50 * time.Millisecond, WriteTimeout: 3 * time.Second}
+	timeout := func(op string) error {
+		return fmt.Errorf("fetch stats: %w", &net.OpError{Op: op, Net: "tcp", Err: os.ErrDeadlineExceeded})
+	}
+
+	tests := map[string]struct {
+		err  error
+		want string
+	}{
+		"dial":  {err: timeout("dial"), want: "Could not connect to Redis within 1s (--dial-timeout)."},
+		"read":  {err: timeout("read"), want: "Redis did not reply within 50ms (--read-timeout). Raise --read-timeout if Redis is slow."},
+		"write": {err: timeout("write"), want: "Could not send a command to Redis within 3s (--write-timeout)."},
+		"refused": {
+			err:  &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")},
+			want: "dial tcp: connection refused",
+		},
+		"other": {err: errors.New("ERR unknown command"), want: "ERR unknown command"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := ConnectionErrorText(tc.err, opts); got != tc.want {
+				t.Fatalf("ConnectionErrorText() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -7,12 +7,14 @@ import (
 	"time"
 
 	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/spinner"
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
 	"github.com/charmbracelet/x/ansi"
 
 	"github.com/kpumuk/lazykiq/internal/devtools"
 	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/components/errorbanner"
 	"github.com/kpumuk/lazykiq/internal/ui/components/frame"
 	"github.com/kpumuk/lazykiq/internal/ui/components/stats"
 	"github.com/kpumuk/lazykiq/internal/ui/components/timeseries"
@@ -25,6 +27,12 @@ const (
 	dashboardPaneHistory
 )
 
+// Dashboard fetches named in fetchErrorMsg.
+const (
+	dashboardFetchRedisInfo = "redis info"
+	dashboardFetchHistory   = "history"
+)
+
 // DashboardHistoryMsg carries historical dashboard data.
 type DashboardHistoryMsg struct {
 	history sidekiq.StatsHistory
@@ -32,7 +40,9 @@ type DashboardHistoryMsg struct {
 
 // DashboardRedisInfoMsg carries Redis info for the dashboard.
 type DashboardRedisInfoMsg struct {
-	RedisInfo sidekiq.RedisInfo
+	RedisInfo         sidekiq.RedisInfo
+	QueuesMemory      int64
+	QueuesMemoryKnown bool // False when the server cannot estimate memory
 }
 
 // Dashboard is the main overview view.
@@ -61,19 +71,32 @@ type Dashboard struct {
 	historyProcessed []int64
 	historyFailed    []int64
 
-	redisInfo sidekiq.RedisInfo
+	redisInfo         sidekiq.RedisInfo
+	queuesMemory      int64
+	queuesMemoryKnown bool
+
+	showBacklog bool
+	queueSizes  map[string]int64
 
 	redisInfoRequest requestctx.Controller
 	historyRequest   requestctx.Controller
+	redisInfoLoading bool
+	historyLoading   bool
+	updatedAt        time.Time // When the last stats or Redis info arrived
+	refresh          refreshIndicator
+	errorBanner      errorbanner.Model
+	failedFetch      string // Fetch whose error the banner shows
 }
 
 // NewDashboard creates a new Dashboard view.
 func NewDashboard(client sidekiq.API) *Dashboard {
 	return &Dashboard{
 		client:          client,
+		errorBanner:     newErrorBanner(),
 		focusedPane:     dashboardPaneRealtime,
 		historyRanges:   []int{7, 30, 90, 180},
 		historyRangeIdx: 1,
+		refresh:         newRefreshIndicator(),
 	}
 }
 
@@ -90,6 +113,8 @@ func (d *Dashboard) Update(msg tea.Msg) (View, tea.Cmd) {
 	switch msg := msg.(type) {
 	case stats.UpdateMsg:
 		// Use stats from the shared metrics update (already fetched by app)
+		d.updatedAt = nowFuncRefreshIndicator()
+		d.queueSizes = msg.Data.QueueSizes
 		var deltaProcessed int64
 		var deltaFailed int64
 		if d.hasLastTotals {
@@ -120,20 +145,47 @@ func (d *Dashboard) Update(msg tea.Msg) (View, tea.Cmd) {
 
 	case DashboardRedisInfoMsg:
 		d.redisInfo = msg.RedisInfo
+		d.queuesMemory = msg.QueuesMemory
+		d.queuesMemoryKnown = msg.QueuesMemoryKnown
+		d.redisInfoLoading = false
+		d.updatedAt = nowFuncRefreshIndicator()
+		d.clearFetchError(dashboardFetchRedisInfo)
 		return d, nil
 
 	case DashboardHistoryMsg:
 		d.historyDates = msg.history.Dates
 		d.historyProcessed = msg.history.Processed
 		d.historyFailed = msg.history.Failed
+		d.historyLoading = false
+		d.clearFetchError(dashboardFetchHistory)
 		return d, nil
 
-	case RefreshMsg:
+	case fetchErrorMsg:
+		if msg.view != d.Name() {
+			return d, nil
+		}
+		switch msg.fetch {
+		case dashboardFetchRedisInfo:
+			d.redisInfoLoading = false
+		case dashboardFetchHistory:
+			d.historyLoading = false
+		}
+		d.failedFetch = msg.fetch
+		d.errorBanner.SetMessage(fetchErrorText(d.client, msg.err))
+		return d, nil
+
+	case spinner.TickMsg:
+		return d, d.refresh.update(msg, d.loading())
+
+	case RefreshMsg, RefreshViewMsg:
 		// Fetch Redis info on refresh (stats come via stats.UpdateMsg)
 		return d, d.fetchRedisInfoCmd()
 
 	case tea.KeyPressMsg:
 		switch msg.String() {
+		case "esc":
+			d.errorBanner.Dismiss()
+			return d, nil
 		case "tab":
 			if d.focusedPane == dashboardPaneRealtime {
 				d.focusedPane = dashboardPaneHistory
@@ -145,6 +197,11 @@ func (d *Dashboard) Update(msg tea.Msg) (View, tea.Cmd) {
 			return d.adjustHistoryRange(-1)
 		case "}":
 			return d.adjustHistoryRange(1)
+		case "b":
+			d.showBacklog = !d.showBacklog
+			return d, nil
+		case "J":
+			return d, func() tea.Msg { return ShowDuplicatesMsg{} }
 		}
 	}
 
@@ -157,14 +214,18 @@ func (d *Dashboard) View() string {
 		return ""
 	}
 
-	available := max(d.height, 2)
+	backlogHeight := d.backlogHeight()
+	available := max(d.height-backlogHeight, 2)
 	topHeight := available / 2
 	bottomHeight := available - topHeight
 
 	realtimeBox := d.renderRealtimeBox(topHeight)
 	historyBox := d.renderHistoryBox(bottomHeight)
+	if backlogHeight > 0 {
+		return d.errorBanner.Overlay(lipgloss.JoinVertical(lipgloss.Left, realtimeBox, historyBox, d.renderBacklogBox(backlogHeight)))
+	}
 
-	return lipgloss.JoinVertical(lipgloss.Left, realtimeBox, historyBox)
+	return d.errorBanner.Overlay(lipgloss.JoinVertical(lipgloss.Left, realtimeBox, historyBox))
 }
 
 // Name implements View.
@@ -179,7 +240,7 @@ func (d *Dashboard) ShortHelp() []key.Binding {
 
 // ContextItems implements ContextProvider.
 func (d *Dashboard) ContextItems() []ContextItem {
-	redisVersion := orNA(d.redisInfo.Version)
+	redisVersion := orNA(d.redisInfo.ServerVersion())
 	redisURL := strings.TrimSpace(d.client.DisplayRedisURL())
 	redisValue := redisVersion
 	if redisURL != "" {
@@ -192,6 +253,7 @@ func (d *Dashboard) ContextItems() []ContextItem {
 		{Label: "Connections", Value: display.ShortNumber(d.redisInfo.Connections)},
 		{Label: "Memory", Value: orNA(d.redisInfo.UsedMemory)},
 		{Label: "Peak", Value: orNA(d.redisInfo.UsedMemoryPeak)},
+		{Label: "Queues", Value: formatMemory(d.queuesMemory, d.queuesMemoryKnown)},
 	}
 }
 
@@ -200,6 +262,8 @@ func (d *Dashboard) HintBindings() []key.Binding {
 	return []key.Binding{
 		helpBinding([]string{"tab"}, "tab", "switch pane"),
 		helpBinding([]string{"{", "}"}, "{ ⋰ }", "change period"),
+		helpBinding([]string{"b"}, "b", "backlog"),
+		helpBinding([]string{"J"}, "J", "duplicate jids"),
 	}
 }
 
@@ -212,6 +276,8 @@ func (d *Dashboard) HelpSections() []HelpSection {
 				helpBinding([]string{"tab"}, "tab", "switch pane"),
 				helpBinding([]string{"{"}, "{", "previous range"),
 				helpBinding([]string{"}"}, "}", "next range"),
+				helpBinding([]string{"b"}, "b", "toggle queue backlog"),
+				helpBinding([]string{"J"}, "J", "find duplicate jids"),
 			},
 		},
 	}
@@ -221,6 +287,7 @@ func (d *Dashboard) HelpSections() []HelpSection {
 func (d *Dashboard) SetSize(width, height int) View {
 	d.width = width
 	d.height = height
+	d.errorBanner.SetWidth(width)
 	d.seedRealtimeSeries()
 	d.trimRealtimeSeries()
 	return d
@@ -229,6 +296,7 @@ func (d *Dashboard) SetSize(width, height int) View {
 // SetStyles implements View.
 func (d *Dashboard) SetStyles(styles Styles) View {
 	d.styles = styles
+	d.errorBanner.SetStyles(errorBannerStylesFromTheme(styles))
 	return d
 }
 
@@ -236,6 +304,21 @@ func (d *Dashboard) SetStyles(styles Styles) View {
 func (d *Dashboard) CancelRequests() {
 	d.redisInfoRequest.Cancel()
 	d.historyRequest.Cancel()
+	d.redisInfoLoading = false
+	d.historyLoading = false
+}
+
+// clearFetchError hides the error banner once the fetch that failed succeeds.
+func (d *Dashboard) clearFetchError(fetch string) {
+	if d.failedFetch == fetch {
+		d.failedFetch = ""
+		d.errorBanner.Dismiss()
+	}
+}
+
+// loading reports whether a dashboard fetch is in flight.
+func (d *Dashboard) loading() bool {
+	return d.redisInfoLoading || d.historyLoading
 }
 
 func (d *Dashboard) adjustHistoryRange(delta int) (View, tea.Cmd) {
@@ -252,31 +335,39 @@ func (d *Dashboard) adjustHistoryRange(delta int) (View, tea.Cmd) {
 
 func (d *Dashboard) fetchRedisInfoCmd() tea.Cmd {
 	ctx := d.redisInfoRequest.Start(devtools.WithTracker(context.Background(), "dashboard.fetchRedisInfoCmd"))
-	return func() tea.Msg {
+	d.redisInfoLoading = true
+	return tea.Batch(d.refresh.tick(), func() tea.Msg {
 		redisInfo, err := d.client.GetRedisInfo(ctx)
 		if err != nil {
 			if requestctx.IsCanceled(err) {
 				return nil
 			}
-			return ConnectionErrorMsg{Err: err}
+			return fetchErrorMsg{view: "Dashboard", fetch: dashboardFetchRedisInfo, err: err}
 		}
-		return DashboardRedisInfoMsg{RedisInfo: redisInfo}
-	}
+		// The queue estimate is best effort; failures show as "n/a".
+		queuesMemory, memoryErr := d.client.QueuesMemoryUsage(ctx)
+		return DashboardRedisInfoMsg{
+			RedisInfo:         redisInfo,
+			QueuesMemory:      queuesMemory,
+			QueuesMemoryKnown: memoryErr == nil,
+		}
+	})
 }
 
 func (d *Dashboard) fetchHistoryCmd() tea.Cmd {
 	ctx := d.historyRequest.Start(devtools.WithTracker(context.Background(), "dashboard.fetchHistoryCmd"))
-	return func() tea.Msg {
+	d.historyLoading = true
+	return tea.Batch(d.refresh.tick(), func() tea.Msg {
 		days := d.historyRanges[d.historyRangeIdx]
 		history, err := d.client.GetStatsHistory(ctx, days)
 		if err != nil {
 			if requestctx.IsCanceled(err) {
 				return nil
 			}
-			return ConnectionErrorMsg{Err: err}
+			return fetchErrorMsg{view: "Dashboard", fetch: dashboardFetchHistory, err: err}
 		}
 		return DashboardHistoryMsg{history: history}
-	}
+	})
 }
 
 func (d *Dashboard) renderRealtimeBox(height int) string {
@@ -298,6 +389,7 @@ func (d *Dashboard) renderRealtimeBox(height int) string {
 		}),
 		frame.WithTitle("Dashboard"),
 		frame.WithTitlePadding(0),
+		frame.WithMeta(d.refresh.view(d.styles, d.loading(), d.updatedAt)),
 		frame.WithContent(content),
 		frame.WithPadding(1),
 		frame.WithSize(d.width, height),
@@ -532,22 +624,28 @@ func trimTimes(values []time.Time, maxItems int) []time.Time {
 	return values[len(values)-maxItems:]
 }
 
+// seedRealtimeSeries pads the realtime series with zero samples, older than
+// the first one, until they span the chart width. It runs on every resize so
+// the chart fills the new width after the terminal grows.
 func (d *Dashboard) seedRealtimeSeries() {
-	if len(d.realtimeTimes) > 0 {
-		return
-	}
 	maxPoints := d.chartContentWidth()
-	if maxPoints <= 0 {
+	missing := maxPoints - len(d.realtimeTimes)
+	if missing <= 0 {
 		return
 	}
 	// App ticker runs every 5 seconds
 	const interval = 5 * time.Second
-	start := time.Now().Add(-interval * time.Duration(maxPoints-1))
-	for i := range maxPoints {
-		d.realtimeTimes = append(d.realtimeTimes, start.Add(interval*time.Duration(i)))
-		d.realtimeProcessed = append(d.realtimeProcessed, 0)
-		d.realtimeFailed = append(d.realtimeFailed, 0)
+	first := time.Now().Add(interval)
+	if len(d.realtimeTimes) > 0 {
+		first = d.realtimeTimes[0]
+	}
+	times := make([]time.Time, 0, maxPoints)
+	for i := missing; i > 0; i-- {
+		times = append(times, first.Add(-interval*time.Duration(i)))
 	}
+	d.realtimeTimes = append(times, d.realtimeTimes...)
+	d.realtimeProcessed = append(make([]int64, missing), d.realtimeProcessed...)
+	d.realtimeFailed = append(make([]int64, missing), d.realtimeFailed...)
 }
 
 func shortYLabelFormatter() func(int, float64) string {
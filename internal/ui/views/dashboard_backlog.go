@@ -0,0 +1,124 @@
+package views
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/kpumuk/lazykiq/internal/ui/charts"
+	"github.com/kpumuk/lazykiq/internal/ui/components/frame"
+	"github.com/kpumuk/lazykiq/internal/ui/display"
+)
+
+// dashboardBacklogLimit caps how many queues the backlog pane lists before
+// folding the rest into "other".
+const dashboardBacklogLimit = 5
+
+// dashboardBacklogMaxLabelWidth caps the queue name column so bars keep room.
+const dashboardBacklogMaxLabelWidth = 30
+
+// queueShare is one row of the backlog pane.
+type queueShare struct {
+	name  string
+	size  int64
+	other bool // Row sums the queues beyond the limit
+}
+
+// topQueueShares returns the largest non-empty queues, biggest first and
+// then by name, with everything past limit summed into a trailing "other"
+// row, along with the total size of every queue.
+func topQueueShares(sizes map[string]int64, limit int) ([]queueShare, int64) {
+	shares := make([]queueShare, 0, len(sizes))
+	var total int64
+	for name, size := range sizes {
+		if size <= 0 {
+			continue
+		}
+		shares = append(shares, queueShare{name: name, size: size})
+		total += size
+	}
+	sort.Slice(shares, func(i, j int) bool {
+		if shares[i].size != shares[j].size {
+			return shares[i].size > shares[j].size
+		}
+		return shares[i].name < shares[j].name
+	})
+
+	if len(shares) > limit {
+		other := queueShare{name: fmt.Sprintf("%d other", len(shares)-limit), other: true}
+		for _, share := range shares[limit:] {
+			other.size += share.size
+		}
+		shares = append(shares[:limit], other)
+	}
+	return shares, total
+}
+
+// backlogHeight returns the height of the backlog pane, borders included, or
+// 0 when it is hidden or would leave too little room for the charts.
+func (d *Dashboard) backlogHeight() int {
+	if !d.showBacklog {
+		return 0
+	}
+	shares, _ := topQueueShares(d.queueSizes, dashboardBacklogLimit)
+	height := max(len(shares), 1) + 2
+	if d.height-height < 10 {
+		return 0
+	}
+	return height
+}
+
+// renderBacklogBox draws one bar per queue sized by its share of every
+// enqueued job, so together the bars span roughly the full width.
+func (d *Dashboard) renderBacklogBox(height int) string {
+	shares, total := topQueueShares(d.queueSizes, dashboardBacklogLimit)
+	width, _ := framedTableSize(d.width, height)
+	rows := max(height-2, 1)
+
+	var content string
+	if total == 0 {
+		content = charts.RenderCentered(width, rows, d.styles.Muted.Render("All queues are empty"))
+	} else {
+		labelWidth := 0
+		sizeWidth := 0
+		for _, share := range shares {
+			labelWidth = max(labelWidth, ansi.StringWidth(share.name))
+			sizeWidth = max(sizeWidth, len(display.Number(share.size)))
+		}
+		labelWidth = min(labelWidth, dashboardBacklogMaxLabelWidth, max(width/3, 1))
+		// Leave room for " <size> 100%" after the bar.
+		barWidth := max(width-labelWidth-sizeWidth-7, 0)
+
+		lines := make([]string, 0, min(len(shares), rows))
+		for _, share := range shares[:min(len(shares), rows)] {
+			label := ansi.Truncate(share.name, labelWidth, "…")
+			label += strings.Repeat(" ", labelWidth-ansi.StringWidth(label))
+			if share.other {
+				label = d.styles.Muted.Render(label)
+			} else {
+				label = d.styles.QueueText.Render(label)
+			}
+			bar := strings.Repeat("█", charts.BarLength(share.size, total, barWidth))
+			percent := fmt.Sprintf("%3d%%", share.size*100/total)
+			lines = append(lines, label+" "+
+				d.styles.ChartHistogram.Render(bar+strings.Repeat(" ", barWidth-ansi.StringWidth(bar)))+" "+
+				d.styles.Muted.Render(fmt.Sprintf("%*s %s", sizeWidth, display.Number(share.size), percent)))
+		}
+		content = strings.Join(lines, "\n")
+	}
+
+	meta := d.styles.MetricLabel.Render("enqueued: ") + d.styles.MetricValue.Render(display.Number(total))
+	box := frame.New(
+		frame.WithStyles(frameStylesFromTheme(d.styles)),
+		frame.WithTitle("Backlog"),
+		frame.WithTitlePadding(0),
+		frame.WithMeta(meta),
+		frame.WithContent(content),
+		frame.WithPadding(1),
+		frame.WithSize(d.width, height),
+		frame.WithFocused(false),
+	)
+	return box.View()
+}
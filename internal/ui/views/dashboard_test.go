@@ -0,0 +1,111 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/components/stats"
+)
+
+type dashboardClientStub struct {
+	sidekiq.API
+	queuesMemory    int64
+	queuesMemoryErr error
+}
+
+func (s *dashboardClientStub) DisplayRedisURL() string { return "" }
+
+func (s *dashboardClientStub) GetRedisInfo(context.Context) (sidekiq.RedisInfo, error) {
+	return sidekiq.RedisInfo{Version: "7.2.4"}, nil
+}
+
+func (s *dashboardClientStub) QueuesMemoryUsage(context.Context) (int64, error) {
+	return s.queuesMemory, s.queuesMemoryErr
+}
+
+func TestDashboardShowsQueuesMemory(t *testing.T) {
+	tests := []struct {
+		name string
+		stub *dashboardClientStub
+		want string
+	}{
+		{name: "estimated", stub: &dashboardClientStub{queuesMemory: 2048}, want: "2.0 KB"},
+		{name: "unsupported", stub: &dashboardClientStub{queuesMemoryErr: sidekiq.ErrMemoryUsageUnsupported}, want: "n/a"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			view := NewDashboard(tt.stub)
+			view.SetStyles(Styles{})
+			view.Update(fetchResult(view.fetchRedisInfoCmd()))
+
+			if got := contextValue(view.ContextItems(), "Queues"); got != tt.want {
+				t.Fatalf("Queues = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDashboardResizeKeepsRealtimeSeriesAligned(t *testing.T) {
+	view := NewDashboard(&dashboardClientStub{})
+	view.SetStyles(Styles{})
+
+	for _, size := range [][2]int{{120, 30}, {20, 10}, {1, 1}, {3, 3}, {160, 40}} {
+		view.SetSize(size[0], size[1])
+
+		want := view.chartContentWidth()
+		if len(view.realtimeTimes) != want || len(view.realtimeProcessed) != want || len(view.realtimeFailed) != want {
+			t.Fatalf("%dx%d: series lengths %d/%d/%d, want %d", size[0], size[1],
+				len(view.realtimeTimes), len(view.realtimeProcessed), len(view.realtimeFailed), want)
+		}
+		for i := 1; i < len(view.realtimeTimes); i++ {
+			if !view.realtimeTimes[i].After(view.realtimeTimes[i-1]) {
+				t.Fatalf("%dx%d: realtime times out of order at %d", size[0], size[1], i)
+			}
+		}
+		assertChartFits(t, view.renderRealtimeContent(max(size[1]-4, 0)), want)
+	}
+}
+
+func TestDashboardBacklogPane(t *testing.T) {
+	view := NewDashboard(&dashboardClientStub{})
+	view.SetStyles(Styles{})
+	view.SetSize(100, 40)
+
+	view.Update(tea.KeyPressMsg{Code: 'b', Text: "b"})
+	output := ansi.Strip(view.View())
+	if lines := strings.Split(output, "\n"); len(lines) != 40 {
+		t.Fatalf("lines = %d, want 40", len(lines))
+	}
+	if !strings.Contains(output, "Backlog") || !strings.Contains(output, "All queues are empty") {
+		t.Fatalf("View() missing empty backlog:\n%s", output)
+	}
+
+	sizes := map[string]int64{"default": 500, "mailers": 300, "zero": 0}
+	for i := range 5 {
+		sizes[fmt.Sprintf("low%d", i)] = 40
+	}
+	view.Update(stats.UpdateMsg{Data: stats.Data{QueueSizes: sizes}})
+	output = ansi.Strip(view.View())
+	if lines := strings.Split(output, "\n"); len(lines) != 40 {
+		t.Fatalf("lines = %d, want 40", len(lines))
+	}
+	for _, want := range []string{"default", "500  50%", "mailers", "300  30%", "2 other", "80   8%", "enqueued: 1,000"} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("View() missing %q:\n%s", want, output)
+		}
+	}
+	if strings.Contains(output, "zero") {
+		t.Fatalf("View() lists an empty queue:\n%s", output)
+	}
+
+	view.Update(tea.KeyPressMsg{Code: 'b', Text: "b"})
+	if strings.Contains(ansi.Strip(view.View()), "Backlog") {
+		t.Fatal("expected backlog pane to be hidden")
+	}
+}
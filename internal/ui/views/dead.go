@@ -2,6 +2,7 @@ package views
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -15,22 +16,45 @@ import (
 	"github.com/kpumuk/lazykiq/internal/ui/dialogs"
 	confirmdialog "github.com/kpumuk/lazykiq/internal/ui/dialogs/confirm"
 	filterdialog "github.com/kpumuk/lazykiq/internal/ui/dialogs/filter"
+	promptdialog "github.com/kpumuk/lazykiq/internal/ui/dialogs/prompt"
 	"github.com/kpumuk/lazykiq/internal/ui/display"
 )
 
 const (
 	deadWindowPages      = 3
 	deadFallbackPageSize = 25
+	// deadRetryLaterDefault pre-fills the delay prompt for retry later.
+	deadRetryLaterDefault = "15m"
+	// deadPruneDefault pre-fills the age prompt for pruning.
+	deadPruneDefault = "30d"
+	deadPruneTarget  = "dead.prune"
+	// deadTimeRangeTarget identifies the time range prompt.
+	deadTimeRangeTarget = "dead.time_range"
+	// deadForceDeleteTarget identifies the force delete confirmation.
+	deadForceDeleteTarget = "dead.force_delete"
+	// deadNearCapRatio is the share of dead_max_jobs at which the size warns.
+	deadNearCapRatio = 0.9
 )
 
+// deadLimitsMsg carries the configured dead set limits.
+type deadLimitsMsg struct {
+	limits sidekiq.DeadLimits
+}
+
 type deadJobAction int
 
 const (
 	deadJobActionNone deadJobAction = iota
 	deadJobActionDelete
 	deadJobActionRetry
+	deadJobActionRetryToFront
+	deadJobActionRetryAndWatch
+	deadJobActionRequeue
 	deadJobActionDeleteAll
 	deadJobActionRetryAll
+	deadJobActionRetryAllLater
+	deadJobActionPrune
+	deadJobActionForceDelete
 )
 
 // Dead shows dead/morgue jobs.
@@ -38,7 +62,13 @@ type Dead struct {
 	client sidekiq.API
 	sortedJobsView
 	dangerousActionsEnabled bool
+	strictConfirm           bool
 	pendingConfirm          pendingConfirm[deadJobAction]
+	pendingSuggestion       pendingSuggestion
+	actionRules             []DeadActionRule
+	pendingRetryLater       *sidekiq.SortedEntry
+	pendingPruneAge         time.Duration
+	limits                  sidekiq.DeadLimits
 }
 
 // NewDead creates a new Dead view.
@@ -59,7 +89,7 @@ func NewDead(client sidekiq.API) *Dead {
 
 // Init implements View.
 func (d *Dead) Init() tea.Cmd {
-	return d.init(d.reset)
+	return tea.Batch(d.init(d.reset), d.fetchLimitsCmd())
 }
 
 // Update implements View.
@@ -71,13 +101,20 @@ func (d *Dead) Update(msg tea.Msg) (View, tea.Cmd) {
 		}
 		return d, nil
 
-	case RefreshMsg:
+	case deadLimitsMsg:
+		d.limits = msg.limits
+		return d, nil
+
+	case RefreshMsg, RefreshViewMsg:
 		return d, d.refreshWindow()
 
 	case filterdialog.ActionMsg:
 		return d, d.handleFilterAction(msg, d.updateEmptyMessage)
 
 	case confirmdialog.ActionMsg:
+		if action, entry, ok := d.pendingSuggestion.Resolve(msg, d.dangerousActionsEnabled); ok {
+			return d, d.runSuggestedAction(action, entry)
+		}
 		action, entry, ok := d.pendingConfirm.Confirm(msg, d.dangerousActionsEnabled, deadJobActionNone)
 		if !ok {
 			return d, nil
@@ -90,16 +127,69 @@ func (d *Dead) Update(msg tea.Msg) (View, tea.Cmd) {
 				return d, nil
 			}
 			return d, d.deleteJobCmd(entry)
-		case deadJobActionRetry:
+		case deadJobActionRetry, deadJobActionRetryToFront:
+			if entry == nil {
+				return d, nil
+			}
+			return d, d.retryNowJobCmd(entry, action == deadJobActionRetryToFront)
+		case deadJobActionRetryAndWatch:
+			if entry == nil {
+				return d, nil
+			}
+			return d, retryAndWatchCmd(d.client, sidekiq.SortedSetDead, entry, "dead.retryAndWatchCmd")
+		case deadJobActionRequeue:
 			if entry == nil {
 				return d, nil
 			}
-			return d, d.retryNowJobCmd(entry)
+			return d, d.requeueJobCmd(entry)
 		case deadJobActionDeleteAll:
 			return d, d.deleteAllCmd()
 		case deadJobActionRetryAll:
 			return d, d.retryAllCmd()
+		case deadJobActionRetryAllLater:
+			return d, d.retryAllToRetryCmd()
+		case deadJobActionPrune:
+			return d, d.pruneCmd(d.pendingPruneAge)
+		case deadJobActionForceDelete:
+			if entry == nil {
+				return d, nil
+			}
+			return d, forceDeleteCmd(d.client, sidekiq.SortedSetDead, entry, "dead.forceDeleteCmd")
+		}
+
+	case forceDeleteOfferMsg:
+		if !d.dangerousActionsEnabled {
+			return d, nil
 		}
+		d.pendingConfirm.Set(deadJobActionForceDelete, msg.entry, deadForceDeleteTarget)
+		return d, openForceDeleteConfirm(d.styles, msg, deadForceDeleteTarget)
+
+	case promptdialog.ActionMsg:
+		if msg.Target == deadTimeRangeTarget {
+			return d, d.setTimeRange(msg.Value, d.updateEmptyMessage)
+		}
+		if msg.Target == deadPruneTarget {
+			if !d.dangerousActionsEnabled {
+				return d, nil
+			}
+			age, err := parsePruneAge(msg.Value)
+			if err != nil {
+				return d, nil
+			}
+			d.pendingPruneAge = age
+			d.pendingConfirm.Set(deadJobActionPrune, nil, deadPruneTarget)
+			return d, d.openPruneConfirm(msg.Value)
+		}
+		entry := d.pendingRetryLater
+		d.pendingRetryLater = nil
+		if !d.dangerousActionsEnabled || entry == nil || msg.Target != entry.JID() {
+			return d, nil
+		}
+		delay, err := parseRetryDelay(msg.Value)
+		if err != nil {
+			return d, nil
+		}
+		return d, d.retryLaterJobCmd(entry, delay)
 
 	case tea.KeyPressMsg:
 		if handled, cmd := d.handleKeyPress(msg, d.updateEmptyMessage); handled {
@@ -107,19 +197,28 @@ func (d *Dead) Update(msg tea.Msg) (View, tea.Cmd) {
 		}
 
 		switch msg.String() {
+		case "t":
+			return d, d.openTimeRangePrompt("Failed within", deadTimeRangeTarget)
+		case "o":
+			return d, d.toggleOrder(sidekiq.SortedSetDead)
 		case "c":
 			if entry, ok := d.selectedSortedEntry(); ok {
 				return d, copyTextCmd(entry.JID())
 			}
 			return d, nil
-		case "enter":
-			// Show detail for selected job
-			if idx := d.lazy.Table().Cursor(); idx >= 0 && idx < len(d.jobs) {
-				return d, func() tea.Msg {
-					return ShowJobDetailMsg{Job: d.jobs[idx].JobRecord}
-				}
+		case "C":
+			if entry, ok := d.selectedSortedEntry(); ok {
+				return d, copyJobRefCmd(sidekiq.SortedSetDead, entry)
 			}
 			return d, nil
+		case "Y":
+			return d, copyTextCmd(sidekiq.SortedSetDead.Key())
+		case "b":
+			return d, func() tea.Msg {
+				return ShowDeadReasonsMsg{}
+			}
+		case "enter":
+			return d, d.showJobDetailCmd(d.client, sidekiq.SortedSetDead)
 		}
 
 		if d.dangerousActionsEnabled {
@@ -133,7 +232,36 @@ func (d *Dead) Update(msg tea.Msg) (View, tea.Cmd) {
 			case "R":
 				if entry, ok := d.selectedSortedEntry(); ok {
 					d.pendingConfirm.SetForEntry(deadJobActionRetry, entry)
-					return d, d.openRetryNowConfirm(entry)
+					return d, d.openRetryNowConfirm(entry, false)
+				}
+				return d, nil
+			case "alt+r":
+				if entry, ok := d.selectedSortedEntry(); ok {
+					d.pendingConfirm.SetForEntry(deadJobActionRetryToFront, entry)
+					return d, d.openRetryNowConfirm(entry, true)
+				}
+				return d, nil
+			case "W":
+				if entry, ok := d.selectedSortedEntry(); ok {
+					d.pendingConfirm.SetForEntry(deadJobActionRetryAndWatch, entry)
+					return d, d.openRetryAndWatchConfirm(entry)
+				}
+				return d, nil
+			case "E":
+				if entry, ok := d.selectedSortedEntry(); ok {
+					d.pendingConfirm.SetForEntry(deadJobActionRequeue, entry)
+					return d, d.openRequeueConfirm(entry)
+				}
+				return d, nil
+			case "A":
+				if entry, ok := d.selectedSortedEntry(); ok {
+					return d, d.openSuggestionConfirm(entry)
+				}
+				return d, nil
+			case "S":
+				if entry, ok := d.selectedSortedEntry(); ok {
+					d.pendingRetryLater = entry
+					return d, d.openRetryLaterPrompt(entry)
 				}
 				return d, nil
 			case "ctrl+d":
@@ -142,6 +270,11 @@ func (d *Dead) Update(msg tea.Msg) (View, tea.Cmd) {
 			case "ctrl+r":
 				d.pendingConfirm.Set(deadJobActionRetryAll, nil, "dead.retry_all")
 				return d, d.openRetryAllConfirm()
+			case "ctrl+t":
+				d.pendingConfirm.Set(deadJobActionRetryAllLater, nil, "dead.retry_all_later")
+				return d, d.openRetryAllToRetryConfirm()
+			case "ctrl+p":
+				return d, d.openPrunePrompt()
 			}
 		}
 
@@ -182,21 +315,53 @@ func (d *Dead) ContextItems() []ContextItem {
 		oldestFailed = display.Duration(int64(now.Sub(d.firstEntry.At()).Seconds()))
 	}
 
+	retention := "unknown"
+	if d.limits.Timeout > 0 {
+		retention = display.Duration(int64(d.limits.Timeout.Seconds()))
+	}
+
 	items := []ContextItem{
 		{Label: "Last failed", Value: lastFailed},
 		{Label: "Oldest failed", Value: oldestFailed},
-		{Label: "Total items", Value: display.Number(d.lazy.Total())},
+		{Label: "Total items", Value: d.totalItemsValue()},
+		{Label: "Retention", Value: retention},
+		{Label: "Order", Value: d.orderValue(sidekiq.SortedSetDead)},
+		{Label: "Largest", Value: largestPayloadText(sortedJobRecords(d.jobs), d.largePayload)},
+	}
+	if d.timeRange.active() {
+		items = append(items, ContextItem{Label: "Range", Value: d.timeRange.text})
 	}
 	return items
 }
 
+// totalItemsValue renders the dead set size against dead_max_jobs, warning
+// when the set is close to the cap Sidekiq trims it at.
+func (d *Dead) totalItemsValue() string {
+	total := d.lazy.Total()
+	if d.limits.MaxJobs <= 0 {
+		return display.Number(total) + " / unknown"
+	}
+	value := display.Number(total) + " / " + display.Number(d.limits.MaxJobs)
+	switch {
+	case total >= d.limits.MaxJobs:
+		return d.styles.ErrorText.Render(value)
+	case float64(total) >= float64(d.limits.MaxJobs)*deadNearCapRatio:
+		return d.styles.WarningText.Render(value)
+	default:
+		return value
+	}
+}
+
 // HintBindings implements HintProvider.
 func (d *Dead) HintBindings() []key.Binding {
 	return []key.Binding{
 		helpBinding([]string{"/"}, "/", "filter"),
 		helpBinding([]string{"ctrl+u"}, "ctrl+u", "reset filter"),
+		helpBinding([]string{"t"}, "t", "time range"),
+		helpBinding([]string{"o"}, "o", "sort order"),
 		helpBinding([]string{"[", "]"}, "[ ⋰ ]", "page up/down"),
 		helpBinding([]string{"enter"}, "enter", "job detail"),
+		helpBinding([]string{"b"}, "b", "reasons chart"),
 	}
 }
 
@@ -208,8 +373,15 @@ func (d *Dead) MutationBindings() []key.Binding {
 	return []key.Binding{
 		helpBinding([]string{"D"}, "shift+d", "delete job"),
 		helpBinding([]string{"R"}, "shift+r", "retry now"),
+		helpBinding([]string{"alt+r"}, "alt+r", "retry to front"),
+		helpBinding([]string{"W"}, "shift+w", "retry and watch"),
+		helpBinding([]string{"E"}, "shift+e", "requeue as-is"),
+		helpBinding([]string{"S"}, "shift+s", "retry later"),
+		helpBinding([]string{"A"}, "shift+a", "suggested action"),
 		helpBinding([]string{"ctrl+d"}, "ctrl+d", "delete all"),
 		helpBinding([]string{"ctrl+r"}, "ctrl+r", "retry all"),
+		helpBinding([]string{"ctrl+t"}, "ctrl+t", "all to retry set"),
+		helpBinding([]string{"ctrl+p"}, "ctrl+p", "prune older than"),
 	}
 }
 
@@ -221,12 +393,17 @@ func (d *Dead) HelpSections() []HelpSection {
 			Bindings: []key.Binding{
 				helpBinding([]string{"/"}, "/", "filter"),
 				helpBinding([]string{"ctrl+u"}, "ctrl+u", "clear filter"),
+				helpBinding([]string{"t"}, "t", "filter by time range"),
+				helpBinding([]string{"o"}, "o", "toggle newest/oldest first"),
 				helpBinding([]string{"["}, "[", "page up"),
 				helpBinding([]string{"]"}, "]", "page down"),
 				helpBinding([]string{"g"}, "g", "jump to start"),
 				helpBinding([]string{"G"}, "shift+g", "jump to end"),
 				helpBinding([]string{"c"}, "c", "copy jid"),
+				helpBinding([]string{"C"}, "shift+c", "copy job link"),
+				helpBinding([]string{"Y"}, "shift+y", "copy redis key"),
 				helpBinding([]string{"enter"}, "enter", "job detail"),
+				helpBinding([]string{"b"}, "b", "top error classes chart"),
 			},
 		},
 	}
@@ -236,8 +413,15 @@ func (d *Dead) HelpSections() []HelpSection {
 			Bindings: []key.Binding{
 				helpBinding([]string{"D"}, "shift+d", "delete job"),
 				helpBinding([]string{"R"}, "shift+r", "retry now"),
+				helpBinding([]string{"alt+r"}, "alt+r", "retry to front of queue"),
+				helpBinding([]string{"W"}, "shift+w", "retry now and watch until done"),
+				helpBinding([]string{"E"}, "shift+e", "requeue as-is"),
+				helpBinding([]string{"S"}, "shift+s", "retry later"),
+				helpBinding([]string{"A"}, "shift+a", "suggested action for error class"),
 				helpBinding([]string{"ctrl+d"}, "ctrl+d", "delete all"),
 				helpBinding([]string{"ctrl+r"}, "ctrl+r", "retry all"),
+				helpBinding([]string{"ctrl+t"}, "ctrl+t", "all to retry set"),
+				helpBinding([]string{"ctrl+p"}, "ctrl+p", "prune older than"),
 			},
 		})
 	}
@@ -260,8 +444,20 @@ func (d *Dead) SetDangerousActionsEnabled(enabled bool) {
 	d.dangerousActionsEnabled = enabled
 }
 
+// SetStrictConfirm implements StrictConfirmSetter.
+func (d *Dead) SetStrictConfirm(strict bool) {
+	d.strictConfirm = strict
+}
+
+// SetDeadActionRules implements DeadActionRulesSetter.
+func (d *Dead) SetDeadActionRules(rules []DeadActionRule) {
+	d.actionRules = rules
+}
+
 // Dispose clears cached data when the view is removed from the stack.
 func (d *Dead) Dispose() {
+	d.timeRange = sortedTimeRange{}
+	d.order = sidekiq.SortDefault
 	d.dispose(d.reset)
 }
 
@@ -286,7 +482,9 @@ func (d *Dead) fetchWindow(
 		tracker:          "dead.fetchWindow",
 		client:           d.client,
 		kind:             sidekiq.SortedSetDead,
+		order:            d.order,
 		filter:           d.filter,
+		timeRange:        d.timeRange,
 		windowStart:      windowStart,
 		windowSize:       windowSize,
 		fallbackPageSize: deadFallbackPageSize,
@@ -299,6 +497,18 @@ func (d *Dead) reset() {
 	d.resetSortedJobs(d.updateEmptyMessage)
 }
 
+func (d *Dead) fetchLimitsCmd() tea.Cmd {
+	if d.client == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		ctx := devtools.WithTracker(context.Background(), "dead.fetchLimitsCmd")
+		// Limits are advisory, so a failed lookup only shows them as unknown.
+		limits, _ := d.client.GetDeadLimits(ctx)
+		return deadLimitsMsg{limits: limits}
+	}
+}
+
 // Table columns for dead job list.
 var deadJobColumns = []table.Column{
 	{Title: "Last Retry", Width: 12},
@@ -310,7 +520,7 @@ var deadJobColumns = []table.Column{
 
 func (d *Dead) updateEmptyMessage() {
 	msg := "No dead jobs"
-	if d.filter != "" {
+	if d.filter != "" || d.timeRange.active() {
 		msg = "No matches"
 	}
 	d.lazy.SetEmptyMessage(msg)
@@ -335,7 +545,7 @@ func (d *Dead) buildRows(jobs []*sidekiq.SortedEntry) []table.Row {
 			Cells: []string{
 				lastRetry,
 				d.styles.QueueText.Render(job.Queue()),
-				job.DisplayClass(),
+				jobClassCell(d.styles, job.JobRecord, d.largePayload),
 				display.Args(job.DisplayArgs()),
 				errorStr,
 			},
@@ -357,24 +567,92 @@ func (d *Dead) openDeleteConfirm(entry *sidekiq.SortedEntry) tea.Cmd {
 				),
 				entry.JID(),
 				d.styles.DangerAction,
+				copyCommandsOption(d.client, "dead.previewDelete", deleteSortedEntryAction(sidekiq.SortedSetDead, entry)),
 			),
 		}
 	}
 }
 
-func (d *Dead) openRetryNowConfirm(entry *sidekiq.SortedEntry) tea.Cmd {
+// openRetryNowConfirm asks to retry the job now, at the back of its queue or,
+// with front set, ahead of the jobs already waiting.
+func (d *Dead) openRetryNowConfirm(entry *sidekiq.SortedEntry, front bool) tea.Cmd {
 	jobName := d.jobName(entry)
+	detail := "This will enqueue it immediately."
+	if front {
+		detail = "This will enqueue it immediately, ahead of the jobs already waiting."
+	}
 	return func() tea.Msg {
 		return dialogs.OpenDialogMsg{
 			Model: newConfirmDialog(
 				d.styles,
 				"Retry job",
 				fmt.Sprintf(
-					"Retry the %s job now?\n\nThis will enqueue it immediately.",
+					"Retry the %s job now?\n\n%s",
 					d.styles.Text.Bold(true).Render(jobName),
+					detail,
 				),
 				entry.JID(),
 				d.styles.DangerAction,
+				copyCommandsOption(d.client, "dead.previewRetryNow", enqueueSortedEntryAction(sidekiq.SortedSetDead, entry, front)),
+			),
+		}
+	}
+}
+
+// openRetryAndWatchConfirm asks to retry the job now and follow it until it
+// finishes.
+func (d *Dead) openRetryAndWatchConfirm(entry *sidekiq.SortedEntry) tea.Cmd {
+	jobName := d.jobName(entry)
+	return func() tea.Msg {
+		return dialogs.OpenDialogMsg{
+			Model: newConfirmDialog(
+				d.styles,
+				"Retry and watch job",
+				fmt.Sprintf(
+					"Retry the %s job now?\n\nThis will enqueue it immediately and watch it until it completes or dies again.",
+					d.styles.Text.Bold(true).Render(jobName),
+				),
+				entry.JID(),
+				d.styles.DangerAction,
+				copyCommandsOption(d.client, "dead.previewRetryNow", enqueueSortedEntryAction(sidekiq.SortedSetDead, entry, false)),
+			),
+		}
+	}
+}
+
+func (d *Dead) openRequeueConfirm(entry *sidekiq.SortedEntry) tea.Cmd {
+	jobName := d.jobName(entry)
+	return func() tea.Msg {
+		return dialogs.OpenDialogMsg{
+			Model: newConfirmDialog(
+				d.styles,
+				"Requeue job",
+				fmt.Sprintf(
+					"Requeue the %s job as-is?\n\nIt is enqueued immediately and keeps its retry count.",
+					d.styles.Text.Bold(true).Render(jobName),
+				),
+				entry.JID(),
+				d.styles.DangerAction,
+				copyCommandsOption(d.client, "dead.previewRequeue", func(ctx context.Context, api sidekiq.API) error {
+					return api.RequeueDeadJob(ctx, entry)
+				}),
+			),
+		}
+	}
+}
+
+func (d *Dead) openRetryLaterPrompt(entry *sidekiq.SortedEntry) tea.Cmd {
+	return func() tea.Msg {
+		return dialogs.OpenDialogMsg{
+			Model: newPromptDialog(
+				d.styles,
+				"Retry later (delay)",
+				deadRetryLaterDefault,
+				entry.JID(),
+				func(value string) error {
+					_, err := parseRetryDelay(value)
+					return err
+				},
 			),
 		}
 	}
@@ -389,6 +667,7 @@ func (d *Dead) openDeleteAllConfirm() tea.Cmd {
 				"Are you sure you want to delete all dead jobs?\n\nThis action is not recoverable.",
 				"dead.delete_all",
 				d.styles.DangerAction,
+				strictConfirmOption(d.strictConfirm, "dead"),
 			),
 		}
 	}
@@ -408,6 +687,56 @@ func (d *Dead) openRetryAllConfirm() tea.Cmd {
 	}
 }
 
+func (d *Dead) openRetryAllToRetryConfirm() tea.Cmd {
+	return func() tea.Msg {
+		return dialogs.OpenDialogMsg{
+			Model: newConfirmDialog(
+				d.styles,
+				"Move all dead to retries",
+				"Move all dead jobs to the retry set?\n\nTheir retry count is reset, so each gets a full new retry cycle.",
+				"dead.retry_all_later",
+				d.styles.DangerAction,
+				strictConfirmOption(d.strictConfirm, "dead"),
+			),
+		}
+	}
+}
+
+func (d *Dead) openPrunePrompt() tea.Cmd {
+	return func() tea.Msg {
+		return dialogs.OpenDialogMsg{
+			Model: newPromptDialog(
+				d.styles,
+				"Prune dead older than",
+				deadPruneDefault,
+				deadPruneTarget,
+				func(value string) error {
+					_, err := parsePruneAge(value)
+					return err
+				},
+			),
+		}
+	}
+}
+
+func (d *Dead) openPruneConfirm(age string) tea.Cmd {
+	return func() tea.Msg {
+		return dialogs.OpenDialogMsg{
+			Model: newConfirmDialog(
+				d.styles,
+				"Prune dead jobs",
+				fmt.Sprintf(
+					"Delete all dead jobs that died more than %s ago?\n\nThis action is not recoverable.",
+					d.styles.Text.Bold(true).Render(age),
+				),
+				deadPruneTarget,
+				d.styles.DangerAction,
+				strictConfirmOption(d.strictConfirm, "dead"),
+			),
+		}
+	}
+}
+
 func (d *Dead) deleteJobCmd(entry *sidekiq.SortedEntry) tea.Cmd {
 	return func() tea.Msg {
 		ctx := devtools.WithTracker(context.Background(), "dead.deleteJobCmd")
@@ -428,10 +757,44 @@ func (d *Dead) deleteAllCmd() tea.Cmd {
 	}
 }
 
-func (d *Dead) retryNowJobCmd(entry *sidekiq.SortedEntry) tea.Cmd {
+func (d *Dead) retryNowJobCmd(entry *sidekiq.SortedEntry, front bool) tea.Cmd {
+	enqueue := d.client.EnqueueSortedEntry
+	if front {
+		enqueue = d.client.EnqueueSortedEntryToFront
+	}
 	return func() tea.Msg {
 		ctx := devtools.WithTracker(context.Background(), "dead.retryNowJobCmd")
-		if err := d.client.EnqueueSortedEntry(ctx, sidekiq.SortedSetDead, entry); err != nil {
+		if err := enqueue(ctx, sidekiq.SortedSetDead, entry); err != nil {
+			return sortedEntryErrorMsg(entry, err)
+		}
+		return RefreshMsg{}
+	}
+}
+
+func (d *Dead) requeueJobCmd(entry *sidekiq.SortedEntry) tea.Cmd {
+	return func() tea.Msg {
+		ctx := devtools.WithTracker(context.Background(), "dead.requeueJobCmd")
+		if err := d.client.RequeueDeadJob(ctx, entry); err != nil {
+			return sortedEntryErrorMsg(entry, err)
+		}
+		return RefreshMsg{}
+	}
+}
+
+func (d *Dead) retryLaterJobCmd(entry *sidekiq.SortedEntry, delay time.Duration) tea.Cmd {
+	return func() tea.Msg {
+		ctx := devtools.WithTracker(context.Background(), "dead.retryLaterJobCmd")
+		if err := d.client.RetryDeadJobWithDelay(ctx, entry, delay); err != nil {
+			return sortedEntryErrorMsg(entry, err)
+		}
+		return RefreshMsg{}
+	}
+}
+
+func (d *Dead) pruneCmd(age time.Duration) tea.Cmd {
+	return func() tea.Msg {
+		ctx := devtools.WithTracker(context.Background(), "dead.pruneCmd")
+		if _, err := d.client.DeleteDeadJobsOlderThan(ctx, time.Now().Add(-age)); err != nil {
 			return ConnectionErrorMsg{Err: err}
 		}
 		return RefreshMsg{}
@@ -448,5 +811,45 @@ func (d *Dead) retryAllCmd() tea.Cmd {
 	}
 }
 
+func (d *Dead) retryAllToRetryCmd() tea.Cmd {
+	return func() tea.Msg {
+		ctx := devtools.WithTracker(context.Background(), "dead.retryAllToRetryCmd")
+		if _, err := d.client.RetryAllDeadJobsToRetry(ctx); err != nil {
+			return ConnectionErrorMsg{Err: err}
+		}
+		return RefreshMsg{}
+	}
+}
+
+// parseRetryDelay parses a positive duration such as "90s", "1h30m", or "1d".
+func parseRetryDelay(value string) (time.Duration, error) {
+	if value == "" {
+		return 0, errors.New("enter a delay")
+	}
+	delay, err := display.ParseDuration(value)
+	if err != nil {
+		return 0, errors.New("invalid duration")
+	}
+	if delay <= 0 {
+		return 0, errors.New("delay must be positive")
+	}
+	return delay, nil
+}
+
+// parsePruneAge parses a positive age such as "30d" or "12h".
+func parsePruneAge(value string) (time.Duration, error) {
+	if value == "" {
+		return 0, errors.New("enter an age")
+	}
+	age, err := display.ParseDuration(value)
+	if err != nil {
+		return 0, errors.New("invalid duration")
+	}
+	if age <= 0 {
+		return 0, errors.New("age must be positive")
+	}
+	return age, nil
+}
+
 // renderJobsBox renders the bordered box containing the jobs table.
 // renderJobDetail renders the job detail view.
@@ -0,0 +1,226 @@
+package views
+
+import (
+	"context"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/kpumuk/lazykiq/internal/devtools"
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/charts"
+	"github.com/kpumuk/lazykiq/internal/ui/components/frame"
+	"github.com/kpumuk/lazykiq/internal/ui/display"
+	"github.com/kpumuk/lazykiq/internal/ui/requestctx"
+)
+
+// deadReasonsLimit caps how many error classes the chart shows.
+const deadReasonsLimit = 10
+
+// deadReasonsMaxLabelWidth caps the error class column so bars keep room.
+const deadReasonsMaxLabelWidth = 40
+
+// deadReasonsDataMsg carries error class counts internally.
+type deadReasonsDataMsg struct {
+	includeRetries bool
+	counts         []sidekiq.ErrorClassCount
+}
+
+// DeadReasons charts the most frequent error classes in the dead set, and
+// optionally the retry set.
+type DeadReasons struct {
+	client         sidekiq.API
+	width          int
+	height         int
+	styles         Styles
+	counts         []sidekiq.ErrorClassCount
+	includeRetries bool
+	ready          bool
+	frameStyles    frame.Styles
+	fetchRequest   requestctx.Controller
+}
+
+// NewDeadReasons creates a new DeadReasons view.
+func NewDeadReasons(client sidekiq.API) *DeadReasons {
+	return &DeadReasons{client: client}
+}
+
+// Init implements View.
+func (d *DeadReasons) Init() tea.Cmd {
+	return d.fetchCmd()
+}
+
+// Update implements View.
+func (d *DeadReasons) Update(msg tea.Msg) (View, tea.Cmd) {
+	switch msg := msg.(type) {
+	case deadReasonsDataMsg:
+		if msg.includeRetries != d.includeRetries {
+			return d, nil
+		}
+		d.counts = msg.counts
+		d.ready = true
+		return d, nil
+
+	case RefreshMsg, RefreshViewMsg:
+		return d, d.fetchCmd()
+
+	case tea.KeyPressMsg:
+		if msg.String() == "t" {
+			d.includeRetries = !d.includeRetries
+			return d, d.fetchCmd()
+		}
+	}
+
+	return d, nil
+}
+
+// View implements View.
+func (d *DeadReasons) View() string {
+	if !d.ready {
+		return renderStatusMessage("Dead Reasons", "Loading...", d.styles, d.width, d.height)
+	}
+
+	meta := d.styles.MetricLabel.Render("source: ") + d.styles.MetricValue.Render(d.sourceLabel())
+	box := frame.New(
+		frame.WithStyles(d.frameStyles),
+		frame.WithTitle("Dead Reasons"),
+		frame.WithTitlePadding(0),
+		frame.WithMeta(meta),
+		frame.WithContent(d.renderChart()),
+		frame.WithPadding(1),
+		frame.WithSize(d.width, d.height),
+		frame.WithMinHeight(5),
+		frame.WithFocused(true),
+	)
+	return box.View()
+}
+
+// Name implements View.
+func (d *DeadReasons) Name() string {
+	return "Dead Reasons"
+}
+
+// ShortHelp implements View.
+func (d *DeadReasons) ShortHelp() []key.Binding {
+	return nil
+}
+
+// ContextItems implements ContextProvider.
+func (d *DeadReasons) ContextItems() []ContextItem {
+	var total int64
+	for _, count := range d.counts {
+		total += count.Count
+	}
+	top := "-"
+	if len(d.counts) > 0 {
+		top = d.counts[0].ErrorClass
+	}
+
+	return []ContextItem{
+		{Label: "Source", Value: d.sourceLabel()},
+		{Label: "Jobs", Value: display.Number(total)},
+		{Label: "Classes", Value: display.Number(int64(len(d.counts)))},
+		{Label: "Top", Value: top},
+	}
+}
+
+// HintBindings implements HintProvider.
+func (d *DeadReasons) HintBindings() []key.Binding {
+	return []key.Binding{
+		helpBinding([]string{"t"}, "t", "toggle retries"),
+	}
+}
+
+// HelpSections implements HelpProvider.
+func (d *DeadReasons) HelpSections() []HelpSection {
+	return []HelpSection{{
+		Title: "Dead Reasons",
+		Bindings: []key.Binding{
+			helpBinding([]string{"t"}, "t", "include or exclude retries"),
+		},
+	}}
+}
+
+// SetSize implements View.
+func (d *DeadReasons) SetSize(width, height int) View {
+	d.width = width
+	d.height = height
+	return d
+}
+
+// SetStyles implements View.
+func (d *DeadReasons) SetStyles(styles Styles) View {
+	d.styles = styles
+	d.frameStyles = frameStylesFromTheme(styles)
+	return d
+}
+
+// Dispose clears cached data when the view is removed from the stack.
+func (d *DeadReasons) Dispose() {
+	d.fetchRequest.Cancel()
+	d.counts = nil
+	d.ready = false
+}
+
+// CancelRequests stops in-flight fetches when the view is hidden.
+func (d *DeadReasons) CancelRequests() {
+	d.fetchRequest.Cancel()
+}
+
+func (d *DeadReasons) fetchCmd() tea.Cmd {
+	includeRetries := d.includeRetries
+	client := d.client
+	ctx := d.fetchRequest.Start(devtools.WithTracker(context.Background(), "dead_reasons.fetchCmd"))
+	return func() tea.Msg {
+		counts, err := client.GetErrorClassCounts(ctx, includeRetries)
+		if err != nil {
+			if requestctx.IsCanceled(err) {
+				return nil
+			}
+			return ConnectionErrorMsg{Err: err}
+		}
+		return deadReasonsDataMsg{includeRetries: includeRetries, counts: counts}
+	}
+}
+
+func (d *DeadReasons) sourceLabel() string {
+	if d.includeRetries {
+		return "dead + retries"
+	}
+	return "dead"
+}
+
+// renderChart draws one horizontal bar per error class: the class, a bar
+// scaled to the most frequent class, and the count.
+func (d *DeadReasons) renderChart() string {
+	width, height := framedTableSize(d.width, d.height)
+	if len(d.counts) == 0 {
+		return charts.RenderCentered(width, height, d.styles.Muted.Render("No failed jobs"))
+	}
+
+	shown := d.counts[:min(len(d.counts), deadReasonsLimit)]
+	labelWidth := 0
+	countWidth := 0
+	for _, count := range shown {
+		labelWidth = max(labelWidth, ansi.StringWidth(count.ErrorClass))
+		countWidth = max(countWidth, len(display.Number(count.Count)))
+	}
+	labelWidth = min(labelWidth, deadReasonsMaxLabelWidth, max(width/2, 1))
+	barWidth := max(width-labelWidth-countWidth-2, 0)
+	maxCount := shown[0].Count
+
+	lines := make([]string, len(shown))
+	for i, count := range shown {
+		label := ansi.Truncate(count.ErrorClass, labelWidth, "…")
+		bar := strings.Repeat("█", charts.BarLength(count.Count, maxCount, barWidth))
+		lines[i] = d.styles.Text.Render(label+strings.Repeat(" ", labelWidth-ansi.StringWidth(label))) + " " +
+			d.styles.ChartHistogram.Render(bar) + " " +
+			d.styles.Muted.Render(display.Number(count.Count))
+	}
+	if hidden := len(d.counts) - len(shown); hidden > 0 {
+		lines = append(lines, "", d.styles.Muted.Render("+"+display.Number(int64(hidden))+" more classes"))
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,61 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+)
+
+type deadReasonsClientStub struct {
+	sidekiq.API
+	counts         []sidekiq.ErrorClassCount
+	includeRetries []bool
+}
+
+func (s *deadReasonsClientStub) GetErrorClassCounts(_ context.Context, includeRetries bool) ([]sidekiq.ErrorClassCount, error) {
+	s.includeRetries = append(s.includeRetries, includeRetries)
+	return s.counts, nil
+}
+
+func TestDeadReasonsChartsTopErrorClasses(t *testing.T) {
+	stub := &deadReasonsClientStub{}
+	for i := range 12 {
+		stub.counts = append(stub.counts, sidekiq.ErrorClassCount{
+			ErrorClass: fmt.Sprintf("Error%02d", i),
+			Count:      int64(1200 - i*100),
+		})
+	}
+
+	view := NewDeadReasons(stub)
+	view.SetStyles(Styles{})
+	view.SetSize(80, 20)
+	view.Update(view.Init()())
+
+	output := ansi.Strip(view.View())
+	for _, want := range []string{"Error00", "1,200", "Error09", "+2 more classes", "source: dead"} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("view missing %q:\n%s", want, output)
+		}
+	}
+	if strings.Contains(output, "Error10") {
+		t.Fatalf("view shows more than %d classes:\n%s", deadReasonsLimit, output)
+	}
+	if got := contextValue(view.ContextItems(), "Top"); got != "Error00" {
+		t.Fatalf("Top = %q, want Error00", got)
+	}
+
+	_, cmd := view.Update(tea.KeyPressMsg{Code: 't', Text: "t"})
+	view.Update(cmd())
+	if got := stub.includeRetries; len(got) != 2 || got[0] || !got[1] {
+		t.Fatalf("includeRetries calls = %v, want [false true]", got)
+	}
+	if got := contextValue(view.ContextItems(), "Source"); got != "dead + retries" {
+		t.Fatalf("Source = %q, want dead + retries", got)
+	}
+}
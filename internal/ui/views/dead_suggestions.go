@@ -0,0 +1,146 @@
+package views
+
+import (
+	"fmt"
+	"path"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/dialogs"
+	confirmdialog "github.com/kpumuk/lazykiq/internal/ui/dialogs/confirm"
+)
+
+// deadSuggestionTarget prefixes the target of the suggested action dialog.
+const deadSuggestionTarget = "dead.suggest"
+
+// DeadAction is an action the Dead view can suggest for a job.
+type DeadAction string
+
+// Actions a dead job rule can suggest.
+const (
+	DeadActionRetry  DeadAction = "retry"
+	DeadActionDelete DeadAction = "delete"
+)
+
+// DeadActionRule suggests an action for dead jobs whose error class matches
+// Pattern, a shell-style glob such as "*Timeout*".
+type DeadActionRule struct {
+	Pattern string
+	Action  DeadAction
+}
+
+// DefaultDeadActionRules are consulted after any configured rules. Errors
+// about data that is gone will not go away on retry, while timeouts and
+// refused connections usually do.
+var DefaultDeadActionRules = []DeadActionRule{
+	{Pattern: "ActiveRecord::RecordNotFound", Action: DeadActionDelete},
+	{Pattern: "ActiveJob::DeserializationError", Action: DeadActionDelete},
+	{Pattern: "*Timeout*", Action: DeadActionRetry},
+	{Pattern: "Errno::ECONN*", Action: DeadActionRetry},
+}
+
+// DeadActionRulesSetter is implemented by views that suggest actions for dead
+// jobs.
+type DeadActionRulesSetter interface {
+	SetDeadActionRules(rules []DeadActionRule)
+}
+
+// suggestDeadAction returns the action suggested for errorClass by the first
+// matching rule in rules, then in DefaultDeadActionRules, along with that
+// rule. Jobs no rule matches are suggested a retry.
+func suggestDeadAction(rules []DeadActionRule, errorClass string) (DeadAction, DeadActionRule, bool) {
+	for _, set := range [][]DeadActionRule{rules, DefaultDeadActionRules} {
+		for _, rule := range set {
+			if ok, err := path.Match(rule.Pattern, errorClass); err == nil && ok {
+				return rule.Action, rule, true
+			}
+		}
+	}
+	return DeadActionRetry, DeadActionRule{}, false
+}
+
+// alternative returns the action offered next to a, so the user can still
+// pick the other one.
+func (a DeadAction) alternative() DeadAction {
+	if a == DeadActionDelete {
+		return DeadActionRetry
+	}
+	return DeadActionDelete
+}
+
+// pendingSuggestion tracks the job a suggested action dialog is open for.
+// Unlike pendingConfirm, declining the suggestion runs the alternative action;
+// only esc cancels.
+type pendingSuggestion struct {
+	action DeadAction
+	entry  *sidekiq.SortedEntry
+	target string
+}
+
+func (p *pendingSuggestion) Set(action DeadAction, entry *sidekiq.SortedEntry) {
+	p.action = action
+	p.entry = entry
+	p.target = deadSuggestionTarget + ":" + entry.JID()
+}
+
+// Resolve clears the pending suggestion on a matching confirmation message
+// and returns the action the user picked.
+func (p *pendingSuggestion) Resolve(msg confirmdialog.ActionMsg, enabled bool) (DeadAction, *sidekiq.SortedEntry, bool) {
+	if p.entry == nil || msg.Target != p.target {
+		return "", nil, false
+	}
+	action := p.action
+	entry := p.entry
+	*p = pendingSuggestion{}
+	if !enabled {
+		return "", nil, false
+	}
+	if !msg.Confirmed {
+		action = action.alternative()
+	}
+	return action, entry, true
+}
+
+// openSuggestionConfirm offers the action suggested for the job's error
+// class as the highlighted button, with the other action next to it.
+func (d *Dead) openSuggestionConfirm(entry *sidekiq.SortedEntry) tea.Cmd {
+	errorClass := entry.ErrorClass()
+	action, rule, matched := suggestDeadAction(d.actionRules, errorClass)
+	d.pendingSuggestion.Set(action, entry)
+
+	reason := "no rule matches it"
+	if matched {
+		reason = fmt.Sprintf("it matches %q", rule.Pattern)
+	}
+	if errorClass == "" {
+		errorClass = "an unknown error"
+	}
+	return func() tea.Msg {
+		return dialogs.OpenDialogMsg{
+			Model: newConfirmDialog(
+				d.styles,
+				"Suggested action",
+				fmt.Sprintf(
+					"The %s job failed with %s.\n\nSuggested: %s, as %s.",
+					d.styles.Text.Bold(true).Render(d.jobName(entry)),
+					d.styles.Text.Bold(true).Render(errorClass),
+					action,
+					reason,
+				),
+				d.pendingSuggestion.target,
+				d.styles.DangerAction,
+				confirmdialog.WithLabels("Yes, "+string(action), "No, "+string(action.alternative())),
+				confirmdialog.WithSelection(confirmdialog.SelectionYes),
+			),
+		}
+	}
+}
+
+// runSuggestedAction runs the action picked in the suggestion dialog.
+func (d *Dead) runSuggestedAction(action DeadAction, entry *sidekiq.SortedEntry) tea.Cmd {
+	if action == DeadActionDelete {
+		return d.deleteJobCmd(entry)
+	}
+	return d.retryNowJobCmd(entry, false)
+}
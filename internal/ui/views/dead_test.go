@@ -0,0 +1,661 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/components/lazytable"
+	"github.com/kpumuk/lazykiq/internal/ui/components/table"
+	"github.com/kpumuk/lazykiq/internal/ui/dialogs"
+	confirmdialog "github.com/kpumuk/lazykiq/internal/ui/dialogs/confirm"
+	promptdialog "github.com/kpumuk/lazykiq/internal/ui/dialogs/prompt"
+	"github.com/kpumuk/lazykiq/internal/ui/display"
+)
+
+type deadActionsStub struct {
+	sidekiq.API
+	entry    *sidekiq.SortedEntry
+	delay    time.Duration
+	cutoff   time.Time
+	limits   sidekiq.DeadLimits
+	requeued *sidekiq.SortedEntry
+	toRetry  bool
+
+	requeueErr   error
+	forceDeleted string
+	enqueued     *sidekiq.SortedEntry
+	toFront      bool
+	deleted      *sidekiq.SortedEntry
+	preview      *deadActionsStub
+}
+
+func (s *deadActionsStub) DeleteSortedEntry(_ context.Context, _ sidekiq.SortedSetKind, entry *sidekiq.SortedEntry) error {
+	s.deleted = entry
+	return nil
+}
+
+func (s *deadActionsStub) PreviewCommands(
+	ctx context.Context,
+	action func(context.Context, sidekiq.API) error,
+) ([]string, error) {
+	s.preview = &deadActionsStub{}
+	return []string{"ZREM dead job"}, action(ctx, s.preview)
+}
+
+func (s *deadActionsStub) EnqueueSortedEntry(_ context.Context, _ sidekiq.SortedSetKind, entry *sidekiq.SortedEntry) error {
+	s.enqueued, s.toFront = entry, false
+	return nil
+}
+
+func (s *deadActionsStub) EnqueueSortedEntryJob(
+	_ context.Context,
+	_ sidekiq.SortedSetKind,
+	entry *sidekiq.SortedEntry,
+) (*sidekiq.JobRecord, error) {
+	s.enqueued, s.toFront = entry, false
+	return sidekiq.NewJobRecord(entry.Value(), entry.Queue()), nil
+}
+
+func (s *deadActionsStub) EnqueueSortedEntryToFront(
+	_ context.Context,
+	_ sidekiq.SortedSetKind,
+	entry *sidekiq.SortedEntry,
+) error {
+	s.enqueued, s.toFront = entry, true
+	return nil
+}
+
+func (s *deadActionsStub) ForceDeleteEntry(_ context.Context, _ sidekiq.SortedSetKind, rawValue string) error {
+	s.forceDeleted = rawValue
+	return nil
+}
+
+func (s *deadActionsStub) RetryAllDeadJobsToRetry(context.Context) (int, error) {
+	s.toRetry = true
+	return 2, nil
+}
+
+func (s *deadActionsStub) RequeueDeadJob(_ context.Context, entry *sidekiq.SortedEntry) error {
+	s.requeued = entry
+	return s.requeueErr
+}
+
+func (s *deadActionsStub) GetDeadLimits(context.Context) (sidekiq.DeadLimits, error) {
+	return s.limits, nil
+}
+
+func (s *deadActionsStub) RetryDeadJobWithDelay(_ context.Context, entry *sidekiq.SortedEntry, delay time.Duration) error {
+	s.entry = entry
+	s.delay = delay
+	return nil
+}
+
+func (s *deadActionsStub) DeleteDeadJobsOlderThan(_ context.Context, cutoff time.Time) (int64, error) {
+	s.cutoff = cutoff
+	return 1, nil
+}
+
+func TestDeadRetryLaterPrompt(t *testing.T) {
+	stub := &deadActionsStub{}
+	view := NewDead(stub)
+	view.SetDangerousActionsEnabled(true)
+
+	entry := sidekiq.NewSortedEntry(`{"jid":"dead-1","class":"MyJob","queue":"default"}`, 1700000000)
+	view.jobs = []*sidekiq.SortedEntry{entry}
+	view.lazy.SetSize(80, 10)
+	view.lazy.Table().SetRows([]table.Row{{ID: entry.JID(), Cells: []string{"row"}}})
+	view.lazy.Table().SetCursor(0)
+
+	_, cmd := view.Update(tea.KeyPressMsg(tea.Key{Code: 'S', Text: "S"}))
+	if cmd == nil {
+		t.Fatal("expected retry later prompt command")
+	}
+	open, ok := cmd().(dialogs.OpenDialogMsg)
+	if !ok {
+		t.Fatalf("expected OpenDialogMsg, got %T", cmd())
+	}
+	if open.Model.ID() != promptdialog.DialogID {
+		t.Fatalf("dialog id = %q, want %q", open.Model.ID(), promptdialog.DialogID)
+	}
+
+	_, cmd = view.Update(promptdialog.ActionMsg{Target: "dead-1", Value: "90s"})
+	if cmd == nil {
+		t.Fatal("expected retry later command")
+	}
+	if _, ok := cmd().(RefreshMsg); !ok {
+		t.Fatal("expected RefreshMsg after retry later")
+	}
+	if stub.entry != entry {
+		t.Fatal("RetryDeadJobWithDelay called with unexpected entry")
+	}
+	if stub.delay != 90*time.Second {
+		t.Fatalf("delay = %v, want %v", stub.delay, 90*time.Second)
+	}
+
+	if _, cmd = view.Update(promptdialog.ActionMsg{Target: "dead-1", Value: "90s"}); cmd != nil {
+		t.Fatal("expected stale prompt result to be ignored")
+	}
+}
+
+func TestDeadRequeueConfirm(t *testing.T) {
+	stub := &deadActionsStub{}
+	view := NewDead(stub)
+	view.SetDangerousActionsEnabled(true)
+
+	entry := sidekiq.NewSortedEntry(`{"jid":"dead-1","class":"MyJob","queue":"default","retry_count":3}`, 1700000000)
+	view.jobs = []*sidekiq.SortedEntry{entry}
+	view.lazy.SetSize(80, 10)
+	view.lazy.Table().SetRows([]table.Row{{ID: entry.JID(), Cells: []string{"row"}}})
+	view.lazy.Table().SetCursor(0)
+
+	_, cmd := view.Update(tea.KeyPressMsg(tea.Key{Code: 'E', Text: "E"}))
+	if cmd == nil {
+		t.Fatal("expected requeue confirm command")
+	}
+	if open, ok := cmd().(dialogs.OpenDialogMsg); !ok || open.Model.ID() != confirmdialog.DialogID {
+		t.Fatal("expected requeue confirm dialog")
+	}
+	if stub.requeued != nil {
+		t.Fatal("requeue must wait for confirmation")
+	}
+
+	_, cmd = view.Update(confirmdialog.ActionMsg{Confirmed: true, Target: entry.JID()})
+	if cmd == nil {
+		t.Fatal("expected requeue command")
+	}
+	if _, ok := cmd().(RefreshMsg); !ok {
+		t.Fatal("expected RefreshMsg after requeue")
+	}
+	if stub.requeued != entry {
+		t.Fatal("RequeueDeadJob called with unexpected entry")
+	}
+}
+
+func TestDeadRetryToFront(t *testing.T) {
+	for _, tt := range []struct {
+		key     tea.Key
+		toFront bool
+	}{
+		{key: tea.Key{Code: 'R', Text: "R"}, toFront: false},
+		{key: tea.Key{Code: 'r', Mod: tea.ModAlt}, toFront: true},
+	} {
+		stub := &deadActionsStub{}
+		view := NewDead(stub)
+		view.SetDangerousActionsEnabled(true)
+
+		entry := sidekiq.NewSortedEntry(`{"jid":"dead-1","class":"MyJob","queue":"default"}`, 1700000000)
+		view.jobs = []*sidekiq.SortedEntry{entry}
+		view.lazy.SetSize(80, 10)
+		view.lazy.Table().SetRows([]table.Row{{ID: entry.JID(), Cells: []string{"row"}}})
+		view.lazy.Table().SetCursor(0)
+
+		_, cmd := view.Update(tea.KeyPressMsg(tt.key))
+		if cmd == nil {
+			t.Fatalf("%s: expected retry confirm command", tt.key)
+		}
+		if open, ok := cmd().(dialogs.OpenDialogMsg); !ok || open.Model.ID() != confirmdialog.DialogID {
+			t.Fatalf("%s: expected retry confirm dialog", tt.key)
+		}
+
+		_, cmd = view.Update(confirmdialog.ActionMsg{Confirmed: true, Target: entry.JID()})
+		if cmd == nil {
+			t.Fatalf("%s: expected retry command", tt.key)
+		}
+		if _, ok := cmd().(RefreshMsg); !ok {
+			t.Fatalf("%s: expected RefreshMsg after retry", tt.key)
+		}
+		if stub.enqueued != entry || stub.toFront != tt.toFront {
+			t.Fatalf("%s: enqueued %v to front=%v, want front=%v", tt.key, stub.enqueued, stub.toFront, tt.toFront)
+		}
+	}
+}
+
+func TestDeadSuggestedAction(t *testing.T) {
+	tests := map[string]struct {
+		errorClass  string
+		rules       []DeadActionRule
+		confirmed   bool
+		wantDeleted bool
+	}{
+		"record not found suggests delete": {errorClass: "ActiveRecord::RecordNotFound", confirmed: true, wantDeleted: true},
+		"timeout suggests retry":           {errorClass: "Net::ReadTimeout", confirmed: true, wantDeleted: false},
+		"declining runs the alternative":   {errorClass: "Net::ReadTimeout", confirmed: false, wantDeleted: true},
+		"configured rules come first": {
+			errorClass:  "Net::ReadTimeout",
+			rules:       []DeadActionRule{{Pattern: "Net::*", Action: DeadActionDelete}},
+			confirmed:   true,
+			wantDeleted: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			stub := &deadActionsStub{}
+			view := NewDead(stub)
+			view.SetDangerousActionsEnabled(true)
+			view.SetDeadActionRules(tc.rules)
+
+			entry := sidekiq.NewSortedEntry(
+				fmt.Sprintf(`{"jid":"dead-1","class":"MyJob","queue":"default","error_class":%q}`, tc.errorClass),
+				1700000000,
+			)
+			view.jobs = []*sidekiq.SortedEntry{entry}
+			view.lazy.SetSize(80, 10)
+			view.lazy.Table().SetRows([]table.Row{{ID: entry.JID(), Cells: []string{"row"}}})
+			view.lazy.Table().SetCursor(0)
+
+			_, cmd := view.Update(tea.KeyPressMsg(tea.Key{Code: 'A', Text: "A"}))
+			if cmd == nil {
+				t.Fatal("expected suggestion confirm command")
+			}
+			if open, ok := cmd().(dialogs.OpenDialogMsg); !ok || open.Model.ID() != confirmdialog.DialogID {
+				t.Fatal("expected suggestion confirm dialog")
+			}
+
+			// A plain confirmation for the job must not trigger the suggestion.
+			if _, cmd = view.Update(confirmdialog.ActionMsg{Confirmed: true, Target: entry.JID()}); cmd != nil {
+				t.Fatal("unexpected command for unrelated confirmation")
+			}
+
+			_, cmd = view.Update(confirmdialog.ActionMsg{Confirmed: tc.confirmed, Target: "dead.suggest:" + entry.JID()})
+			if cmd == nil {
+				t.Fatal("expected action command")
+			}
+			if _, ok := cmd().(RefreshMsg); !ok {
+				t.Fatal("expected RefreshMsg after action")
+			}
+			if tc.wantDeleted {
+				if stub.deleted != entry || stub.enqueued != nil {
+					t.Fatalf("deleted %v, enqueued %v; want delete", stub.deleted, stub.enqueued)
+				}
+			} else if stub.enqueued != entry || stub.deleted != nil {
+				t.Fatalf("deleted %v, enqueued %v; want retry", stub.deleted, stub.enqueued)
+			}
+		})
+	}
+}
+
+func TestSuggestDeadAction(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		errorClass  string
+		want        DeadAction
+		wantMatched bool
+	}{
+		"record not found": {errorClass: "ActiveRecord::RecordNotFound", want: DeadActionDelete, wantMatched: true},
+		"deserialization":  {errorClass: "ActiveJob::DeserializationError", want: DeadActionDelete, wantMatched: true},
+		"timeout":          {errorClass: "Faraday::TimeoutError", want: DeadActionRetry, wantMatched: true},
+		"connection":       {errorClass: "Errno::ECONNREFUSED", want: DeadActionRetry, wantMatched: true},
+		"unmatched":        {errorClass: "RuntimeError", want: DeadActionRetry},
+		"empty":            {errorClass: "", want: DeadActionRetry},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, _, matched := suggestDeadAction(nil, tc.errorClass)
+			if got != tc.want || matched != tc.wantMatched {
+				t.Fatalf("suggestDeadAction(%q) = %q, %v; want %q, %v", tc.errorClass, got, matched, tc.want, tc.wantMatched)
+			}
+		})
+	}
+}
+
+type deadOrderStub struct {
+	sidekiq.API
+	order   sidekiq.SortOrder
+	entries []*sidekiq.SortedEntry
+}
+
+func (s *deadOrderStub) GetSortedEntries(
+	_ context.Context,
+	_ sidekiq.SortedSetKind,
+	order sidekiq.SortOrder,
+	_, _ int,
+) ([]*sidekiq.SortedEntry, int64, error) {
+	s.order = order
+	if order == sidekiq.SortAscending {
+		return []*sidekiq.SortedEntry{s.entries[0], s.entries[1]}, 2, nil
+	}
+	return []*sidekiq.SortedEntry{s.entries[1], s.entries[0]}, 2, nil
+}
+
+func (s *deadOrderStub) GetSortedEntryBounds(
+	context.Context,
+	sidekiq.SortedSetKind,
+) (*sidekiq.SortedEntry, *sidekiq.SortedEntry, error) {
+	return s.entries[0], s.entries[1], nil
+}
+
+func TestDeadToggleOrder(t *testing.T) {
+	now := time.Now()
+	stub := &deadOrderStub{entries: []*sidekiq.SortedEntry{
+		sidekiq.NewSortedEntry(`{"jid":"old"}`, float64(now.Add(-2*time.Hour).Unix())),
+		sidekiq.NewSortedEntry(`{"jid":"new"}`, float64(now.Add(-time.Hour).Unix())),
+	}}
+	view := NewDead(stub)
+
+	for _, want := range []struct {
+		order sidekiq.SortOrder
+		label string
+		first string
+	}{
+		{order: sidekiq.SortDefault, label: "latest first", first: "new"},
+		{order: sidekiq.SortAscending, label: "earliest first", first: "old"},
+		{order: sidekiq.SortDescending, label: "latest first", first: "new"},
+	} {
+		if want.order != sidekiq.SortDefault {
+			view.Update(tea.KeyPressMsg(tea.Key{Code: 'o', Text: "o"}))
+		}
+		result, err := view.fetchWindow(context.Background(), 0, 10, 0)
+		if err != nil {
+			t.Fatalf("fetchWindow failed: %v", err)
+		}
+		view.handleSortedEntriesData(lazytable.DataMsg{RequestID: view.lazy.RequestID(), Result: result})
+		if stub.order != want.order {
+			t.Fatalf("order = %v, want %v", stub.order, want.order)
+		}
+		if got := contextValue(view.ContextItems(), "Order"); got != want.label {
+			t.Fatalf("Order = %q, want %q", got, want.label)
+		}
+		if got := view.jobs[0].JID(); got != want.first {
+			t.Fatalf("first job = %q, want %q", got, want.first)
+		}
+		if got, want := contextValue(view.ContextItems(), "Last failed"), display.Duration(3600); got != want {
+			t.Fatalf("Last failed = %q, want %q", got, want)
+		}
+	}
+}
+
+func TestDeadConfirmCopiesCommands(t *testing.T) {
+	stub := &deadActionsStub{}
+	view := NewDead(stub)
+	view.SetDangerousActionsEnabled(true)
+
+	entry := sidekiq.NewSortedEntry(`{"jid":"dead-1","class":"MyJob","queue":"default"}`, 1700000000)
+	view.jobs = []*sidekiq.SortedEntry{entry}
+	view.lazy.SetSize(80, 10)
+	view.lazy.Table().SetRows([]table.Row{{ID: entry.JID(), Cells: []string{"row"}}})
+	view.lazy.Table().SetCursor(0)
+
+	_, cmd := view.Update(tea.KeyPressMsg(tea.Key{Code: 'E', Text: "E"}))
+	if cmd == nil {
+		t.Fatal("expected requeue confirm command")
+	}
+	open, ok := cmd().(dialogs.OpenDialogMsg)
+	if !ok || open.Model.ID() != confirmdialog.DialogID {
+		t.Fatal("expected requeue confirm dialog")
+	}
+
+	_, cmd = open.Model.Update(tea.KeyPressMsg(tea.Key{Code: 'y', Mod: tea.ModCtrl}))
+	if cmd == nil {
+		t.Fatal("expected preview command on ctrl+y")
+	}
+	cmd()
+	if stub.requeued != nil {
+		t.Fatal("ctrl+y requeued the job, want only a preview")
+	}
+	if stub.preview == nil || stub.preview.requeued != entry {
+		t.Fatal("ctrl+y did not preview the requeue")
+	}
+}
+
+func TestDeadRequeueCorruptJobOffersForceDelete(t *testing.T) {
+	corrupt := `{"jid":"dead-1","class":"MyJob","queue":"def`
+	stub := &deadActionsStub{requeueErr: fmt.Errorf("%w: unexpected EOF", sidekiq.ErrInvalidPayload)}
+	view := NewDead(stub)
+	view.SetDangerousActionsEnabled(true)
+
+	entry := sidekiq.NewSortedEntry(corrupt, 1700000000)
+	view.jobs = []*sidekiq.SortedEntry{entry}
+	view.lazy.SetSize(80, 10)
+	view.lazy.Table().SetRows([]table.Row{{ID: "corrupt", Cells: []string{"row"}}})
+	view.lazy.Table().SetCursor(0)
+
+	_, _ = view.Update(tea.KeyPressMsg(tea.Key{Code: 'E', Text: "E"}))
+	_, cmd := view.Update(confirmdialog.ActionMsg{Confirmed: true, Target: entry.JID()})
+	if cmd == nil {
+		t.Fatal("expected requeue command")
+	}
+	offer, ok := cmd().(forceDeleteOfferMsg)
+	if !ok {
+		t.Fatalf("requeue of a corrupt job returned %T, want forceDeleteOfferMsg", cmd())
+	}
+
+	_, cmd = view.Update(offer)
+	if cmd == nil {
+		t.Fatal("expected force delete confirm command")
+	}
+	if open, ok := cmd().(dialogs.OpenDialogMsg); !ok || open.Model.ID() != confirmdialog.DialogID {
+		t.Fatal("expected force delete confirm dialog")
+	}
+	if stub.forceDeleted != "" {
+		t.Fatal("force delete must wait for confirmation")
+	}
+
+	_, cmd = view.Update(confirmdialog.ActionMsg{Confirmed: true, Target: deadForceDeleteTarget})
+	if cmd == nil {
+		t.Fatal("expected force delete command")
+	}
+	if _, ok := cmd().(RefreshMsg); !ok {
+		t.Fatal("expected RefreshMsg after force delete")
+	}
+	if stub.forceDeleted != corrupt {
+		t.Fatalf("ForceDeleteEntry value = %q, want the raw member", stub.forceDeleted)
+	}
+}
+
+func TestDeadPrunePromptAndConfirm(t *testing.T) {
+	stub := &deadActionsStub{}
+	view := NewDead(stub)
+	view.SetDangerousActionsEnabled(true)
+
+	_, cmd := view.Update(tea.KeyPressMsg(tea.Key{Code: 'p', Mod: tea.ModCtrl}))
+	if cmd == nil {
+		t.Fatal("expected prune prompt command")
+	}
+	if open, ok := cmd().(dialogs.OpenDialogMsg); !ok || open.Model.ID() != promptdialog.DialogID {
+		t.Fatal("expected prune prompt dialog")
+	}
+
+	_, cmd = view.Update(promptdialog.ActionMsg{Target: deadPruneTarget, Value: "7d"})
+	if cmd == nil {
+		t.Fatal("expected prune confirm command")
+	}
+	if open, ok := cmd().(dialogs.OpenDialogMsg); !ok || open.Model.ID() != confirmdialog.DialogID {
+		t.Fatal("expected prune confirm dialog")
+	}
+	if !stub.cutoff.IsZero() {
+		t.Fatal("prune must wait for confirmation")
+	}
+
+	before := time.Now()
+	_, cmd = view.Update(confirmdialog.ActionMsg{Confirmed: true, Target: deadPruneTarget})
+	if cmd == nil {
+		t.Fatal("expected prune command")
+	}
+	if _, ok := cmd().(RefreshMsg); !ok {
+		t.Fatal("expected RefreshMsg after prune")
+	}
+	want := before.Add(-7 * 24 * time.Hour)
+	if diff := stub.cutoff.Sub(want); diff < 0 || diff > time.Minute {
+		t.Fatalf("cutoff = %v, want about %v", stub.cutoff, want)
+	}
+}
+
+func TestDeadPruneRequiresDangerousActions(t *testing.T) {
+	view := NewDead(&deadActionsStub{})
+
+	if _, cmd := view.Update(tea.KeyPressMsg(tea.Key{Code: 'p', Mod: tea.ModCtrl})); cmd != nil {
+		t.Fatal("expected ctrl+p to be ignored without dangerous actions")
+	}
+	if _, cmd := view.Update(promptdialog.ActionMsg{Target: deadPruneTarget, Value: "7d"}); cmd != nil {
+		t.Fatal("expected prune prompt result to be ignored without dangerous actions")
+	}
+}
+
+func TestDeadRetryAllToRetrySet(t *testing.T) {
+	stub := &deadActionsStub{}
+	view := NewDead(stub)
+
+	ctrlT := tea.KeyPressMsg(tea.Key{Code: 't', Mod: tea.ModCtrl})
+	if _, cmd := view.Update(ctrlT); cmd != nil {
+		if _, ok := cmd().(dialogs.OpenDialogMsg); ok {
+			t.Fatal("expected ctrl+t to be ignored without dangerous actions")
+		}
+	}
+
+	view.SetDangerousActionsEnabled(true)
+	_, cmd := view.Update(ctrlT)
+	if cmd == nil {
+		t.Fatal("expected confirm dialog command")
+	}
+	if open, ok := cmd().(dialogs.OpenDialogMsg); !ok || open.Model.ID() != confirmdialog.DialogID {
+		t.Fatal("expected confirm dialog")
+	}
+	if stub.toRetry {
+		t.Fatal("move must wait for confirmation")
+	}
+
+	_, cmd = view.Update(confirmdialog.ActionMsg{Confirmed: true, Target: "dead.retry_all_later"})
+	if cmd == nil {
+		t.Fatal("expected move command")
+	}
+	if _, ok := cmd().(RefreshMsg); !ok {
+		t.Fatal("expected RefreshMsg after move")
+	}
+	if !stub.toRetry {
+		t.Fatal("expected RetryAllDeadJobsToRetry to be called")
+	}
+}
+
+func TestDeadContextShowsLimits(t *testing.T) {
+	tests := map[string]struct {
+		limits        sidekiq.DeadLimits
+		wantTotal     string
+		wantRetention string
+	}{
+		"unknown": {
+			wantTotal:     "0 / unknown",
+			wantRetention: "unknown",
+		},
+		"configured": {
+			limits:        sidekiq.DeadLimits{MaxJobs: 10000, Timeout: 48 * time.Hour},
+			wantTotal:     "0 / 10,000",
+			wantRetention: display.Duration(int64((48 * time.Hour).Seconds())),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			view := NewDead(&deadActionsStub{limits: tc.limits})
+			view.SetStyles(Styles{})
+			view.Update(view.fetchLimitsCmd()())
+
+			values := map[string]string{}
+			for _, item := range view.ContextItems() {
+				values[item.Label] = item.Value
+			}
+			if got := values["Total items"]; got != tc.wantTotal {
+				t.Fatalf("total items = %q, want %q", got, tc.wantTotal)
+			}
+			if got := values["Retention"]; got != tc.wantRetention {
+				t.Fatalf("retention = %q, want %q", got, tc.wantRetention)
+			}
+		})
+	}
+}
+
+func TestParseRetryDelay(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value   string
+		want    time.Duration
+		wantErr bool
+	}{
+		"minutes":  {value: "15m", want: 15 * time.Minute},
+		"days":     {value: "1d", want: 24 * time.Hour},
+		"compound": {value: "1h30m", want: 90 * time.Minute},
+		"empty":    {value: "", wantErr: true},
+		"invalid":  {value: "soon", wantErr: true},
+		"zero":     {value: "0s", wantErr: true},
+		"negative": {value: "-5m", wantErr: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			got, err := parseRetryDelay(tc.value)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("parseRetryDelay(%q) expected error", tc.value)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseRetryDelay(%q) error = %v", tc.value, err)
+			}
+			if got != tc.want {
+				t.Fatalf("parseRetryDelay(%q) = %v, want %v", tc.value, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDeadTimeRangePrompt(t *testing.T) {
+	view := NewDead(&deadActionsStub{})
+	view.SetStyles(Styles{})
+
+	_, cmd := view.Update(tea.KeyPressMsg{Code: 't', Text: "t"})
+	if cmd == nil {
+		t.Fatal("expected time range prompt command")
+	}
+	if _, ok := cmd().(dialogs.OpenDialogMsg); !ok {
+		t.Fatalf("msg = %#v, want OpenDialogMsg", cmd())
+	}
+
+	if _, cmd := view.Update(promptdialog.ActionMsg{Target: deadTimeRangeTarget, Value: "1h"}); cmd == nil {
+		t.Fatal("expected reload after setting a time range")
+	}
+	if got := contextValue(view.ContextItems(), "Range"); got != "1h" {
+		t.Fatalf("Range = %q, want 1h", got)
+	}
+
+	view.Update(promptdialog.ActionMsg{Target: deadTimeRangeTarget, Value: ""})
+	if got := contextValue(view.ContextItems(), "Range"); got != "" {
+		t.Fatalf("Range = %q after clearing, want none", got)
+	}
+}
+
+func TestDeadDeleteAllStrictConfirm(t *testing.T) {
+	confirms := map[string]func(*Dead) tea.Cmd{
+		"delete all":       (*Dead).openDeleteAllConfirm,
+		"all to retry set": (*Dead).openRetryAllToRetryConfirm,
+		"prune":            func(d *Dead) tea.Cmd { return d.openPruneConfirm("30d") },
+	}
+	for name, open := range confirms {
+		for _, strict := range []bool{false, true} {
+			view := NewDead(nil)
+			view.SetStrictConfirm(strict)
+			msg, ok := open(view)().(dialogs.OpenDialogMsg)
+			if !ok {
+				t.Fatalf("%s: did not open a dialog", name)
+			}
+			model, ok := msg.Model.(*confirmdialog.Model)
+			if !ok {
+				t.Fatalf("%s: dialog = %T, want *confirmdialog.Model", name, msg.Model)
+			}
+			if got := model.InputFocused(); got != strict {
+				t.Fatalf("%s: strict %v: typed confirmation = %v", name, strict, got)
+			}
+		}
+	}
+}
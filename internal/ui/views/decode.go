@@ -0,0 +1,59 @@
+package views
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/display"
+)
+
+// decodeHexDumpLimit caps how many bytes of binary data are shown as hex.
+const decodeHexDumpLimit = 512
+
+// decodeBlob decodes a base64 + zlib string, the encoding Sidekiq uses for
+// compressed backtraces, and formats the result for display: indented JSON,
+// plain text, or a hex dump of binary data. The note describes what was
+// found; ok is false when value is not decodable.
+func decodeBlob(value string) (note, text string, ok bool) {
+	data, err := sidekiq.DecodeCompressed(value)
+	if err != nil {
+		return "Not a base64 + zlib value", err.Error(), false
+	}
+
+	size := display.Bytes(int64(len(data)))
+	var indented bytes.Buffer
+	if json.Indent(&indented, data, "", "  ") == nil {
+		return "base64 + zlib, " + size + ", JSON", indented.String(), true
+	}
+	if isPrintableText(data) {
+		return "base64 + zlib, " + size + ", text", string(data), true
+	}
+
+	dump := hex.Dump(data[:min(len(data), decodeHexDumpLimit)])
+	if hidden := len(data) - decodeHexDumpLimit; hidden > 0 {
+		dump += fmt.Sprintf("… %s more bytes", display.Number(int64(hidden)))
+	}
+	return "base64 + zlib, " + size + ", binary", dump, true
+}
+
+// isPrintableText reports whether data is UTF-8 without control characters
+// other than line breaks and tabs.
+func isPrintableText(data []byte) bool {
+	if !utf8.Valid(data) {
+		return false
+	}
+	for _, r := range string(data) {
+		if r == '\n' || r == '\r' || r == '\t' {
+			continue
+		}
+		if !unicode.IsPrint(r) && !unicode.IsSpace(r) {
+			return false
+		}
+	}
+	return true
+}
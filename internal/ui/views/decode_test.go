@@ -0,0 +1,102 @@
+package views
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/dialogs"
+	textdialog "github.com/kpumuk/lazykiq/internal/ui/dialogs/text"
+)
+
+func compressString(t *testing.T, data []byte) string {
+	t.Helper()
+	var buf bytes.Buffer
+	w := zlib.NewWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		t.Fatalf("compress: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("compress: %v", err)
+	}
+	return base64.StdEncoding.EncodeToString(buf.Bytes())
+}
+
+func TestDecodeBlob(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		value    string
+		wantOK   bool
+		wantNote string
+		wantText string
+	}{
+		{"json", compressString(t, []byte(`{"tenant":42}`)), true, "JSON", "{\n  \"tenant\": 42\n}"},
+		{"text", compressString(t, []byte("hello\nworld")), true, "text", "hello\nworld"},
+		{"binary", compressString(t, []byte{0x00, 0xff, 0x10}), true, "binary", "00 ff 10"},
+		{"garbage", "not base64!", false, "Not a base64 + zlib value", "decode base64"},
+		{"plain base64", base64.StdEncoding.EncodeToString([]byte("plain")), false, "Not a base64 + zlib value", "inflate zlib"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			note, text, ok := decodeBlob(tt.value)
+			if ok != tt.wantOK {
+				t.Fatalf("ok = %v, want %v", ok, tt.wantOK)
+			}
+			if !strings.Contains(note, tt.wantNote) {
+				t.Fatalf("note = %q, want it to contain %q", note, tt.wantNote)
+			}
+			if !strings.Contains(text, tt.wantText) {
+				t.Fatalf("text = %q, want it to contain %q", text, tt.wantText)
+			}
+		})
+	}
+}
+
+func TestDecodeBlobTruncatesHexDump(t *testing.T) {
+	t.Parallel()
+
+	_, text, ok := decodeBlob(compressString(t, bytes.Repeat([]byte{0x01}, decodeHexDumpLimit+100)))
+	if !ok {
+		t.Fatal("decodeBlob rejected binary data")
+	}
+	if !strings.HasSuffix(text, "… 100 more bytes") {
+		t.Fatalf("hex dump does not note hidden bytes:\n%s", text)
+	}
+}
+
+func TestJobDetailDecodeTopLine(t *testing.T) {
+	view := NewJobDetail(nil)
+	view.SetStyles(Styles{})
+	view.SetSize(120, 40)
+	view.SetJob(sidekiq.NewJobRecord(`{"jid":"j1","class":"BlobJob","blob":"`+compressString(t, []byte(`{"a":1}`))+`"}`, ""))
+
+	for i := 0; ; i++ {
+		if path := view.jsonView.Path(i); path == "blob" || strings.HasSuffix(path, ".blob") {
+			view.rightYOffset = i
+			break
+		}
+		if i > 20 {
+			t.Fatal("blob line not found")
+		}
+	}
+
+	_, cmd := view.Update(tea.KeyPressMsg{Code: 'd', Text: "d"})
+	if cmd == nil {
+		t.Fatal("expected decode dialog command")
+	}
+	msg, ok := cmd().(dialogs.OpenDialogMsg)
+	if !ok {
+		t.Fatalf("msg = %#v, want OpenDialogMsg", cmd())
+	}
+	if _, ok := msg.Model.(*textdialog.Model); !ok {
+		t.Fatalf("dialog = %T, want text dialog", msg.Model)
+	}
+}
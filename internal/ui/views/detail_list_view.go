@@ -2,6 +2,7 @@ package views
 
 import (
 	"fmt"
+	"time"
 
 	"charm.land/bubbles/v2/key"
 	tea "charm.land/bubbletea/v2"
@@ -14,6 +15,13 @@ import (
 	"github.com/kpumuk/lazykiq/internal/ui/display"
 )
 
+// Default paging of lazily loaded views: rows per page until the table
+// height is known, and how many pages are fetched around the cursor.
+const (
+	DefaultPageSize    = 25
+	DefaultWindowPages = 3
+)
+
 type detailListView struct {
 	title       string
 	width       int
@@ -21,6 +29,7 @@ type detailListView struct {
 	styles      Styles
 	lazy        lazytable.Model
 	ready       bool
+	updatedAt   time.Time // When the last window arrived
 	filter      string
 	frameStyles frame.Styles
 	filterStyle filterdialog.Styles
@@ -66,6 +75,7 @@ func (s *detailListView) handleData(
 
 	apply(msg.Result)
 	s.ready = true
+	s.updatedAt = nowFuncRefreshIndicator()
 
 	var cmd tea.Cmd
 	s.lazy, cmd = s.lazy.Update(msg)
@@ -86,6 +96,9 @@ func (s *detailListView) handleKeyPress(
 	msg tea.KeyPressMsg,
 	updateEmptyMessage func(),
 ) (bool, tea.Cmd) {
+	if s.lazy.Table().JumpActive() {
+		return true, s.updateKeyPress(msg)
+	}
 	switch msg.String() {
 	case "/":
 		return true, s.openFilterDialog()
@@ -108,8 +121,14 @@ func (s *detailListView) updateKeyPress(msg tea.KeyPressMsg) tea.Cmd {
 	return cmd
 }
 
+// InputFocused implements InputFocuser.
+func (s *detailListView) InputFocused() bool {
+	return s.lazy.Table().JumpActive()
+}
+
 func (s *detailListView) resetShell() {
 	s.ready = false
+	s.updatedAt = time.Time{}
 	s.lazy.Reset()
 }
 
@@ -157,6 +176,16 @@ func (s *detailListView) setStyles(styles Styles) {
 	s.lazy.SetTableStyles(tableStylesFromTheme(styles))
 }
 
+// SaveState implements StatePersister.
+func (s *detailListView) SaveState() ViewState {
+	return ViewState{Filter: s.filter}
+}
+
+// RestoreState implements StatePersister. It must be called before Init.
+func (s *detailListView) RestoreState(state ViewState) {
+	s.filter = state.Filter
+}
+
 func (s *detailListView) cancelRequests() {
 	s.lazy.CancelRequest()
 }
@@ -181,13 +210,22 @@ func (s detailListView) rowsMeta(rowCount int) string {
 	return label + s.styles.MetricValue.Render(rangeLabel)
 }
 
+// updatedMeta tells how fresh the rows are. The table header shows its own
+// spinner while a window is loading.
+func (s detailListView) updatedMeta() string {
+	if s.updatedAt.IsZero() {
+		return ""
+	}
+	return s.styles.Muted.Render(updatedAgoLabel(s.updatedAt))
+}
+
 func (s detailListView) renderBox(title string, rowCount int) string {
 	box := frame.New(
 		frame.WithStyles(s.frameStyles),
 		frame.WithTitle(title),
 		frame.WithFilter(s.filter),
 		frame.WithTitlePadding(0),
-		frame.WithMeta(s.rowsMeta(rowCount)),
+		frame.WithMeta(joinMeta(s.styles, s.rowsMeta(rowCount), s.updatedMeta())),
 		frame.WithContent(s.lazy.View()),
 		frame.WithPadding(1),
 		frame.WithSize(s.width, s.height),
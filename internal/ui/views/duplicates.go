@@ -0,0 +1,250 @@
+package views
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/kpumuk/lazykiq/internal/devtools"
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/components/frame"
+	"github.com/kpumuk/lazykiq/internal/ui/components/table"
+	"github.com/kpumuk/lazykiq/internal/ui/display"
+	"github.com/kpumuk/lazykiq/internal/ui/requestctx"
+)
+
+// duplicatesDataMsg carries duplicate JIDs internally.
+type duplicatesDataMsg struct {
+	duplicates []duplicateJID
+}
+
+// duplicateJID is a JID found in more than one place.
+type duplicateJID struct {
+	jid       string
+	locations []string
+}
+
+// Duplicates lists JIDs found more than once across the retry, scheduled,
+// and dead sets and the queues, which usually points at a bug in how jobs
+// are pushed or moved. The scan reads thousands of jobs, so it only runs
+// when the view opens or on r, not on every refresh tick.
+type Duplicates struct {
+	client       sidekiq.API
+	width        int
+	height       int
+	styles       Styles
+	duplicates   []duplicateJID
+	ready        bool
+	table        table.Model
+	frameStyles  frame.Styles
+	fetchRequest requestctx.Controller
+}
+
+// NewDuplicates creates a new Duplicates view.
+func NewDuplicates(client sidekiq.API) *Duplicates {
+	return &Duplicates{
+		client: client,
+		table: table.New(
+			table.WithColumns(duplicatesColumns),
+			table.WithEmptyMessage("No duplicate JIDs"),
+		),
+	}
+}
+
+var duplicatesColumns = []table.Column{
+	{Title: "JID", Width: 24},
+	{Title: "Count", Width: 5, Align: table.AlignRight},
+	{Title: "Found In", Width: 50},
+}
+
+// Init implements View.
+func (d *Duplicates) Init() tea.Cmd {
+	return d.fetchCmd()
+}
+
+// Update implements View.
+func (d *Duplicates) Update(msg tea.Msg) (View, tea.Cmd) {
+	switch msg := msg.(type) {
+	case duplicatesDataMsg:
+		d.duplicates = msg.duplicates
+		d.ready = true
+		d.updateTableRows()
+		return d, nil
+
+	case RefreshViewMsg:
+		return d, d.fetchCmd()
+
+	case tea.KeyPressMsg:
+		if d.table.JumpActive() {
+			d.table, _ = d.table.Update(msg)
+			return d, nil
+		}
+		if msg.String() == "c" {
+			if idx := d.table.Cursor(); idx >= 0 && idx < len(d.duplicates) {
+				return d, copyTextCmd(d.duplicates[idx].jid)
+			}
+			return d, nil
+		}
+		d.table, _ = d.table.Update(msg)
+	}
+
+	return d, nil
+}
+
+// View implements View.
+func (d *Duplicates) View() string {
+	if !d.ready {
+		return renderStatusMessage("Duplicate JIDs", "Scanning...", d.styles, d.width, d.height)
+	}
+
+	box := frame.New(
+		frame.WithStyles(d.frameStyles),
+		frame.WithTitle("Duplicate JIDs"),
+		frame.WithTitlePadding(0),
+		frame.WithMeta(d.styles.Muted.Render(display.Number(int64(len(d.duplicates)))+" jids")),
+		frame.WithContent(d.table.View()),
+		frame.WithPadding(1),
+		frame.WithSize(d.width, d.height),
+		frame.WithMinHeight(5),
+		frame.WithFocused(true),
+	)
+	return box.View()
+}
+
+// Name implements View.
+func (d *Duplicates) Name() string {
+	return "Duplicates"
+}
+
+// ShortHelp implements View.
+func (d *Duplicates) ShortHelp() []key.Binding {
+	return nil
+}
+
+// ContextItems implements ContextProvider.
+func (d *Duplicates) ContextItems() []ContextItem {
+	var crossSet int64
+	for _, duplicate := range d.duplicates {
+		if len(slices.Compact(slices.Sorted(slices.Values(duplicate.locations)))) > 1 {
+			crossSet++
+		}
+	}
+	return []ContextItem{
+		{Label: "Duplicates", Value: display.Number(int64(len(d.duplicates)))},
+		{Label: "Across Places", Value: display.Number(crossSet)},
+		{Label: "Scanned", Value: "first " + display.Number(sidekiq.DuplicateScanLimit) + " jobs per set and queue"},
+	}
+}
+
+// HintBindings implements HintProvider.
+func (d *Duplicates) HintBindings() []key.Binding {
+	return []key.Binding{
+		helpBinding([]string{"c"}, "c", "copy jid"),
+	}
+}
+
+// HelpSections implements HelpProvider.
+func (d *Duplicates) HelpSections() []HelpSection {
+	return []HelpSection{{
+		Title: "Duplicate JIDs",
+		Bindings: []key.Binding{
+			helpBinding([]string{"c"}, "c", "copy jid"),
+		},
+		Lines: []string{
+			"Scans on open and on r, not on every refresh.",
+		},
+	}}
+}
+
+// TableHelp implements TableHelpProvider.
+func (d *Duplicates) TableHelp() []key.Binding {
+	return tableHelpBindings(d.table.KeyMap)
+}
+
+// SetSize implements View.
+func (d *Duplicates) SetSize(width, height int) View {
+	d.width = width
+	d.height = height
+	tableWidth, tableHeight := framedTableSize(width, height)
+	d.table.SetSize(tableWidth, tableHeight)
+	return d
+}
+
+// SetStyles implements View.
+func (d *Duplicates) SetStyles(styles Styles) View {
+	d.styles = styles
+	d.frameStyles = frameStylesFromTheme(styles)
+	d.table.SetStyles(tableStylesFromTheme(styles))
+	d.updateTableRows()
+	return d
+}
+
+// InputFocused implements InputFocuser.
+func (d *Duplicates) InputFocused() bool {
+	return d.table.JumpActive()
+}
+
+// Dispose clears cached data when the view is removed from the stack.
+func (d *Duplicates) Dispose() {
+	d.fetchRequest.Cancel()
+	d.duplicates = nil
+	d.ready = false
+	d.table.SetRows(nil)
+	d.table.SetCursor(0)
+}
+
+// CancelRequests stops in-flight fetches when the view is hidden.
+func (d *Duplicates) CancelRequests() {
+	d.fetchRequest.Cancel()
+}
+
+func (d *Duplicates) fetchCmd() tea.Cmd {
+	client := d.client
+	ctx := d.fetchRequest.Start(devtools.WithTracker(context.Background(), "duplicates.fetchCmd"))
+	return func() tea.Msg {
+		found, err := client.FindDuplicateJIDs(ctx)
+		if err != nil {
+			if requestctx.IsCanceled(err) {
+				return nil
+			}
+			return ConnectionErrorMsg{Err: err}
+		}
+		return duplicatesDataMsg{duplicates: sortDuplicateJIDs(found)}
+	}
+}
+
+// sortDuplicateJIDs orders duplicates by how often they were found, most
+// first, then by JID.
+func sortDuplicateJIDs(found map[string][]string) []duplicateJID {
+	duplicates := make([]duplicateJID, 0, len(found))
+	for jid, locations := range found {
+		duplicates = append(duplicates, duplicateJID{jid: jid, locations: locations})
+	}
+	slices.SortFunc(duplicates, func(a, b duplicateJID) int {
+		if c := cmp.Compare(len(b.locations), len(a.locations)); c != 0 {
+			return c
+		}
+		return strings.Compare(a.jid, b.jid)
+	})
+	return duplicates
+}
+
+func (d *Duplicates) updateTableRows() {
+	rows := make([]table.Row, len(d.duplicates))
+	for i, duplicate := range d.duplicates {
+		rows[i] = table.Row{
+			ID: duplicate.jid,
+			Cells: []string{
+				duplicate.jid,
+				strconv.Itoa(len(duplicate.locations)),
+				strings.Join(duplicate.locations, ", "),
+			},
+		}
+	}
+	d.table.SetRows(rows)
+}
@@ -0,0 +1,51 @@
+package views
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+)
+
+type duplicatesClientStub struct {
+	sidekiq.API
+	duplicates map[string][]string
+}
+
+func (s *duplicatesClientStub) FindDuplicateJIDs(context.Context) (map[string][]string, error) {
+	return s.duplicates, nil
+}
+
+func TestDuplicatesView(t *testing.T) {
+	stub := &duplicatesClientStub{duplicates: map[string][]string{
+		"aaa": {"retry", "dead"},
+		"bbb": {"queue:default", "queue:default", "dead"},
+	}}
+
+	view := NewDuplicates(stub)
+	view.SetStyles(Styles{})
+	view.SetSize(120, 20)
+	view.Update(view.Init()())
+
+	output := ansi.Strip(view.View())
+	for _, want := range []string{"aaa", "retry, dead", "bbb", "queue:default, queue:default, dead"} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("view missing %q:\n%s", want, output)
+		}
+	}
+	if strings.Index(output, "bbb") > strings.Index(output, "aaa") {
+		t.Fatalf("bbb, found three times, should be listed first:\n%s", output)
+	}
+	if got := contextValue(view.ContextItems(), "Across Places"); got != "2" {
+		t.Fatalf("Across Places = %q, want 2", got)
+	}
+
+	stub.duplicates = map[string][]string{}
+	view.Update(view.fetchCmd()())
+	if output := ansi.Strip(view.View()); !strings.Contains(output, "No duplicate JIDs") {
+		t.Fatalf("empty view missing the empty message:\n%s", output)
+	}
+}
@@ -0,0 +1,42 @@
+package views
+
+import (
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/components/errorbanner"
+)
+
+// errorBannerHint tells the user how to act on a fetch error banner.
+const errorBannerHint = "showing last data · r retry · esc dismiss"
+
+// fetchErrorMsg reports a failed fetch to the view that started it. Views
+// handling it keep their last data and show an error banner instead of the
+// connection error popup; the popup still appears when the stats refresh
+// fails, which means Redis itself is unreachable.
+type fetchErrorMsg struct {
+	view  string // Name of the view that started the fetch
+	fetch string // Which fetch failed, for views that run several
+	err   error
+}
+
+func newErrorBanner() errorbanner.Model {
+	return errorbanner.New(errorbanner.WithHint(errorBannerHint))
+}
+
+func errorBannerStylesFromTheme(styles Styles) errorbanner.Styles {
+	return errorbanner.Styles{
+		Title:   styles.ErrorText.Bold(true),
+		Message: styles.Text,
+		Hint:    styles.Muted,
+		Border:  styles.ErrorText,
+	}
+}
+
+// fetchErrorText words err for a banner, naming the timeout flag to raise
+// when a Redis command timed out.
+func fetchErrorText(client sidekiq.API, err error) string {
+	opts := sidekiq.DefaultConnectionOptions()
+	if client != nil {
+		opts = client.ConnectionOptions()
+	}
+	return ConnectionErrorText(err, opts)
+}
@@ -0,0 +1,164 @@
+package views
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+)
+
+type flakyErrorsSummaryClient struct {
+	errorsSummaryClientStub
+	err error
+}
+
+func (s *flakyErrorsSummaryClient) ConnectionOptions() sidekiq.ConnectionOptions {
+	return sidekiq.DefaultConnectionOptions()
+}
+
+func (s *flakyErrorsSummaryClient) GetErrorSummary(
+	ctx context.Context,
+	query string,
+) ([]sidekiq.ErrorSummaryRow, sidekiq.ErrorSummaryMeta, error) {
+	if s.err != nil {
+		return nil, sidekiq.ErrorSummaryMeta{}, s.err
+	}
+	return s.errorsSummaryClientStub.GetErrorSummary(ctx, query)
+}
+
+func TestErrorsSummaryKeepsDataOnFetchError(t *testing.T) {
+	freezeErrorsSummaryTime(t, time.Date(2026, 3, 21, 12, 0, 0, 0, time.UTC))
+	client := &flakyErrorsSummaryClient{errorsSummaryClientStub: errorsSummaryClientStub{
+		rows: []sidekiq.ErrorSummaryRow{{DisplayClass: "CleanupJob", ErrorClass: "ArgumentError", Queue: "default", Count: 3}},
+	}}
+	view := NewErrorsSummary(client)
+	view.SetSize(100, 14)
+	view.SetStyles(Styles{})
+	view.Update(fetchResult(view.Init()))
+
+	client.err = errors.New("LOADING Redis is loading the dataset in memory")
+	msg := fetchResult(view.fetchDataCmd(true))
+	if _, ok := msg.(fetchErrorMsg); !ok {
+		t.Fatalf("failed fetch returned %T, want fetchErrorMsg", msg)
+	}
+	view.Update(msg)
+	if view.refreshing {
+		t.Fatal("refreshing still set after the fetch failed")
+	}
+	output := ansi.Strip(view.View())
+	for _, want := range []string{"CleanupJob", "LOADING Redis is loading", errorBannerHint} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("view is missing %q:\n%s", want, output)
+		}
+	}
+
+	view.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+	if view.errorBanner.Visible() {
+		t.Fatal("esc did not dismiss the banner")
+	}
+
+	view.Update(msg)
+	client.err = nil
+	view.Update(fetchResult(view.fetchDataCmd(true)))
+	if view.errorBanner.Visible() {
+		t.Fatal("a successful fetch did not clear the banner")
+	}
+}
+
+type flakyDashboardClient struct {
+	dashboardClientStub
+	historyErr error
+}
+
+func (s *flakyDashboardClient) ConnectionOptions() sidekiq.ConnectionOptions {
+	return sidekiq.DefaultConnectionOptions()
+}
+
+func (s *flakyDashboardClient) GetStatsHistory(context.Context, int) (sidekiq.StatsHistory, error) {
+	if s.historyErr != nil {
+		return sidekiq.StatsHistory{}, s.historyErr
+	}
+	return sidekiq.StatsHistory{
+		Dates:     []time.Time{time.Date(2026, 3, 21, 0, 0, 0, 0, time.UTC)},
+		Processed: []int64{10},
+		Failed:    []int64{1},
+	}, nil
+}
+
+func TestDashboardKeepsDataOnFetchError(t *testing.T) {
+	client := &flakyDashboardClient{}
+	view := NewDashboard(client)
+	view.SetStyles(Styles{})
+	view.SetSize(100, 30)
+	view.Update(fetchResult(view.fetchHistoryCmd()))
+
+	client.historyErr = errors.New("i/o error")
+	view.Update(fetchResult(view.fetchHistoryCmd()))
+	if view.historyLoading {
+		t.Fatal("history still loading after the fetch failed")
+	}
+	if len(view.historyDates) != 1 {
+		t.Fatalf("history dates = %v, want the last good history kept", view.historyDates)
+	}
+	if !strings.Contains(ansi.Strip(view.View()), "i/o error") {
+		t.Fatal("banner missing from the dashboard")
+	}
+
+	// Another fetch succeeding does not clear the history error.
+	view.Update(fetchResult(view.fetchRedisInfoCmd()))
+	if !view.errorBanner.Visible() {
+		t.Fatal("redis info dismissed the history error")
+	}
+	client.historyErr = nil
+	view.Update(fetchResult(view.fetchHistoryCmd()))
+	if view.errorBanner.Visible() {
+		t.Fatal("a successful history fetch did not clear the banner")
+	}
+}
+
+func TestQueueDetailsKeepsDataOnFetchError(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client, err := sidekiq.NewClient("redis://" + mr.Addr())
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	_, _ = mr.SetAdd("queues", "default")
+	_, _ = mr.Lpush("queue:default", `{"jid":"kept","class":"KeptJob","args":[]}`)
+
+	view := NewQueueDetails(client)
+	view.SetSize(100, 30)
+	view.SetStyles(Styles{})
+	view.Update(fetchResult(view.Init()))
+
+	mr.SetError("ERR server is busy")
+	view.Update(fetchResult(view.refreshWindow()))
+	if view.lazy.Loading() {
+		t.Fatal("lazy table still loading after the fetch failed")
+	}
+	output := ansi.Strip(view.View())
+	for _, want := range []string{"KeptJob", "server is busy"} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("view is missing %q:\n%s", want, output)
+		}
+	}
+
+	mr.SetError("")
+	updated, cmd := view.Update(RefreshViewMsg{})
+	if cmd == nil {
+		t.Fatal("retry after an error returned no fetch")
+	}
+	updated.Update(fetchResult(cmd))
+	if view.errorBanner.Visible() {
+		t.Fatal("a successful retry did not clear the banner")
+	}
+}
@@ -73,7 +73,7 @@ func (e *ErrorsDetails) Update(msg tea.Msg) (View, tea.Cmd) {
 		}
 		return e, nil
 
-	case RefreshMsg:
+	case RefreshMsg, RefreshViewMsg:
 		return e, e.refreshWindow()
 
 	case filterdialog.ActionMsg:
@@ -96,7 +96,7 @@ func (e *ErrorsDetails) Update(msg tea.Msg) (View, tea.Cmd) {
 		case "enter":
 			if job, ok := e.selectedEntry(); ok && job.Entry != nil {
 				return e, func() tea.Msg {
-					return ShowJobDetailMsg{Job: job.Entry.JobRecord}
+					return ShowJobDetailMsg{Job: job.Entry.JobRecord, Source: errorGroupJobSource(job)}
 				}
 			}
 			return e, nil
@@ -295,6 +295,16 @@ func (e *ErrorsDetails) selectedEntry() (sidekiq.ErrorGroupEntry, bool) {
 	return e.groupJobs[idx], true
 }
 
+// errorGroupJobSource locates a grouped job in the dead or retry set it was
+// read from.
+func errorGroupJobSource(job sidekiq.ErrorGroupEntry) JobSource {
+	kind := sidekiq.SortedSetRetry
+	if job.Source == sidekiq.SortedSetDead.String() {
+		kind = sidekiq.SortedSetDead
+	}
+	return SortedJobSource(kind, job.Entry)
+}
+
 // renderDetailsBox renders the bordered box containing the detail table.
 func (e *ErrorsDetails) renderDetailsBox() string {
 	return e.renderBox(
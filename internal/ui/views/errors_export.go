@@ -0,0 +1,111 @@
+package views
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/atotto/clipboard"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/dialogs"
+)
+
+// errorsExportTarget identifies the export file prompt.
+const errorsExportTarget = "errors.export"
+
+// errorsExportedMsg reports where the Markdown summary went.
+type errorsExportedMsg struct {
+	destination string
+	rows        int
+	err         error
+}
+
+// errorSummaryMarkdown renders rows as a GitHub-flavored Markdown table.
+func errorSummaryMarkdown(rows []sidekiq.ErrorSummaryRow) string {
+	var b strings.Builder
+	b.WriteString("| Job | Error | Queue | Count | Message |\n")
+	b.WriteString("| --- | --- | --- | ---: | --- |\n")
+	for _, row := range rows {
+		fmt.Fprintf(&b, "| %s | %s | %s | %s | %s |\n",
+			markdownCell(row.DisplayClass),
+			markdownCell(row.ErrorClass),
+			markdownCell(row.Queue),
+			strconv.FormatInt(row.Count, 10),
+			markdownCell(row.ErrorMessage),
+		)
+	}
+	return b.String()
+}
+
+// markdownCell escapes pipes and folds line breaks so text stays in one
+// table cell.
+func markdownCell(text string) string {
+	text = strings.ReplaceAll(text, "\r\n", " ")
+	text = strings.ReplaceAll(text, "\n", " ")
+	return strings.ReplaceAll(text, "|", `\|`)
+}
+
+// copyMarkdownCmd copies the filtered summary as Markdown.
+func (e *ErrorsSummary) copyMarkdownCmd() tea.Cmd {
+	if len(e.rows) == 0 {
+		e.note = "no errors to export"
+		return nil
+	}
+	text := errorSummaryMarkdown(e.rows)
+	rows := len(e.rows)
+	return func() tea.Msg {
+		return errorsExportedMsg{destination: "clipboard", rows: rows, err: clipboard.WriteAll(text)}
+	}
+}
+
+func (e *ErrorsSummary) openExportPrompt() tea.Cmd {
+	if len(e.rows) == 0 {
+		e.note = "no errors to export"
+		return nil
+	}
+	name := "errors-" + nowFuncErrorsSummary().Format("20060102-150405") + ".md"
+	return func() tea.Msg {
+		return dialogs.OpenDialogMsg{
+			Model: newPromptDialog(
+				e.styles,
+				"Save Markdown to",
+				name,
+				errorsExportTarget,
+				func(value string) error {
+					if strings.TrimSpace(value) == "" {
+						return errors.New("enter a file name")
+					}
+					return nil
+				},
+			),
+		}
+	}
+}
+
+// writeMarkdownCmd writes the filtered summary as Markdown to path.
+func (e *ErrorsSummary) writeMarkdownCmd(path string) tea.Cmd {
+	text := errorSummaryMarkdown(e.rows)
+	rows := len(e.rows)
+	return func() tea.Msg {
+		return errorsExportedMsg{destination: path, rows: rows, err: os.WriteFile(path, []byte(text), 0o644)}
+	}
+}
+
+// exportNote describes the outcome of an export for the context bar.
+func exportNote(msg errorsExportedMsg) string {
+	if msg.err != nil {
+		return "export failed: " + msg.err.Error()
+	}
+	noun := "rows"
+	if msg.rows == 1 {
+		noun = "row"
+	}
+	if msg.destination == "clipboard" {
+		return fmt.Sprintf("copied %d %s as Markdown", msg.rows, noun)
+	}
+	return fmt.Sprintf("wrote %d %s to %s", msg.rows, noun, msg.destination)
+}
@@ -2,17 +2,22 @@ package views
 
 import (
 	"context"
+	"regexp"
+	"strings"
 	"time"
 
 	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/spinner"
 	tea "charm.land/bubbletea/v2"
 
 	"github.com/kpumuk/lazykiq/internal/devtools"
 	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/components/errorbanner"
 	"github.com/kpumuk/lazykiq/internal/ui/components/frame"
 	"github.com/kpumuk/lazykiq/internal/ui/components/table"
 	"github.com/kpumuk/lazykiq/internal/ui/dialogs"
 	filterdialog "github.com/kpumuk/lazykiq/internal/ui/dialogs/filter"
+	promptdialog "github.com/kpumuk/lazykiq/internal/ui/dialogs/prompt"
 	"github.com/kpumuk/lazykiq/internal/ui/display"
 	"github.com/kpumuk/lazykiq/internal/ui/requestctx"
 )
@@ -41,15 +46,23 @@ type ErrorsSummary struct {
 	meta         sidekiq.ErrorSummaryMeta
 	fetchedAt    time.Time
 	filter       string
+	filterRe     *regexp.Regexp
+	fuzzy        bool
+	fuzzyPattern string
 	frameStyles  frame.Styles
 	filterStyle  filterdialog.Styles
 	fetchRequest requestctx.Controller
+	refresh      refreshIndicator
+	errorBanner  errorbanner.Model
+	note         string // Brief notice shown until the next key press
 }
 
 // NewErrorsSummary creates a new ErrorsSummary view.
 func NewErrorsSummary(client sidekiq.API) *ErrorsSummary {
 	return &ErrorsSummary{
-		client: client,
+		client:      client,
+		refresh:     newRefreshIndicator(),
+		errorBanner: newErrorBanner(),
 		table: table.New(
 			table.WithColumns(errorsSummaryColumns),
 			table.WithEmptyMessage("No errors"),
@@ -72,12 +85,27 @@ func (e *ErrorsSummary) Update(msg tea.Msg) (View, tea.Cmd) {
 		e.fetchedAt = msg.fetchedAt
 		e.ready = true
 		e.refreshing = false
+		e.errorBanner.Dismiss()
 		e.updateTableRows()
 		return e, nil
 
+	case fetchErrorMsg:
+		if msg.view != e.Name() {
+			return e, nil
+		}
+		e.refreshing = false
+		e.errorBanner.SetMessage(fetchErrorText(e.client, msg.err))
+		return e, nil
+
+	case spinner.TickMsg:
+		return e, e.refresh.update(msg, e.refreshing)
+
 	case RefreshMsg:
 		return e, e.fetchDataCmd(false)
 
+	case RefreshViewMsg:
+		return e, e.fetchDataCmd(true)
+
 	case filterdialog.ActionMsg:
 		if msg.Action == filterdialog.ActionNone {
 			return e, nil
@@ -85,26 +113,47 @@ func (e *ErrorsSummary) Update(msg tea.Msg) (View, tea.Cmd) {
 		if msg.Query == e.filter {
 			return e, nil
 		}
-		e.filter = msg.Query
+		e.setFilter(msg.Query)
 		e.table.SetCursor(0)
 		return e, e.fetchDataCmd(true)
 
+	case promptdialog.ActionMsg:
+		if msg.Target != errorsExportTarget {
+			return e, nil
+		}
+		return e, e.writeMarkdownCmd(strings.TrimSpace(msg.Value))
+
+	case errorsExportedMsg:
+		e.note = exportNote(msg)
+		return e, nil
+
 	case tea.KeyPressMsg:
+		if e.table.JumpActive() {
+			e.table, _ = e.table.Update(msg)
+			return e, nil
+		}
+		e.note = ""
+		if msg.String() == "esc" && e.errorBanner.Visible() {
+			e.errorBanner.Dismiss()
+			return e, nil
+		}
 		switch msg.String() {
 		case "/":
 			return e, e.openFilterDialog()
 		case "ctrl+u":
 			if e.filter != "" {
-				e.filter = ""
+				e.setFilter("")
 				e.table.SetCursor(0)
 				return e, e.fetchDataCmd(true)
 			}
 			return e, nil
-		case "r":
-			return e, e.fetchDataCmd(true)
 		}
 
 		switch msg.String() {
+		case "m":
+			return e, e.copyMarkdownCmd()
+		case "M":
+			return e, e.openExportPrompt()
 		case "enter":
 			row, ok := e.selectedRow()
 			if !ok {
@@ -113,7 +162,7 @@ func (e *ErrorsSummary) Update(msg tea.Msg) (View, tea.Cmd) {
 			return e, func() tea.Msg {
 				return ShowErrorDetailsMsg{
 					Key:   errorGroupKeyForRow(row),
-					Query: e.filter,
+					Query: e.scanQuery(),
 				}
 			}
 		}
@@ -128,10 +177,13 @@ func (e *ErrorsSummary) Update(msg tea.Msg) (View, tea.Cmd) {
 // View implements View.
 func (e *ErrorsSummary) View() string {
 	if !e.ready {
+		if e.errorBanner.Visible() {
+			return e.errorBanner.Overlay(e.renderMessage("No data loaded"))
+		}
 		return e.renderMessage("Loading...")
 	}
 
-	return e.renderSummaryBox()
+	return e.errorBanner.Overlay(e.renderSummaryBox())
 }
 
 // Name implements View.
@@ -147,13 +199,15 @@ func (e *ErrorsSummary) ShortHelp() []key.Binding {
 // ContextItems implements ContextProvider.
 func (e *ErrorsSummary) ContextItems() []ContextItem {
 	items := []ContextItem{
-		{Label: "Updated", Value: e.updatedLabel()},
 		{Label: "Dead", Value: display.Number(e.meta.DeadCount)},
 		{Label: "Retry", Value: display.Number(e.meta.RetryCount)},
 	}
 	if e.filter != "" {
 		items = append(items, ContextItem{Label: "Filter", Value: e.filter})
 	}
+	if e.note != "" {
+		items = append(items, ContextItem{Label: "Note", Value: e.styles.Muted.Render(e.note)})
+	}
 	return items
 }
 
@@ -164,6 +218,7 @@ func (e *ErrorsSummary) HintBindings() []key.Binding {
 		helpBinding([]string{"ctrl+u"}, "ctrl+u", "reset filter"),
 		helpBinding([]string{"r"}, "r", "refresh"),
 		helpBinding([]string{"enter"}, "enter", "error details"),
+		helpBinding([]string{"m"}, "m", "copy markdown"),
 	}
 }
 
@@ -175,8 +230,9 @@ func (e *ErrorsSummary) HelpSections() []HelpSection {
 			Bindings: []key.Binding{
 				helpBinding([]string{"/"}, "/", "filter"),
 				helpBinding([]string{"ctrl+u"}, "ctrl+u", "clear filter"),
-				helpBinding([]string{"r"}, "r", "refresh"),
 				helpBinding([]string{"enter"}, "enter", "error details"),
+				helpBinding([]string{"m"}, "m", "copy as markdown table"),
+				helpBinding([]string{"M"}, "shift+m", "save as markdown file"),
 			},
 		},
 	}
@@ -191,6 +247,7 @@ func (e *ErrorsSummary) TableHelp() []key.Binding {
 func (e *ErrorsSummary) SetSize(width, height int) View {
 	e.width = width
 	e.height = height
+	e.errorBanner.SetWidth(width)
 	e.updateTableSize()
 	return e
 }
@@ -198,16 +255,22 @@ func (e *ErrorsSummary) SetSize(width, height int) View {
 // Dispose clears cached data when the view is removed from the stack.
 func (e *ErrorsSummary) Dispose() {
 	e.reset()
-	e.filter = ""
+	e.setFilter("")
 	e.SetStyles(e.styles)
 	e.updateTableSize()
 }
 
+// InputFocused implements InputFocuser.
+func (e *ErrorsSummary) InputFocused() bool {
+	return e.table.JumpActive()
+}
+
 // SetStyles implements View.
 func (e *ErrorsSummary) SetStyles(styles Styles) View {
 	e.styles = styles
 	e.frameStyles = frameStylesFromTheme(styles)
 	e.filterStyle = filterDialogStylesFromTheme(styles)
+	e.errorBanner.SetStyles(errorBannerStylesFromTheme(styles))
 	e.table.SetStyles(tableStylesFromTheme(styles))
 	return e
 }
@@ -226,13 +289,24 @@ func (e *ErrorsSummary) fetchDataCmd(force bool) tea.Cmd {
 
 	e.refreshing = true
 	ctx := e.fetchRequest.Start(devtools.WithTracker(context.Background(), "errors.fetchDataCmd"))
-	return func() tea.Msg {
-		rows, meta, err := e.client.GetErrorSummary(ctx, e.filter)
+	query, re := e.scanQuery(), e.filterRe
+	fuzzy, fuzzyPattern := e.fuzzy, e.fuzzyPattern
+	name := e.Name()
+	return tea.Batch(e.refresh.tick(), func() tea.Msg {
+		rows, meta, err := e.client.GetErrorSummary(ctx, query)
 		if err != nil {
 			if requestctx.IsCanceled(err) {
 				return nil
 			}
-			return ConnectionErrorMsg{Err: err}
+			return fetchErrorMsg{view: name, err: err}
+		}
+		if re != nil {
+			rows = filterErrorSummaryRows(rows, re)
+		}
+		if fuzzy {
+			rows = filterdialog.RankFuzzy(rows, fuzzyPattern, func(row sidekiq.ErrorSummaryRow) string {
+				return row.DisplayClass
+			})
 		}
 
 		return errorsSummaryDataMsg{
@@ -240,7 +314,43 @@ func (e *ErrorsSummary) fetchDataCmd(force bool) tea.Cmd {
 			meta:      meta,
 			fetchedAt: nowFuncErrorsSummary(),
 		}
+	})
+}
+
+// setFilter applies a filter query. Regex queries (see filterdialog.RegexPrefix)
+// and fuzzy job class queries (see filterdialog.FuzzyPrefix) are matched
+// client-side; an invalid pattern clears the filter.
+func (e *ErrorsSummary) setFilter(query string) {
+	re, isRegex, err := filterdialog.ParseRegex(query)
+	if err != nil {
+		query = ""
+	}
+	e.filter = query
+	e.filterRe = nil
+	if isRegex {
+		e.filterRe = re
 	}
+	e.fuzzyPattern, e.fuzzy = filterdialog.ParseFuzzy(query)
+}
+
+// scanQuery returns the query passed to Redis scans. Regex and fuzzy filters
+// scan everything and match after fetching.
+func (e *ErrorsSummary) scanQuery() string {
+	if e.filterRe != nil || e.fuzzy {
+		return ""
+	}
+	return e.filter
+}
+
+// filterErrorSummaryRows keeps rows whose job class or error message match re.
+func filterErrorSummaryRows(rows []sidekiq.ErrorSummaryRow, re *regexp.Regexp) []sidekiq.ErrorSummaryRow {
+	filtered := rows[:0]
+	for _, row := range rows {
+		if re.MatchString(row.DisplayClass) || re.MatchString(row.ErrorMessage) {
+			filtered = append(filtered, row)
+		}
+	}
+	return filtered
 }
 
 func (e *ErrorsSummary) shouldSkipRefresh(force bool) bool {
@@ -260,6 +370,16 @@ func (e *ErrorsSummary) renderMessage(msg string) string {
 	return renderStatusMessage("Errors", msg, e.styles, e.width, e.height)
 }
 
+// SaveState implements StatePersister.
+func (e *ErrorsSummary) SaveState() ViewState {
+	return ViewState{Filter: e.filter}
+}
+
+// RestoreState implements StatePersister. It must be called before Init.
+func (e *ErrorsSummary) RestoreState(state ViewState) {
+	e.setFilter(state.Filter)
+}
+
 func (e *ErrorsSummary) reset() {
 	e.fetchRequest.Cancel()
 	e.ready = false
@@ -267,6 +387,8 @@ func (e *ErrorsSummary) reset() {
 	e.rows = nil
 	e.meta = sidekiq.ErrorSummaryMeta{}
 	e.fetchedAt = time.Time{}
+	e.note = ""
+	e.errorBanner.Dismiss()
 	e.table.SetRows(nil)
 	e.table.SetCursor(0)
 }
@@ -320,22 +442,14 @@ func (e *ErrorsSummary) selectedRow() (sidekiq.ErrorSummaryRow, bool) {
 	return e.rows[idx], true
 }
 
-func (e *ErrorsSummary) updatedLabel() string {
-	if e.fetchedAt.IsZero() {
-		if e.refreshing {
-			return "updating..."
-		}
-		return "-"
-	}
-	return display.Duration(int64(nowFuncErrorsSummary().Sub(e.fetchedAt).Seconds())) + " ago"
-}
-
 func (e *ErrorsSummary) openFilterDialog() tea.Cmd {
 	return func() tea.Msg {
 		return dialogs.OpenDialogMsg{
 			Model: filterdialog.New(
 				filterdialog.WithStyles(e.filterStyle),
 				filterdialog.WithQuery(e.filter),
+				filterdialog.WithRegex(true),
+				filterdialog.WithFuzzy(true),
 			),
 		}
 	}
@@ -350,6 +464,7 @@ func (e *ErrorsSummary) renderSummaryBox() string {
 		frame.WithTitle("Errors"),
 		frame.WithFilter(e.filter),
 		frame.WithTitlePadding(0),
+		frame.WithMeta(e.refresh.view(e.styles, e.refreshing, e.fetchedAt)),
 		frame.WithContent(content),
 		frame.WithPadding(1),
 		frame.WithSize(e.width, e.height),
@@ -2,6 +2,8 @@ package views
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -15,20 +17,24 @@ import (
 	"github.com/kpumuk/lazykiq/internal/ui/components/contextbar"
 	"github.com/kpumuk/lazykiq/internal/ui/components/lazytable"
 	"github.com/kpumuk/lazykiq/internal/ui/components/table"
+	"github.com/kpumuk/lazykiq/internal/ui/dialogs"
+	promptdialog "github.com/kpumuk/lazykiq/internal/ui/dialogs/prompt"
 )
 
 type errorsSummaryClientStub struct {
 	sidekiq.API
-	calls int
-	rows  []sidekiq.ErrorSummaryRow
-	meta  sidekiq.ErrorSummaryMeta
+	calls     int
+	lastQuery string
+	rows      []sidekiq.ErrorSummaryRow
+	meta      sidekiq.ErrorSummaryMeta
 }
 
 func (s *errorsSummaryClientStub) GetErrorSummary(
-	context.Context,
-	string,
+	_ context.Context,
+	query string,
 ) ([]sidekiq.ErrorSummaryRow, sidekiq.ErrorSummaryMeta, error) {
 	s.calls++
+	s.lastQuery = query
 	return append([]sidekiq.ErrorSummaryRow(nil), s.rows...), s.meta, nil
 }
 
@@ -56,7 +62,7 @@ func TestErrorsSummaryRefreshMsgUsesTTL(t *testing.T) {
 	if cmd == nil {
 		t.Fatal("Init returned nil cmd")
 	}
-	msg := cmd()
+	msg := fetchResult(cmd)
 	if msg == nil {
 		t.Fatal("Init fetch returned nil msg")
 	}
@@ -83,7 +89,7 @@ func TestErrorsSummaryRefreshMsgUsesTTL(t *testing.T) {
 		t.Fatal("RefreshMsg after TTL returned nil cmd")
 	}
 
-	msg = cmd()
+	msg = fetchResult(cmd)
 	if msg == nil {
 		t.Fatal("TTL refresh returned nil msg")
 	}
@@ -93,17 +99,144 @@ func TestErrorsSummaryRefreshMsgUsesTTL(t *testing.T) {
 		t.Fatalf("client.calls after TTL refresh = %d, want 2", client.calls)
 	}
 
-	updated, cmd = summary.Update(tea.KeyPressMsg(tea.Key{Text: "r", Code: 'r'}))
+	updated, cmd = summary.Update(RefreshViewMsg{})
 	_ = updated.(*ErrorsSummary)
 	if cmd == nil {
 		t.Fatal("manual refresh returned nil cmd")
 	}
-	_ = cmd()
+	_ = fetchResult(cmd)
 	if client.calls != 3 {
 		t.Fatalf("client.calls after manual refresh = %d, want 3", client.calls)
 	}
 }
 
+func TestErrorsSummaryRegexFilter(t *testing.T) {
+	client := &errorsSummaryClientStub{
+		rows: []sidekiq.ErrorSummaryRow{
+			{DisplayClass: "CleanupJob", ErrorClass: "ArgumentError", Queue: "default", Count: 3, ErrorMessage: "boom"},
+			{DisplayClass: "MailerJob", ErrorClass: "Net::ReadTimeout", Queue: "mailers", Count: 2, ErrorMessage: "timed out"},
+			{DisplayClass: "ReportJob", ErrorClass: "RuntimeError", Queue: "default", Count: 1, ErrorMessage: "disk full"},
+		},
+	}
+
+	tests := map[string]struct {
+		query     string
+		wantQuery string
+		wantRows  []string
+		wantState string
+	}{
+		"substring passes through": {
+			query:     "Job",
+			wantQuery: "Job",
+			wantRows:  []string{"CleanupJob", "MailerJob", "ReportJob"},
+			wantState: "Job",
+		},
+		"regex matches class": {
+			query:    "/re:^(Cleanup|Report)",
+			wantRows: []string{"CleanupJob", "ReportJob"},
+		},
+		"regex matches message": {
+			query:    "/re:timed|full$",
+			wantRows: []string{"MailerJob", "ReportJob"},
+		},
+		"invalid regex clears filter": {
+			query:    "/re:(",
+			wantRows: []string{"CleanupJob", "MailerJob", "ReportJob"},
+		},
+		"fuzzy ranks classes": {
+			query:     "~rptjb",
+			wantRows:  []string{"ReportJob"},
+			wantState: "~rptjb",
+		},
+		"fuzzy ranks best match first": {
+			query:    "~lJ",
+			wantRows: []string{"MailerJob", "CleanupJob"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			view := NewErrorsSummary(client)
+			view.SetSize(100, 12)
+			view.SetStyles(Styles{})
+			view.RestoreState(ViewState{Filter: tc.query})
+
+			updated, _ := view.Update(fetchResult(view.Init()))
+			summary := updated.(*ErrorsSummary)
+
+			if client.lastQuery != tc.wantQuery {
+				t.Fatalf("scan query = %q, want %q", client.lastQuery, tc.wantQuery)
+			}
+			got := make([]string, 0, len(summary.rows))
+			for _, row := range summary.rows {
+				got = append(got, row.DisplayClass)
+			}
+			if strings.Join(got, ",") != strings.Join(tc.wantRows, ",") {
+				t.Fatalf("rows = %v, want %v", got, tc.wantRows)
+			}
+			if tc.wantState != "" && summary.SaveState().Filter != tc.wantState {
+				t.Fatalf("filter = %q, want %q", summary.SaveState().Filter, tc.wantState)
+			}
+		})
+	}
+}
+
+func TestErrorSummaryMarkdown(t *testing.T) {
+	rows := []sidekiq.ErrorSummaryRow{
+		{DisplayClass: "CleanupJob", ErrorClass: "ArgumentError", Queue: "default", Count: 1234, ErrorMessage: "expected a|b\ngot c"},
+	}
+	want := "| Job | Error | Queue | Count | Message |\n" +
+		"| --- | --- | --- | ---: | --- |\n" +
+		"| CleanupJob | ArgumentError | default | 1234 | expected a\\|b got c |\n"
+	if got := errorSummaryMarkdown(rows); got != want {
+		t.Fatalf("errorSummaryMarkdown =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestErrorsSummaryWritesMarkdownFile(t *testing.T) {
+	freezeErrorsSummaryTime(t, time.Date(2026, 3, 21, 12, 0, 0, 0, time.UTC))
+
+	client := &errorsSummaryClientStub{
+		rows: []sidekiq.ErrorSummaryRow{
+			{DisplayClass: "CleanupJob", ErrorClass: "ArgumentError", Queue: "default", Count: 3, ErrorMessage: "boom"},
+			{DisplayClass: "MailerJob", ErrorClass: "Net::ReadTimeout", Queue: "mailers", Count: 2, ErrorMessage: "timed out"},
+		},
+	}
+	view := NewErrorsSummary(client)
+	view.SetSize(100, 12)
+	view.SetStyles(Styles{})
+	view.RestoreState(ViewState{Filter: "/re:^Mailer"})
+	updated, _ := view.Update(fetchResult(view.Init()))
+	summary := updated.(*ErrorsSummary)
+
+	_, cmd := summary.Update(tea.KeyPressMsg(tea.Key{Code: 'M', Text: "M"}))
+	if cmd == nil {
+		t.Fatal("expected export prompt")
+	}
+	open, ok := cmd().(dialogs.OpenDialogMsg)
+	if !ok || open.Model.ID() != promptdialog.DialogID {
+		t.Fatal("expected export prompt dialog")
+	}
+
+	path := filepath.Join(t.TempDir(), "errors.md")
+	_, cmd = summary.Update(promptdialog.ActionMsg{Target: errorsExportTarget, Value: path})
+	if cmd == nil {
+		t.Fatal("expected write command")
+	}
+	summary.Update(cmd())
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read export: %v", err)
+	}
+	if !strings.Contains(string(data), "| MailerJob |") || strings.Contains(string(data), "CleanupJob") {
+		t.Fatalf("export does not respect the filter:\n%s", data)
+	}
+	if got := contextValue(summary.ContextItems(), "Note"); got != "wrote 1 row to "+path {
+		t.Fatalf("Note = %q, want destination", got)
+	}
+}
+
 func TestGoldenErrorsSummaryContext(t *testing.T) {
 	freezeErrorsSummaryTime(t, time.Date(2026, 3, 21, 12, 0, 0, 0, time.UTC))
 
@@ -139,6 +272,8 @@ func TestGoldenErrorsDetailsContext(t *testing.T) {
 }
 
 func TestGoldenErrorsDetailsRowsMeta(t *testing.T) {
+	freezeRefreshIndicatorTime(t, time.Date(2026, 3, 21, 12, 0, 0, 0, time.UTC))
+
 	view := NewErrorsDetails(nil)
 	view.SetSize(140, 40)
 	view.SetStyles(Styles{})
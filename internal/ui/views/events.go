@@ -0,0 +1,248 @@
+package views
+
+import (
+	"strconv"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/components/frame"
+	"github.com/kpumuk/lazykiq/internal/ui/components/table"
+	"github.com/kpumuk/lazykiq/internal/ui/display"
+)
+
+// MaxEvents caps how many events the Events view keeps; older ones are
+// dropped first.
+const MaxEvents = 1000
+
+// EventMsg delivers an event received on the events channel. The app sends
+// it to the Events view whether or not it is shown, so the log keeps
+// growing in the background.
+type EventMsg struct {
+	Event sidekiq.Event
+}
+
+// EventsChannelSetter is implemented by views that show the events channel.
+type EventsChannelSetter interface {
+	SetEventsChannel(channel string)
+}
+
+// Events shows messages published on the --events-channel pub/sub channel
+// as a scrolling log, oldest first. It is fed by the app's subscription,
+// not by the refresh tick, and never queries Redis itself.
+type Events struct {
+	channel     string
+	width       int
+	height      int
+	styles      Styles
+	events      []sidekiq.Event
+	firstSeq    int64 // Sequence number of events[0], used as its row ID
+	dropped     int64
+	follow      bool // Keep the newest event selected
+	dirty       bool // Rows are rebuilt on render, not on every event
+	table       table.Model
+	frameStyles frame.Styles
+}
+
+// NewEvents creates a new Events view.
+func NewEvents() *Events {
+	return &Events{
+		follow: true,
+		table: table.New(
+			table.WithColumns(eventsColumns),
+			table.WithEmptyMessage("Waiting for events"),
+		),
+	}
+}
+
+var eventsColumns = []table.Column{
+	{Title: "Time", Width: 8},
+	{Title: "Channel", Width: 20},
+	{Title: "Message", Width: 60},
+}
+
+// Init implements View.
+func (e *Events) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements View.
+func (e *Events) Update(msg tea.Msg) (View, tea.Cmd) {
+	switch msg := msg.(type) {
+	case EventMsg:
+		e.appendEvent(msg.Event)
+		return e, nil
+
+	case tea.KeyPressMsg:
+		e.syncRows()
+		if e.table.JumpActive() {
+			e.table, _ = e.table.Update(msg)
+			e.follow = e.table.Cursor() >= e.table.RowCount()-1
+			return e, nil
+		}
+		switch msg.String() {
+		case "c":
+			if idx := e.table.Cursor(); idx >= 0 && idx < len(e.events) {
+				return e, copyTextCmd(e.events[idx].Payload)
+			}
+			return e, nil
+		case "ctrl+x":
+			e.firstSeq += int64(len(e.events))
+			e.events = nil
+			e.dropped = 0
+			e.follow = true
+			e.updateTableRows()
+			return e, nil
+		}
+		e.table, _ = e.table.Update(msg)
+		e.follow = e.table.Cursor() >= e.table.RowCount()-1
+	}
+	return e, nil
+}
+
+// View implements View.
+func (e *Events) View() string {
+	e.syncRows()
+	box := frame.New(
+		frame.WithStyles(e.frameStyles),
+		frame.WithTitle("Events"),
+		frame.WithTitlePadding(0),
+		frame.WithMeta(e.styles.Muted.Render(display.Number(int64(len(e.events)))+" events")),
+		frame.WithContent(e.table.View()),
+		frame.WithPadding(1),
+		frame.WithSize(e.width, e.height),
+		frame.WithMinHeight(5),
+		frame.WithFocused(true),
+	)
+	return box.View()
+}
+
+// Name implements View.
+func (e *Events) Name() string {
+	return "Events"
+}
+
+// ShortHelp implements View.
+func (e *Events) ShortHelp() []key.Binding {
+	return nil
+}
+
+// ContextItems implements ContextProvider.
+func (e *Events) ContextItems() []ContextItem {
+	last := "-"
+	if len(e.events) > 0 {
+		last = e.events[len(e.events)-1].Time.Format("15:04:05")
+	}
+	channel := e.channel
+	if channel == "" {
+		channel = "-"
+	}
+	return []ContextItem{
+		{Label: "Channel", Value: channel},
+		{Label: "Events", Value: display.Number(int64(len(e.events)))},
+		{Label: "Last", Value: last},
+		{Label: "Dropped", Value: display.Number(e.dropped)},
+		{Label: "Kept", Value: "last " + strconv.Itoa(MaxEvents)},
+	}
+}
+
+// HintBindings implements HintProvider.
+func (e *Events) HintBindings() []key.Binding {
+	return []key.Binding{
+		helpBinding([]string{"c"}, "c", "copy message"),
+		helpBinding([]string{"ctrl+x"}, "ctrl+x", "clear log"),
+	}
+}
+
+// HelpSections implements HelpProvider.
+func (e *Events) HelpSections() []HelpSection {
+	return []HelpSection{{
+		Title: "Events",
+		Bindings: []key.Binding{
+			helpBinding([]string{"c"}, "c", "copy event message"),
+			helpBinding([]string{"ctrl+x"}, "ctrl+x", "clear event log"),
+		},
+		Lines: []string{
+			"The log follows new events while the last row is selected.",
+		},
+	}}
+}
+
+// TableHelp implements TableHelpProvider.
+func (e *Events) TableHelp() []key.Binding {
+	return tableHelpBindings(e.table.KeyMap)
+}
+
+// SetSize implements View.
+func (e *Events) SetSize(width, height int) View {
+	e.width = width
+	e.height = height
+	tableWidth, tableHeight := framedTableSize(width, height)
+	e.table.SetSize(tableWidth, tableHeight)
+	return e
+}
+
+// SetStyles implements View.
+func (e *Events) SetStyles(styles Styles) View {
+	e.styles = styles
+	e.frameStyles = frameStylesFromTheme(styles)
+	e.table.SetStyles(tableStylesFromTheme(styles))
+	e.updateTableRows()
+	return e
+}
+
+// SetEventsChannel implements EventsChannelSetter.
+func (e *Events) SetEventsChannel(channel string) {
+	e.channel = channel
+	e.table.SetEmptyMessage("Waiting for events on " + channel)
+	e.updateTableRows()
+}
+
+// InputFocused implements InputFocuser.
+func (e *Events) InputFocused() bool {
+	return e.table.JumpActive()
+}
+
+// appendEvent adds an event to the log, dropping the oldest past MaxEvents.
+// The table catches up on the next render, so a burst of events costs one
+// rebuild instead of one per event.
+func (e *Events) appendEvent(event sidekiq.Event) {
+	e.events = append(e.events, event)
+	if over := len(e.events) - MaxEvents; over > 0 {
+		e.events = e.events[over:]
+		e.firstSeq += int64(over)
+		e.dropped += int64(over)
+	}
+	e.dirty = true
+}
+
+// syncRows rebuilds the table after new events. The selection moves to the
+// newest event when following, and stays on the same event otherwise.
+func (e *Events) syncRows() {
+	if !e.dirty {
+		return
+	}
+	e.dirty = false
+	e.updateTableRows()
+	if e.follow {
+		e.table.GotoBottom()
+	}
+}
+
+func (e *Events) updateTableRows() {
+	rows := make([]table.Row, len(e.events))
+	for i, event := range e.events {
+		rows[i] = table.Row{
+			ID: strconv.FormatInt(e.firstSeq+int64(i), 10),
+			Cells: []string{
+				event.Time.Format("15:04:05"),
+				event.Channel,
+				// Multi-line messages are folded to keep one event per row.
+				strings.Join(strings.Fields(event.Payload), " "),
+			},
+		}
+	}
+	e.table.SetRows(rows)
+}
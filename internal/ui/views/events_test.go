@@ -0,0 +1,78 @@
+package views
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+)
+
+func TestEventsViewAppendsAndFollows(t *testing.T) {
+	view := NewEvents()
+	view.SetStyles(Styles{})
+	view.SetSize(120, 20)
+	view.SetEventsChannel("sidekiq:events")
+
+	if output := ansi.Strip(view.View()); !strings.Contains(output, "Waiting for events on sidekiq:events") {
+		t.Fatalf("empty view missing the waiting message:\n%s", output)
+	}
+
+	at := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	for i := range 3 {
+		view.Update(EventMsg{Event: sidekiq.Event{
+			Time:    at,
+			Channel: "sidekiq:events",
+			Payload: "worker-" + strconv.Itoa(i) + "\nstarted",
+		}})
+	}
+
+	output := ansi.Strip(view.View())
+	if !strings.Contains(output, "worker-2 started") {
+		t.Fatalf("view missing the folded message:\n%s", output)
+	}
+	if got := view.table.Cursor(); got != 2 {
+		t.Fatalf("cursor = %d, want 2 following the newest event", got)
+	}
+
+	// Moving off the last row stops following.
+	view.Update(tea.KeyPressMsg{Code: tea.KeyUp})
+	view.Update(EventMsg{Event: sidekiq.Event{Time: at, Channel: "sidekiq:events", Payload: "worker-3"}})
+	view.View()
+	if got := view.table.Cursor(); got != 1 {
+		t.Fatalf("cursor = %d, want 1 kept on the selected event", got)
+	}
+
+	view.Update(tea.KeyPressMsg{Code: 'x', Mod: tea.ModCtrl})
+	if got := contextValue(view.ContextItems(), "Events"); got != "0" {
+		t.Fatalf("Events after clear = %q, want 0", got)
+	}
+}
+
+func TestEventsViewDropsOldestPastLimit(t *testing.T) {
+	view := NewEvents()
+	view.SetStyles(Styles{})
+	view.SetSize(120, 20)
+
+	for i := range MaxEvents + 5 {
+		view.Update(EventMsg{Event: sidekiq.Event{Time: time.Now(), Payload: strconv.Itoa(i)}})
+	}
+
+	view.View()
+	if got := view.table.Cursor(); got != MaxEvents-1 {
+		t.Fatalf("cursor = %d, want %d following the newest event", got, MaxEvents-1)
+	}
+	if len(view.events) != MaxEvents {
+		t.Fatalf("kept %d events, want %d", len(view.events), MaxEvents)
+	}
+	if got := view.events[0].Payload; got != "5" {
+		t.Fatalf("oldest kept event = %q, want 5", got)
+	}
+	if got := contextValue(view.ContextItems(), "Dropped"); got != "5" {
+		t.Fatalf("Dropped = %q, want 5", got)
+	}
+}
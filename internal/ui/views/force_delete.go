@@ -0,0 +1,59 @@
+package views
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/kpumuk/lazykiq/internal/devtools"
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/dialogs"
+)
+
+// forceDeleteOfferMsg reports that an action failed because the job payload
+// is not valid JSON. Views answer it by offering to force delete the job,
+// which is the only way to get rid of it.
+type forceDeleteOfferMsg struct {
+	entry *sidekiq.SortedEntry
+	err   error
+}
+
+// sortedEntryErrorMsg reports a failed action on entry.
+func sortedEntryErrorMsg(entry *sidekiq.SortedEntry, err error) tea.Msg {
+	if errors.Is(err, sidekiq.ErrInvalidPayload) {
+		return forceDeleteOfferMsg{entry: entry, err: err}
+	}
+	return ConnectionErrorMsg{Err: err}
+}
+
+func openForceDeleteConfirm(styles Styles, msg forceDeleteOfferMsg, target string) tea.Cmd {
+	return func() tea.Msg {
+		return dialogs.OpenDialogMsg{
+			Model: newConfirmDialog(
+				styles,
+				"Force delete job",
+				fmt.Sprintf(
+					"This job cannot be changed:\n%s\n\nDelete it from Redis exactly as stored?\n\nThis action is not recoverable.",
+					styles.Muted.Render(msg.err.Error()),
+				),
+				target,
+				styles.DangerAction,
+			),
+		}
+	}
+}
+
+// forceDeleteCmd removes the raw entry from the set. A job that is already
+// gone only refreshes the view.
+func forceDeleteCmd(client sidekiq.API, kind sidekiq.SortedSetKind, entry *sidekiq.SortedEntry, tracker string) tea.Cmd {
+	return func() tea.Msg {
+		ctx := devtools.WithTracker(context.Background(), tracker)
+		err := client.ForceDeleteEntry(ctx, kind, entry.Value())
+		if err != nil && !errors.Is(err, sidekiq.ErrJobNotFound) {
+			return ConnectionErrorMsg{Err: err}
+		}
+		return RefreshMsg{}
+	}
+}
@@ -26,5 +26,6 @@ func tableHelpBindings(km table.KeyMap) []key.Binding {
 		km.ScrollRight,
 		km.Home,
 		km.End,
+		km.JumpToRow,
 	}
 }
@@ -0,0 +1,215 @@
+package views
+
+import (
+	"strconv"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/kpumuk/lazykiq/internal/mathutil"
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/components/frame"
+	"github.com/kpumuk/lazykiq/internal/ui/components/jsonview"
+)
+
+// JobDiff shows a line diff between the JSON of two jobs: job A, marked in
+// job details, and job B, the one it is compared against.
+type JobDiff struct {
+	width       int
+	height      int
+	styles      Styles
+	frameStyles frame.Styles
+	a           *sidekiq.JobRecord
+	b           *sidekiq.JobRecord
+	jsonView    jsonview.Model
+	yOffset     int
+	xOffset     int
+}
+
+// NewJobDiff creates a new JobDiff view.
+func NewJobDiff() *JobDiff {
+	return &JobDiff{jsonView: jsonview.New()}
+}
+
+// Init implements View.
+func (d *JobDiff) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements View.
+func (d *JobDiff) Update(msg tea.Msg) (View, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyPressMsg)
+	if !ok {
+		return d, nil
+	}
+	switch keyMsg.String() {
+	case "up", "k":
+		d.yOffset = mathutil.Clamp(d.yOffset-1, 0, d.maxYOffset())
+	case "down", "j":
+		d.yOffset = mathutil.Clamp(d.yOffset+1, 0, d.maxYOffset())
+	case "left", "h":
+		d.xOffset = mathutil.Clamp(d.xOffset-4, 0, d.maxXOffset())
+	case "right", "l":
+		d.xOffset = mathutil.Clamp(d.xOffset+4, 0, d.maxXOffset())
+	case "g":
+		d.yOffset = 0
+	case "G":
+		d.yOffset = d.maxYOffset()
+	case "home", "0":
+		d.xOffset = 0
+	case "end", "$":
+		d.xOffset = d.maxXOffset()
+	case "n":
+		d.yOffset = d.nextChange()
+	}
+	return d, nil
+}
+
+// View implements View.
+func (d *JobDiff) View() string {
+	if d.a == nil || d.b == nil {
+		return renderStatusMessage("Job Diff", "No jobs to compare", d.styles, d.width, d.height)
+	}
+
+	contentWidth, contentHeight := framedTableSize(d.width, d.height)
+	end := min(d.yOffset+contentHeight, d.jsonView.LineCount())
+	lines := make([]string, 0, contentHeight)
+	for i := d.yOffset; i < end; i++ {
+		lines = append(lines, d.jsonView.RenderLine(i, d.xOffset, contentWidth))
+	}
+
+	meta := d.styles.MetricLabel.Render("A: ") + d.styles.MetricValue.Render(d.a.JID()) +
+		d.styles.MetricLabel.Render(" B: ") + d.styles.MetricValue.Render(d.b.JID())
+	box := frame.New(
+		frame.WithStyles(d.frameStyles),
+		frame.WithTitle("Job Diff"),
+		frame.WithTitlePadding(0),
+		frame.WithMeta(meta),
+		frame.WithContent(strings.Join(lines, "\n")),
+		frame.WithPadding(1),
+		frame.WithSize(d.width, d.height),
+		frame.WithMinHeight(5),
+		frame.WithFocused(true),
+	)
+	return box.View()
+}
+
+// Name implements View.
+func (d *JobDiff) Name() string {
+	return "Job Diff"
+}
+
+// ShortHelp implements View.
+func (d *JobDiff) ShortHelp() []key.Binding {
+	return nil
+}
+
+// ContextItems implements ContextProvider.
+func (d *JobDiff) ContextItems() []ContextItem {
+	if d.a == nil || d.b == nil {
+		return nil
+	}
+	return []ContextItem{
+		{Label: "A", Value: d.a.JID()},
+		{Label: "B", Value: d.b.JID()},
+		{Label: "Added", Value: strconv.Itoa(d.jsonView.DiffCount(jsonview.DiffAdded))},
+		{Label: "Removed", Value: strconv.Itoa(d.jsonView.DiffCount(jsonview.DiffRemoved))},
+		{Label: "Changed", Value: strconv.Itoa(d.jsonView.DiffCount(jsonview.DiffChanged))},
+	}
+}
+
+// HintBindings implements HintProvider.
+func (d *JobDiff) HintBindings() []key.Binding {
+	return []key.Binding{
+		helpBinding([]string{"j"}, "j/k", "scroll"),
+		helpBinding([]string{"h"}, "h/l", "scroll left/right"),
+		helpBinding([]string{"n"}, "n", "next change"),
+	}
+}
+
+// HelpSections implements HelpProvider.
+func (d *JobDiff) HelpSections() []HelpSection {
+	return []HelpSection{{
+		Title: "Job Diff",
+		Bindings: []key.Binding{
+			helpBinding([]string{"j"}, "j/k", "scroll"),
+			helpBinding([]string{"h"}, "h/l", "scroll left/right"),
+			helpBinding([]string{"g"}, "g", "go to top"),
+			helpBinding([]string{"G"}, "G", "go to bottom"),
+			helpBinding([]string{"0"}, "0", "scroll to start"),
+			helpBinding([]string{"$"}, "$", "scroll to end"),
+			helpBinding([]string{"n"}, "n", "jump to next change"),
+		},
+	}}
+}
+
+// SetSize implements View.
+func (d *JobDiff) SetSize(width, height int) View {
+	d.width = width
+	d.height = height
+	d.yOffset = min(d.yOffset, d.maxYOffset())
+	d.xOffset = min(d.xOffset, d.maxXOffset())
+	return d
+}
+
+// SetStyles implements View.
+func (d *JobDiff) SetStyles(styles Styles) View {
+	d.styles = styles
+	d.frameStyles = frameStylesFromTheme(styles)
+	d.jsonView.SetStyles(jsonview.Styles{
+		Text:        styles.Text,
+		Key:         styles.JSONKey,
+		String:      styles.JSONString,
+		Number:      styles.JSONNumber,
+		Bool:        styles.JSONBool,
+		Null:        styles.JSONNull,
+		Punctuation: styles.JSONPunctuation,
+		Muted:       styles.Muted,
+		Added:       styles.ChartSuccess,
+		Removed:     styles.ChartFailure,
+		Changed:     styles.WarningText,
+	})
+	return d
+}
+
+// SetJobs sets the jobs to compare. Sensitive args are masked on both sides.
+func (d *JobDiff) SetJobs(a, b *sidekiq.JobRecord) {
+	d.a = a
+	d.b = b
+	d.yOffset = 0
+	d.xOffset = 0
+	if a == nil || b == nil {
+		d.jsonView.SetValue(nil)
+		return
+	}
+	d.jsonView.SetDiff(a.DisplayItem(), b.DisplayItem())
+}
+
+// Dispose clears cached data when the view is removed from the stack.
+func (d *JobDiff) Dispose() {
+	d.SetJobs(nil, nil)
+}
+
+func (d *JobDiff) maxYOffset() int {
+	_, contentHeight := framedTableSize(d.width, d.height)
+	return max(d.jsonView.LineCount()-contentHeight, 0)
+}
+
+func (d *JobDiff) maxXOffset() int {
+	contentWidth, _ := framedTableSize(d.width, d.height)
+	return max(d.jsonView.MaxWidth()-contentWidth, 0)
+}
+
+// nextChange returns the offset of the next block of differing lines below
+// the top line, wrapping around to the first one.
+func (d *JobDiff) nextChange() int {
+	lineCount := d.jsonView.LineCount()
+	for step := 1; step <= lineCount; step++ {
+		line := (d.yOffset + step) % lineCount
+		if d.jsonView.LineKind(line) != jsonview.DiffSame && d.jsonView.LineKind(line-1) == jsonview.DiffSame {
+			return min(line, d.maxYOffset())
+		}
+	}
+	return d.yOffset
+}
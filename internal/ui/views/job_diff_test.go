@@ -0,0 +1,76 @@
+package views
+
+import (
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+)
+
+func TestJobDetailCompareOpensDiff(t *testing.T) {
+	dead := sidekiq.NewJobRecord(`{"jid":"d1","class":"SyncJob","queue":"default","error_class":"Timeout"}`, "default")
+	retried := sidekiq.NewJobRecord(`{"jid":"r1","class":"SyncJob","queue":"critical"}`, "critical")
+	compare := tea.KeyPressMsg{Code: '=', Text: "="}
+
+	detail := NewJobDetail(nil)
+	detail.SetStyles(Styles{})
+	detail.SetJob(dead)
+	if _, cmd := detail.Update(compare); cmd != nil {
+		t.Fatal("marking job A should not open a diff")
+	}
+	if got := contextValue(detail.ContextItems(), "Diff A"); got != "d1" {
+		t.Fatalf("Diff A = %q, want d1", got)
+	}
+
+	// The mark survives leaving job details and opening another job.
+	detail.Dispose()
+	detail.SetJob(retried)
+	_, cmd := detail.Update(compare)
+	if cmd == nil {
+		t.Fatal("expected a command to open the diff")
+	}
+	msg, ok := cmd().(ShowJobDiffMsg)
+	if !ok || msg.A != dead || msg.B != retried {
+		t.Fatalf("msg = %#v, want a diff of the dead job against the retried one", msg)
+	}
+	if got := contextValue(detail.ContextItems(), "Diff A"); got != "" {
+		t.Fatalf("Diff A after comparing = %q, want the mark cleared", got)
+	}
+
+	diff := NewJobDiff()
+	diff.SetStyles(Styles{})
+	diff.SetSize(80, 20)
+	diff.SetJobs(msg.A, msg.B)
+	output := ansi.Strip(diff.View())
+	for _, want := range []string{
+		`-   "error_class": "Timeout"`,
+		`~   "jid": "d1" -> "r1"`,
+		`~   "queue": "default" -> "critical"`,
+		`    "class": "SyncJob"`,
+	} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("diff missing %q:\n%s", want, output)
+		}
+	}
+	if got := contextValue(diff.ContextItems(), "Changed"); got != "2" {
+		t.Fatalf("Changed = %q, want 2", got)
+	}
+}
+
+func TestJobDetailCompareSameJobClearsMark(t *testing.T) {
+	compare := tea.KeyPressMsg{Code: '=', Text: "="}
+	detail := NewJobDetail(nil)
+	detail.SetStyles(Styles{})
+	detail.SetJob(sidekiq.NewJobRecord(`{"jid":"d1","queue":"default"}`, "default"))
+
+	detail.Update(compare)
+	if _, cmd := detail.Update(compare); cmd != nil {
+		t.Fatal("comparing job A with itself should not open a diff")
+	}
+	if got := contextValue(detail.ContextItems(), "Diff A"); got != "" {
+		t.Fatalf("Diff A = %q, want the mark cleared", got)
+	}
+}
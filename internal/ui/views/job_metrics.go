@@ -1,11 +1,14 @@
 package views
 
 import (
+	"cmp"
 	"context"
 	"slices"
 	"strings"
+	"time"
 
 	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/spinner"
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
 
@@ -23,6 +26,7 @@ import (
 // jobMetricsDataMsg carries job metrics data.
 type jobMetricsDataMsg struct {
 	result sidekiq.MetricsJobDetailResult
+	skew   clockSkew
 }
 
 // JobMetrics shows per-job execution metrics.
@@ -35,11 +39,28 @@ type JobMetrics struct {
 	periods []string
 	period  string
 
-	periodIdx    int
-	result       sidekiq.MetricsJobDetailResult
-	processed    *charts.ProcessedMetrics
-	focused      int
-	fetchRequest requestctx.Controller
+	periodIdx int
+	// savedPeriod is the period last picked with { and }. It outlives
+	// Dispose and is persisted; restorePending makes the first open after a
+	// restore use it instead of the period passed on open.
+	savedPeriod     string
+	restorePending  bool
+	result          sidekiq.MetricsJobDetailResult
+	processed       *charts.ProcessedMetrics
+	focused         int
+	showFailureRate bool
+	showLegend      bool // Show the bucket legend in place of the scatter
+	// cursor is the selected scatter time bucket, -1 when none is selected.
+	cursor int
+	// rawResolution gives every minute its own scatter column; scrollBack is
+	// how many minutes the scatter is scrolled back from the latest.
+	rawResolution bool
+	scrollBack    int
+	skew          clockSkew
+	fetchRequest  requestctx.Controller
+	loading       bool
+	updatedAt     time.Time // When the last metrics arrived
+	refresh       refreshIndicator
 }
 
 // NewJobMetrics creates a new job metrics view.
@@ -49,6 +70,8 @@ func NewJobMetrics(client sidekiq.API) *JobMetrics {
 		client:  client,
 		periods: periods,
 		period:  periods[0],
+		cursor:  -1,
+		refresh: newRefreshIndicator(),
 	}
 }
 
@@ -65,11 +88,21 @@ func (j *JobMetrics) Update(msg tea.Msg) (View, tea.Cmd) {
 	switch msg := msg.(type) {
 	case jobMetricsDataMsg:
 		j.result = msg.result
+		j.skew = msg.skew
+		j.loading = false
+		j.updatedAt = nowFuncRefreshIndicator()
 		// Pre-process histogram data once on arrival instead of every View() call
-		j.processed = charts.ProcessHistogramData(j.result.Hist, j.result.BucketCount)
+		j.processed = charts.ProcessHistogramData(j.result.Hist, j.result.BucketCount, j.result.BucketMetrics)
+		if j.cursor >= 0 {
+			j.cursor = min(j.cursor, len(j.processed.SortedBuckets)-1)
+		}
+		j.scrollBack = j.scatterChart().ClampOffset(j.scrollBack)
 		return j, nil
 
-	case RefreshMsg:
+	case spinner.TickMsg:
+		return j, j.refresh.update(msg, j.loading)
+
+	case RefreshMsg, RefreshViewMsg:
 		return j, j.fetchCmd()
 
 	case tea.KeyPressMsg:
@@ -81,6 +114,29 @@ func (j *JobMetrics) Update(msg tea.Msg) (View, tea.Cmd) {
 				return j, nil
 			}
 			return j, nil
+		case "f":
+			j.showFailureRate = !j.showFailureRate
+			return j, nil
+		case "L":
+			j.showLegend = !j.showLegend
+			return j, nil
+		case "z":
+			j.rawResolution = !j.rawResolution
+			j.scrollBack = 0
+			j.revealCursor()
+			return j, nil
+		case "[":
+			j.scroll(1)
+			return j, nil
+		case "]":
+			j.scroll(-1)
+			return j, nil
+		case "h", "left":
+			j.moveCursor(-1)
+			return j, nil
+		case "l", "right":
+			j.moveCursor(1)
+			return j, nil
 		case "{":
 			return j.adjustPeriod(-1)
 		case "}":
@@ -113,7 +169,7 @@ func (j *JobMetrics) View() string {
 	}
 
 	topHeight, bottomHeight := splitJobMetricsHeights(j.height)
-	meta := j.detailMeta()
+	meta := joinMeta(j.styles, j.detailMeta(), j.refresh.view(j.styles, j.loading, j.updatedAt))
 	topChartHeight := max(topHeight-2, 0)
 	bottomChartHeight := max(bottomHeight-2, 0)
 
@@ -134,27 +190,8 @@ func (j *JobMetrics) View() string {
 	)
 
 	// Render bottom chart using scatter component
-	scatterLabels := sidekiq.MetricsHistogramLabels
-	if j.processed.BucketCount > 0 && len(scatterLabels) > j.processed.BucketCount {
-		scatterLabels = scatterLabels[:j.processed.BucketCount]
-	}
-	scatterChart := scatter.New(
-		scatter.WithStyles(scatter.Styles{
-			Axis:  j.styles.ChartAxis,
-			Label: j.styles.ChartLabel,
-			Point: j.styles.ChartHistogram,
-			Muted: j.styles.Muted,
-		}),
-		scatter.WithSize(contentWidth, bottomChartHeight),
-		scatter.WithData(
-			j.processed.ScatterPoints,
-			j.processed.SortedBuckets,
-			scatterLabels,
-			j.processed.MaxCount,
-			j.processed.MaxBucket,
-		),
-		scatter.WithEmptyMessage(j.noDataMessage()),
-	)
+	scatterLabels := j.scatterLabels()
+	scatterChart := j.scatterChart()
 
 	frameStyles := frame.Styles{
 		Focused: frame.StyleState{
@@ -187,10 +224,42 @@ func (j *JobMetrics) View() string {
 		return topFrame.View()
 	}
 
+	if j.showLegend {
+		legendFrame := frame.New(
+			frame.WithStyles(frameStyles),
+			frame.WithTitle("Buckets"),
+			frame.WithTitlePadding(0),
+			frame.WithMeta(j.styles.Muted.Render("execution time")),
+			frame.WithContent(j.renderLegend(contentWidth, bottomChartHeight)),
+			frame.WithPadding(1),
+			frame.WithSize(j.width, bottomHeight),
+			frame.WithMinHeight(5),
+			frame.WithFocused(j.focused == 1),
+		)
+		return lipgloss.JoinVertical(lipgloss.Left, topFrame.View(), legendFrame.View())
+	}
+
+	var scatterMeta string
+	if j.rawResolution {
+		scatterMeta = j.windowMeta(scatterChart)
+	}
+	if j.showFailureRate {
+		if scatterMeta != "" {
+			scatterMeta += j.styles.Muted.Render(" · ")
+		}
+		scatterMeta += j.styles.ChartFailure.Render("⠒ failure rate")
+	}
+	if readout := j.cursorReadout(scatterLabels); readout != "" {
+		if scatterMeta != "" {
+			scatterMeta += j.styles.Muted.Render(" · ")
+		}
+		scatterMeta += readout
+	}
 	bottomFrame := frame.New(
 		frame.WithStyles(frameStyles),
 		frame.WithTitle("Execution Scatter"),
 		frame.WithTitlePadding(0),
+		frame.WithMeta(scatterMeta),
 		frame.WithContent(scatterChart.View()),
 		frame.WithPadding(1),
 		frame.WithSize(j.width, bottomHeight),
@@ -224,11 +293,19 @@ func (j *JobMetrics) ContextItems() []ContextItem {
 	success := "-"
 	failed := "-"
 	avg := "-"
+	rate := "-"
 	rangeText := "-"
+	resolution := "fit to width"
+	if j.rawResolution {
+		resolution = "1 minute"
+	}
 	if j.processed != nil && len(j.processed.SortedBuckets) > 0 {
 		success = display.Number(j.result.Totals.Success())
 		failed = display.Number(j.result.Totals.Failed)
 		avg = display.Float(j.result.Totals.AvgSeconds(), 2) + "s"
+		if j.result.Totals.Processed > 0 {
+			rate = display.Float(j.result.Totals.SuccessRate()*100, 1) + "%"
+		}
 		if value := formatMetricsRange(j.result.StartsAt, j.result.EndsAt); value != "" {
 			rangeText = value
 		}
@@ -238,8 +315,11 @@ func (j *JobMetrics) ContextItems() []ContextItem {
 		{Label: "Job", Value: jobName},
 		{Label: "Success", Value: success},
 		{Label: "Failed", Value: failed},
+		{Label: "Success rate", Value: rate},
 		{Label: "Average", Value: avg},
 		{Label: "Range", Value: rangeText},
+		{Label: "Resolution", Value: resolution},
+		{Label: "Clock skew", Value: j.skew.contextValue(j.styles)},
 	}
 }
 
@@ -248,6 +328,10 @@ func (j *JobMetrics) HintBindings() []key.Binding {
 	return []key.Binding{
 		helpBinding([]string{"tab"}, "tab", "switch panel"),
 		helpBinding([]string{"{", "}"}, "{ ⋰ }", "change period"),
+		helpBinding([]string{"f"}, "f", "failure rate"),
+		helpBinding([]string{"h", "l"}, "h/l", "select minute"),
+		helpBinding([]string{"z"}, "z", "resolution"),
+		helpBinding([]string{"L"}, "L", "legend"),
 	}
 }
 
@@ -261,6 +345,13 @@ func (j *JobMetrics) HelpSections() []HelpSection {
 				helpBinding([]string{"shift+tab"}, "shift+tab", "switch panel"),
 				helpBinding([]string{"{"}, "{", "previous period"),
 				helpBinding([]string{"}"}, "}", "next period"),
+				helpBinding([]string{"f"}, "f", "toggle failure rate overlay"),
+				helpBinding([]string{"h", "left"}, "h/←", "select previous minute"),
+				helpBinding([]string{"l", "right"}, "l/→", "select next minute"),
+				helpBinding([]string{"z"}, "z", "toggle one column per minute"),
+				helpBinding([]string{"["}, "[", "scroll back (one column per minute)"),
+				helpBinding([]string{"]"}, "]", "scroll forward (one column per minute)"),
+				helpBinding([]string{"L"}, "L", "toggle bucket legend"),
 			},
 		},
 	}
@@ -283,9 +374,14 @@ func (j *JobMetrics) SetStyles(styles Styles) View {
 	return j
 }
 
-// SetJobMetrics sets the job name and period to display.
+// SetJobMetrics sets the job name and period to display. The first call
+// after RestoreState shows the restored period instead.
 func (j *JobMetrics) SetJobMetrics(jobName, period string) {
 	j.jobName = jobName
+	if j.restorePending {
+		period = j.savedPeriod
+		j.restorePending = false
+	}
 	if idx := slices.Index(j.periods, period); idx >= 0 {
 		j.periodIdx = idx
 		j.period = j.periods[idx]
@@ -296,6 +392,9 @@ func (j *JobMetrics) SetJobMetrics(jobName, period string) {
 	j.result = sidekiq.MetricsJobDetailResult{}
 	j.processed = nil
 	j.focused = 0
+	j.cursor = -1
+	j.scrollBack = 0
+	j.updatedAt = time.Time{}
 }
 
 // Dispose clears cached data when the view is removed from the stack.
@@ -307,11 +406,17 @@ func (j *JobMetrics) Dispose() {
 	j.result = sidekiq.MetricsJobDetailResult{}
 	j.processed = nil
 	j.focused = 0
+	j.cursor = -1
+	j.scrollBack = 0
+	j.skew = clockSkew{}
+	j.loading = false
+	j.updatedAt = time.Time{}
 }
 
 // CancelRequests stops in-flight fetches when the view is hidden.
 func (j *JobMetrics) CancelRequests() {
 	j.fetchRequest.Cancel()
+	j.loading = false
 }
 
 func (j *JobMetrics) fetchCmd() tea.Cmd {
@@ -320,11 +425,20 @@ func (j *JobMetrics) fetchCmd() tea.Cmd {
 	client := j.client
 	periods := j.periods
 	ctx := j.fetchRequest.Start(devtools.WithTracker(context.Background(), "job_metrics.fetchCmd"))
-	return func() tea.Msg {
+	j.loading = true
+	return tea.Batch(j.refresh.tick(), func() tea.Msg {
 		params, ok := sidekiq.MetricsPeriods[period]
 		if !ok {
 			params = sidekiq.MetricsPeriods[periods[0]]
 		}
+		// Estimate first so the buckets below follow the workers' clock.
+		skew, err := estimateClockSkew(ctx, client)
+		if err != nil {
+			if requestctx.IsCanceled(err) {
+				return nil
+			}
+			return ConnectionErrorMsg{Err: err}
+		}
 		result, err := client.GetMetricsJobDetail(ctx, jobName, params)
 		if err != nil {
 			if requestctx.IsCanceled(err) {
@@ -332,8 +446,8 @@ func (j *JobMetrics) fetchCmd() tea.Cmd {
 			}
 			return ConnectionErrorMsg{Err: err}
 		}
-		return jobMetricsDataMsg{result: result}
-	}
+		return jobMetricsDataMsg{result: result, skew: skew}
+	})
 }
 
 func (j *JobMetrics) adjustPeriod(delta int) (View, tea.Cmd) {
@@ -343,14 +457,208 @@ func (j *JobMetrics) adjustPeriod(delta int) (View, tea.Cmd) {
 	}
 	j.periodIdx = next
 	j.period = j.periods[next]
+	j.savedPeriod = j.period
 	return j, j.fetchCmd()
 }
 
+// SaveState implements StatePersister.
+func (j *JobMetrics) SaveState() ViewState {
+	return ViewState{Period: j.savedPeriod}
+}
+
+// RestoreState implements StatePersister. Unknown periods are ignored.
+func (j *JobMetrics) RestoreState(state ViewState) {
+	if slices.Contains(j.periods, state.Period) {
+		j.savedPeriod = state.Period
+		j.restorePending = true
+	}
+}
+
+// moveCursor moves the scatter selection by delta time buckets. The first
+// move selects the most recent bucket; without data it does nothing.
+func (j *JobMetrics) moveCursor(delta int) {
+	if j.processed == nil || len(j.processed.SortedBuckets) == 0 {
+		return
+	}
+	last := len(j.processed.SortedBuckets) - 1
+	if j.cursor < 0 {
+		j.cursor = last
+	} else {
+		j.cursor = mathutil.Clamp(j.cursor+delta, 0, last)
+	}
+	j.revealCursor()
+}
+
+// scatterLabels returns the execution time labels of the scatter's Y axis.
+func (j *JobMetrics) scatterLabels() []string {
+	labels := sidekiq.MetricsHistogramLabels
+	if j.processed != nil && j.processed.BucketCount > 0 && len(labels) > j.processed.BucketCount {
+		labels = labels[:j.processed.BucketCount]
+	}
+	return labels
+}
+
+// scatterChart builds the execution scatter at the size View draws it.
+func (j *JobMetrics) scatterChart() scatter.Model {
+	_, bottomHeight := splitJobMetricsHeights(j.height)
+	opts := []scatter.Option{
+		scatter.WithStyles(scatter.Styles{
+			Axis:    j.styles.ChartAxis,
+			Label:   j.styles.ChartLabel,
+			Point:   j.styles.ChartHistogram,
+			Muted:   j.styles.Muted,
+			Overlay: j.styles.ChartFailure,
+		}),
+		scatter.WithSize(max(j.width-4, 0), max(bottomHeight-2, 0)),
+		scatter.WithRawResolution(j.rawResolution),
+		scatter.WithOffset(j.scrollBack),
+		scatter.WithCursor(j.cursor),
+		scatter.WithEmptyMessage(j.noDataMessage()),
+	}
+	if j.processed != nil {
+		var failureRates []float64
+		if j.showFailureRate {
+			failureRates = failureRateOverlay(j.processed.SuccessRates)
+		}
+		opts = append(opts,
+			scatter.WithData(
+				j.processed.ScatterPoints,
+				j.processed.SortedBuckets,
+				j.scatterLabels(),
+				j.processed.MaxCount,
+				j.processed.MaxBucket,
+			),
+			scatter.WithOverlay(failureRates),
+		)
+	}
+	return scatter.New(opts...)
+}
+
+// scroll moves the one-column-per-minute scatter back (positive pages) or
+// forward by whole pages, keeping a selected minute inside the window.
+func (j *JobMetrics) scroll(pages int) {
+	if !j.rawResolution {
+		return
+	}
+	chart := j.scatterChart()
+	j.scrollBack = chart.ClampOffset(j.scrollBack + pages*chart.VisibleBuckets())
+	if j.cursor < 0 {
+		return
+	}
+	start, end := j.scatterChart().Window()
+	j.cursor = mathutil.Clamp(j.cursor, start, max(end-1, start))
+}
+
+// revealCursor scrolls the one-column-per-minute scatter just enough to show
+// the selected minute.
+func (j *JobMetrics) revealCursor() {
+	if !j.rawResolution || j.cursor < 0 {
+		return
+	}
+	chart := j.scatterChart()
+	start, end := chart.Window()
+	switch {
+	case j.cursor < start:
+		j.scrollBack += start - j.cursor
+	case j.cursor >= end:
+		j.scrollBack -= j.cursor - end + 1
+	}
+	j.scrollBack = chart.ClampOffset(j.scrollBack)
+}
+
+// windowMeta describes the minutes shown by a one-column-per-minute scatter,
+// or just the resolution when every minute fits.
+func (j *JobMetrics) windowMeta(chart scatter.Model) string {
+	meta := j.styles.MetricLabel.Render("1 min/col")
+	start, end := chart.Window()
+	if j.processed == nil || end-start >= len(j.processed.SortedBuckets) || end <= start {
+		return meta
+	}
+	from := j.processed.SortedBuckets[start].UTC().Format("15:04")
+	to := j.processed.SortedBuckets[end-1].UTC().Format("15:04")
+	return meta + j.styles.Muted.Render(" · ") + j.styles.MetricValue.Render(from+"–"+to+" UTC")
+}
+
+// cursorReadout lists the selected bucket's time and its job count per
+// latency bucket, fastest first.
+func (j *JobMetrics) cursorReadout(labels []string) string {
+	if j.processed == nil || j.cursor < 0 || j.cursor >= len(j.processed.SortedBuckets) {
+		return ""
+	}
+
+	var points []charts.ScatterPoint
+	for _, point := range j.processed.ScatterPoints {
+		if int(point.X) == j.cursor {
+			points = append(points, point)
+		}
+	}
+	slices.SortFunc(points, func(a, b charts.ScatterPoint) int {
+		return cmp.Compare(a.Y, b.Y)
+	})
+
+	bucketTime := j.processed.SortedBuckets[j.cursor].UTC().Format("15:04") + " UTC"
+	parts := []string{j.styles.MetricValue.Render(bucketTime)}
+	if len(points) == 0 {
+		parts = append(parts, j.styles.Muted.Render("no jobs"))
+	}
+	for _, point := range points {
+		label := "?"
+		if idx := int(point.Y); idx >= 0 && idx < len(labels) {
+			label = labels[idx]
+		}
+		parts = append(parts, j.styles.MetricLabel.Render(label+" ")+j.styles.MetricValue.Render(display.Number(point.Count)))
+	}
+	return strings.Join(parts, j.styles.Muted.Render(" · "))
+}
+
 func (j *JobMetrics) detailMeta() string {
 	if j.period == "" {
 		return ""
 	}
-	return j.styles.MetricLabel.Render("period: ") + j.styles.MetricValue.Render(j.period)
+	return j.styles.MetricLabel.Render("period: ") + j.styles.MetricValue.Render(j.period) + j.skew.warning(j.styles)
+}
+
+// renderLegend lists every histogram bucket label with the execution times it
+// holds, in as many columns as fit, filled top to bottom.
+func (j *JobMetrics) renderLegend(width, height int) string {
+	buckets := sidekiq.MetricsHistogramBuckets
+	if width <= 0 || height <= 0 || len(buckets) == 0 {
+		return ""
+	}
+
+	labelWidth, rangeWidth := 0, 0
+	for i, bucket := range buckets {
+		labelWidth = max(labelWidth, lipgloss.Width(bucket.Label))
+		rangeWidth = max(rangeWidth, lipgloss.Width(sidekiq.MetricsHistogramRange(i)))
+	}
+	const gap = 4
+	columnWidth := labelWidth + 2 + rangeWidth
+	columns := max((width+gap)/(columnWidth+gap), 1)
+	rows := max((len(buckets)+columns-1)/columns, height)
+	columns = (len(buckets) + rows - 1) / rows
+
+	lines := make([]string, 0, rows)
+	for row := range rows {
+		var line strings.Builder
+		for col := range columns {
+			i := col*rows + row
+			if i >= len(buckets) {
+				break
+			}
+			if col > 0 {
+				line.WriteString(strings.Repeat(" ", gap))
+			}
+			label := buckets[i].Label
+			bucketRange := sidekiq.MetricsHistogramRange(i)
+			line.WriteString(strings.Repeat(" ", labelWidth-lipgloss.Width(label)))
+			line.WriteString(j.styles.MetricLabel.Render(label))
+			line.WriteString("  ")
+			line.WriteString(j.styles.MetricValue.Render(bucketRange))
+			line.WriteString(strings.Repeat(" ", rangeWidth-lipgloss.Width(bucketRange)))
+		}
+		lines = append(lines, line.String())
+	}
+	return strings.Join(lines, "\n")
 }
 
 func (j *JobMetrics) noDataMessage() string {
@@ -364,6 +672,16 @@ func (j *JobMetrics) noDataMessage() string {
 	return line1 + "\n" + line2 + "\n" + line3
 }
 
+// failureRateOverlay converts per-bucket success rates to failure rates,
+// keeping NaN gaps for buckets without processed jobs.
+func failureRateOverlay(successRates []float64) []float64 {
+	rates := make([]float64, len(successRates))
+	for i, rate := range successRates {
+		rates[i] = 1 - rate
+	}
+	return rates
+}
+
 // splitJobMetricsHeights splits the total height between top and bottom panels.
 func splitJobMetricsHeights(total int) (int, int) {
 	top := max(total/2, 5)
@@ -0,0 +1,126 @@
+package views
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+)
+
+func TestJobMetricsResize(t *testing.T) {
+	base := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+	hist := make(map[string][]int64)
+	bucketMetrics := make(map[string]sidekiq.MetricsJobTotals)
+	for minute := range 60 {
+		key := base.Add(time.Duration(minute) * time.Minute).Format(time.RFC3339)
+		hist[key] = []int64{int64(minute % 7), int64(minute % 3), 1}
+		bucketMetrics[key] = sidekiq.MetricsJobTotals{Processed: int64(minute%7 + minute%3 + 1)}
+	}
+
+	view := NewJobMetrics(nil)
+	view.SetStyles(Styles{})
+	view.jobName = "SyncJob"
+	view.Update(jobMetricsDataMsg{result: sidekiq.MetricsJobDetailResult{
+		Hist:          hist,
+		BucketCount:   3,
+		BucketMetrics: bucketMetrics,
+	}})
+
+	for _, size := range [][2]int{{120, 40}, {30, 12}, {2, 2}, {5, 3}, {150, 50}} {
+		view.SetSize(size[0], size[1])
+		assertChartFits(t, view.View(), size[0])
+	}
+}
+
+// assertChartFits fails when a rendered chart has a line wider than width,
+// which happens when a chart is drawn for a stale size.
+func assertChartFits(t *testing.T, output string, width int) {
+	t.Helper()
+	for i, line := range strings.Split(ansi.Strip(output), "\n") {
+		if w := ansi.StringWidth(line); w > width {
+			t.Fatalf("line %d is %d cells wide, want at most %d:\n%s", i, w, width, output)
+		}
+	}
+}
+
+func TestJobMetricsBucketLegend(t *testing.T) {
+	view := NewJobMetrics(nil)
+	view.SetStyles(Styles{})
+	view.SetSize(120, 40)
+	view.jobName = "SyncJob"
+	view.Update(jobMetricsDataMsg{result: sidekiq.MetricsJobDetailResult{
+		Hist:          map[string][]int64{"2026-01-02T10:00:00Z": {1, 2, 3}},
+		BucketCount:   3,
+		BucketMetrics: map[string]sidekiq.MetricsJobTotals{"2026-01-02T10:00:00Z": {Processed: 6}},
+	}})
+
+	view.Update(tea.KeyPressMsg{Code: 'L', Text: "L"})
+	if !view.showLegend {
+		t.Fatal("L did not show the legend")
+	}
+	output := ansi.Strip(view.View())
+	for _, want := range []string{"Buckets", "< 20ms", "1.1s–1.7s", "≥ 335s"} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("legend is missing %q:\n%s", want, output)
+		}
+	}
+	for _, size := range [][2]int{{30, 12}, {60, 20}} {
+		view.SetSize(size[0], size[1])
+		assertChartFits(t, view.View(), size[0])
+	}
+
+	view.Update(tea.KeyPressMsg{Code: 'L', Text: "L"})
+	if view.showLegend {
+		t.Fatal("second L did not hide the legend")
+	}
+}
+
+func TestJobMetricsRawResolution(t *testing.T) {
+	base := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+	hist := make(map[string][]int64)
+	for minute := range 240 {
+		hist[base.Add(time.Duration(minute)*time.Minute).Format(time.RFC3339)] = []int64{1, 0, 0}
+	}
+
+	view := NewJobMetrics(nil)
+	view.SetStyles(Styles{})
+	view.SetSize(60, 30)
+	view.jobName = "SyncJob"
+	view.Update(jobMetricsDataMsg{result: sidekiq.MetricsJobDetailResult{Hist: hist, BucketCount: 3}})
+
+	if start, end := view.scatterChart().Window(); start != 0 || end != 240 {
+		t.Fatalf("fit to width shows [%d, %d), want every minute", start, end)
+	}
+
+	view.Update(tea.KeyPressMsg{Code: 'z', Text: "z"})
+	start, end := view.scatterChart().Window()
+	if end != 240 || end-start >= 240 {
+		t.Fatalf("raw resolution shows [%d, %d), want the latest minutes", start, end)
+	}
+	assertChartFits(t, view.View(), 60)
+
+	view.Update(tea.KeyPressMsg{Code: '[', Text: "["})
+	if view.scrollBack != end-start {
+		t.Fatalf("[ scrolled back %d minutes, want %d", view.scrollBack, end-start)
+	}
+
+	// Selecting the latest minute scrolls it back into view.
+	view.Update(tea.KeyPressMsg{Code: 'l', Text: "l"})
+	if view.cursor != 239 || view.scrollBack != 0 {
+		t.Fatalf("cursor = %d, scrollBack = %d, want 239 and 0", view.cursor, view.scrollBack)
+	}
+
+	view.Update(tea.KeyPressMsg{Code: '[', Text: "["})
+	if start, end := view.scatterChart().Window(); view.cursor < start || view.cursor >= end {
+		t.Fatalf("cursor %d left the window [%d, %d)", view.cursor, start, end)
+	}
+
+	view.Update(tea.KeyPressMsg{Code: 'z', Text: "z"})
+	if view.rawResolution || view.scrollBack != 0 {
+		t.Fatal("second z did not return to fit to width")
+	}
+}
@@ -0,0 +1,421 @@
+package views
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/kpumuk/lazykiq/internal/devtools"
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/components/frame"
+	"github.com/kpumuk/lazykiq/internal/ui/components/table"
+	"github.com/kpumuk/lazykiq/internal/ui/display"
+	"github.com/kpumuk/lazykiq/internal/ui/requestctx"
+)
+
+// DefaultWatchTimeout is how long a retried job is watched before giving up.
+const DefaultWatchTimeout = 2 * time.Minute
+
+// jobWatchPollInterval is the delay between two lookups of a watched job.
+const jobWatchPollInterval = time.Second
+
+// A job a worker has just fetched is in no queue until the worker's next
+// heartbeat records it as running, which Sidekiq does every 10 seconds. A
+// missing job is only taken to have completed once it stayed missing for
+// jobWatchMissingChecks lookups in a row spanning more than
+// jobWatchMissingFor.
+const (
+	jobWatchMissingChecks = 3
+	jobWatchMissingFor    = 10 * time.Second
+)
+
+// WatchTimeoutSetter is implemented by views that watch a job for a limited
+// time.
+type WatchTimeoutSetter interface {
+	SetWatchTimeout(timeout time.Duration)
+}
+
+// JobWatchSetter is implemented by views that watch a single job.
+type JobWatchSetter interface {
+	SetJobWatch(job *sidekiq.JobRecord, from sidekiq.SortedSetKind)
+}
+
+// jobWatchTickMsg schedules the next lookup of the watched job.
+type jobWatchTickMsg struct {
+	seq int
+}
+
+// jobWatchLocationMsg carries where the watched job was found.
+type jobWatchLocationMsg struct {
+	seq      int
+	location sidekiq.JobLocation
+	at       time.Time
+}
+
+// jobWatchOutcome is how a watch ended, if it has.
+type jobWatchOutcome int
+
+const (
+	jobWatchActive jobWatchOutcome = iota
+	jobWatchCompleted
+	jobWatchDead
+	jobWatchTimedOut
+)
+
+// jobWatchStep is a place the watched job was seen in.
+type jobWatchStep struct {
+	at       time.Time
+	location sidekiq.JobLocation
+}
+
+// JobWatch follows a job retried with W from the retry or dead set. It looks
+// the job up every second until it stays out of every queue and set for
+// longer than a heartbeat, which is taken to mean it completed, lands in the
+// dead set again, or the watch times out.
+type JobWatch struct {
+	client      sidekiq.API
+	width       int
+	height      int
+	styles      Styles
+	job         *sidekiq.JobRecord
+	from        sidekiq.SortedSetKind
+	timeout     time.Duration
+	started     time.Time
+	steps       []jobWatchStep
+	outcome     jobWatchOutcome
+	misses      int       // Consecutive lookups that found the job nowhere
+	missedSince time.Time // When the current run of misses began
+	seq         int       // Lookups from an older seq are ignored
+	pollRequest requestctx.Controller
+	table       table.Model
+	frameStyles frame.Styles
+}
+
+// NewJobWatch creates a new JobWatch view.
+func NewJobWatch(client sidekiq.API) *JobWatch {
+	return &JobWatch{
+		client:  client,
+		timeout: DefaultWatchTimeout,
+		table: table.New(
+			table.WithColumns(jobWatchColumns),
+			table.WithEmptyMessage("Looking for the job..."),
+		),
+	}
+}
+
+var jobWatchColumns = []table.Column{
+	{Title: "Time", Width: 8},
+	{Title: "After", Width: 6, Align: table.AlignRight},
+	{Title: "Location", Width: 50},
+}
+
+// Init implements View.
+func (w *JobWatch) Init() tea.Cmd {
+	w.started = time.Now()
+	w.steps = nil
+	w.outcome = jobWatchActive
+	w.misses = 0
+	w.missedSince = time.Time{}
+	w.updateTableRows()
+	return w.pollCmd()
+}
+
+// Update implements View.
+func (w *JobWatch) Update(msg tea.Msg) (View, tea.Cmd) {
+	switch msg := msg.(type) {
+	case jobWatchTickMsg:
+		if msg.seq != w.seq || w.outcome != jobWatchActive {
+			return w, nil
+		}
+		return w, w.pollCmd()
+
+	case jobWatchLocationMsg:
+		if msg.seq != w.seq || w.outcome != jobWatchActive {
+			return w, nil
+		}
+		return w, w.observe(msg.location, msg.at)
+
+	case RefreshMsg, RefreshViewMsg:
+		// Ticks are only delivered to the active view, so a watch shown
+		// again after being covered picks up from here.
+		if w.outcome != jobWatchActive || w.job == nil {
+			return w, nil
+		}
+		return w, w.pollCmd()
+
+	case tea.KeyPressMsg:
+		if w.table.JumpActive() {
+			w.table, _ = w.table.Update(msg)
+			return w, nil
+		}
+		if msg.String() == "c" && w.job != nil {
+			return w, copyTextCmd(w.job.JID())
+		}
+		w.table, _ = w.table.Update(msg)
+	}
+
+	return w, nil
+}
+
+// View implements View.
+func (w *JobWatch) View() string {
+	if w.job == nil {
+		return renderStatusMessage("Watch Job", "No job to watch", w.styles, w.width, w.height)
+	}
+
+	box := frame.New(
+		frame.WithStyles(w.frameStyles),
+		frame.WithTitle("Watch "+w.job.DisplayClass()),
+		frame.WithTitlePadding(0),
+		frame.WithMeta(w.statusText()),
+		frame.WithContent(w.table.View()),
+		frame.WithPadding(1),
+		frame.WithSize(w.width, w.height),
+		frame.WithMinHeight(5),
+		frame.WithFocused(true),
+	)
+	return box.View()
+}
+
+// Name implements View.
+func (w *JobWatch) Name() string {
+	return "Watch"
+}
+
+// ShortHelp implements View.
+func (w *JobWatch) ShortHelp() []key.Binding {
+	return nil
+}
+
+// ContextItems implements ContextProvider.
+func (w *JobWatch) ContextItems() []ContextItem {
+	if w.job == nil {
+		return nil
+	}
+	return []ContextItem{
+		{Label: "Job", Value: w.job.DisplayClass()},
+		{Label: "JID", Value: w.job.JID()},
+		{Label: "Retried From", Value: w.from.String()},
+		{Label: "Status", Value: w.statusText()},
+		{Label: "Watching", Value: display.Duration(int64(w.elapsed().Seconds())) + " of " + display.Duration(int64(w.timeout.Seconds()))},
+	}
+}
+
+// HintBindings implements HintProvider.
+func (w *JobWatch) HintBindings() []key.Binding {
+	return []key.Binding{
+		helpBinding([]string{"c"}, "c", "copy jid"),
+	}
+}
+
+// HelpSections implements HelpProvider.
+func (w *JobWatch) HelpSections() []HelpSection {
+	return []HelpSection{{
+		Title: "Watch Job",
+		Bindings: []key.Binding{
+			helpBinding([]string{"c"}, "c", "copy jid"),
+		},
+		Lines: []string{
+			"A job missing from every queue and set for over 10s is presumed completed.",
+		},
+	}}
+}
+
+// TableHelp implements TableHelpProvider.
+func (w *JobWatch) TableHelp() []key.Binding {
+	return tableHelpBindings(w.table.KeyMap)
+}
+
+// SetSize implements View.
+func (w *JobWatch) SetSize(width, height int) View {
+	w.width = width
+	w.height = height
+	tableWidth, tableHeight := framedTableSize(width, height)
+	w.table.SetSize(tableWidth, tableHeight)
+	return w
+}
+
+// SetStyles implements View.
+func (w *JobWatch) SetStyles(styles Styles) View {
+	w.styles = styles
+	w.frameStyles = frameStylesFromTheme(styles)
+	w.table.SetStyles(tableStylesFromTheme(styles))
+	w.updateTableRows()
+	return w
+}
+
+// SetJobWatch implements JobWatchSetter. The job must be the record exactly
+// as it was pushed to its queue, which is how the watch finds it there.
+func (w *JobWatch) SetJobWatch(job *sidekiq.JobRecord, from sidekiq.SortedSetKind) {
+	w.job = job
+	w.from = from
+}
+
+// SetWatchTimeout implements WatchTimeoutSetter. Values below the poll
+// interval are raised to it, so the job is looked up at least once.
+func (w *JobWatch) SetWatchTimeout(timeout time.Duration) {
+	w.timeout = max(timeout, jobWatchPollInterval)
+}
+
+// InputFocused implements InputFocuser.
+func (w *JobWatch) InputFocused() bool {
+	return w.table.JumpActive()
+}
+
+// Dispose clears cached data when the view is removed from the stack.
+func (w *JobWatch) Dispose() {
+	w.pollRequest.Cancel()
+	w.seq++
+	w.job = nil
+	w.steps = nil
+	w.outcome = jobWatchActive
+	w.misses = 0
+	w.missedSince = time.Time{}
+	w.table.SetRows(nil)
+	w.table.SetCursor(0)
+}
+
+// CancelRequests stops an in-flight lookup when the view is hidden.
+func (w *JobWatch) CancelRequests() {
+	w.pollRequest.Cancel()
+}
+
+// observe records where the job was found and decides whether to keep
+// watching.
+func (w *JobWatch) observe(location sidekiq.JobLocation, at time.Time) tea.Cmd {
+	if len(w.steps) == 0 || w.steps[len(w.steps)-1].location != location {
+		w.steps = append(w.steps, jobWatchStep{at: at, location: location})
+		w.updateTableRows()
+		w.table.GotoBottom()
+	}
+
+	if location.Kind == sidekiq.JobLocationNone {
+		if w.misses == 0 {
+			w.missedSince = at
+		}
+		w.misses++
+	} else {
+		w.misses = 0
+	}
+
+	switch {
+	case w.misses >= jobWatchMissingChecks && at.Sub(w.missedSince) > jobWatchMissingFor:
+		w.outcome = jobWatchCompleted
+	case location.Kind == sidekiq.JobLocationDead:
+		w.outcome = jobWatchDead
+	case at.Sub(w.started) >= w.timeout:
+		w.outcome = jobWatchTimedOut
+	}
+	if w.outcome != jobWatchActive {
+		return nil
+	}
+
+	seq := w.seq
+	return tea.Tick(jobWatchPollInterval, func(time.Time) tea.Msg {
+		return jobWatchTickMsg{seq: seq}
+	})
+}
+
+// pollCmd looks the job up once. Starting a lookup supersedes any earlier
+// one, so only one chain of lookups runs at a time.
+func (w *JobWatch) pollCmd() tea.Cmd {
+	if w.job == nil || w.client == nil {
+		return nil
+	}
+	w.seq++
+	seq := w.seq
+	client := w.client
+	job := w.job
+	ctx := w.pollRequest.Start(devtools.WithTracker(context.Background(), "job_watch.pollCmd"))
+	return func() tea.Msg {
+		location, err := client.LocateJob(ctx, job)
+		if err != nil {
+			if requestctx.IsCanceled(err) {
+				return nil
+			}
+			return ConnectionErrorMsg{Err: err}
+		}
+		return jobWatchLocationMsg{seq: seq, location: location, at: time.Now()}
+	}
+}
+
+// elapsed returns how long the job has been watched, frozen once the watch
+// ends.
+func (w *JobWatch) elapsed() time.Duration {
+	if w.started.IsZero() {
+		return 0
+	}
+	if w.outcome != jobWatchActive && len(w.steps) > 0 {
+		return w.steps[len(w.steps)-1].at.Sub(w.started)
+	}
+	return time.Since(w.started)
+}
+
+// statusText describes where the job is now, or how the watch ended.
+func (w *JobWatch) statusText() string {
+	var last sidekiq.JobLocation
+	if len(w.steps) > 0 {
+		last = w.steps[len(w.steps)-1].location
+	}
+	switch w.outcome {
+	case jobWatchCompleted:
+		return w.styles.ChartSuccess.Render("completed")
+	case jobWatchDead:
+		return w.styles.ErrorText.Render("failed, back in dead")
+	case jobWatchTimedOut:
+		return w.styles.WarningText.Render("timed out") + w.styles.Muted.Render(", last seen "+jobWatchLocationText(last))
+	}
+	if len(w.steps) == 0 {
+		return w.styles.Muted.Render("looking…")
+	}
+	return jobWatchLocationText(last)
+}
+
+// jobWatchLocationText describes a location for the watch table.
+func jobWatchLocationText(location sidekiq.JobLocation) string {
+	switch location.Kind {
+	case sidekiq.JobLocationQueue:
+		return "waiting in queue " + location.Name
+	case sidekiq.JobLocationBusy:
+		return "running on " + location.Name
+	case sidekiq.JobLocationRetry:
+		return "failed, waiting to retry"
+	case sidekiq.JobLocationScheduled:
+		return "scheduled"
+	case sidekiq.JobLocationDead:
+		return "dead"
+	default:
+		return "gone from every queue and set"
+	}
+}
+
+func (w *JobWatch) updateTableRows() {
+	rows := make([]table.Row, len(w.steps))
+	for i, step := range w.steps {
+		rows[i] = table.Row{
+			ID: strconv.Itoa(i),
+			Cells: []string{
+				step.at.Format("15:04:05"),
+				"+" + display.Duration(int64(step.at.Sub(w.started).Seconds())),
+				jobWatchLocationText(step.location),
+			},
+		}
+	}
+	w.table.SetRows(rows)
+}
+
+// retryAndWatchCmd enqueues a sorted-set job now and opens the job watch once
+// it is in its queue.
+func retryAndWatchCmd(client sidekiq.API, kind sidekiq.SortedSetKind, entry *sidekiq.SortedEntry, caller string) tea.Cmd {
+	return func() tea.Msg {
+		ctx := devtools.WithTracker(context.Background(), caller)
+		job, err := client.EnqueueSortedEntryJob(ctx, kind, entry)
+		if err != nil {
+			return sortedEntryErrorMsg(entry, err)
+		}
+		return ShowJobWatchMsg{Job: job, From: kind}
+	}
+}
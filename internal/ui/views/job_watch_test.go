@@ -0,0 +1,178 @@
+package views
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/components/table"
+	"github.com/kpumuk/lazykiq/internal/ui/dialogs"
+	confirmdialog "github.com/kpumuk/lazykiq/internal/ui/dialogs/confirm"
+)
+
+type jobWatchStub struct {
+	sidekiq.API
+	locations []sidekiq.JobLocation
+	lookups   int
+	queue     string
+}
+
+func (s *jobWatchStub) LocateJob(_ context.Context, job *sidekiq.JobRecord) (sidekiq.JobLocation, error) {
+	s.queue = job.Queue()
+	location := s.locations[min(s.lookups, len(s.locations)-1)]
+	s.lookups++
+	return location, nil
+}
+
+// runJobWatch feeds lookups into the view until the watch stops asking for
+// more, without waiting for the poll interval. Each lookup is dated 5s after
+// the previous one.
+func runJobWatch(t *testing.T, view *JobWatch, cmd tea.Cmd) {
+	t.Helper()
+	for i := range 20 {
+		if cmd == nil {
+			return
+		}
+		msg, ok := cmd().(jobWatchLocationMsg)
+		if !ok {
+			t.Fatal("expected a job location")
+		}
+		msg.at = view.started.Add(time.Duration(i) * 5 * time.Second)
+		_, cmd = view.Update(msg)
+		if cmd == nil {
+			return
+		}
+		_, cmd = view.Update(jobWatchTickMsg{seq: view.seq})
+	}
+	t.Fatal("watch did not finish")
+}
+
+func TestJobWatchFollowsJobUntilCompleted(t *testing.T) {
+	stub := &jobWatchStub{locations: []sidekiq.JobLocation{
+		{Kind: sidekiq.JobLocationQueue, Name: "default"},
+		{Kind: sidekiq.JobLocationQueue, Name: "default"},
+		{Kind: sidekiq.JobLocationBusy, Name: "host1:100:abc"},
+		{},
+	}}
+	view := NewJobWatch(stub)
+	view.SetStyles(Styles{})
+	view.SetSize(100, 20)
+	view.SetJobWatch(sidekiq.NewJobRecord(`{"jid":"job-1","class":"MyJob","queue":"default"}`, ""), sidekiq.SortedSetDead)
+
+	runJobWatch(t, view, view.Init())
+
+	if stub.queue != "default" {
+		t.Fatalf("looked up in queue %q, want default", stub.queue)
+	}
+	if got := contextValue(view.ContextItems(), "Status"); got != "completed" {
+		t.Fatalf("Status = %q, want completed", got)
+	}
+	output := ansi.Strip(view.View())
+	for _, want := range []string{"waiting in queue default", "running on host1:100:abc", "gone from every queue and set"} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("view missing %q:\n%s", want, output)
+		}
+	}
+	if got := len(view.steps); got != 3 {
+		t.Fatalf("recorded %d steps, want 3 location changes", got)
+	}
+}
+
+func TestJobWatchKeepsWatchingBetweenFetchAndHeartbeat(t *testing.T) {
+	stub := &jobWatchStub{locations: []sidekiq.JobLocation{
+		{Kind: sidekiq.JobLocationQueue, Name: "default"},
+		{},
+		{},
+		{Kind: sidekiq.JobLocationBusy, Name: "host1:100:abc"},
+		{Kind: sidekiq.JobLocationDead},
+	}}
+	view := NewJobWatch(stub)
+	view.SetStyles(Styles{})
+	view.SetJobWatch(sidekiq.NewJobRecord(`{"jid":"job-1","class":"MyJob","queue":"default"}`, ""), sidekiq.SortedSetDead)
+
+	runJobWatch(t, view, view.Init())
+
+	if got := contextValue(view.ContextItems(), "Status"); got != "failed, back in dead" {
+		t.Fatalf("Status = %q, want failed, back in dead", got)
+	}
+	if stub.lookups != 5 {
+		t.Fatalf("looked the job up %d times, want 5", stub.lookups)
+	}
+}
+
+func TestJobWatchStopsWhenDeadAgain(t *testing.T) {
+	stub := &jobWatchStub{locations: []sidekiq.JobLocation{
+		{Kind: sidekiq.JobLocationBusy, Name: "host1:100:abc"},
+		{Kind: sidekiq.JobLocationDead},
+	}}
+	view := NewJobWatch(stub)
+	view.SetStyles(Styles{})
+	view.SetJobWatch(sidekiq.NewJobRecord(`{"jid":"job-1","class":"MyJob","queue":"default"}`, ""), sidekiq.SortedSetRetry)
+
+	runJobWatch(t, view, view.Init())
+
+	if got := contextValue(view.ContextItems(), "Status"); got != "failed, back in dead" {
+		t.Fatalf("Status = %q, want failed, back in dead", got)
+	}
+	if _, cmd := view.Update(RefreshMsg{}); cmd != nil {
+		t.Fatal("a finished watch must not poll on refresh")
+	}
+}
+
+func TestJobWatchTimesOut(t *testing.T) {
+	stub := &jobWatchStub{locations: []sidekiq.JobLocation{
+		{Kind: sidekiq.JobLocationRetry},
+	}}
+	view := NewJobWatch(stub)
+	view.SetStyles(Styles{})
+	view.SetWatchTimeout(time.Second)
+	view.SetJobWatch(sidekiq.NewJobRecord(`{"jid":"job-1","class":"MyJob","queue":"default"}`, ""), sidekiq.SortedSetRetry)
+
+	cmd := view.Init()
+	view.started = view.started.Add(-2 * time.Second)
+	runJobWatch(t, view, cmd)
+
+	if got := contextValue(view.ContextItems(), "Status"); got != "timed out, last seen failed, waiting to retry" {
+		t.Fatalf("Status = %q, want timed out with the retry set", got)
+	}
+}
+
+func TestDeadRetryAndWatch(t *testing.T) {
+	stub := &deadActionsStub{}
+	view := NewDead(stub)
+	view.SetDangerousActionsEnabled(true)
+
+	entry := sidekiq.NewSortedEntry(`{"jid":"dead-1","class":"MyJob","queue":"default"}`, 1700000000)
+	view.jobs = []*sidekiq.SortedEntry{entry}
+	view.lazy.SetSize(80, 10)
+	view.lazy.Table().SetRows([]table.Row{{ID: entry.JID(), Cells: []string{"row"}}})
+	view.lazy.Table().SetCursor(0)
+
+	_, cmd := view.Update(tea.KeyPressMsg(tea.Key{Code: 'W', Text: "W"}))
+	if cmd == nil {
+		t.Fatal("expected retry and watch confirm command")
+	}
+	if open, ok := cmd().(dialogs.OpenDialogMsg); !ok || open.Model.ID() != confirmdialog.DialogID {
+		t.Fatal("expected retry and watch confirm dialog")
+	}
+
+	_, cmd = view.Update(confirmdialog.ActionMsg{Confirmed: true, Target: entry.JID()})
+	if cmd == nil {
+		t.Fatal("expected retry command")
+	}
+	msg, ok := cmd().(ShowJobWatchMsg)
+	if !ok {
+		t.Fatal("expected ShowJobWatchMsg after retry")
+	}
+	if msg.Job.JID() != "dead-1" || msg.From != sidekiq.SortedSetDead {
+		t.Fatalf("watch = %s from %s, want dead-1 from dead", msg.Job.JID(), msg.From)
+	}
+	if stub.enqueued != entry || stub.toFront {
+		t.Fatal("job was not enqueued at the back of its queue")
+	}
+}
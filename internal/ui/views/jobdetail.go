@@ -1,7 +1,9 @@
 package views
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"strconv"
@@ -13,26 +15,44 @@ import (
 	"charm.land/lipgloss/v2"
 	"github.com/charmbracelet/x/ansi"
 
+	"github.com/kpumuk/lazykiq/internal/devtools"
 	"github.com/kpumuk/lazykiq/internal/mathutil"
 	"github.com/kpumuk/lazykiq/internal/sidekiq"
 	"github.com/kpumuk/lazykiq/internal/ui/components/frame"
 	"github.com/kpumuk/lazykiq/internal/ui/components/jsonview"
 	"github.com/kpumuk/lazykiq/internal/ui/components/messagebox"
+	"github.com/kpumuk/lazykiq/internal/ui/dialogs"
+	confirmdialog "github.com/kpumuk/lazykiq/internal/ui/dialogs/confirm"
+	promptdialog "github.com/kpumuk/lazykiq/internal/ui/dialogs/prompt"
 	"github.com/kpumuk/lazykiq/internal/ui/display"
+	"github.com/kpumuk/lazykiq/internal/ui/requestctx"
 )
 
 // KeyMap defines keybindings for the job detail view.
 type KeyMap struct {
-	SwitchPanel key.Binding
-	CopyJSON    key.Binding
-	LineUp      key.Binding
-	LineDown    key.Binding
-	ScrollLeft  key.Binding
-	ScrollRight key.Binding
-	GotoTop     key.Binding
-	GotoBottom  key.Binding
-	Home        key.Binding
-	End         key.Binding
+	SwitchPanel  key.Binding
+	Collapse     key.Binding
+	CopyJSON     key.Binding
+	CopyPath     key.Binding
+	CopyKey      key.Binding
+	CopyTrace    key.Binding
+	CopyTraceID  key.Binding
+	CopyTraceURL key.Binding
+	Decode       key.Binding
+	OpenBatch    key.Binding
+	OpenQueue    key.Binding
+	Compare      key.Binding
+	Enqueue      key.Binding
+	PrevJob      key.Binding
+	NextJob      key.Binding
+	LineUp       key.Binding
+	LineDown     key.Binding
+	ScrollLeft   key.Binding
+	ScrollRight  key.Binding
+	GotoTop      key.Binding
+	GotoBottom   key.Binding
+	Home         key.Binding
+	End          key.Binding
 }
 
 // DefaultKeyMap returns default keybindings.
@@ -42,10 +62,65 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("tab"),
 			key.WithHelp("tab", "switch panel"),
 		),
+		Collapse: key.NewBinding(
+			key.WithKeys("\\"),
+			key.WithHelp("\\", "collapse / expand panel"),
+		),
 		CopyJSON: key.NewBinding(
 			key.WithKeys("c"),
 			key.WithHelp("c", "copy json"),
 		),
+		CopyPath: key.NewBinding(
+			key.WithKeys("y"),
+			key.WithHelp("y", "copy json path"),
+		),
+		CopyKey: key.NewBinding(
+			key.WithKeys("Y"),
+			key.WithHelp("Y", "copy redis command"),
+		),
+		CopyTrace: key.NewBinding(
+			key.WithKeys("B"),
+			key.WithHelp("B", "copy error backtrace"),
+		),
+		CopyTraceID: key.NewBinding(
+			key.WithKeys("t"),
+			key.WithHelp("t", "copy trace id"),
+		),
+		CopyTraceURL: key.NewBinding(
+			key.WithKeys("T"),
+			key.WithHelp("T", "copy trace url"),
+			key.WithDisabled(),
+		),
+		Decode: key.NewBinding(
+			key.WithKeys("d"),
+			key.WithHelp("d", "decode base64/zlib value"),
+		),
+		OpenBatch: key.NewBinding(
+			key.WithKeys("b"),
+			key.WithHelp("b", "open batch"),
+		),
+		OpenQueue: key.NewBinding(
+			key.WithKeys("Q"),
+			key.WithHelp("Q", "go to queue"),
+		),
+		Compare: key.NewBinding(
+			key.WithKeys("="),
+			key.WithHelp("=", "mark / compare job"),
+		),
+		Enqueue: key.NewBinding(
+			key.WithKeys("ctrl+n"),
+			key.WithHelp("ctrl+n", "enqueue copies"),
+		),
+		PrevJob: key.NewBinding(
+			key.WithKeys("["),
+			key.WithHelp("[", "previous job in list"),
+			key.WithDisabled(),
+		),
+		NextJob: key.NewBinding(
+			key.WithKeys("]"),
+			key.WithHelp("]", "next job in list"),
+			key.WithDisabled(),
+		),
 		LineUp: key.NewBinding(
 			key.WithKeys("up", "k"),
 			key.WithHelp("j/k", "scroll"),
@@ -101,24 +176,55 @@ type jobDetailStyles struct {
 	FilterBlurred   lipgloss.Style
 }
 
-// PropertyRow represents a key-value pair for display.
+// PropertyRow represents a key-value pair for display. Rows with a Depth
+// above zero are nested under the preceding section row (such as "Args") and
+// render inline as "key: value", indented by depth.
 type PropertyRow struct {
 	Label string
 	Value string
+	Depth int
 }
 
 // JobDetail shows a full job detail panel.
 type JobDetail struct {
+	client sidekiq.API
 	KeyMap KeyMap
 	styles jobDetailStyles
 	width  int
 	height int
 
+	// dialogStyles keeps the shared styles for prompt and confirm dialogs.
+	dialogStyles Styles
+
+	// argsDepth limits how deep the Args tree expands nested values
+	argsDepth int
+	// retryBackoff scales Sidekiq's default backoff in retry estimates.
+	retryBackoff float64
+	// largePayload is the payload size flagged as large.
+	largePayload int64
+	// traceURL links the job's trace id to a tracing backend.
+	traceURL TraceURL
+
+	dangerousActionsEnabled bool
+	enqueueAllowed          bool // Set by --allow-enqueue; copies also need danger mode
+	pendingCopies           int
+	copiesStatus            string
+
+	// diffBase is the job marked as A for comparison. It survives SetJob so
+	// another job can be opened and compared against it.
+	diffBase *sidekiq.JobRecord
+
 	// Job data
 	job        *sidekiq.JobRecord
+	source     JobSource
 	properties []PropertyRow
 	jsonView   jsonview.Model
 
+	// siblings steps through the list the job was opened from
+	siblings       JobSiblings
+	siblingRequest requestctx.Controller
+	note           string // Brief notice shown until the next key press
+
 	// Scroll state
 	leftYOffset  int
 	rightYOffset int
@@ -127,22 +233,51 @@ type JobDetail struct {
 	// Focus state (false = left panel, true = right panel)
 	focusRight bool
 
+	// collapsed shows only the focused panel at full width. It survives
+	// SetJob so the layout sticks while browsing jobs.
+	collapsed bool
+
 	// Calculated dimensions
 	leftWidth   int
 	rightWidth  int
 	panelHeight int
 }
 
+// DefaultArgsDepth is how many levels of nested job arguments the job details
+// panel expands before collapsing them to "{…}" or "[…]".
+const DefaultArgsDepth = 3
+
 const (
 	jobDetailPanelPadding = 1
 	jobDetailValueIndent  = 2
+	// jobDetailCopiesDefault pre-fills the count prompt for enqueuing copies.
+	jobDetailCopiesDefault = "10"
+	jobDetailCopiesTarget  = "job.enqueue_copies"
 )
 
+// jobSiblingMsg carries the job stepped to with [ or ].
+type jobSiblingMsg struct {
+	sibling JobSibling
+	note    string
+}
+
+// jobCopiesEnqueuedMsg reports how many copies of a job were enqueued.
+type jobCopiesEnqueuedMsg struct {
+	jid   string
+	queue string
+	count int
+	err   error
+}
+
 // NewJobDetail creates a new job detail view.
-func NewJobDetail() *JobDetail {
+func NewJobDetail(client sidekiq.API) *JobDetail {
 	return &JobDetail{
-		KeyMap:   DefaultKeyMap(),
-		jsonView: jsonview.New(),
+		client:       client,
+		KeyMap:       DefaultKeyMap(),
+		jsonView:     jsonview.New(),
+		argsDepth:    DefaultArgsDepth,
+		retryBackoff: DefaultRetryBackoff,
+		largePayload: DefaultLargePayload,
 	}
 }
 
@@ -154,14 +289,108 @@ func (j *JobDetail) Init() tea.Cmd {
 // Update implements View.
 func (j *JobDetail) Update(msg tea.Msg) (View, tea.Cmd) {
 	switch msg := msg.(type) {
+	case jobCopiesEnqueuedMsg:
+		current := j.job != nil && j.job.JID() == msg.jid
+		if msg.err != nil {
+			if current {
+				j.copiesStatus = "failed"
+			}
+			return j, func() tea.Msg { return ConnectionErrorMsg{Err: msg.err} }
+		}
+		if current {
+			j.copiesStatus = fmt.Sprintf("enqueued %d to %s", msg.count, msg.queue)
+		}
+		return j, nil
+
+	case promptdialog.ActionMsg:
+		if msg.Target != jobDetailCopiesTarget || !j.copiesEnabled() || j.job == nil {
+			return j, nil
+		}
+		count, err := parseCopyCount(msg.Value)
+		if err != nil {
+			return j, nil
+		}
+		j.pendingCopies = count
+		return j, j.openEnqueueConfirm(count)
+
+	case confirmdialog.ActionMsg:
+		if msg.Target != jobDetailCopiesTarget {
+			return j, nil
+		}
+		count := j.pendingCopies
+		j.pendingCopies = 0
+		if !j.copiesEnabled() || !msg.Confirmed || count <= 0 || j.job == nil {
+			return j, nil
+		}
+		j.copiesStatus = fmt.Sprintf("enqueuing %d…", count)
+		return j, j.enqueueCopiesCmd(j.job, count)
+
+	case jobSiblingMsg:
+		j.showSibling(msg)
+		return j, nil
+
 	case tea.KeyPressMsg:
+		j.note = ""
 		switch {
+		case key.Matches(msg, j.KeyMap.PrevJob):
+			return j, j.stepSiblingCmd(-1)
+
+		case key.Matches(msg, j.KeyMap.NextJob):
+			return j, j.stepSiblingCmd(1)
+
+		case key.Matches(msg, j.KeyMap.Enqueue):
+			if j.copiesEnabled() && j.job != nil && j.job.Queue() != "" {
+				return j, j.openEnqueuePrompt()
+			}
+
 		case key.Matches(msg, j.KeyMap.SwitchPanel):
 			j.focusRight = !j.focusRight
+			if j.collapsed {
+				j.updateDimensions()
+				j.clampScroll()
+			}
+
+		case key.Matches(msg, j.KeyMap.Collapse):
+			j.toggleCollapsed()
 
 		case key.Matches(msg, j.KeyMap.CopyJSON):
 			return j, copyTextCmd(j.jobJSON())
 
+		case key.Matches(msg, j.KeyMap.CopyPath):
+			// The top line of the JSON panel acts as its cursor.
+			if path := j.jsonView.Path(j.rightYOffset); path != "" {
+				return j, copyTextCmd(path)
+			}
+
+		case key.Matches(msg, j.KeyMap.CopyKey):
+			return j, copyTextCmd(j.source.Command)
+
+		case key.Matches(msg, j.KeyMap.CopyTrace):
+			return j, j.copyBacktraceCmd()
+
+		case key.Matches(msg, j.KeyMap.CopyTraceID):
+			return j, j.copyTraceCmd(j.traceID(), "trace id")
+
+		case key.Matches(msg, j.KeyMap.CopyTraceURL):
+			return j, j.copyTraceCmd(j.traceURL.Render(j.traceID()), "trace url")
+
+		case key.Matches(msg, j.KeyMap.Decode):
+			return j, j.openDecodeDialog()
+
+		case key.Matches(msg, j.KeyMap.OpenBatch):
+			if bid := j.batchID(); bid != "" {
+				return j, func() tea.Msg { return ShowBatchMsg{BID: bid} }
+			}
+
+		case key.Matches(msg, j.KeyMap.OpenQueue):
+			if j.job != nil && j.job.Queue() != "" {
+				queue := j.job.Queue()
+				return j, func() tea.Msg { return ShowQueueDetailsMsg{QueueName: queue} }
+			}
+
+		case key.Matches(msg, j.KeyMap.Compare):
+			return j, j.compare()
+
 		case key.Matches(msg, j.KeyMap.LineUp):
 			if j.focusRight {
 				j.rightYOffset = mathutil.Clamp(j.rightYOffset-1, 0, j.maxRightYOffset())
@@ -230,6 +459,13 @@ func (j *JobDetail) View() string {
 		).View()
 	}
 
+	if j.collapsed {
+		if j.focusRight {
+			return j.renderRightPanel()
+		}
+		return j.renderLeftPanel()
+	}
+
 	leftPanel := j.renderLeftPanel()
 	rightPanel := j.renderRightPanel()
 
@@ -272,32 +508,83 @@ func (j *JobDetail) ContextItems() []ContextItem {
 		latency = display.Duration(int64(math.Round(value)))
 	}
 
-	return []ContextItem{
+	items := []ContextItem{
 		{Label: "JID", Value: j.job.JID()},
 		{Label: "Queue", Value: queue},
 		{Label: "Class", Value: className},
 		{Label: "Latency", Value: latency},
 	}
+	if j.copiesStatus != "" {
+		items = append(items, ContextItem{Label: "Copies", Value: j.copiesStatus})
+	}
+	if j.diffBase != nil {
+		items = append(items, ContextItem{Label: "Diff A", Value: j.diffBase.JID()})
+	}
+	if j.siblings.Fetch != nil && j.siblings.Total > 0 {
+		items = append(items, ContextItem{
+			Label: "Position",
+			Value: fmt.Sprintf("%s of %s", display.Number(int64(j.siblings.Index+1)), display.Number(int64(j.siblings.Total))),
+		})
+	}
+	if j.note != "" {
+		items = append(items, ContextItem{Label: "Note", Value: j.styles.Muted.Render(j.note)})
+	}
+	return items
 }
 
 // HintBindings implements HintProvider.
 func (j *JobDetail) HintBindings() []key.Binding {
-	return []key.Binding{
+	bindings := []key.Binding{
 		helpBinding([]string{"tab"}, "tab", "switch panel"),
+		j.KeyMap.Collapse,
 		helpBinding([]string{"c"}, "c", "copy json"),
 		helpBinding([]string{"j"}, "j/k", "scroll"),
 		helpBinding([]string{"h"}, "h/l", "scroll left/right"),
 	}
+	if j.job != nil && j.job.Queue() != "" {
+		bindings = append(bindings, j.KeyMap.OpenQueue)
+	}
+	bindings = append(bindings, j.KeyMap.Compare)
+	if j.siblings.Fetch != nil {
+		bindings = append(bindings, helpBinding([]string{"[", "]"}, "[ ⋰ ]", "prev/next job"))
+	}
+	if j.batchID() != "" {
+		bindings = append(bindings, j.KeyMap.OpenBatch)
+	}
+	if j.traceID() != "" {
+		bindings = append(bindings, j.KeyMap.CopyTraceID, j.KeyMap.CopyTraceURL)
+	}
+	return bindings
+}
+
+// MutationBindings implements MutationHintProvider.
+func (j *JobDetail) MutationBindings() []key.Binding {
+	if !j.copiesEnabled() {
+		return nil
+	}
+	return []key.Binding{j.KeyMap.Enqueue}
 }
 
 // HelpSections implements HelpProvider.
 func (j *JobDetail) HelpSections() []HelpSection {
-	return []HelpSection{
+	sections := []HelpSection{
 		{
 			Title: "Job Detail",
 			Bindings: []key.Binding{
 				j.KeyMap.SwitchPanel,
+				j.KeyMap.Collapse,
 				j.KeyMap.CopyJSON,
+				j.KeyMap.CopyPath,
+				j.KeyMap.CopyKey,
+				j.KeyMap.CopyTrace,
+				j.KeyMap.CopyTraceID,
+				j.KeyMap.CopyTraceURL,
+				j.KeyMap.Decode,
+				j.KeyMap.OpenBatch,
+				j.KeyMap.OpenQueue,
+				j.KeyMap.Compare,
+				j.KeyMap.PrevJob,
+				j.KeyMap.NextJob,
 				j.KeyMap.LineUp,
 				j.KeyMap.LineDown,
 				j.KeyMap.ScrollLeft,
@@ -309,6 +596,124 @@ func (j *JobDetail) HelpSections() []HelpSection {
 			},
 		},
 	}
+	if j.copiesEnabled() {
+		sections = append(sections, HelpSection{
+			Title:    "Dangerous Actions",
+			Bindings: []key.Binding{j.KeyMap.Enqueue},
+		})
+	}
+	return sections
+}
+
+// SetDangerousActionsEnabled toggles mutational actions for the view.
+func (j *JobDetail) SetDangerousActionsEnabled(enabled bool) {
+	j.dangerousActionsEnabled = enabled
+}
+
+// SetEnqueueAllowed implements EnqueueAllowedSetter.
+func (j *JobDetail) SetEnqueueAllowed(allowed bool) {
+	j.enqueueAllowed = allowed
+}
+
+// copiesEnabled reports whether copies of the job may be enqueued, which
+// takes both --danger and --allow-enqueue.
+func (j *JobDetail) copiesEnabled() bool {
+	return j.dangerousActionsEnabled && j.enqueueAllowed
+}
+
+// openDecodeDialog decodes the string value on the top line of the JSON
+// panel, which acts as its cursor, and shows the result in a popup.
+func (j *JobDetail) openDecodeDialog() tea.Cmd {
+	if j.job == nil {
+		return nil
+	}
+	title := "Decode"
+	if path := j.jsonView.Path(j.rightYOffset); path != "" {
+		title = "Decode " + path
+	}
+	note := "No string value"
+	text := "Scroll the JSON panel so a string value is on its top line."
+	if value, ok := j.jsonView.StringValue(j.rightYOffset); ok {
+		note, text, _ = decodeBlob(value)
+	}
+	styles := j.dialogStyles
+	return func() tea.Msg {
+		return dialogs.OpenDialogMsg{Model: newTextDialog(styles, title, note, text)}
+	}
+}
+
+// toggleCollapsed collapses the properties panel to give the JSON panel the
+// full width, or restores the split. Collapsing always keeps the JSON panel;
+// tab then swaps which panel is shown.
+func (j *JobDetail) toggleCollapsed() {
+	j.collapsed = !j.collapsed
+	if j.collapsed {
+		j.focusRight = true
+	}
+	j.updateDimensions()
+	j.clampScroll()
+}
+
+// compare marks the shown job as A, or opens its diff against the job marked
+// earlier. Pressing it again on job A clears the mark.
+func (j *JobDetail) compare() tea.Cmd {
+	if j.job == nil {
+		return nil
+	}
+	if j.diffBase == nil {
+		j.diffBase = j.job
+		return nil
+	}
+	if j.diffBase.Value() == j.job.Value() {
+		j.diffBase = nil
+		return nil
+	}
+	a, b := j.diffBase, j.job
+	j.diffBase = nil
+	return func() tea.Msg { return ShowJobDiffMsg{A: a, B: b} }
+}
+
+// copyBacktraceCmd copies the job's error backtrace with its original line
+// breaks, ready to paste into an issue.
+func (j *JobDetail) copyBacktraceCmd() tea.Cmd {
+	if j.job == nil {
+		return nil
+	}
+	backtrace := j.job.ErrorBacktrace()
+	if len(backtrace) == 0 {
+		j.note = "no backtrace"
+		return nil
+	}
+	j.note = fmt.Sprintf("copied %d backtrace lines", len(backtrace))
+	return copyTextCmd(strings.Join(backtrace, "\n"))
+}
+
+// copyTraceCmd copies the job's trace id or trace URL, noting when the job
+// has no trace.
+func (j *JobDetail) copyTraceCmd(text, what string) tea.Cmd {
+	if j.job == nil {
+		return nil
+	}
+	if text == "" {
+		j.note = "no trace id"
+		return nil
+	}
+	j.note = "copied " + what
+	return copyTextCmd(text)
+}
+
+func (j *JobDetail) traceID() string {
+	if j.job == nil {
+		return ""
+	}
+	return j.job.TraceID()
+}
+
+func (j *JobDetail) batchID() string {
+	if j.job == nil {
+		return ""
+	}
+	return j.job.Bid()
 }
 
 // SetSize implements View.
@@ -323,6 +728,7 @@ func (j *JobDetail) SetSize(width, height int) View {
 
 // SetStyles implements View.
 func (j *JobDetail) SetStyles(styles Styles) View {
+	j.dialogStyles = styles
 	j.styles = jobDetailStyles{
 		Title:           styles.Title,
 		Label:           styles.Muted,
@@ -355,28 +761,135 @@ func (j *JobDetail) SetStyles(styles Styles) View {
 	return j
 }
 
+// SetArgsDepth implements ArgsDepthSetter.
+func (j *JobDetail) SetArgsDepth(depth int) {
+	j.argsDepth = depth
+	j.extractProperties()
+}
+
+// SetTraceURL implements TraceURLSetter.
+func (j *JobDetail) SetTraceURL(template TraceURL) {
+	j.traceURL = template
+	j.KeyMap.CopyTraceURL.SetEnabled(template != "")
+	j.extractProperties()
+}
+
 // SetJob sets the job to display.
 func (j *JobDetail) SetJob(job *sidekiq.JobRecord) {
 	j.job = job
+	j.source = JobSource{}
 	j.leftYOffset = 0
 	j.rightYOffset = 0
 	j.rightXOffset = 0
-	j.focusRight = false
+	if !j.collapsed {
+		j.focusRight = false
+	}
+	j.pendingCopies = 0
+	j.copiesStatus = ""
 
 	j.extractProperties()
 	j.formatJSON()
 }
 
+// SetJobSource sets where the displayed job lives in Redis. It must be called
+// after SetJob, which clears it.
+func (j *JobDetail) SetJobSource(source JobSource) {
+	j.source = source
+	j.extractProperties()
+}
+
+// SetRetryBackoff implements RetryBackoffSetter.
+func (j *JobDetail) SetRetryBackoff(multiplier float64) {
+	j.retryBackoff = multiplier
+	j.extractProperties()
+}
+
+// SetLargePayload implements LargePayloadSetter.
+func (j *JobDetail) SetLargePayload(threshold int64) {
+	j.largePayload = threshold
+	j.extractProperties()
+}
+
+// SetJobSiblings sets the list [ and ] step through. Without a fetcher the
+// keys are disabled.
+func (j *JobDetail) SetJobSiblings(siblings JobSiblings) {
+	j.siblingRequest.Cancel()
+	j.siblings = siblings
+	j.note = ""
+	enabled := siblings.Fetch != nil
+	j.KeyMap.PrevJob.SetEnabled(enabled)
+	j.KeyMap.NextJob.SetEnabled(enabled)
+}
+
 // Dispose clears cached data when the view is removed from the stack.
 func (j *JobDetail) Dispose() {
 	j.SetJob(nil)
+	j.SetJobSiblings(JobSiblings{})
+}
+
+// CancelRequests stops a pending step to another job when the view is hidden.
+func (j *JobDetail) CancelRequests() {
+	j.siblingRequest.Cancel()
+}
+
+// stepSiblingCmd loads the job delta positions away in the list the job was
+// opened from, wrapping around at either end.
+func (j *JobDetail) stepSiblingCmd(delta int) tea.Cmd {
+	fetch := j.siblings.Fetch
+	if fetch == nil || j.siblings.Total <= 0 {
+		return nil
+	}
+	index := j.siblings.Index + delta
+	note := ""
+	switch {
+	case index >= j.siblings.Total:
+		index = 0
+		note = "wrapped to the first job"
+	case index < 0:
+		index = j.siblings.Total - 1
+		note = "wrapped to the last job"
+	}
+	ctx := j.siblingRequest.Start(devtools.WithTracker(context.Background(), "jobdetail.stepSiblingCmd"))
+	return func() tea.Msg {
+		sibling, err := fetch(ctx, index)
+		if err != nil {
+			if requestctx.IsCanceled(err) {
+				return nil
+			}
+			return ConnectionErrorMsg{Err: err}
+		}
+		return jobSiblingMsg{sibling: sibling, note: note}
+	}
+}
+
+// showSibling displays the job stepped to, resetting scroll positions like
+// SetJob does.
+func (j *JobDetail) showSibling(msg jobSiblingMsg) {
+	j.siblings.Total = msg.sibling.Total
+	if msg.sibling.Job == nil {
+		j.note = "no jobs left in the list"
+		return
+	}
+	j.SetJob(msg.sibling.Job)
+	j.SetJobSource(msg.sibling.Source)
+	j.siblings.Index = msg.sibling.Index
+	j.note = msg.note
 }
 
 // updateDimensions recalculates panel dimensions.
 func (j *JobDetail) updateDimensions() {
-	// Split width: 40% left, 60% right (with 1 char gap)
-	j.leftWidth = max((j.width*40)/100, 30)
-	j.rightWidth = j.width - j.leftWidth
+	switch {
+	case !j.collapsed:
+		// Split width: 40% left, 60% right (with 1 char gap)
+		j.leftWidth = max((j.width*40)/100, 30)
+		j.rightWidth = j.width - j.leftWidth
+	case j.focusRight:
+		j.leftWidth = 0
+		j.rightWidth = j.width
+	default:
+		j.leftWidth = j.width
+		j.rightWidth = 0
+	}
 
 	// Height minus border (2 lines: top and bottom)
 	// Note: title is part of the top border, not a separate line
@@ -430,26 +943,7 @@ func (j *JobDetail) clampScroll() {
 
 // countLeftPanelLines counts total display lines in left panel (with wrapping).
 func (j *JobDetail) countLeftPanelLines() int {
-	if len(j.properties) == 0 {
-		return 0
-	}
-
-	// Calculate value width (same as in renderLeftPanel)
-	innerWidth := j.leftWidth - 2
-	contentWidth := max(innerWidth-2*jobDetailPanelPadding, 0)
-	valueWidth := max(contentWidth-jobDetailValueIndent, 10)
-
-	count := 0
-	for _, prop := range j.properties {
-		count++ // label line
-		lines := wrapText(prop.Value, valueWidth)
-		if len(lines) == 0 {
-			count++ // empty value line
-		} else {
-			count += len(lines)
-		}
-	}
-	return count
+	return len(j.leftPanelLines())
 }
 
 // extractProperties builds the properties list from job data.
@@ -464,8 +958,19 @@ func (j *JobDetail) extractProperties() {
 	if bid := j.job.Bid(); bid != "" {
 		j.properties = append(j.properties, PropertyRow{Label: "BID", Value: bid})
 	}
+	if traceID := j.job.TraceID(); traceID != "" {
+		j.properties = append(j.properties, PropertyRow{Label: "Trace ID", Value: traceID})
+		if traceURL := j.traceURL.Render(traceID); traceURL != "" {
+			j.properties = append(j.properties, PropertyRow{Label: "Trace URL", Value: traceURL})
+		}
+	}
 	j.properties = append(j.properties, PropertyRow{Label: "Queue", Value: j.job.Queue()})
 	j.properties = append(j.properties, PropertyRow{Label: "Class", Value: j.job.DisplayClass()})
+	payload := display.Bytes(payloadSize(j.job))
+	if isLargePayload(j.job, j.largePayload) {
+		payload += " (large)"
+	}
+	j.properties = append(j.properties, PropertyRow{Label: "Payload Size", Value: payload})
 
 	// Timestamps
 	if enqueuedAt := j.job.EnqueuedAt(); !enqueuedAt.IsZero() {
@@ -516,6 +1021,14 @@ func (j *JobDetail) extractProperties() {
 			Value: formatTimestamp(retriedAt),
 		})
 	}
+	if j.source.Key == sidekiq.SortedSetRetry.Key() && j.source.Score > 0 {
+		now := time.Now()
+		entry := &sidekiq.SortedEntry{JobRecord: j.job, Score: j.source.Score}
+		j.properties = append(j.properties,
+			PropertyRow{Label: "Next Retry", Value: formatUpcomingTimestamp(entry.At(), now)},
+			PropertyRow{Label: "Retry After", Value: nextRetryEstimateText(entry, j.retryBackoff, now)},
+		)
+	}
 	if backtrace := j.job.ErrorBacktrace(); len(backtrace) > 0 {
 		j.properties = append(j.properties, PropertyRow{
 			Label: "Backtrace",
@@ -523,13 +1036,17 @@ func (j *JobDetail) extractProperties() {
 		})
 	}
 
-	// Arguments summary
+	// Arguments tree
 	displayArgs := j.job.DisplayArgs()
 	if len(displayArgs) > 0 {
-		j.properties = append(j.properties, PropertyRow{
-			Label: "Args",
-			Value: display.Args(displayArgs),
-		})
+		j.properties = append(j.properties, PropertyRow{Label: "Args"})
+		for _, row := range display.ArgsTree(displayArgs, j.argsDepth) {
+			j.properties = append(j.properties, PropertyRow{
+				Label: row.Key,
+				Value: row.Value,
+				Depth: row.Depth + 1,
+			})
+		}
 	}
 }
 
@@ -539,7 +1056,8 @@ func (j *JobDetail) formatJSON() {
 		j.jsonView.SetValue(nil)
 		return
 	}
-	j.jsonView.SetValue(j.job.Item())
+	// Sensitive args are masked on screen; copying still uses the raw item.
+	j.jsonView.SetValue(j.job.DisplayItem())
 }
 
 func (j *JobDetail) jobJSON() string {
@@ -553,8 +1071,76 @@ func (j *JobDetail) jobJSON() string {
 	return string(formatted)
 }
 
-// renderLeftPanel renders the properties panel.
-func (j *JobDetail) renderLeftPanel() string {
+func (j *JobDetail) openEnqueuePrompt() tea.Cmd {
+	return func() tea.Msg {
+		return dialogs.OpenDialogMsg{
+			Model: newPromptDialog(
+				j.dialogStyles,
+				"Enqueue copies",
+				jobDetailCopiesDefault,
+				jobDetailCopiesTarget,
+				func(value string) error {
+					_, err := parseCopyCount(value)
+					return err
+				},
+			),
+		}
+	}
+}
+
+func (j *JobDetail) openEnqueueConfirm(count int) tea.Cmd {
+	className := j.job.DisplayClass()
+	queue := j.job.Queue()
+	return func() tea.Msg {
+		return dialogs.OpenDialogMsg{
+			Model: newConfirmDialog(
+				j.dialogStyles,
+				"Enqueue copies",
+				fmt.Sprintf(
+					"Enqueue %s copies of %s onto %s?\n\nEach copy gets a new JID and runs as a fresh job.",
+					j.dialogStyles.Text.Bold(true).Render(strconv.Itoa(count)),
+					j.dialogStyles.Text.Bold(true).Render(className),
+					j.dialogStyles.QueueText.Render(queue),
+				),
+				jobDetailCopiesTarget,
+				j.dialogStyles.DangerAction,
+			),
+		}
+	}
+}
+
+func (j *JobDetail) enqueueCopiesCmd(job *sidekiq.JobRecord, count int) tea.Cmd {
+	return func() tea.Msg {
+		ctx := devtools.WithTracker(context.Background(), "jobdetail.enqueueCopiesCmd")
+		enqueued, err := j.client.EnqueueJobCopies(ctx, job, count)
+		return jobCopiesEnqueuedMsg{jid: job.JID(), queue: job.Queue(), count: enqueued, err: err}
+	}
+}
+
+// parseCopyCount parses a copy count between 1 and sidekiq.MaxEnqueueCopies.
+func parseCopyCount(value string) (int, error) {
+	if value == "" {
+		return 0, errors.New("enter a count")
+	}
+	count, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil {
+		return 0, errors.New("invalid number")
+	}
+	if count <= 0 {
+		return 0, errors.New("count must be positive")
+	}
+	if count > sidekiq.MaxEnqueueCopies {
+		return 0, fmt.Errorf("at most %d copies", sidekiq.MaxEnqueueCopies)
+	}
+	return count, nil
+}
+
+// leftPanelLines builds the styled, wrapped lines of the properties panel.
+func (j *JobDetail) leftPanelLines() []string {
+	if len(j.properties) == 0 {
+		return nil
+	}
+
 	innerWidth := j.leftWidth - 2 // minus left and right border
 
 	// Calculate available width for values (with 2-space indent)
@@ -564,14 +1150,25 @@ func (j *JobDetail) renderLeftPanel() string {
 
 	// Build all display lines (label on own row, value indented below)
 	allLines := make([]string, 0, len(j.properties)*2)
-	for _, prop := range j.properties {
+	for i, prop := range j.properties {
+		if prop.Depth > 0 {
+			allLines = append(allLines, j.nestedPropertyLines(prop, contentWidth)...)
+			continue
+		}
 		// Label row
 		label := j.styles.Label.Render(prop.Label + ":")
 		allLines = append(allLines, label)
+		// Section rows (such as Args) carry no value of their own
+		if prop.Value == "" && i+1 < len(j.properties) && j.properties[i+1].Depth > 0 {
+			continue
+		}
 		// Value rows (indented, wrapped if needed)
 		valueStyle := j.styles.Value
-		if prop.Label == "Queue" {
+		switch prop.Label {
+		case "Queue":
 			valueStyle = j.styles.QueueText
+		case "Trace ID":
+			valueStyle = j.styles.Value.Bold(true)
 		}
 		valueLines := wrapText(prop.Value, valueWidth)
 		if len(valueLines) == 0 {
@@ -581,6 +1178,44 @@ func (j *JobDetail) renderLeftPanel() string {
 			allLines = append(allLines, valueIndent+valueStyle.Render(vl))
 		}
 	}
+	return allLines
+}
+
+// nestedPropertyLines renders a nested row as "key: value", wrapping the
+// value under its first character. Long keys push the value to the next line.
+func (j *JobDetail) nestedPropertyLines(prop PropertyRow, contentWidth int) []string {
+	indent := strings.Repeat(" ", jobDetailValueIndent*prop.Depth)
+	label := indent + j.styles.Label.Render(prop.Label+":")
+	if prop.Value == "" {
+		return []string{label}
+	}
+
+	prefixWidth := lipgloss.Width(indent + prop.Label + ": ")
+	if prefixWidth+10 > contentWidth {
+		valueIndent := indent + strings.Repeat(" ", jobDetailValueIndent)
+		lines := []string{label}
+		for _, vl := range wrapText(prop.Value, max(contentWidth-lipgloss.Width(valueIndent), 10)) {
+			lines = append(lines, valueIndent+j.styles.Value.Render(vl))
+		}
+		return lines
+	}
+
+	valueLines := wrapText(prop.Value, contentWidth-prefixWidth)
+	lines := make([]string, 0, len(valueLines))
+	continuation := strings.Repeat(" ", prefixWidth)
+	for i, vl := range valueLines {
+		if i == 0 {
+			lines = append(lines, label+" "+j.styles.Value.Render(vl))
+			continue
+		}
+		lines = append(lines, continuation+j.styles.Value.Render(vl))
+	}
+	return lines
+}
+
+// renderLeftPanel renders the properties panel.
+func (j *JobDetail) renderLeftPanel() string {
+	allLines := j.leftPanelLines()
 
 	// Apply vertical scroll
 	var contentLines []string
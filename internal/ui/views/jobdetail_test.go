@@ -0,0 +1,405 @@
+package views
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/dialogs"
+	confirmdialog "github.com/kpumuk/lazykiq/internal/ui/dialogs/confirm"
+	promptdialog "github.com/kpumuk/lazykiq/internal/ui/dialogs/prompt"
+)
+
+type enqueueClientStub struct {
+	sidekiq.API
+	job   *sidekiq.JobRecord
+	count int
+}
+
+func (s *enqueueClientStub) EnqueueJobCopies(_ context.Context, job *sidekiq.JobRecord, count int) (int, error) {
+	s.job = job
+	s.count = count
+	return count, nil
+}
+
+func TestJobDetailEnqueueCopies(t *testing.T) {
+	client := &enqueueClientStub{}
+	view := NewJobDetail(client)
+	view.SetStyles(Styles{})
+	view.SetJob(sidekiq.NewJobRecord(`{"jid":"j1","class":"LoadJob","queue":"load"}`, ""))
+
+	ctrlN := tea.KeyPressMsg{Code: 'n', Mod: tea.ModCtrl}
+	if _, cmd := view.Update(ctrlN); cmd != nil {
+		t.Fatal("expected no command without dangerous actions")
+	}
+
+	view.SetDangerousActionsEnabled(true)
+	if _, cmd := view.Update(ctrlN); cmd != nil {
+		t.Fatal("expected no command without --allow-enqueue")
+	}
+
+	view.SetEnqueueAllowed(true)
+	_, cmd := view.Update(ctrlN)
+	if cmd == nil {
+		t.Fatal("expected prompt dialog command")
+	}
+	if _, ok := cmd().(dialogs.OpenDialogMsg); !ok {
+		t.Fatalf("msg = %#v, want OpenDialogMsg", cmd())
+	}
+
+	_, cmd = view.Update(promptdialog.ActionMsg{Target: jobDetailCopiesTarget, Value: "25"})
+	if cmd == nil {
+		t.Fatal("expected confirm dialog command")
+	}
+	if _, ok := cmd().(dialogs.OpenDialogMsg); !ok {
+		t.Fatalf("msg = %#v, want OpenDialogMsg", cmd())
+	}
+
+	_, cmd = view.Update(confirmdialog.ActionMsg{Target: jobDetailCopiesTarget, Confirmed: true})
+	if cmd == nil {
+		t.Fatal("expected enqueue command")
+	}
+	if got := contextValue(view.ContextItems(), "Copies"); got != "enqueuing 25…" {
+		t.Fatalf("Copies = %q, want progress", got)
+	}
+
+	view.Update(cmd())
+	if client.count != 25 || client.job == nil || client.job.JID() != "j1" {
+		t.Fatalf("EnqueueJobCopies called with job %v count %d, want j1 x25", client.job, client.count)
+	}
+	if got := contextValue(view.ContextItems(), "Copies"); got != "enqueued 25 to load" {
+		t.Fatalf("Copies = %q, want result", got)
+	}
+}
+
+func TestJobDetailEnqueueCopiesCancelled(t *testing.T) {
+	client := &enqueueClientStub{}
+	view := NewJobDetail(client)
+	view.SetDangerousActionsEnabled(true)
+	view.SetEnqueueAllowed(true)
+	view.SetJob(sidekiq.NewJobRecord(`{"jid":"j1","class":"LoadJob","queue":"load"}`, ""))
+
+	view.Update(promptdialog.ActionMsg{Target: jobDetailCopiesTarget, Value: "5"})
+	if _, cmd := view.Update(confirmdialog.ActionMsg{Target: jobDetailCopiesTarget, Confirmed: false}); cmd != nil {
+		t.Fatal("expected no command when cancelled")
+	}
+	if client.count != 0 {
+		t.Fatalf("EnqueueJobCopies called with count %d, want no call", client.count)
+	}
+}
+
+func TestParseCopyCount(t *testing.T) {
+	tests := map[string]struct {
+		value   string
+		want    int
+		wantErr bool
+	}{
+		"valid":     {value: "10", want: 10},
+		"padded":    {value: " 3 ", want: 3},
+		"max":       {value: "1000", want: sidekiq.MaxEnqueueCopies},
+		"empty":     {value: "", wantErr: true},
+		"zero":      {value: "0", wantErr: true},
+		"negative":  {value: "-1", wantErr: true},
+		"too many":  {value: "1001", wantErr: true},
+		"not a num": {value: "ten", wantErr: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := parseCopyCount(tc.value)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("parseCopyCount(%q) = %d, want error", tc.value, got)
+				}
+				return
+			}
+			if err != nil || got != tc.want {
+				t.Fatalf("parseCopyCount(%q) = %d, %v; want %d", tc.value, got, err, tc.want)
+			}
+		})
+	}
+}
+
+func TestJobDetailArgsTree(t *testing.T) {
+	view := NewJobDetail(nil)
+	view.SetJob(sidekiq.NewJobRecord(
+		`{"jid":"j1","class":"SyncJob","queue":"default","args":[7,{"account":{"id":1,"owner":{"name":"x"}},"force":true}]}`,
+		"",
+	))
+
+	want := []PropertyRow{
+		{Label: "Args"},
+		{Label: "0", Value: "7", Depth: 1},
+		{Label: "1", Depth: 1},
+		{Label: "account", Depth: 2},
+		{Label: "id", Value: "1", Depth: 3},
+		{Label: "owner", Depth: 3},
+		{Label: "name", Value: `"x"`, Depth: 4},
+		{Label: "force", Value: "true", Depth: 2},
+	}
+	if got := argsProperties(view.properties); !slices.Equal(got, want) {
+		t.Fatalf("args rows =\n%v\nwant\n%v", got, want)
+	}
+
+	view.SetArgsDepth(2)
+	got := argsProperties(view.properties)
+	if owner := got[5]; owner.Label != "owner" || owner.Value != "{…}" {
+		t.Fatalf("owner row = %+v, want collapsed at depth 2", owner)
+	}
+}
+
+func TestJobDetailRetryEstimate(t *testing.T) {
+	view := NewJobDetail(nil)
+	entry := sidekiq.NewSortedEntry(`{"jid":"j1","class":"SyncJob","queue":"default","retry":true,"retry_count":2}`, 1700000000)
+	view.SetJob(entry.JobRecord)
+	if got := propertyValue(view.properties, "Retry After"); got != "" {
+		t.Fatalf("Retry After = %q without a source, want no row", got)
+	}
+
+	view.SetJobSource(SortedJobSource(sidekiq.SortedSetRetry, entry))
+	if got := propertyValue(view.properties, "Next Retry"); !strings.HasPrefix(got, "2023-11-14") {
+		t.Fatalf("Next Retry = %q, want the retry time", got)
+	}
+	if got := propertyValue(view.properties, "Retry After"); !strings.HasPrefix(got, "in ") {
+		t.Fatalf("Retry After = %q, want a range", got)
+	}
+
+	view.SetJobSource(SortedJobSource(sidekiq.SortedSetDead, entry))
+	if got := propertyValue(view.properties, "Retry After"); got != "" {
+		t.Fatalf("Retry After = %q for a dead job, want no row", got)
+	}
+}
+
+func propertyValue(rows []PropertyRow, label string) string {
+	for _, row := range rows {
+		if row.Label == label && row.Depth == 0 {
+			return row.Value
+		}
+	}
+	return ""
+}
+
+func TestJobDetailArgsTreeRendering(t *testing.T) {
+	view := NewJobDetail(nil)
+	view.SetStyles(Styles{})
+	view.SetSize(100, 40)
+	view.SetJob(sidekiq.NewJobRecord(
+		`{"jid":"j1","class":"SyncJob","queue":"default","args":[{"account_id":42}]}`,
+		"",
+	))
+
+	out := ansi.Strip(view.View())
+	for _, want := range []string{"Args:", "  0:", "    account_id: 42"} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("view missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func argsProperties(rows []PropertyRow) []PropertyRow {
+	for i, row := range rows {
+		if row.Label == "Args" && row.Depth == 0 {
+			return rows[i:]
+		}
+	}
+	return nil
+}
+
+func TestJobDetailCollapse(t *testing.T) {
+	view := NewJobDetail(nil)
+	view.SetStyles(Styles{})
+	view.SetSize(100, 20)
+	view.SetJob(sidekiq.NewJobRecord(`{"jid":"j1","class":"SyncJob","queue":"default","args":[]}`, ""))
+
+	view.Update(tea.KeyPressMsg{Code: '\\', Text: "\\"})
+	if !view.focusRight || view.leftWidth != 0 || view.rightWidth != 100 {
+		t.Fatalf("collapsed: focusRight=%v left=%d right=%d, want JSON at full width",
+			view.focusRight, view.leftWidth, view.rightWidth)
+	}
+	if out := ansi.Strip(view.View()); strings.Contains(out, "Job Details") || !strings.Contains(out, "Job Data (JSON)") {
+		t.Fatalf("collapsed view shows the properties panel:\n%s", out)
+	}
+
+	view.Update(tea.KeyPressMsg{Code: tea.KeyTab})
+	if view.focusRight || view.leftWidth != 100 {
+		t.Fatalf("tab while collapsed: focusRight=%v left=%d, want properties at full width",
+			view.focusRight, view.leftWidth)
+	}
+
+	view.SetJob(sidekiq.NewJobRecord(`{"jid":"j2","class":"SyncJob","queue":"default","args":[]}`, ""))
+	if !view.collapsed || view.focusRight {
+		t.Fatalf("SetJob reset the layout: collapsed=%v focusRight=%v", view.collapsed, view.focusRight)
+	}
+
+	view.Update(tea.KeyPressMsg{Code: '\\', Text: "\\"})
+	if view.collapsed || view.leftWidth != 40 || view.rightWidth != 60 {
+		t.Fatalf("expanded: collapsed=%v left=%d right=%d, want the 40/60 split",
+			view.collapsed, view.leftWidth, view.rightWidth)
+	}
+}
+
+func TestJobDetailCopyBacktrace(t *testing.T) {
+	view := NewJobDetail(nil)
+	view.SetStyles(Styles{})
+	view.SetJob(sidekiq.NewJobRecord(`{"jid":"j1","class":"SyncJob","queue":"default"}`, ""))
+
+	if _, cmd := view.Update(tea.KeyPressMsg{Code: 'B', Text: "B"}); cmd != nil {
+		t.Fatal("B without a backtrace returned a command")
+	}
+	if got := contextValue(view.ContextItems(), "Note"); got != "no backtrace" {
+		t.Fatalf("note = %q, want no backtrace", got)
+	}
+
+	encoded := compressString(t, []byte(`["app/jobs/sync_job.rb:12:in 'perform'","lib/sidekiq.rb:3"]`))
+	view.SetJob(sidekiq.NewJobRecord(`{"jid":"j2","class":"SyncJob","queue":"default","error_backtrace":"`+encoded+`"}`, ""))
+	if _, cmd := view.Update(tea.KeyPressMsg{Code: 'B', Text: "B"}); cmd == nil {
+		t.Fatal("B with a backtrace returned no copy command")
+	}
+	if got := contextValue(view.ContextItems(), "Note"); got != "copied 2 backtrace lines" {
+		t.Fatalf("note = %q, want copy notice", got)
+	}
+}
+
+func TestJobDetailTraceID(t *testing.T) {
+	const traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+	view := NewJobDetail(nil)
+	view.SetStyles(Styles{})
+	view.SetSize(200, 30)
+	view.SetJob(sidekiq.NewJobRecord(`{"jid":"j1","class":"SyncJob","queue":"default"}`, ""))
+
+	if output := ansi.Strip(view.View()); strings.Contains(output, "Trace ID") {
+		t.Fatalf("job without a trace shows a Trace ID row:\n%s", output)
+	}
+	if _, cmd := view.Update(tea.KeyPressMsg{Code: 't', Text: "t"}); cmd != nil {
+		t.Fatal("t without a trace returned a command")
+	}
+	if got := contextValue(view.ContextItems(), "Note"); got != "no trace id" {
+		t.Fatalf("note = %q, want no trace id", got)
+	}
+
+	view.SetTraceURL("https://tempo.example.com/trace/{trace_id}")
+	view.SetJob(sidekiq.NewJobRecord(`{"jid":"j2","class":"SyncJob","queue":"default","trace_context":{"traceparent":"00-`+traceID+`-00f067aa0ba902b7-01"}}`, ""))
+	output := ansi.Strip(view.View())
+	for _, want := range []string{"Trace ID:", traceID, "https://tempo.example.com/trace/" + traceID} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("job details are missing %q:\n%s", want, output)
+		}
+	}
+	if _, cmd := view.Update(tea.KeyPressMsg{Code: 't', Text: "t"}); cmd == nil {
+		t.Fatal("t with a trace returned no copy command")
+	}
+	if got := contextValue(view.ContextItems(), "Note"); got != "copied trace id" {
+		t.Fatalf("note = %q, want copy notice", got)
+	}
+	if _, cmd := view.Update(tea.KeyPressMsg{Code: 'T', Text: "T"}); cmd == nil {
+		t.Fatal("T with a trace url returned no copy command")
+	}
+	if got := contextValue(view.ContextItems(), "Note"); got != "copied trace url" {
+		t.Fatalf("note = %q, want copy notice", got)
+	}
+}
+
+func TestJobDetailStepsThroughSiblings(t *testing.T) {
+	jobs := []*sidekiq.JobRecord{
+		sidekiq.NewJobRecord(`{"jid":"j0","class":"LoadJob","queue":"load"}`, ""),
+		sidekiq.NewJobRecord(`{"jid":"j1","class":"LoadJob","queue":"load"}`, ""),
+		sidekiq.NewJobRecord(`{"jid":"j2","class":"LoadJob","queue":"load"}`, ""),
+	}
+	var asked []int
+	fetch := func(_ context.Context, index int) (JobSibling, error) {
+		asked = append(asked, index)
+		return JobSibling{Job: jobs[index], Source: JobSource{Key: "dead"}, Index: index, Total: len(jobs)}, nil
+	}
+
+	view := NewJobDetail(nil)
+	view.SetSize(120, 30)
+	view.SetStyles(Styles{})
+	view.SetJob(jobs[2])
+	view.SetJobSiblings(JobSiblings{Index: 2, Total: len(jobs), Fetch: fetch})
+	view.leftYOffset = 3
+
+	step := func(code rune) {
+		t.Helper()
+		_, cmd := view.Update(tea.KeyPressMsg(tea.Key{Code: code, Text: string(code)}))
+		if cmd == nil {
+			t.Fatalf("%q returned no command", code)
+		}
+		view.Update(cmd())
+	}
+
+	step(']')
+	if view.job.JID() != "j0" || view.siblings.Index != 0 {
+		t.Fatalf("after ] at the end: jid = %q, index = %d, want j0 at 0", view.job.JID(), view.siblings.Index)
+	}
+	if view.leftYOffset != 0 || view.source.Key != "dead" {
+		t.Fatalf("scroll = %d, source = %q, want reset scroll and new source", view.leftYOffset, view.source.Key)
+	}
+	if got := contextValue(view.ContextItems(), "Note"); got != "wrapped to the first job" {
+		t.Fatalf("note = %q, want wrap notice", got)
+	}
+	if got := contextValue(view.ContextItems(), "Position"); got != "1 of 3" {
+		t.Fatalf("position = %q, want 1 of 3", got)
+	}
+
+	step('[')
+	if view.job.JID() != "j2" {
+		t.Fatalf("after [ at the start: jid = %q, want j2", view.job.JID())
+	}
+	step('[')
+	if view.job.JID() != "j1" || contextValue(view.ContextItems(), "Note") != "" {
+		t.Fatalf("after [: jid = %q, note = %q, want j1 without notice", view.job.JID(), contextValue(view.ContextItems(), "Note"))
+	}
+	if !slices.Equal(asked, []int{0, 2, 1}) {
+		t.Fatalf("fetched indexes = %v, want [0 2 1]", asked)
+	}
+}
+
+func TestJobDetailWithoutSiblingsIgnoresBrackets(t *testing.T) {
+	view := NewJobDetail(nil)
+	view.SetStyles(Styles{})
+	view.SetJob(sidekiq.NewJobRecord(`{"jid":"j1","class":"LoadJob","queue":"load"}`, ""))
+	view.SetJobSiblings(JobSiblings{})
+
+	if _, cmd := view.Update(tea.KeyPressMsg(tea.Key{Code: ']', Text: "]"})); cmd != nil {
+		t.Fatal("expected no command without a sibling list")
+	}
+	if contextValue(view.ContextItems(), "Position") != "" {
+		t.Fatal("expected no position without a sibling list")
+	}
+}
+
+func TestJobDetailOpenQueue(t *testing.T) {
+	view := NewJobDetail(nil)
+	view.SetJob(sidekiq.NewJobRecord(`{"jid":"j1","queue":"mailers"}`, ""))
+
+	if !hasHint(view.HintBindings(), view.KeyMap.OpenQueue) {
+		t.Fatal("hints missing open queue for a job with a queue")
+	}
+	_, cmd := view.Update(tea.KeyPressMsg{Code: 'Q', Text: "Q"})
+	if cmd == nil {
+		t.Fatal("expected ShowQueueDetailsMsg command")
+	}
+	msg, ok := cmd().(ShowQueueDetailsMsg)
+	if !ok || msg.QueueName != "mailers" {
+		t.Fatalf("msg = %#v, want ShowQueueDetailsMsg{QueueName: %q}", cmd(), "mailers")
+	}
+
+	view.SetJob(sidekiq.NewJobRecord(`{"jid":"j2"}`, ""))
+	if hasHint(view.HintBindings(), view.KeyMap.OpenQueue) {
+		t.Fatal("hints offer open queue for a job without a queue")
+	}
+	if _, cmd := view.Update(tea.KeyPressMsg{Code: 'Q', Text: "Q"}); cmd != nil {
+		t.Fatal("expected no command for a job without a queue")
+	}
+}
+
+// hasHint reports whether bindings show the help of want.
+func hasHint(bindings []key.Binding, want key.Binding) bool {
+	return slices.ContainsFunc(bindings, func(b key.Binding) bool { return b.Help() == want.Help() })
+}
@@ -0,0 +1,68 @@
+package views
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+)
+
+// JobRefScheme prefixes job references, e.g. lazykiq://retry/<jid>.
+const JobRefScheme = "lazykiq://"
+
+// JobRef identifies a job in one of Sidekiq's sorted sets so it can be
+// shared and reopened with --open.
+type JobRef struct {
+	Kind sidekiq.SortedSetKind
+	JID  string
+}
+
+var jobRefKinds = map[string]sidekiq.SortedSetKind{
+	sidekiq.SortedSetRetry.String():     sidekiq.SortedSetRetry,
+	sidekiq.SortedSetScheduled.String(): sidekiq.SortedSetScheduled,
+	sidekiq.SortedSetDead.String():      sidekiq.SortedSetDead,
+}
+
+// String formats the reference as lazykiq://<set>/<jid>.
+func (r JobRef) String() string {
+	return JobRefScheme + r.Kind.String() + "/" + r.JID
+}
+
+// ParseJobRef parses a lazykiq://<set>/<jid> reference. The set must be
+// retry, scheduled, or dead, and the JID may only contain letters, digits,
+// '-' and '_'.
+func ParseJobRef(ref string) (JobRef, error) {
+	rest, ok := strings.CutPrefix(ref, JobRefScheme)
+	if !ok {
+		return JobRef{}, fmt.Errorf("job ref must start with %s", JobRefScheme)
+	}
+	set, jid, ok := strings.Cut(rest, "/")
+	if !ok {
+		return JobRef{}, errors.New("job ref must look like lazykiq://<set>/<jid>")
+	}
+	kind, ok := jobRefKinds[set]
+	if !ok {
+		return JobRef{}, fmt.Errorf("unknown job set %q (want retry, scheduled, or dead)", set)
+	}
+	if jid == "" {
+		return JobRef{}, errors.New("job ref is missing a jid")
+	}
+	for _, r := range jid {
+		if !isJIDRune(r) {
+			return JobRef{}, fmt.Errorf("invalid jid %q", jid)
+		}
+	}
+	return JobRef{Kind: kind, JID: jid}, nil
+}
+
+func isJIDRune(r rune) bool {
+	return r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_'
+}
+
+// copyJobRefCmd copies a shareable reference to the sorted-set job.
+func copyJobRefCmd(kind sidekiq.SortedSetKind, entry *sidekiq.SortedEntry) tea.Cmd {
+	return copyTextCmd(JobRef{Kind: kind, JID: entry.JID()}.String())
+}
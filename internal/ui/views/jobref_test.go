@@ -0,0 +1,46 @@
+package views
+
+import (
+	"testing"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+)
+
+func TestJobRefRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, kind := range []sidekiq.SortedSetKind{sidekiq.SortedSetRetry, sidekiq.SortedSetScheduled, sidekiq.SortedSetDead} {
+		ref := JobRef{Kind: kind, JID: "a1b2c3d4e5f6_-x"}
+		got, err := ParseJobRef(ref.String())
+		if err != nil {
+			t.Fatalf("ParseJobRef(%q) error = %v", ref.String(), err)
+		}
+		if got != ref {
+			t.Fatalf("ParseJobRef(%q) = %+v, want %+v", ref.String(), got, ref)
+		}
+	}
+
+	if got := (JobRef{Kind: sidekiq.SortedSetRetry, JID: "abc"}).String(); got != "lazykiq://retry/abc" {
+		t.Fatalf("String() = %q, want lazykiq://retry/abc", got)
+	}
+}
+
+func TestParseJobRefRejectsMalformed(t *testing.T) {
+	t.Parallel()
+
+	for _, ref := range []string{
+		"",
+		"retry/abc",
+		"https://retry/abc",
+		"lazykiq://retry",
+		"lazykiq://retry/",
+		"lazykiq://queue/abc",
+		"lazykiq://retry/abc/def",
+		"lazykiq://retry/abc*",
+		"lazykiq://retry/ab c",
+	} {
+		if _, err := ParseJobRef(ref); err == nil {
+			t.Errorf("ParseJobRef(%q) error = nil, want error", ref)
+		}
+	}
+}
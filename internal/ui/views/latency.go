@@ -0,0 +1,90 @@
+package views
+
+import (
+	"time"
+
+	"charm.land/lipgloss/v2"
+)
+
+// Default queue latency thresholds.
+const (
+	DefaultLatencyWarn     = time.Minute
+	DefaultLatencyCritical = 5 * time.Minute
+)
+
+// LatencyThreshold holds the latencies at which a queue is highlighted.
+// A zero limit disables that level.
+type LatencyThreshold struct {
+	Warn     time.Duration
+	Critical time.Duration
+}
+
+// LatencyThresholds configures queue latency highlighting, with optional
+// per-queue overrides of the default threshold.
+type LatencyThresholds struct {
+	Default LatencyThreshold
+	Queues  map[string]LatencyThreshold
+}
+
+// DefaultLatencyThresholds returns the thresholds used when none are configured.
+func DefaultLatencyThresholds() LatencyThresholds {
+	return LatencyThresholds{
+		Default: LatencyThreshold{Warn: DefaultLatencyWarn, Critical: DefaultLatencyCritical},
+	}
+}
+
+// For returns the threshold that applies to the named queue.
+func (t LatencyThresholds) For(queue string) LatencyThreshold {
+	if threshold, ok := t.Queues[queue]; ok {
+		return threshold
+	}
+	return t.Default
+}
+
+// LatencyThresholdsSetter is implemented by views that highlight queue latency.
+type LatencyThresholdsSetter interface {
+	SetLatencyThresholds(thresholds LatencyThresholds)
+}
+
+type latencyLevel int
+
+const (
+	latencyLevelNormal latencyLevel = iota
+	latencyLevelWarn
+	latencyLevelCritical
+)
+
+// level classifies latency in seconds against the threshold.
+func (t LatencyThreshold) level(seconds float64) latencyLevel {
+	latency := time.Duration(seconds * float64(time.Second))
+	switch {
+	case t.Critical > 0 && latency >= t.Critical:
+		return latencyLevelCritical
+	case t.Warn > 0 && latency >= t.Warn:
+		return latencyLevelWarn
+	default:
+		return latencyLevelNormal
+	}
+}
+
+// latencyStyle returns the style for a latency level, falling back to base.
+func latencyStyle(styles Styles, level latencyLevel, base lipgloss.Style) lipgloss.Style {
+	switch level {
+	case latencyLevelCritical:
+		return styles.ErrorText
+	case latencyLevelWarn:
+		return styles.WarningText
+	default:
+		return base
+	}
+}
+
+// highlightLatency renders text in the warning or critical style when latency
+// crosses the threshold, and leaves it untouched otherwise.
+func highlightLatency(styles Styles, threshold LatencyThreshold, seconds float64, text string) string {
+	level := threshold.level(seconds)
+	if level == latencyLevelNormal {
+		return text
+	}
+	return latencyStyle(styles, level, lipgloss.NewStyle()).Render(text)
+}
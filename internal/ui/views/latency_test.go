@@ -0,0 +1,121 @@
+package views
+
+import (
+	"testing"
+	"time"
+
+	"charm.land/lipgloss/v2"
+)
+
+func TestLatencyThresholdLevel(t *testing.T) {
+	t.Parallel()
+
+	threshold := LatencyThreshold{Warn: time.Minute, Critical: 5 * time.Minute}
+
+	tests := map[string]struct {
+		threshold LatencyThreshold
+		seconds   float64
+		want      latencyLevel
+	}{
+		"below warn":        {threshold: threshold, seconds: 59.9, want: latencyLevelNormal},
+		"at warn":           {threshold: threshold, seconds: 60, want: latencyLevelWarn},
+		"between":           {threshold: threshold, seconds: 299, want: latencyLevelWarn},
+		"at critical":       {threshold: threshold, seconds: 300, want: latencyLevelCritical},
+		"warn disabled":     {threshold: LatencyThreshold{Critical: time.Minute}, seconds: 30, want: latencyLevelNormal},
+		"critical disabled": {threshold: LatencyThreshold{Warn: time.Minute}, seconds: 3600, want: latencyLevelWarn},
+		"all disabled":      {seconds: 3600, want: latencyLevelNormal},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			if got := tc.threshold.level(tc.seconds); got != tc.want {
+				t.Fatalf("level(%v) = %v, want %v", tc.seconds, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLatencyStyle(t *testing.T) {
+	t.Parallel()
+
+	styles := Styles{
+		ErrorText:   lipgloss.NewStyle().Foreground(lipgloss.Color("1")),
+		WarningText: lipgloss.NewStyle().Foreground(lipgloss.Color("3")),
+	}
+	base := lipgloss.NewStyle().Foreground(lipgloss.Color("8"))
+
+	tests := map[string]struct {
+		level latencyLevel
+		want  lipgloss.Style
+	}{
+		"normal":   {level: latencyLevelNormal, want: base},
+		"warn":     {level: latencyLevelWarn, want: styles.WarningText},
+		"critical": {level: latencyLevelCritical, want: styles.ErrorText},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			got := latencyStyle(styles, tc.level, base)
+			if got.GetForeground() != tc.want.GetForeground() {
+				t.Fatalf("foreground = %v, want %v", got.GetForeground(), tc.want.GetForeground())
+			}
+		})
+	}
+}
+
+func TestLatencyThresholdsFor(t *testing.T) {
+	t.Parallel()
+
+	thresholds := DefaultLatencyThresholds()
+	thresholds.Queues = map[string]LatencyThreshold{
+		"critical": {Warn: 5 * time.Second, Critical: 30 * time.Second},
+	}
+
+	if got := thresholds.For("critical"); got.Warn != 5*time.Second {
+		t.Fatalf("For(critical) = %+v, want override", got)
+	}
+	if got := thresholds.For("default"); got != thresholds.Default {
+		t.Fatalf("For(default) = %+v, want default", got)
+	}
+}
+
+func TestWorstLatencyLevel(t *testing.T) {
+	t.Parallel()
+
+	thresholds := DefaultLatencyThresholds()
+	thresholds.Queues = map[string]LatencyThreshold{
+		"critical": {Warn: 5 * time.Second, Critical: 30 * time.Second},
+	}
+
+	tests := map[string]struct {
+		queues []*QueuesListInfo
+		want   latencyLevel
+	}{
+		"no queues": {want: latencyLevelNormal},
+		"override crossed below slowest queue": {
+			queues: []*QueuesListInfo{
+				{Name: "default", Latency: 50},
+				{Name: "critical", Latency: 40},
+			},
+			want: latencyLevelCritical,
+		},
+		"slowest queue decides": {
+			queues: []*QueuesListInfo{
+				{Name: "default", Latency: 90},
+				{Name: "critical", Latency: 1},
+			},
+			want: latencyLevelWarn,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			if got := worstLatencyLevel(thresholds, tc.queues); got != tc.want {
+				t.Fatalf("worstLatencyLevel = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
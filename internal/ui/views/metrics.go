@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"slices"
-	"sort"
 	"time"
 
 	"charm.land/bubbles/v2/key"
@@ -26,13 +25,18 @@ type metricsListMsg struct {
 	result  sidekiq.MetricsTopJobsResult
 	periods []string
 	period  string
+	skew    clockSkew
 }
 
 type metricsRow struct {
 	class  string
 	totals sidekiq.MetricsJobTotals
+	all    bool // Totals across every listed job rather than one class
 }
 
+// metricsAllJobsRowID identifies the totals row; no job class contains it.
+const metricsAllJobsRowID = "*"
+
 // Metrics shows job execution metrics.
 type Metrics struct {
 	client sidekiq.API
@@ -48,6 +52,8 @@ type Metrics struct {
 	period       string
 	periodIdx    int
 	filter       string
+	sort         sidekiq.MetricsSort
+	skew         clockSkew
 	frameStyles  frame.Styles
 	filterStyle  filterdialog.Styles
 	table        table.Model
@@ -81,6 +87,7 @@ func (m *Metrics) Update(msg tea.Msg) (View, tea.Cmd) {
 	case metricsListMsg:
 		m.applyPeriodState(msg.periods, msg.period)
 		m.result = msg.result
+		m.skew = msg.skew
 		m.ready = true
 		m.buildListRows()
 		if m.resetScroll {
@@ -89,7 +96,7 @@ func (m *Metrics) Update(msg tea.Msg) (View, tea.Cmd) {
 		}
 		return m, nil
 
-	case RefreshMsg:
+	case RefreshMsg, RefreshViewMsg:
 		return m, m.fetchListCmd()
 
 	case filterdialog.ActionMsg:
@@ -99,6 +106,10 @@ func (m *Metrics) Update(msg tea.Msg) (View, tea.Cmd) {
 		return m, m.setFilterAndReload(msg.Query)
 
 	case tea.KeyPressMsg:
+		if m.table.JumpActive() {
+			m.table, _ = m.table.Update(msg)
+			return m, nil
+		}
 		switch msg.String() {
 		case "/":
 			return m, m.openFilterDialog()
@@ -120,6 +131,11 @@ func (m *Metrics) Update(msg tea.Msg) (View, tea.Cmd) {
 				}
 			}
 			return m, nil
+		case "o":
+			m.sort = (m.sort + 1) % sidekiq.MetricsSortCount
+			m.buildListRows()
+			m.table.GotoTop()
+			return m, nil
 		case "{":
 			return m.adjustPeriod(-1)
 		case "}":
@@ -192,6 +208,7 @@ func (m *Metrics) ContextItems() []ContextItem {
 		{Label: "Succeeded", Value: succeededText},
 		{Label: "Failed", Value: failedText},
 		{Label: "Range", Value: rangeText},
+		{Label: "Clock skew", Value: m.skew.contextValue(m.styles)},
 	}
 }
 
@@ -201,6 +218,7 @@ func (m *Metrics) HintBindings() []key.Binding {
 		helpBinding([]string{"/"}, "/", "filter"),
 		helpBinding([]string{"ctrl+u"}, "ctrl+u", "reset filter"),
 		helpBinding([]string{"{", "}"}, "{ ⋰ }", "change period"),
+		helpBinding([]string{"o"}, "o", "sort"),
 		helpBinding([]string{"[", "]"}, "[ ⋰ ]", "page up/down"),
 		helpBinding([]string{"enter"}, "enter", "job metrics"),
 	}
@@ -216,6 +234,7 @@ func (m *Metrics) HelpSections() []HelpSection {
 				helpBinding([]string{"ctrl+u"}, "ctrl+u", "clear filter"),
 				helpBinding([]string{"{"}, "{", "previous period"),
 				helpBinding([]string{"}"}, "}", "next period"),
+				helpBinding([]string{"o"}, "o", "sort by total/processed/failed/avg"),
 				helpBinding([]string{"["}, "[", "page up"),
 				helpBinding([]string{"]"}, "]", "page down"),
 				helpBinding([]string{"enter"}, "enter", "job metrics"),
@@ -242,6 +261,11 @@ func (m *Metrics) CancelRequests() {
 	m.fetchRequest.Cancel()
 }
 
+// InputFocused implements InputFocuser.
+func (m *Metrics) InputFocused() bool {
+	return m.table.JumpActive()
+}
+
 // SetStyles implements View.
 func (m *Metrics) SetStyles(styles Styles) View {
 	m.styles = styles
@@ -262,7 +286,13 @@ var metricsColumns = []table.Column{
 
 func (m *Metrics) fetchListCmd() tea.Cmd {
 	period := m.period
+	// Fuzzy filters fetch every job and match locally; buildListRows ranks.
 	filter := m.filter
+	fuzzyPattern, fuzzy := filterdialog.ParseFuzzy(filter)
+	if fuzzy {
+		filter = ""
+	}
+	sortBy := m.sort
 	client := m.client
 	ctx := m.fetchRequest.Start(devtools.WithTracker(context.Background(), "metrics.fetchListCmd"))
 	return func() tea.Msg {
@@ -272,18 +302,37 @@ func (m *Metrics) fetchListCmd() tea.Cmd {
 			queryPeriod = periods[0]
 		}
 
+		// Estimate first so the buckets below follow the workers' clock.
+		skew, err := estimateClockSkew(ctx, client)
+		if err != nil {
+			if requestctx.IsCanceled(err) {
+				return nil
+			}
+			return ConnectionErrorMsg{Err: err}
+		}
+
 		params := sidekiq.MetricsPeriods[queryPeriod]
-		result, err := client.GetMetricsTopJobs(ctx, params, filter)
+		result, err := client.GetMetricsTopJobs(ctx, params, filter, sortBy, 0)
 		if err != nil {
 			if requestctx.IsCanceled(err) {
 				return nil
 			}
 			return ConnectionErrorMsg{Err: err}
 		}
+		if fuzzy {
+			jobs := make(map[string]sidekiq.MetricsJobTotals)
+			for class, totals := range result.Jobs {
+				if _, ok := filterdialog.FuzzyScore(fuzzyPattern, class); ok {
+					jobs[class] = totals
+				}
+			}
+			result.Jobs = jobs
+		}
 		return metricsListMsg{
 			result:  result,
 			periods: periods,
 			period:  queryPeriod,
+			skew:    skew,
 		}
 	}
 }
@@ -328,6 +377,19 @@ func normalizeMetricsPeriods(periods []string) []string {
 	return normalized
 }
 
+// SaveState implements StatePersister.
+func (m *Metrics) SaveState() ViewState {
+	return ViewState{Filter: m.filter, Period: m.period}
+}
+
+// RestoreState implements StatePersister. It must be called before Init.
+func (m *Metrics) RestoreState(state ViewState) {
+	m.filter = state.Filter
+	if state.Period != "" {
+		m.applyPeriodState(m.periods, state.Period)
+	}
+}
+
 func (m *Metrics) adjustPeriod(delta int) (View, tea.Cmd) {
 	next := mathutil.Clamp(m.periodIdx+delta, 0, len(m.periods)-1)
 	if next == m.periodIdx {
@@ -341,14 +403,20 @@ func (m *Metrics) adjustPeriod(delta int) (View, tea.Cmd) {
 }
 
 func (m *Metrics) buildListRows() {
-	rows := make([]metricsRow, 0, len(m.result.Jobs))
-	for className, totals := range m.result.Jobs {
-		rows = append(rows, metricsRow{class: className, totals: totals})
+	// Rank locally so changing the sort does not need a refetch.
+	ranked := sidekiq.RankMetricsTopJobs(m.result.Jobs, m.sort, 0)
+	if pattern, ok := filterdialog.ParseFuzzy(m.filter); ok {
+		ranked = filterdialog.RankFuzzy(ranked, pattern, func(job sidekiq.MetricsTopJob) string {
+			return job.Class
+		})
+	}
+	rows := make([]metricsRow, 0, len(ranked)+1)
+	if len(ranked) > 0 {
+		rows = append(rows, metricsRow{class: "All jobs", totals: m.result.Totals(), all: true})
+	}
+	for _, job := range ranked {
+		rows = append(rows, metricsRow{class: job.Class, totals: job.MetricsJobTotals})
 	}
-
-	sort.Slice(rows, func(i, j int) bool {
-		return rows[i].totals.Seconds > rows[j].totals.Seconds
-	})
 
 	m.rows = rows
 	m.updateTableRows()
@@ -369,10 +437,14 @@ func (m *Metrics) updateTableRows() {
 
 	rows := make([]table.Row, len(m.rows))
 	for i, row := range m.rows {
+		id, class := row.class, row.class
+		if row.all {
+			id, class = metricsAllJobsRowID, m.styles.Text.Bold(true).Render(row.class)
+		}
 		rows[i] = table.Row{
-			ID: row.class,
+			ID: id,
 			Cells: []string{
-				row.class,
+				class,
 				display.Number(row.totals.Success()),
 				display.Number(row.totals.Failed),
 				display.Float(row.totals.Seconds, 2),
@@ -388,7 +460,7 @@ func (m *Metrics) updateTableRows() {
 
 func (m *Metrics) selectedRow() (metricsRow, bool) {
 	idx := m.table.Cursor()
-	if idx < 0 || idx >= len(m.rows) {
+	if idx < 0 || idx >= len(m.rows) || m.rows[idx].all {
 		return metricsRow{}, false
 	}
 	return m.rows[idx], true
@@ -409,6 +481,7 @@ func (m *Metrics) openFilterDialog() tea.Cmd {
 			Model: filterdialog.New(
 				filterdialog.WithStyles(m.filterStyle),
 				filterdialog.WithQuery(m.filter),
+				filterdialog.WithFuzzy(true),
 			),
 		}
 	}
@@ -430,18 +503,14 @@ func (m *Metrics) listMeta() string {
 	if m.period == "" {
 		return ""
 	}
-	return m.styles.MetricLabel.Render("period: ") + m.styles.MetricValue.Render(m.period)
+	return m.styles.MetricLabel.Render("period: ") + m.styles.MetricValue.Render(m.period) +
+		m.styles.MetricLabel.Render(" sort: ") + m.styles.MetricValue.Render(m.sort.String()) +
+		m.skew.warning(m.styles)
 }
 
 func (m *Metrics) aggregateTotals() (int64, int64, int64) {
-	var processed int64
-	var failed int64
-	for _, totals := range m.result.Jobs {
-		processed += totals.Processed
-		failed += totals.Failed
-	}
-	succeeded := max(processed-failed, 0)
-	return int64(len(m.result.Jobs)), succeeded, failed
+	totals := m.result.Totals()
+	return int64(len(m.result.Jobs)), totals.Success(), totals.Failed
 }
 
 func formatMetricsRange(start, end time.Time) string {
@@ -2,8 +2,15 @@ package views
 
 import (
 	"context"
+	"errors"
+	"math"
 	"slices"
+	"strings"
 	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
 
 	"github.com/kpumuk/lazykiq/internal/sidekiq"
 )
@@ -15,6 +22,13 @@ type metricsClientStub struct {
 	err             error
 	requestedPeriod sidekiq.MetricsPeriod
 	requestedFilter string
+	requestedSort   sidekiq.MetricsSort
+	skew            time.Duration
+	skewErr         error
+}
+
+func (m *metricsClientStub) EstimateClockSkew(context.Context) (time.Duration, error) {
+	return m.skew, m.skewErr
 }
 
 func (m *metricsClientStub) MetricsPeriodOrder(context.Context) []string {
@@ -25,7 +39,10 @@ func (m *metricsClientStub) GetMetricsTopJobs(
 	_ context.Context,
 	period sidekiq.MetricsPeriod,
 	classFilter string,
+	sortBy sidekiq.MetricsSort,
+	_ int,
 ) (sidekiq.MetricsTopJobsResult, error) {
+	m.requestedSort = sortBy
 	m.requestedPeriod = period
 	m.requestedFilter = classFilter
 	if m.err != nil {
@@ -110,3 +127,276 @@ func TestNormalizeMetricsPeriods_FallbackToDefaults(t *testing.T) {
 		t.Fatalf("normalizeMetricsPeriods(nil) = %v, want %v", got, sidekiq.MetricsPeriodOrder)
 	}
 }
+
+func TestJobMetricsPersistsPickedPeriod(t *testing.T) {
+	view := NewJobMetrics(nil)
+	view.SetJobMetrics("Worker", "1h")
+	if got := view.SaveState(); !got.IsZero() {
+		t.Fatalf("state before picking = %+v, want empty", got)
+	}
+
+	view.adjustPeriod(2)
+	view.Dispose()
+	state := view.SaveState()
+	if state.Period != "4h" {
+		t.Fatalf("saved period = %q, want 4h", state.Period)
+	}
+
+	restored := NewJobMetrics(nil)
+	restored.RestoreState(state)
+	restored.SetJobMetrics("Worker", "1h")
+	if restored.period != "4h" {
+		t.Fatalf("period after restore = %q, want 4h", restored.period)
+	}
+	// Only the first open after a restore uses the saved period.
+	restored.Dispose()
+	restored.SetJobMetrics("Worker", "8h")
+	if restored.period != "8h" {
+		t.Fatalf("period on second open = %q, want 8h from Metrics", restored.period)
+	}
+	if got := restored.SaveState().Period; got != "4h" {
+		t.Fatalf("saved period = %q, want the picked 4h", got)
+	}
+
+	ignored := NewJobMetrics(nil)
+	ignored.RestoreState(ViewState{Period: "72h"})
+	ignored.SetJobMetrics("Worker", "2h")
+	if ignored.period != "2h" {
+		t.Fatalf("period with unknown saved period = %q, want 2h", ignored.period)
+	}
+}
+
+func TestJobMetricsScatterCursor(t *testing.T) {
+	view := NewJobMetrics(nil)
+	view.SetJobMetrics("Worker", "1h")
+	right := tea.KeyPressMsg{Code: 'l', Text: "l"}
+	left := tea.KeyPressMsg{Code: 'h', Text: "h"}
+
+	view.Update(right)
+	if view.cursor != -1 {
+		t.Fatalf("cursor without data = %d, want -1", view.cursor)
+	}
+
+	labels := []string{"fast", "slow"}
+	view.Update(jobMetricsDataMsg{result: sidekiq.MetricsJobDetailResult{
+		BucketCount: 2,
+		Hist: map[string][]int64{
+			"2024-01-01T12:00:00Z": {0, 4},
+			"2024-01-01T12:01:00Z": {2, 7},
+		},
+	}})
+
+	view.Update(right)
+	if view.cursor != 1 {
+		t.Fatalf("cursor after first move = %d, want last bucket", view.cursor)
+	}
+	if got := ansi.Strip(view.cursorReadout(labels)); got != "12:01 UTC · fast 7 · slow 2" {
+		t.Fatalf("readout = %q", got)
+	}
+
+	view.Update(left)
+	view.Update(left)
+	if view.cursor != 0 {
+		t.Fatalf("cursor = %d, want clamped to 0", view.cursor)
+	}
+	if got := ansi.Strip(view.cursorReadout(labels)); got != "12:00 UTC · fast 4" {
+		t.Fatalf("readout = %q", got)
+	}
+
+	view.Dispose()
+	if view.cursor != -1 || view.cursorReadout(labels) != "" {
+		t.Fatalf("cursor after dispose = %d, want cleared", view.cursor)
+	}
+}
+
+func TestMetricsSortCyclesRanking(t *testing.T) {
+	client := &metricsClientStub{
+		periodOrder: []string{"1h"},
+		result: sidekiq.MetricsTopJobsResult{
+			Jobs: map[string]sidekiq.MetricsJobTotals{
+				"SlowJob":  {Processed: 2, Milliseconds: 9000, Seconds: 9},
+				"BusyJob":  {Processed: 50, Failed: 1, Milliseconds: 5000, Seconds: 5},
+				"FlakyJob": {Processed: 10, Failed: 8, Milliseconds: 1000, Seconds: 1},
+			},
+		},
+	}
+	m := NewMetrics(client)
+	m.Update(m.fetchListCmd()())
+
+	// The totals row stays first whatever the sort.
+	order := func() []string {
+		if len(m.rows) == 0 || !m.rows[0].all {
+			t.Fatalf("rows = %v, want the totals row first", m.rows)
+		}
+		classes := make([]string, 0, len(m.rows)-1)
+		for _, row := range m.rows[1:] {
+			classes = append(classes, row.class)
+		}
+		return classes
+	}
+	if got := order(); !slices.Equal(got, []string{"SlowJob", "BusyJob", "FlakyJob"}) {
+		t.Fatalf("order by ms = %v", got)
+	}
+
+	want := [][]string{
+		{"BusyJob", "FlakyJob", "SlowJob"},
+		{"FlakyJob", "BusyJob", "SlowJob"},
+		{"SlowJob", "FlakyJob", "BusyJob"},
+		{"SlowJob", "BusyJob", "FlakyJob"},
+	}
+	for _, classes := range want {
+		m.Update(tea.KeyPressMsg{Code: 'o', Text: "o"})
+		if got := order(); !slices.Equal(got, classes) {
+			t.Fatalf("order by %s = %v, want %v", m.sort, got, classes)
+		}
+	}
+
+	m.fetchListCmd()()
+	if client.requestedSort != m.sort {
+		t.Fatalf("requested sort = %s, want %s", client.requestedSort, m.sort)
+	}
+}
+
+func TestMetricsFuzzyFilterRanksClasses(t *testing.T) {
+	client := &metricsClientStub{
+		periodOrder: []string{"1h"},
+		result: sidekiq.MetricsTopJobsResult{
+			Jobs: map[string]sidekiq.MetricsJobTotals{
+				"UnsubscribeMailerJob": {Processed: 90, Seconds: 9},
+				"UserMailer":           {Processed: 2, Seconds: 1},
+				"OrderMailer":          {Processed: 50, Seconds: 5},
+			},
+		},
+	}
+	m := NewMetrics(client)
+	m.Update(m.setFilterAndReload("~usrmlr")())
+
+	if client.requestedFilter != "" {
+		t.Fatalf("requested filter = %q, want every job for fuzzy matching", client.requestedFilter)
+	}
+	classes := make([]string, len(m.rows))
+	for i, row := range m.rows {
+		classes[i] = row.class
+	}
+	if want := []string{"All jobs", "UserMailer", "UnsubscribeMailerJob"}; !slices.Equal(classes, want) {
+		t.Fatalf("rows = %v, want %v", classes, want)
+	}
+	if jobs, _, _ := m.aggregateTotals(); jobs != 2 {
+		t.Fatalf("aggregated jobs = %d, want 2", jobs)
+	}
+	if processed := m.rows[0].totals.Processed; processed != 92 {
+		t.Fatalf("totals row processed = %d, want 92 across matching jobs", processed)
+	}
+}
+
+func TestMetricsAllJobsRow(t *testing.T) {
+	client := &metricsClientStub{
+		periodOrder: []string{"1h"},
+		result: sidekiq.MetricsTopJobsResult{
+			Jobs: map[string]sidekiq.MetricsJobTotals{
+				"SlowJob": {Processed: 4, Failed: 1, Milliseconds: 9000, Seconds: 9},
+				"FastJob": {Processed: 6, Failed: 1, Milliseconds: 1000, Seconds: 1},
+			},
+		},
+	}
+	m := NewMetrics(client)
+	m.SetStyles(Styles{})
+	m.SetSize(120, 20)
+	m.Update(m.fetchListCmd()())
+
+	row := m.table.Rows()[0]
+	if row.ID != metricsAllJobsRowID {
+		t.Fatalf("first row ID = %q, want the totals row", row.ID)
+	}
+	cells := make([]string, len(row.Cells))
+	for i, cell := range row.Cells {
+		cells[i] = ansi.Strip(cell)
+	}
+	if want := []string{"All jobs", "8", "2", "10.00", "1.25", ""}; !slices.Equal(cells, want) {
+		t.Fatalf("totals row = %v, want %v", cells, want)
+	}
+	if _, cmd := m.Update(tea.KeyPressMsg{Code: tea.KeyEnter}); cmd != nil {
+		t.Fatal("enter on the totals row opened job metrics")
+	}
+
+	m.Update(tea.KeyPressMsg{Code: tea.KeyDown})
+	if selected, ok := m.selectedRow(); !ok || selected.class != "SlowJob" {
+		t.Fatalf("selected row = %+v, %v, want SlowJob", selected, ok)
+	}
+
+	client.result = sidekiq.MetricsTopJobsResult{}
+	m.Update(m.fetchListCmd()())
+	if len(m.rows) != 0 {
+		t.Fatalf("rows without metrics = %v, want none", m.rows)
+	}
+}
+
+func TestJobMetricsSuccessRateAndFailureOverlay(t *testing.T) {
+	view := NewJobMetrics(nil)
+	view.SetJobMetrics("Worker", "1h")
+	view.SetSize(80, 24)
+
+	bucket := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC).Format(time.RFC3339)
+	view.Update(jobMetricsDataMsg{result: sidekiq.MetricsJobDetailResult{
+		Totals:        sidekiq.MetricsJobTotals{Processed: 8, Failed: 2, Seconds: 3},
+		Hist:          map[string][]int64{bucket: {0, 6}},
+		BucketCount:   2,
+		BucketMetrics: map[string]sidekiq.MetricsJobTotals{bucket: {Processed: 8, Failed: 2}},
+	}})
+
+	var rate string
+	for _, item := range view.ContextItems() {
+		if item.Label == "Success rate" {
+			rate = item.Value
+		}
+	}
+	if rate != "75.0%" {
+		t.Fatalf("success rate = %q, want 75.0%%", rate)
+	}
+
+	if strings.Contains(ansi.Strip(view.View()), "failure rate") {
+		t.Fatal("failure rate overlay shown before toggling")
+	}
+	view.Update(tea.KeyPressMsg{Code: 'f', Text: "f"})
+	if !strings.Contains(ansi.Strip(view.View()), "failure rate") {
+		t.Fatal("failure rate overlay not shown after pressing f")
+	}
+
+	rates := failureRateOverlay([]float64{0.75, math.NaN()})
+	if rates[0] != 0.25 || !math.IsNaN(rates[1]) {
+		t.Fatalf("failureRateOverlay = %v, want [0.25 NaN]", rates)
+	}
+}
+
+func TestMetricsShowsClockSkew(t *testing.T) {
+	client := &metricsClientStub{periodOrder: []string{"1h"}, skewErr: sidekiq.ErrNoHeartbeats}
+	m := NewMetrics(client)
+	m.SetSize(100, 20)
+	m.Update(m.fetchListCmd()())
+	if got := contextValue(m.ContextItems(), "Clock skew"); got != "-" {
+		t.Fatalf("skew without heartbeats = %q, want -", got)
+	}
+
+	client.skew, client.skewErr = 3*time.Second, nil
+	m.Update(m.fetchListCmd()())
+	if got := ansi.Strip(contextValue(m.ContextItems(), "Clock skew")); got != "+3s" {
+		t.Fatalf("small skew = %q, want +3s", got)
+	}
+	if strings.Contains(ansi.Strip(m.View()), "clock skew") {
+		t.Fatal("small skew should not warn")
+	}
+
+	client.skew = -2 * time.Minute
+	m.Update(m.fetchListCmd()())
+	if got := ansi.Strip(contextValue(m.ContextItems(), "Clock skew")); got != "-2m0s" {
+		t.Fatalf("large skew = %q, want -2m0s", got)
+	}
+	if !strings.Contains(ansi.Strip(m.View()), "clock skew -2m0s") {
+		t.Fatal("large skew should warn in the frame header")
+	}
+
+	client.skewErr = errors.New("boom")
+	if _, ok := m.fetchListCmd()().(ConnectionErrorMsg); !ok {
+		t.Fatal("skew estimate failure should surface as a connection error")
+	}
+}
@@ -0,0 +1,90 @@
+package views
+
+import (
+	"fmt"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/display"
+)
+
+// DefaultLargePayload is the raw payload size, in bytes, from which job lists
+// flag a job as large.
+const DefaultLargePayload int64 = 100 * 1024
+
+// LargePayloadSetter is implemented by views that flag jobs with large
+// payloads.
+type LargePayloadSetter interface {
+	SetLargePayload(threshold int64)
+}
+
+// payloadSize returns the size of the job's raw payload in bytes.
+func payloadSize(job *sidekiq.JobRecord) int64 {
+	if job == nil {
+		return 0
+	}
+	return int64(len(job.Value()))
+}
+
+// isLargePayload reports whether job's raw payload reaches threshold. A zero
+// threshold flags nothing.
+func isLargePayload(job *sidekiq.JobRecord, threshold int64) bool {
+	return threshold > 0 && payloadSize(job) >= threshold
+}
+
+// jobClassCell renders the job class for a list row, followed by a warning
+// with the payload size when the payload is large.
+func jobClassCell(styles Styles, job *sidekiq.JobRecord, threshold int64) string {
+	class := job.DisplayClass()
+	if !isLargePayload(job, threshold) {
+		return class
+	}
+	return class + " " + styles.WarningText.Render("⚠ "+display.Bytes(payloadSize(job)))
+}
+
+// largestPayloadText summarizes the loaded jobs' payload sizes as the largest
+// one and how many are large, e.g. "1.2 MB (3 large)". It returns "-" when
+// there are no jobs.
+func largestPayloadText(jobs []*sidekiq.JobRecord, threshold int64) string {
+	var largest int64
+	large := 0
+	for _, job := range jobs {
+		largest = max(largest, payloadSize(job))
+		if isLargePayload(job, threshold) {
+			large++
+		}
+	}
+	if len(jobs) == 0 {
+		return "-"
+	}
+	if large == 0 {
+		return display.Bytes(largest)
+	}
+	return fmt.Sprintf("%s (%d large)", display.Bytes(largest), large)
+}
+
+// sortedJobRecords returns the job records of sorted-set entries.
+func sortedJobRecords(entries []*sidekiq.SortedEntry) []*sidekiq.JobRecord {
+	records := make([]*sidekiq.JobRecord, 0, len(entries))
+	for _, entry := range entries {
+		if entry != nil {
+			records = append(records, entry.JobRecord)
+		}
+	}
+	return records
+}
+
+// positionedJobRecords returns the job records of queue entries.
+func positionedJobRecords(entries []*sidekiq.PositionedEntry) []*sidekiq.JobRecord {
+	records := make([]*sidekiq.JobRecord, 0, len(entries))
+	for _, entry := range entries {
+		if entry != nil {
+			records = append(records, entry.JobRecord)
+		}
+	}
+	return records
+}
+
+// SetLargePayload implements LargePayloadSetter.
+func (v *sortedJobsView) SetLargePayload(threshold int64) {
+	v.largePayload = threshold
+}
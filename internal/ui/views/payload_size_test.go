@@ -0,0 +1,46 @@
+package views
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+)
+
+func TestJobClassCellFlagsLargePayloads(t *testing.T) {
+	small := sidekiq.NewJobRecord(`{"jid":"a","class":"SyncJob","args":[]}`, "")
+	large := sidekiq.NewJobRecord(`{"jid":"b","class":"ImportJob","args":["`+strings.Repeat("x", 2048)+`"]}`, "")
+
+	if got := jobClassCell(Styles{}, small, 1024); got != "SyncJob" {
+		t.Fatalf("small job cell = %q, want the class alone", got)
+	}
+	if got := jobClassCell(Styles{}, large, 1024); got != "ImportJob ⚠ 2.0 KB" {
+		t.Fatalf("large job cell = %q, want a size warning", got)
+	}
+	if got := jobClassCell(Styles{}, large, 0); got != "ImportJob" {
+		t.Fatalf("cell with threshold 0 = %q, want no warning", got)
+	}
+
+	if got := largestPayloadText([]*sidekiq.JobRecord{small, large}, 1024); got != "2.0 KB (1 large)" {
+		t.Fatalf("largest = %q, want 2.0 KB (1 large)", got)
+	}
+	if got := largestPayloadText([]*sidekiq.JobRecord{small}, 1024); got != "39 B" {
+		t.Fatalf("largest = %q, want 39 B", got)
+	}
+	if got := largestPayloadText(nil, 1024); got != "-" {
+		t.Fatalf("largest of no jobs = %q, want -", got)
+	}
+}
+
+func TestJobDetailPayloadSize(t *testing.T) {
+	view := NewJobDetail(nil)
+	view.SetJob(sidekiq.NewJobRecord(`{"jid":"a","class":"SyncJob","args":["`+strings.Repeat("x", 2048)+`"]}`, ""))
+	if got := propertyValue(view.properties, "Payload Size"); got != "2.0 KB" {
+		t.Fatalf("Payload Size = %q, want 2.0 KB", got)
+	}
+
+	view.SetLargePayload(1024)
+	if got := propertyValue(view.properties, "Payload Size"); got != "2.0 KB (large)" {
+		t.Fatalf("Payload Size = %q, want the large flag", got)
+	}
+}
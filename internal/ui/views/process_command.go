@@ -0,0 +1,85 @@
+package views
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+)
+
+// processCommandPlaceholders are the fields a process command template may
+// reference.
+var processCommandPlaceholders = []string{"{hostname}", "{pid}", "{tag}", "{identity}"}
+
+// ProcessCommand is a command template such as "ssh {hostname}" that the
+// Busy view fills in from a process and copies to the clipboard.
+type ProcessCommand string
+
+// ProcessCommandSetter is implemented by views that copy a command for the
+// selected process.
+type ProcessCommandSetter interface {
+	SetProcessCommand(command ProcessCommand)
+}
+
+// ParseProcessCommand validates template, rejecting placeholders other than
+// {hostname}, {pid}, {tag} and {identity}.
+func ParseProcessCommand(template string) (ProcessCommand, error) {
+	rest := template
+	for _, placeholder := range processCommandPlaceholders {
+		rest = strings.ReplaceAll(rest, placeholder, "")
+	}
+	if start := strings.Index(rest, "{"); start >= 0 {
+		end := strings.Index(rest[start:], "}")
+		if end < 0 {
+			return "", fmt.Errorf("unterminated placeholder in %q", template)
+		}
+		return "", fmt.Errorf("unknown placeholder %s in %q (want {hostname}, {pid}, {tag} or {identity})", rest[start:start+end+1], template)
+	}
+	return ProcessCommand(strings.TrimSpace(template)), nil
+}
+
+// Render fills in the template from proc. Placeholders for fields the process
+// does not report, such as {pid} of an identity without one, are an error
+// rather than an empty value that would produce a broken command.
+func (c ProcessCommand) Render(proc sidekiq.Process) (string, error) {
+	hostname, pid := processHostPID(proc)
+	values := map[string]string{
+		"{hostname}": hostname,
+		"{pid}":      pid,
+		"{tag}":      proc.Tag,
+		"{identity}": proc.Identity,
+	}
+	command := string(c)
+	for _, placeholder := range processCommandPlaceholders {
+		if !strings.Contains(command, placeholder) {
+			continue
+		}
+		value := values[placeholder]
+		if value == "" {
+			return "", fmt.Errorf("%s is unknown for %s", strings.Trim(placeholder, "{}"), proc.Identity)
+		}
+		command = strings.ReplaceAll(command, placeholder, value)
+	}
+	return command, nil
+}
+
+// processHostPID returns the process hostname and PID, falling back to the
+// identity when the process info lacks them. Identities that do not split
+// into hostname:pid yield the whole identity as the hostname and no PID.
+func processHostPID(proc sidekiq.Process) (string, string) {
+	hostname, pid := proc.Hostname, ""
+	if proc.PID > 0 {
+		pid = strconv.Itoa(proc.PID)
+	}
+	parts := strings.Split(proc.Identity, ":")
+	if hostname == "" {
+		hostname = parts[0]
+	}
+	if pid == "" && len(parts) >= 2 {
+		if n, err := strconv.Atoi(parts[1]); err == nil && n > 0 {
+			pid = parts[1]
+		}
+	}
+	return hostname, pid
+}
@@ -0,0 +1,84 @@
+package views
+
+import (
+	"testing"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+)
+
+func TestParseProcessCommand(t *testing.T) {
+	tests := []struct {
+		template string
+		want     ProcessCommand
+		wantErr  bool
+	}{
+		{template: "", want: ""},
+		{template: " ssh deploy@{hostname} ", want: "ssh deploy@{hostname}"},
+		{template: "kill -TTIN {pid} # {tag} {identity}", want: "kill -TTIN {pid} # {tag} {identity}"},
+		{template: "ssh {host}", wantErr: true},
+		{template: "ssh {hostname", wantErr: true},
+	}
+
+	for _, tc := range tests {
+		got, err := ParseProcessCommand(tc.template)
+		if (err != nil) != tc.wantErr {
+			t.Fatalf("ParseProcessCommand(%q) error = %v, wantErr %v", tc.template, err, tc.wantErr)
+		}
+		if got != tc.want {
+			t.Fatalf("ParseProcessCommand(%q) = %q, want %q", tc.template, got, tc.want)
+		}
+	}
+}
+
+func TestProcessCommandRender(t *testing.T) {
+	tests := []struct {
+		name    string
+		command ProcessCommand
+		proc    sidekiq.Process
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "parsed process",
+			command: "ssh {hostname} # {pid} {tag}",
+			proc:    sidekiq.Process{Identity: "web-1:14:abc", Hostname: "web-1", PID: 14, Tag: "app"},
+			want:    "ssh web-1 # 14 app",
+		},
+		{
+			name:    "identity only",
+			command: "ssh {hostname} kill {pid}",
+			proc:    sidekiq.Process{Identity: "web-1:14:abc"},
+			want:    "ssh web-1 kill 14",
+		},
+		{
+			name:    "identity without pid",
+			command: "ssh {hostname}",
+			proc:    sidekiq.Process{Identity: "worker.internal"},
+			want:    "ssh worker.internal",
+		},
+		{
+			name:    "missing pid",
+			command: "kill {pid}",
+			proc:    sidekiq.Process{Identity: "worker:main:abc"},
+			wantErr: true,
+		},
+		{
+			name:    "missing tag",
+			command: "ssh {hostname} {tag}",
+			proc:    sidekiq.Process{Identity: "web-1:14:abc"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.command.Render(tc.proc)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("Render error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if got != tc.want {
+				t.Fatalf("Render = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package views
+
+import (
+	"fmt"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+)
+
+// processStatusLabel describes a process status, counting the jobs still in
+// flight while it quiets or stops, e.g. "quieting (3 in-flight)".
+func processStatusLabel(proc sidekiq.Process) string {
+	switch proc.Status {
+	case sidekiq.ProcessStatusQuieting, sidekiq.ProcessStatusStopping:
+		if proc.Busy > 0 {
+			return fmt.Sprintf("%s (%d in-flight)", proc.Status, proc.Busy)
+		}
+	}
+	return proc.Status
+}
+
+// processStatusBadge flags a process that no longer fetches jobs. It returns
+// "" for a running process.
+func processStatusBadge(styles Styles, proc sidekiq.Process) string {
+	switch proc.Status {
+	case sidekiq.ProcessStatusQuieting:
+		return " " + styles.WarningText.Render(processStatusLabel(proc))
+	case sidekiq.ProcessStatusQuiet:
+		return " " + styles.Muted.Render(processStatusLabel(proc))
+	case sidekiq.ProcessStatusStopping:
+		return " " + styles.ErrorText.Render(processStatusLabel(proc))
+	}
+	return ""
+}
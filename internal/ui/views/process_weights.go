@@ -0,0 +1,262 @@
+package views
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/components/frame"
+	"github.com/kpumuk/lazykiq/internal/ui/components/table"
+	"github.com/kpumuk/lazykiq/internal/ui/display"
+)
+
+// ProcessWeights previews how a process's capsules poll their queues. It only
+// reads the process info it was opened with; worker config cannot be changed.
+type ProcessWeights struct {
+	width       int
+	height      int
+	styles      Styles
+	process     sidekiq.Process
+	capsules    []string
+	selected    int
+	table       table.Model
+	frameStyles frame.Styles
+}
+
+// NewProcessWeights creates a new ProcessWeights view.
+func NewProcessWeights() *ProcessWeights {
+	return &ProcessWeights{
+		table: table.New(
+			table.WithColumns(processWeightsColumns),
+			table.WithEmptyMessage("No queues"),
+		),
+	}
+}
+
+var processWeightsColumns = []table.Column{
+	{Title: "#", Width: 3, Align: table.AlignRight},
+	{Title: "Queue", Width: 30},
+	{Title: "Weight", Width: 6, Align: table.AlignRight},
+	{Title: "Polling", Width: 12, Align: table.AlignRight},
+}
+
+// Init implements View.
+func (w *ProcessWeights) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements View.
+func (w *ProcessWeights) Update(msg tea.Msg) (View, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyPressMsg)
+	if !ok {
+		return w, nil
+	}
+	if w.table.JumpActive() {
+		w.table, _ = w.table.Update(keyMsg)
+		return w, nil
+	}
+	switch keyMsg.String() {
+	case "tab":
+		w.selectCapsule(w.selected + 1)
+		return w, nil
+	case "shift+tab":
+		w.selectCapsule(w.selected - 1)
+		return w, nil
+	}
+
+	w.table, _ = w.table.Update(keyMsg)
+	return w, nil
+}
+
+// View implements View.
+func (w *ProcessWeights) View() string {
+	if len(w.capsules) == 0 {
+		return renderStatusMessage("Weights", "No capsules reported", w.styles, w.width, w.height)
+	}
+
+	box := frame.New(
+		frame.WithStyles(w.frameStyles),
+		frame.WithTitle("Weights"),
+		frame.WithTitlePadding(0),
+		frame.WithMeta(w.capsuleMeta()),
+		frame.WithContent(w.table.View()),
+		frame.WithPadding(1),
+		frame.WithSize(w.width, w.height),
+		frame.WithMinHeight(5),
+		frame.WithFocused(true),
+	)
+	return box.View()
+}
+
+// Name implements View.
+func (w *ProcessWeights) Name() string {
+	return "Weights"
+}
+
+// ShortHelp implements View.
+func (w *ProcessWeights) ShortHelp() []key.Binding {
+	return nil
+}
+
+// ContextItems implements ContextProvider.
+func (w *ProcessWeights) ContextItems() []ContextItem {
+	capsuleName := "-"
+	mode := "-"
+	concurrency := "-"
+	weights := "-"
+	if capsule, ok := w.selectedCapsule(); ok {
+		capsuleName = w.capsules[w.selected]
+		mode = capsuleMode(capsule)
+		concurrency = strconv.Itoa(capsule.Concurrency)
+		weights = formatRawWeights(capsule)
+	}
+
+	return []ContextItem{
+		{Label: "Process", Value: processIdentity(w.process)},
+		{Label: "Capsule", Value: capsuleName},
+		{Label: "Mode", Value: mode},
+		{Label: "Concurrency", Value: concurrency},
+		{Label: "Weights", Value: weights},
+	}
+}
+
+// HintBindings implements HintProvider.
+func (w *ProcessWeights) HintBindings() []key.Binding {
+	return []key.Binding{
+		helpBinding([]string{"tab"}, "tab", "next capsule"),
+	}
+}
+
+// HelpSections implements HelpProvider.
+func (w *ProcessWeights) HelpSections() []HelpSection {
+	return []HelpSection{{
+		Title: "Weights",
+		Bindings: []key.Binding{
+			helpBinding([]string{"tab"}, "tab", "next capsule"),
+			helpBinding([]string{"shift+tab"}, "shift+tab", "previous capsule"),
+		},
+	}}
+}
+
+// TableHelp implements TableHelpProvider.
+func (w *ProcessWeights) TableHelp() []key.Binding {
+	return tableHelpBindings(w.table.KeyMap)
+}
+
+// SetSize implements View.
+func (w *ProcessWeights) SetSize(width, height int) View {
+	w.width = width
+	w.height = height
+	tableWidth, tableHeight := framedTableSize(width, height)
+	w.table.SetSize(tableWidth, tableHeight)
+	return w
+}
+
+// SetStyles implements View.
+func (w *ProcessWeights) SetStyles(styles Styles) View {
+	w.styles = styles
+	w.frameStyles = frameStylesFromTheme(styles)
+	w.table.SetStyles(tableStylesFromTheme(styles))
+	return w
+}
+
+// InputFocused implements InputFocuser.
+func (w *ProcessWeights) InputFocused() bool {
+	return w.table.JumpActive()
+}
+
+// SetProcess sets the process whose capsules are shown.
+func (w *ProcessWeights) SetProcess(process sidekiq.Process) {
+	w.process = process
+	w.capsules = sortedCapsuleNames(process.Capsules)
+	w.selectCapsule(0)
+}
+
+// Dispose clears cached data when the view is removed from the stack.
+func (w *ProcessWeights) Dispose() {
+	w.SetProcess(sidekiq.Process{})
+}
+
+func (w *ProcessWeights) selectCapsule(idx int) {
+	if len(w.capsules) == 0 {
+		w.selected = 0
+		w.table.SetRows(nil)
+		return
+	}
+	// Wrap around so tab cycles through every capsule.
+	w.selected = (idx%len(w.capsules) + len(w.capsules)) % len(w.capsules)
+	w.updateTableRows()
+	w.table.SetCursor(0)
+}
+
+func (w *ProcessWeights) selectedCapsule() (sidekiq.Capsule, bool) {
+	if w.selected < 0 || w.selected >= len(w.capsules) {
+		return sidekiq.Capsule{}, false
+	}
+	return w.process.Capsules[w.capsules[w.selected]], true
+}
+
+func (w *ProcessWeights) updateTableRows() {
+	capsule, ok := w.selectedCapsule()
+	if !ok {
+		w.table.SetRows(nil)
+		return
+	}
+
+	strict := capsuleMode(capsule) == "strict"
+	polls := capsule.PollingOrder()
+	rows := make([]table.Row, len(polls))
+	for i, poll := range polls {
+		polling := display.Float(poll.Probability*100, 1) + "%"
+		if strict {
+			polling = "priority " + strconv.Itoa(i+1)
+		}
+		rows[i] = table.Row{
+			ID: poll.Queue,
+			Cells: []string{
+				strconv.Itoa(i + 1),
+				poll.Queue,
+				strconv.Itoa(poll.Weight),
+				polling,
+			},
+		}
+	}
+	w.table.SetRows(rows)
+}
+
+func (w *ProcessWeights) capsuleMeta() string {
+	capsule, ok := w.selectedCapsule()
+	if !ok {
+		return ""
+	}
+	return w.styles.MetricLabel.Render("capsule: ") +
+		w.styles.MetricValue.Render(w.capsules[w.selected]) +
+		w.styles.MetricLabel.Render(" mode: ") +
+		w.styles.MetricValue.Render(capsuleMode(capsule)) +
+		w.styles.Muted.Render(fmt.Sprintf(" %d/%d", w.selected+1, len(w.capsules)))
+}
+
+// capsuleMode returns the capsule's effective polling mode for display.
+func capsuleMode(capsule sidekiq.Capsule) string {
+	if mode := capsule.EffectiveMode(); mode != "" {
+		return mode
+	}
+	return "-"
+}
+
+// formatRawWeights renders the weights map in declaration order.
+func formatRawWeights(capsule sidekiq.Capsule) string {
+	queues := capsule.Queues
+	if len(queues) == 0 {
+		queues = queuesFromWeights(capsule.Weights)
+	}
+	parts := make([]string, 0, len(queues))
+	for _, queue := range queues {
+		parts = append(parts, queue+": "+strconv.Itoa(capsule.Weights[queue]))
+	}
+	return "{" + strings.Join(parts, ", ") + "}"
+}
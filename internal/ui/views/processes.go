@@ -68,7 +68,7 @@ func (p *ProcessesList) Update(msg tea.Msg) (View, tea.Cmd) {
 		p.updateTableRows()
 		return p, nil
 
-	case RefreshMsg:
+	case RefreshMsg, RefreshViewMsg:
 		return p, p.fetchDataCmd()
 
 	case filterdialog.ActionMsg:
@@ -100,6 +100,10 @@ func (p *ProcessesList) Update(msg tea.Msg) (View, tea.Cmd) {
 		}
 
 	case tea.KeyPressMsg:
+		if p.table.JumpActive() {
+			p.table, _ = p.table.Update(msg)
+			return p, nil
+		}
 		switch msg.String() {
 		case "/":
 			return p, func() tea.Msg {
@@ -115,6 +119,14 @@ func (p *ProcessesList) Update(msg tea.Msg) (View, tea.Cmd) {
 				return p, copyTextCmd(identity)
 			}
 			return p, nil
+		case "w":
+			if idx := p.table.Cursor(); idx >= 0 && idx < len(p.processes) {
+				process := p.processes[idx]
+				return p, func() tea.Msg {
+					return ShowProcessWeightsMsg{Process: process}
+				}
+			}
+			return p, nil
 		case "enter":
 			if idx := p.table.Cursor(); idx >= 0 && idx < len(p.processes) {
 				identity := p.processes[idx].Identity
@@ -210,6 +222,7 @@ func (p *ProcessesList) ContextItems() []ContextItem {
 func (p *ProcessesList) HintBindings() []key.Binding {
 	return []key.Binding{
 		helpBinding([]string{"/"}, "/", "filter"),
+		helpBinding([]string{"w"}, "w", "weights"),
 		helpBinding([]string{"enter"}, "enter", "select process"),
 	}
 }
@@ -232,6 +245,7 @@ func (p *ProcessesList) HelpSections() []HelpSection {
 		Bindings: []key.Binding{
 			helpBinding([]string{"/"}, "/", "filter processes"),
 			helpBinding([]string{"c"}, "c", "copy identity"),
+			helpBinding([]string{"w"}, "w", "capsule weights"),
 			helpBinding([]string{"enter"}, "enter", "select process"),
 		},
 	}}
@@ -276,6 +290,11 @@ func (p *ProcessesList) CancelRequests() {
 	p.fetchRequest.Cancel()
 }
 
+// InputFocused implements InputFocuser.
+func (p *ProcessesList) InputFocused() bool {
+	return p.table.JumpActive()
+}
+
 // SetStyles implements View.
 func (p *ProcessesList) SetStyles(styles Styles) View {
 	p.styles = styles
@@ -447,6 +466,9 @@ func (p *ProcessesList) updateTableRows() {
 		if process.Tag != "" {
 			name += " [" + process.Tag + "]"
 		}
+		for _, label := range process.Labels {
+			name += " " + p.styles.NavKey.Render(label)
+		}
 
 		queues := formatProcessCapsules(process, p.styles.QueueText, p.styles.QueueWeight, p.styles.Muted)
 		version := process.Version
@@ -1,6 +1,7 @@
 package views
 
 import (
+	"reflect"
 	"testing"
 
 	tea "charm.land/bubbletea/v2"
@@ -84,3 +85,77 @@ func collectConfirmAction(t *testing.T, cmd tea.Cmd) confirmdialog.ActionMsg {
 	}
 	return confirmed
 }
+
+func TestProcessWeightsPreviewsPollingOrder(t *testing.T) {
+	process := sidekiq.Process{
+		Identity: "worker:123:abc",
+		Capsules: map[string]sidekiq.Capsule{
+			sidekiq.DefaultCapsuleName: {
+				Concurrency: 10,
+				Mode:        "weighted",
+				Weights:     map[string]int{"low": 1, "critical": 3},
+				Queues:      []string{"low", "critical"},
+			},
+			"serial": {
+				Concurrency: 1,
+				Mode:        "strict",
+				Weights:     map[string]int{"imports": 0, "exports": 0},
+				Queues:      []string{"imports", "exports"},
+			},
+		},
+	}
+
+	list := NewProcessesList(nil)
+	list.processes = []sidekiq.Process{process}
+	list.updateTableRows()
+	_, cmd := list.Update(tea.KeyPressMsg{Code: 'w', Text: "w"})
+	if cmd == nil {
+		t.Fatal("w returned nil command, want ShowProcessWeightsMsg")
+	}
+	msg, ok := cmd().(ShowProcessWeightsMsg)
+	if !ok || msg.Process.Identity != process.Identity {
+		t.Fatalf("w command = %#v, want ShowProcessWeightsMsg for %s", msg, process.Identity)
+	}
+
+	view := NewProcessWeights()
+	view.SetSize(80, 12)
+	view.SetProcess(msg.Process)
+
+	cells := func() [][]string {
+		var rows [][]string
+		for _, row := range view.table.Rows() {
+			rows = append(rows, row.Cells[1:])
+		}
+		return rows
+	}
+	want := [][]string{{"critical", "3", "75.0%"}, {"low", "1", "25.0%"}}
+	if got := cells(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("default capsule rows = %v, want %v", got, want)
+	}
+	if got := contextValue(view.ContextItems(), "Weights"); got != "{low: 1, critical: 3}" {
+		t.Fatalf("raw weights = %q, want declaration order", got)
+	}
+
+	view.Update(tea.KeyPressMsg{Code: tea.KeyTab})
+	want = [][]string{{"imports", "0", "priority 1"}, {"exports", "0", "priority 2"}}
+	if got := cells(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("serial capsule rows = %v, want %v", got, want)
+	}
+	if got := contextValue(view.ContextItems(), "Mode"); got != "strict" {
+		t.Fatalf("mode = %q, want strict", got)
+	}
+
+	view.Update(tea.KeyPressMsg{Code: tea.KeyTab})
+	if got := contextValue(view.ContextItems(), "Capsule"); got != sidekiq.DefaultCapsuleName {
+		t.Fatalf("capsule after wrapping = %q, want default", got)
+	}
+}
+
+func contextValue(items []ContextItem, label string) string {
+	for _, item := range items {
+		if item.Label == label {
+			return item.Value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,22 @@
+package views
+
+import (
+	promptdialog "github.com/kpumuk/lazykiq/internal/ui/dialogs/prompt"
+)
+
+func newPromptDialog(styles Styles, title, value, target string, validator promptdialog.Validator) *promptdialog.Model {
+	return promptdialog.New(
+		promptdialog.WithStyles(promptdialog.Styles{
+			Title:       styles.Title,
+			Border:      styles.FocusBorder,
+			Text:        styles.Text,
+			Placeholder: styles.Muted,
+			Cursor:      styles.Text,
+			Invalid:     styles.ErrorText,
+		}),
+		promptdialog.WithTitle(title),
+		promptdialog.WithValue(value),
+		promptdialog.WithTarget(target),
+		promptdialog.WithValidator(validator),
+	)
+}
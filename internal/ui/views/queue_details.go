@@ -7,16 +7,25 @@ import (
 	"slices"
 	"sort"
 	"strconv"
+	"strings"
+	"time"
 
 	"charm.land/bubbles/v2/key"
 	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
 
 	"github.com/kpumuk/lazykiq/internal/devtools"
 	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/components/errorbanner"
+	"github.com/kpumuk/lazykiq/internal/ui/components/frame"
+	"github.com/kpumuk/lazykiq/internal/ui/components/histogram"
 	"github.com/kpumuk/lazykiq/internal/ui/components/lazytable"
 	"github.com/kpumuk/lazykiq/internal/ui/components/table"
+	confirmdialog "github.com/kpumuk/lazykiq/internal/ui/dialogs/confirm"
 	filterdialog "github.com/kpumuk/lazykiq/internal/ui/dialogs/filter"
+	promptdialog "github.com/kpumuk/lazykiq/internal/ui/dialogs/prompt"
 	"github.com/kpumuk/lazykiq/internal/ui/display"
+	"github.com/kpumuk/lazykiq/internal/ui/requestctx"
 )
 
 // QueueInfo holds pre-fetched queue information for display.
@@ -29,23 +38,112 @@ type QueueInfo struct {
 type queueDetailsPayload struct {
 	queues        []*QueueInfo
 	jobs          []*sidekiq.PositionedEntry
+	nextJob       *sidekiq.PositionedEntry
+	memory        int64
+	memoryKnown   bool
 	selectedQueue int
+	ages          []int64
+	agesSampled   bool
+	missingQueue  string
+	tail          bool  // jobs is the newest slice read in follow mode
+	tailSize      int64 // Size of the followed queue
 }
 
 const (
-	queuesWindowPages      = 3
-	queuesFallbackPageSize = 25
+	// queuesCombinedPerQueue caps how many next-to-run jobs the combined
+	// view reads from each queue.
+	queuesCombinedPerQueue = 100
+	// queueAgeChartHeight is the height of the age chart box, borders included.
+	queueAgeChartHeight = 8
 )
 
+// queueAgeBuckets are the upper bounds of the job age chart columns.
+var queueAgeBuckets = []time.Duration{
+	time.Minute,
+	5 * time.Minute,
+	15 * time.Minute,
+	time.Hour,
+	6 * time.Hour,
+	24 * time.Hour,
+}
+
+var queueAgeLabels = []string{"<1m", "<5m", "<15m", "<1h", "<6h", "<1d", "1d+"}
+
+// queueListSort is the order of the compact queue list above the jobs table.
+type queueListSort int
+
+const (
+	queueListSortSize queueListSort = iota
+	queueListSortLatency
+	queueListSortName
+	queueListSortCount
+)
+
+func (s queueListSort) String() string {
+	switch s {
+	case queueListSortLatency:
+		return "latency"
+	case queueListSortName:
+		return "name"
+	default:
+		return "size"
+	}
+}
+
+// less reports whether queue a is listed before queue b.
+func (s queueListSort) less(a, b *QueueInfo) bool {
+	switch s {
+	case queueListSortLatency:
+		if a.Latency != b.Latency {
+			return a.Latency > b.Latency
+		}
+	case queueListSortName:
+	default:
+		if a.Size != b.Size {
+			return a.Size > b.Size
+		}
+	}
+	return a.Name < b.Name
+}
+
 // QueueDetails shows the jobs in a specific Sidekiq queue.
 type QueueDetails struct {
 	client sidekiq.API
 	detailListView
 	queues           []*QueueInfo
 	jobs             []*sidekiq.PositionedEntry
+	nextJob          *sidekiq.PositionedEntry // Job a worker fetches next, unfiltered
+	memory           int64                    // Estimated bytes of the selected queue
+	memoryKnown      bool
 	selectedQueue    int
-	selectedQueueKey string // Queue name to select after loading
-	displayOrder     []int  // Maps ctrl+1-5 to queue indices
+	selectedQueueKey string   // Queue name to select after loading
+	pinnedQueue      string   // Queue shown even when missing from the queues set
+	missingQueue     string   // Pinned queue that is not in the queues set
+	displayOrder     []int    // Maps ctrl+1-5 to queue indices
+	favorites        []string // Queues pinned to the top of the list, in pin order
+	note             string   // Brief notice shown until the next key press
+	listSort         queueListSort
+	allQueues        bool // Show the merged head of every queue
+	hideEmpty        bool // Leave empty queues out of the queue list
+	showAges         bool
+	ages             []int64
+	agesSampled      bool
+	fullWidth        int
+	fullHeight       int
+	pageSize         int
+	windowPages      int
+	latency          LatencyThresholds
+	dangerousActions bool
+	strictConfirm    bool
+	largePayload     int64
+	migrateTo        string // Target queue awaiting confirmation
+	migrating        bool
+	migrateStatus    string
+	migrateRequest   requestctx.Controller
+	draining         bool
+	following        bool // Follow mode: show new arrivals as they are queued
+	tail             *queueTail
+	errorBanner      errorbanner.Model
 }
 
 // NewQueueDetails creates a new QueueDetails view.
@@ -56,12 +154,20 @@ func NewQueueDetails(client sidekiq.API) *QueueDetails {
 			"Jobs",
 			queueJobColumns,
 			"No jobs in queue",
-			queuesWindowPages,
-			queuesFallbackPageSize,
+			DefaultWindowPages,
+			DefaultPageSize,
 		),
+		pageSize:      DefaultPageSize,
+		windowPages:   DefaultWindowPages,
 		selectedQueue: 0,
+		latency:       DefaultLatencyThresholds(),
+		largePayload:  DefaultLargePayload,
+		errorBanner:   newErrorBanner(),
 	}
 	q.lazy.SetFetcher(q.fetchWindow)
+	q.lazy.SetErrorHandler(func(err error) tea.Msg {
+		return fetchErrorMsg{view: q.Name(), err: err}
+	})
 	return q
 }
 
@@ -74,29 +180,101 @@ func (q *QueueDetails) Init() tea.Cmd {
 func (q *QueueDetails) Update(msg tea.Msg) (View, tea.Cmd) {
 	switch msg := msg.(type) {
 	case lazytable.DataMsg:
+		// A tail fetch that lands after following stopped is shown as a
+		// regular window of the newest jobs.
+		if payload, ok := msg.Result.Payload.(queueDetailsPayload); ok && payload.tail && q.following && msg.RequestID == q.lazy.RequestID() {
+			msg = q.applyTail(msg, payload)
+		}
 		if handled, cmd := q.handleData(msg, func(result lazytable.FetchResult) {
 			if payload, ok := result.Payload.(queueDetailsPayload); ok {
 				q.queues = payload.queues
 				q.jobs = payload.jobs
+				q.nextJob = payload.nextJob
+				q.memory = payload.memory
+				q.memoryKnown = payload.memoryKnown
 				q.selectedQueue = payload.selectedQueue
+				q.ages = payload.ages
+				q.agesSampled = payload.agesSampled
+				q.missingQueue = payload.missingQueue
 			}
 			q.selectedQueueKey = ""
 			q.updateEmptyMessage()
 		}); handled {
+			q.errorBanner.Dismiss()
+			if q.following {
+				q.lazy.GotoTop()
+			}
 			return q, cmd
 		}
 		return q, nil
 
-	case RefreshMsg:
+	case fetchErrorMsg:
+		if msg.view != q.Name() {
+			return q, nil
+		}
+		// The failed request is over; without this the loading state
+		// would block every later refresh.
+		q.lazy.CancelRequest()
+		q.errorBanner.SetMessage(fetchErrorText(q.client, msg.err))
+		return q, nil
+
+	case RefreshMsg, RefreshViewMsg:
 		return q, q.refreshWindow()
 
 	case filterdialog.ActionMsg:
 		return q, q.handleFilterAction(msg, q.updateEmptyMessage)
 
+	case queueMigrationMsg:
+		return q, q.handleMigration(msg)
+
+	case queueDrainedMsg:
+		return q, q.handleDrained(msg)
+
+	case promptdialog.ActionMsg:
+		from, ok := q.migrateSource()
+		if msg.Target != queueMigrateTarget || !q.dangerousActions || q.migrating || !ok {
+			return q, nil
+		}
+		to, err := parseMigrateTarget(from, msg.Value)
+		if err != nil {
+			return q, nil
+		}
+		q.migrateTo = to
+		return q, q.openMigrateConfirm(from, to)
+
+	case confirmdialog.ActionMsg:
+		if msg.Target == queueDrainTarget {
+			queue, ok := q.migrateSource()
+			if !q.dangerousActions || !msg.Confirmed || q.draining || q.migrating || !ok {
+				return q, nil
+			}
+			q.draining = true
+			q.note = "draining " + queue + " to dead…"
+			return q, q.drainCmd(queue)
+		}
+		if msg.Target != queueMigrateTarget {
+			return q, nil
+		}
+		to := q.migrateTo
+		q.migrateTo = ""
+		from, ok := q.migrateSource()
+		if !q.dangerousActions || !msg.Confirmed || to == "" || q.migrating || !ok {
+			return q, nil
+		}
+		q.migrating = true
+		q.migrateStatus = "moving to " + to + "…"
+		return q, q.migrateCmd(from, to)
+
 	case tea.KeyPressMsg:
 		if handled, cmd := q.handleKeyPress(msg, q.updateEmptyMessage); handled {
+			q.stopFollowingIfScrolled()
 			return q, cmd
 		}
+		q.note = ""
+		if msg.String() == "esc" && q.errorBanner.Visible() {
+			q.errorBanner.Dismiss()
+			return q, nil
+		}
 
 		switch msg.String() {
 		case "s":
@@ -109,11 +287,74 @@ func (q *QueueDetails) Update(msg tea.Msg) (View, tea.Cmd) {
 				return q, copyTextCmd(job.JID())
 			}
 			return q, nil
+		case "Y":
+			return q, copyTextCmd(q.redisKey())
+		case "a":
+			q.showAges = !q.showAges
+			q.ages = nil
+			q.applySize()
+			if q.showAges {
+				return q, q.detailListView.refreshWindow()
+			}
+			return q, nil
+		case "A":
+			q.setAllQueues(!q.allQueues)
+			return q, q.reloadFromStart()
+		case "f":
+			if q.following {
+				q.setFollowing(false)
+				return q, q.refreshWindow()
+			}
+			if q.allQueues {
+				q.note = "select a queue to follow"
+				return q, nil
+			}
+			q.setFollowing(true)
+			return q, q.reloadFromStart()
+		case "o":
+			q.listSort = (q.listSort + 1) % queueListSortCount
+			return q, nil
+		case "e":
+			q.hideEmpty = !q.hideEmpty
+			if q.hideEmpty {
+				q.note = "hiding empty queues"
+			} else {
+				q.note = "showing empty queues"
+			}
+			return q, nil
+		case "P":
+			q.toggleFavorite()
+			return q, nil
+		case "m":
+			if !q.dangerousActions {
+				break
+			}
+			if q.migrating {
+				q.migrateRequest.Cancel()
+				q.migrateStatus = "stopping…"
+				return q, nil
+			}
+			if from, ok := q.migrateSource(); ok {
+				return q, q.openMigratePrompt(from)
+			}
+			return q, nil
+		case "K":
+			if !q.dangerousActions || q.draining || q.migrating {
+				break
+			}
+			if queue, ok := q.migrateSource(); ok {
+				return q, q.openDrainConfirm(queue)
+			}
+			return q, nil
+		case "}":
+			return q, q.selectNonEmptyQueue(1)
+		case "{":
+			return q, q.selectNonEmptyQueue(-1)
 		case "ctrl+1", "ctrl+2", "ctrl+3", "ctrl+4", "ctrl+5":
 			displayIdx := int(msg.String()[5] - '1')
 			if displayIdx >= 0 && displayIdx < len(q.displayOrder) {
 				queueIdx := q.displayOrder[displayIdx]
-				if queueIdx >= 0 && queueIdx < len(q.queues) && q.selectedQueue != queueIdx {
+				if queueIdx >= 0 && queueIdx < len(q.queues) && (q.allQueues || q.selectedQueue != queueIdx) {
 					return q, q.selectQueue(queueIdx)
 				}
 			}
@@ -122,13 +363,15 @@ func (q *QueueDetails) Update(msg tea.Msg) (View, tea.Cmd) {
 			// Show detail for selected job
 			if job, ok := q.selectedJob(); ok {
 				return q, func() tea.Msg {
-					return ShowJobDetailMsg{Job: job.JobRecord}
+					return ShowJobDetailMsg{Job: job.JobRecord, Source: queueJobSource(job)}
 				}
 			}
 			return q, nil
 		}
 
-		return q, q.updateKeyPress(msg)
+		cmd := q.updateKeyPress(msg)
+		q.stopFollowingIfScrolled()
+		return q, cmd
 	}
 
 	return q, nil
@@ -137,10 +380,13 @@ func (q *QueueDetails) Update(msg tea.Msg) (View, tea.Cmd) {
 // View implements View.
 func (q *QueueDetails) View() string {
 	if !q.ready {
+		if q.errorBanner.Visible() {
+			return q.errorBanner.Overlay(renderStatusMessage(q.title, "No data loaded", q.styles, q.width, q.height))
+		}
 		return q.renderLoadingMessage()
 	}
 
-	return q.renderJobsBox()
+	return q.errorBanner.Overlay(q.renderJobsBox())
 }
 
 // Name implements View.
@@ -173,8 +419,50 @@ func (q *QueueDetails) ContextItems() []ContextItem {
 		queueName = q.queues[q.selectedQueue].Name
 	}
 	items := []ContextItem{}
-	if queueName != "" {
-		items = append(items, ContextItem{Label: "Queue", Value: q.styles.QueueText.Render(queueName)})
+	if q.allQueues {
+		items = append(items, ContextItem{
+			Label: "Queue",
+			Value: q.styles.QueueText.Render("all") + " " + q.styles.Muted.Render("(approximate)"),
+		})
+	} else if queueName != "" {
+		queue := q.queues[q.selectedQueue]
+		items = append(items,
+			ContextItem{Label: "Queue", Value: q.styles.QueueText.Render(queueName)},
+			ContextItem{
+				Label: "Latency",
+				Value: highlightLatency(q.styles, q.latency.For(queueName), queue.Latency, formatLatency(queue.Latency)),
+			},
+		)
+		nextUp := "-"
+		if q.nextJob != nil {
+			nextUp = q.nextJob.DisplayClass() + " " + q.styles.Muted.Render(q.nextJob.JID())
+		}
+		items = append(items,
+			ContextItem{Label: "Next Up", Value: nextUp},
+			ContextItem{Label: "Memory", Value: formatMemory(q.memory, q.memoryKnown)},
+			ContextItem{Label: "Largest", Value: largestPayloadText(positionedJobRecords(q.jobs), q.largePayload)},
+		)
+		if queueName == q.missingQueue {
+			items = append(items, ContextItem{Label: "Note", Value: q.styles.Muted.Render("queue empty or missing")})
+		}
+	}
+	if q.hideEmpty {
+		items = append(items, ContextItem{Label: "Hidden", Value: display.Number(int64(q.hiddenQueueCount())) + " empty"})
+	}
+	if q.note != "" {
+		items = append(items, ContextItem{Label: "Note", Value: q.styles.Muted.Render(q.note)})
+	}
+	if q.migrateStatus != "" {
+		items = append(items, ContextItem{Label: "Migrate", Value: q.migrateStatus})
+	}
+	if q.following {
+		value := "starting…"
+		if q.tail != nil && len(q.tail.fresh) > 0 {
+			value = display.Number(int64(len(q.tail.fresh))) + " new"
+		} else if q.tail != nil {
+			value = "no new jobs"
+		}
+		items = append(items, ContextItem{Label: "Follow", Value: value})
 	}
 	if q.filter != "" {
 		items = append(items, ContextItem{Label: "Filter", Value: q.filter})
@@ -199,11 +487,32 @@ func (q *QueueDetails) HintBindings() []key.Binding {
 		helpBinding([]string{"/"}, "/", "filter"),
 		helpBinding([]string{"ctrl+u"}, "ctrl+u", "reset filter"),
 		helpBinding([]string{"s"}, "s", "switch queue"),
+		helpBinding([]string{"{", "}"}, "{ ⋰ }", "prev/next with jobs"),
+		helpBinding([]string{"a"}, "a", "age chart"),
+		helpBinding([]string{"o"}, "o", "sort queues"),
+		helpBinding([]string{"e"}, "e", "hide empty"),
+		helpBinding([]string{"P"}, "P", "pin queue"),
+		helpBinding([]string{"A"}, "A", "all queues"),
+		helpBinding([]string{"f"}, "f", "follow"),
 		helpBinding([]string{"[", "]"}, "[ ⋰ ]", "page up/down"),
 		helpBinding([]string{"enter"}, "enter", "job detail"),
 	}
 }
 
+// MutationBindings implements MutationHintProvider.
+func (q *QueueDetails) MutationBindings() []key.Binding {
+	if !q.dangerousActions {
+		return nil
+	}
+	if q.migrating {
+		return []key.Binding{helpBinding([]string{"m"}, "m", "stop migration")}
+	}
+	return []key.Binding{
+		helpBinding([]string{"m"}, "m", "migrate queue"),
+		helpBinding([]string{"K"}, "shift+k", "drain to dead"),
+	}
+}
+
 // HelpSections implements HelpProvider.
 func (q *QueueDetails) HelpSections() []HelpSection {
 	sections := []HelpSection{{
@@ -213,14 +522,32 @@ func (q *QueueDetails) HelpSections() []HelpSection {
 			helpBinding([]string{"ctrl+u"}, "ctrl+u", "clear filter"),
 			helpBinding([]string{"s"}, "s", "switch queue"),
 			helpBinding([]string{"ctrl+1"}, "ctrl+1-5", "select queue"),
+			helpBinding([]string{"}"}, "}", "next queue with jobs"),
+			helpBinding([]string{"{"}, "{", "previous queue with jobs"),
+			helpBinding([]string{"a"}, "a", "toggle age chart"),
+			helpBinding([]string{"o"}, "o", "sort queues by size/latency/name"),
+			helpBinding([]string{"e"}, "e", "hide or show empty queues"),
+			helpBinding([]string{"P"}, "shift+p", "pin or unpin queue"),
+			helpBinding([]string{"A"}, "shift+a", "toggle all queues combined"),
+			helpBinding([]string{"f"}, "f", "follow new jobs"),
 			helpBinding([]string{"["}, "[", "page up"),
 			helpBinding([]string{"]"}, "]", "page down"),
 			helpBinding([]string{"g"}, "g", "jump to start"),
 			helpBinding([]string{"G"}, "shift+g", "jump to end"),
 			helpBinding([]string{"c"}, "c", "copy jid"),
+			helpBinding([]string{"Y"}, "shift+y", "copy redis key"),
 			helpBinding([]string{"enter"}, "enter", "job detail"),
 		},
 	}}
+	if q.dangerousActions {
+		sections = append(sections, HelpSection{
+			Title: "Dangerous Actions",
+			Bindings: []key.Binding{
+				helpBinding([]string{"m"}, "m", "migrate jobs to another queue"),
+				helpBinding([]string{"K"}, "shift+k", "drain queue to dead"),
+			},
+		})
+	}
 	return sections
 }
 
@@ -231,30 +558,86 @@ func (q *QueueDetails) TableHelp() []key.Binding {
 
 // SetSize implements View.
 func (q *QueueDetails) SetSize(width, height int) View {
-	q.setSize(width, height)
+	q.fullWidth = width
+	q.fullHeight = height
+	q.errorBanner.SetWidth(width)
+	q.applySize()
 	return q
 }
 
+// applySize gives the jobs table whatever height the age chart leaves.
+func (q *QueueDetails) applySize() {
+	q.setSize(q.fullWidth, q.fullHeight-q.ageChartHeight())
+}
+
+// ageChartHeight returns the height of the age chart, or 0 when it is hidden
+// or the view is too short to fit it alongside the jobs table.
+func (q *QueueDetails) ageChartHeight() int {
+	if !q.showAges || q.fullHeight < queueAgeChartHeight+8 {
+		return 0
+	}
+	return queueAgeChartHeight
+}
+
 // Dispose clears cached data when the view is removed from the stack.
 func (q *QueueDetails) Dispose() {
+	q.migrateRequest.Cancel()
+	q.migrating = false
+	q.migrateStatus = ""
 	q.dispose(q.reset)
 }
 
-// CancelRequests stops in-flight fetches when the view is hidden.
+// CancelRequests stops in-flight fetches and a running migration when the
+// view is hidden, since progress is only delivered to the active view.
 func (q *QueueDetails) CancelRequests() {
+	if q.migrating {
+		q.migrateRequest.Cancel()
+		q.migrating = false
+		q.migrateStatus = "stopped"
+	}
 	q.cancelRequests()
 }
 
+// SetDangerousActionsEnabled toggles mutational actions for the view.
+func (q *QueueDetails) SetDangerousActionsEnabled(enabled bool) {
+	q.dangerousActions = enabled
+}
+
+// SetLargePayload implements LargePayloadSetter.
+func (q *QueueDetails) SetLargePayload(threshold int64) {
+	q.largePayload = threshold
+}
+
+// SetStrictConfirm implements StrictConfirmSetter.
+func (q *QueueDetails) SetStrictConfirm(strict bool) {
+	q.strictConfirm = strict
+}
+
 // SetStyles implements View.
 func (q *QueueDetails) SetStyles(styles Styles) View {
 	q.setStyles(styles)
+	q.errorBanner.SetStyles(errorBannerStylesFromTheme(styles))
 	return q
 }
 
-// SetQueue allows setting the selected queue by name.
+// SetLatencyThresholds implements LatencyThresholdsSetter.
+func (q *QueueDetails) SetLatencyThresholds(thresholds LatencyThresholds) {
+	q.latency = thresholds
+}
+
+// SetPaging implements PagingSetter. Values below 1 are clamped to 1.
+func (q *QueueDetails) SetPaging(pageSize, windowPages int) {
+	q.pageSize = max(pageSize, 1)
+	q.windowPages = max(windowPages, 1)
+	q.lazy.SetPaging(q.windowPages, q.pageSize)
+}
+
+// SetQueue allows setting the selected queue by name. The queue is shown
+// even when it is no longer in the queues set.
 func (q *QueueDetails) SetQueue(queueName string) {
 	q.selectedQueueKey = queueName
-	q.lazy.Table().SetCursor(0)
+	q.pinnedQueue = queueName
+	q.setAllQueues(false)
 	// Try to find and select immediately if queues are already loaded
 	for i, queue := range q.queues {
 		if queue.Name == queueName {
@@ -264,6 +647,36 @@ func (q *QueueDetails) SetQueue(queueName string) {
 	}
 }
 
+// SetHideEmptyQueues implements HideEmptyQueuesSetter.
+func (q *QueueDetails) SetHideEmptyQueues(hide bool) {
+	q.hideEmpty = hide
+}
+
+// SaveState implements StatePersister.
+func (q *QueueDetails) SaveState() ViewState {
+	state := q.detailListView.SaveState()
+	state.Queue = q.selectedQueueKey
+	if state.Queue == "" && q.selectedQueue >= 0 && q.selectedQueue < len(q.queues) {
+		state.Queue = q.queues[q.selectedQueue].Name
+	}
+	state.Favorites = slices.Clone(q.favorites)
+	state.HideEmptyQueues = q.hideEmpty
+	return state
+}
+
+// RestoreState implements StatePersister. It must be called before Init.
+func (q *QueueDetails) RestoreState(state ViewState) {
+	q.detailListView.RestoreState(state)
+	q.favorites = slices.Clone(state.Favorites)
+	// --hide-empty-queues wins over a saved "show all".
+	q.hideEmpty = q.hideEmpty || state.HideEmptyQueues
+	if state.Queue != "" {
+		q.SetQueue(state.Queue)
+		// A queue deleted since the last run falls back to the first queue.
+		q.pinnedQueue = ""
+	}
+}
+
 func (q *QueueDetails) fetchWindow(
 	ctx context.Context,
 	windowStart int,
@@ -277,8 +690,21 @@ func (q *QueueDetails) fetchWindow(
 		return lazytable.FetchResult{}, err
 	}
 
+	missingQueue := ""
+	if q.pinnedQueue != "" && !slices.ContainsFunc(queues, func(queue *sidekiq.Queue) bool {
+		return queue.Name() == q.pinnedQueue
+	}) {
+		missingQueue = q.pinnedQueue
+		queues = append(queues, q.client.NewQueue(missingQueue))
+	}
+
 	queueInfos := make([]*QueueInfo, len(queues))
 	for i, queue := range queues {
+		// Size and latency errors are ignored per queue, so stop explicitly
+		// once a newer window request supersedes this one.
+		if err := ctx.Err(); err != nil {
+			return lazytable.FetchResult{}, err
+		}
 		size, _ := queue.Size(ctx)
 		latency, _ := queue.Latency(ctx)
 		queueInfos[i] = &QueueInfo{
@@ -289,11 +715,48 @@ func (q *QueueDetails) fetchWindow(
 	}
 
 	selectedQueue := q.resolveSelectedQueue(queues, q.selectedQueue)
-	jobs, totalSize, windowStart, err := q.fetchQueueJobs(ctx, queues, selectedQueue, windowStart, windowSize)
+	var (
+		jobs      []*sidekiq.PositionedEntry
+		totalSize int64
+	)
+	tail := q.following && !q.allQueues && selectedQueue < len(queues)
+	if tail {
+		jobs, totalSize, err = q.fetchTailJobs(ctx, queues[selectedQueue])
+		windowStart = 0
+	} else if q.allQueues {
+		jobs, totalSize, windowStart, err = q.fetchCombinedJobs(ctx, queueInfos, windowStart, windowSize)
+	} else {
+		jobs, totalSize, windowStart, err = q.fetchQueueJobs(ctx, queues, selectedQueue, windowStart, windowSize)
+	}
 	if err != nil {
 		return lazytable.FetchResult{}, err
 	}
 
+	var (
+		nextJob     *sidekiq.PositionedEntry
+		memory      int64
+		memoryKnown bool
+	)
+	if !q.allQueues && selectedQueue < len(queues) {
+		nextJob, err = queues[selectedQueue].NextJob(ctx)
+		if err != nil {
+			return lazytable.FetchResult{}, err
+		}
+		var memoryErr error
+		memory, memoryErr = queues[selectedQueue].MemoryUsage(ctx)
+		memoryKnown = memoryErr == nil
+	}
+
+	var ages []int64
+	agesSampled := false
+	if q.showAges && !q.allQueues && selectedQueue < len(queues) {
+		ages, err = queues[selectedQueue].AgeDistribution(ctx, queueAgeBuckets)
+		if err != nil {
+			return lazytable.FetchResult{}, err
+		}
+		agesSampled = queueInfos[selectedQueue].Size > 2*sidekiq.QueueAgeSampleSize
+	}
+
 	return lazytable.FetchResult{
 		Rows:        q.buildRows(jobs),
 		Total:       totalSize,
@@ -301,7 +764,15 @@ func (q *QueueDetails) fetchWindow(
 		Payload: queueDetailsPayload{
 			queues:        queueInfos,
 			jobs:          jobs,
+			nextJob:       nextJob,
+			memory:        memory,
+			memoryKnown:   memoryKnown,
 			selectedQueue: selectedQueue,
+			ages:          ages,
+			agesSampled:   agesSampled,
+			missingQueue:  missingQueue,
+			tail:          tail,
+			tailSize:      totalSize,
 		},
 	}, nil
 }
@@ -348,7 +819,7 @@ func (q *QueueDetails) fetchQueueJobs(
 	}
 
 	if windowSize <= 0 {
-		windowSize = max(queuesFallbackPageSize, 1) * queuesWindowPages
+		windowSize = q.pageSize * q.windowPages
 	}
 
 	queue := queues[selectedQueue]
@@ -359,6 +830,41 @@ func (q *QueueDetails) fetchQueueJobs(
 	return q.fetchUnfilteredQueueJobs(ctx, queue, windowStart, windowSize)
 }
 
+// fetchCombinedJobs merges the next-to-run jobs of every queue by enqueue
+// time and returns one window of the merged list.
+func (q *QueueDetails) fetchCombinedJobs(
+	ctx context.Context,
+	queues []*QueueInfo,
+	windowStart int,
+	windowSize int,
+) ([]*sidekiq.PositionedEntry, int64, int, error) {
+	if windowSize <= 0 {
+		windowSize = q.pageSize * q.windowPages
+	}
+
+	names := make([]string, 0, len(queues))
+	for _, queue := range queues {
+		if q.hideEmpty && queue.Size <= 0 {
+			continue
+		}
+		names = append(names, queue.Name)
+	}
+	jobs, err := q.client.GetQueuesHead(ctx, names, queuesCombinedPerQueue)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	if q.filter != "" {
+		jobs = slices.DeleteFunc(jobs, func(job *sidekiq.PositionedEntry) bool {
+			return !strings.Contains(job.Value(), q.filter)
+		})
+	}
+
+	total := len(jobs)
+	windowStart = min(windowStart, max(total-windowSize, 0))
+	end := min(windowStart+windowSize, total)
+	return jobs[windowStart:end], int64(total), windowStart, nil
+}
+
 func (q *QueueDetails) fetchFilteredQueueJobs(
 	ctx context.Context,
 	queue *sidekiq.Queue,
@@ -422,16 +928,53 @@ func (q *QueueDetails) reset() {
 	}
 	q.queues = nil
 	q.jobs = nil
+	q.nextJob = nil
+	q.memory = 0
+	q.memoryKnown = false
+	q.ages = nil
+	q.agesSampled = false
+	q.missingQueue = ""
 	q.displayOrder = nil
+	q.errorBanner.Dismiss()
+	q.setFollowing(false)
 	q.updateEmptyMessage()
 }
 
 func (q *QueueDetails) selectQueue(queueIdx int) tea.Cmd {
+	q.setAllQueues(false)
 	q.selectedQueue = queueIdx
 	q.selectedQueueKey = ""
+	q.pinnedQueue = ""
 	return q.reloadFromStart()
 }
 
+// setAllQueues switches between the selected queue and the combined view,
+// which adds a Queue column to the jobs table.
+func (q *QueueDetails) setAllQueues(all bool) {
+	q.allQueues = all
+	if all {
+		q.setFollowing(false)
+	}
+	if all {
+		q.lazy.Table().SetColumns(queueCombinedJobColumns)
+	} else {
+		q.lazy.Table().SetColumns(queueJobColumns)
+	}
+	q.lazy.Table().SetCursor(0)
+}
+
+// redisKey returns the key of the selected queue's list. With all queues
+// shown it is the key of the selected job's queue.
+func (q *QueueDetails) redisKey() string {
+	if name, ok := q.migrateSource(); ok {
+		return sidekiq.QueueKey(name)
+	}
+	if job, ok := q.selectedJob(); ok && job.Queue() != "" {
+		return sidekiq.QueueKey(job.Queue())
+	}
+	return ""
+}
+
 func (q *QueueDetails) selectedJob() (*sidekiq.PositionedEntry, bool) {
 	idx := q.lazy.Table().Cursor()
 	if idx < 0 || idx >= len(q.jobs) {
@@ -449,31 +992,18 @@ func (q *QueueDetails) queueListLines() []string {
 		return nil
 	}
 
-	// Create index mapping for sorting
-	type indexedQueue struct {
-		queue *QueueInfo
-		index int
-	}
-	indexed := make([]indexedQueue, len(q.queues))
-	for i, queue := range q.queues {
-		indexed[i] = indexedQueue{queue: queue, index: i}
-	}
-
-	// Sort by size (desc) and name (asc)
-	sort.Slice(indexed, func(i, j int) bool {
-		if indexed[i].queue.Size != indexed[j].queue.Size {
-			return indexed[i].queue.Size > indexed[j].queue.Size
-		}
-		return indexed[i].queue.Name < indexed[j].queue.Name
-	})
-
 	// Take top 5 and build display order mapping
-	displayCount := min(5, len(indexed))
-	q.displayOrder = make([]int, displayCount)
+	order := q.visibleQueueOrder()
+	hidden := len(q.queues) - len(order)
+	if len(order) == 0 {
+		q.displayOrder = nil
+		return []string{q.styles.Muted.Render(display.Number(int64(hidden)) + " empty queues hidden")}
+	}
+	displayCount := min(5, len(order))
+	q.displayOrder = order[:displayCount]
 	displayQueues := make([]*QueueInfo, displayCount)
-	for i := range displayCount {
-		q.displayOrder[i] = indexed[i].index
-		displayQueues[i] = indexed[i].queue
+	for i, queueIdx := range q.displayOrder {
+		displayQueues[i] = q.queues[queueIdx]
 	}
 
 	// First pass: find max widths for alignment
@@ -494,6 +1024,10 @@ func (q *QueueDetails) queueListLines() []string {
 		}
 	}
 
+	hasFavorites := slices.ContainsFunc(displayQueues, func(queue *QueueInfo) bool {
+		return q.isFavorite(queue.Name)
+	})
+
 	lines := make([]string, 0, len(displayQueues))
 	nameStyle := q.styles.QueueText.Bold(true).Width(maxNameLen)
 	for i, queue := range displayQueues {
@@ -502,26 +1036,132 @@ func (q *QueueDetails) queueListLines() []string {
 		// Hotkey with grey background (like navbar), bold if selected
 		hotkeyText := fmt.Sprintf("ctrl+%d", i+1)
 		var hotkey string
-		if queueIdx == q.selectedQueue {
+		if queueIdx == q.selectedQueue && !q.allQueues {
 			hotkey = q.styles.NavKey.Bold(true).Render(hotkeyText)
 		} else {
 			hotkey = q.styles.NavKey.Render(hotkeyText)
 		}
 
-		// Queue name (left-aligned)
+		// Queue name (left-aligned), marked when pinned
 		name := nameStyle.Render(queue.Name)
+		if q.isFavorite(queue.Name) {
+			name = q.styles.WarningText.Render("★") + name
+		} else if hasFavorites {
+			name = " " + name
+		}
 
 		// Size and latency (right-aligned)
 		sizeStr := fmt.Sprintf("%*d", maxSizeLen, queue.Size)
 		latencyStr := fmt.Sprintf("%*s", maxLatencyLen, formatLatency(queue.Latency))
-		stats := q.styles.Muted.Render(fmt.Sprintf("  %s  %s", sizeStr, latencyStr))
-
-		lines = append(lines, hotkey+name+stats)
+		level := q.latency.For(queue.Name).level(queue.Latency)
+		stats := q.styles.Muted.Render(fmt.Sprintf("  %s  ", sizeStr)) +
+			latencyStyle(q.styles, level, q.styles.Muted).Render(latencyStr)
+
+		line := hotkey + name + stats
+		if i == 0 {
+			meta := "  by " + q.listSort.String()
+			if hidden > 0 {
+				meta += ", " + display.Number(int64(hidden)) + " empty hidden"
+			}
+			line += q.styles.Muted.Render(meta)
+		}
+		lines = append(lines, line)
 	}
 
 	return lines
 }
 
+// sortedQueueOrder returns the indices of every queue in list order: pinned
+// queues in the order they were pinned, then the rest by the active sort key
+// and name.
+func (q *QueueDetails) sortedQueueOrder() []int {
+	order := make([]int, len(q.queues))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := q.queues[order[i]], q.queues[order[j]]
+		aPin, bPin := slices.Index(q.favorites, a.Name), slices.Index(q.favorites, b.Name)
+		if aPin >= 0 || bPin >= 0 {
+			return aPin >= 0 && (bPin < 0 || aPin < bPin)
+		}
+		return q.listSort.less(a, b)
+	})
+	return order
+}
+
+// visibleQueueOrder returns the sorted queue order without empty queues when
+// they are hidden. The selected queue stays listed, so a queue opened by name
+// keeps its hotkey even when it is empty.
+func (q *QueueDetails) visibleQueueOrder() []int {
+	order := q.sortedQueueOrder()
+	if !q.hideEmpty {
+		return order
+	}
+	return slices.DeleteFunc(order, func(queueIdx int) bool {
+		return q.queues[queueIdx].Size <= 0 && (q.allQueues || queueIdx != q.selectedQueue)
+	})
+}
+
+// hiddenQueueCount returns how many queues the queue list leaves out.
+func (q *QueueDetails) hiddenQueueCount() int {
+	return len(q.queues) - len(q.visibleQueueOrder())
+}
+
+func (q *QueueDetails) isFavorite(name string) bool {
+	return slices.Contains(q.favorites, name)
+}
+
+// toggleFavorite pins the selected queue to the top of the queue list, or
+// unpins it.
+func (q *QueueDetails) toggleFavorite() {
+	if q.allQueues || q.selectedQueue < 0 || q.selectedQueue >= len(q.queues) {
+		q.note = "select a queue to pin"
+		return
+	}
+	name := q.queues[q.selectedQueue].Name
+	if idx := slices.Index(q.favorites, name); idx >= 0 {
+		q.favorites = slices.Delete(q.favorites, idx, idx+1)
+		q.note = "unpinned " + name
+		return
+	}
+	q.favorites = append(q.favorites, name)
+	q.note = "pinned " + name
+}
+
+// selectNonEmptyQueue selects the next (delta > 0) or previous queue with
+// jobs in the full sorted queue list, wrapping around. The combined view
+// starts from the top of the list.
+func (q *QueueDetails) selectNonEmptyQueue(delta int) tea.Cmd {
+	order := q.sortedQueueOrder()
+	if len(order) == 0 {
+		return nil
+	}
+
+	current := slices.Index(order, q.selectedQueue)
+	if q.allQueues || current < 0 {
+		// Start just outside the list so the first step lands on an end.
+		current = -1
+		if delta < 0 {
+			current = len(order)
+		}
+	}
+	for step := 1; step <= len(order); step++ {
+		pos := ((current+step*delta)%len(order) + len(order)) % len(order)
+		queueIdx := order[pos]
+		if q.queues[queueIdx].Size <= 0 {
+			continue
+		}
+		if queueIdx == q.selectedQueue && !q.allQueues {
+			return nil
+		}
+		return q.selectQueue(queueIdx)
+	}
+
+	q.note = "all queues are empty"
+	return nil
+}
+
 // formatLatency formats latency in seconds as a readable string.
 func formatLatency(seconds float64) string {
 	if seconds < 1 {
@@ -538,14 +1178,36 @@ var queueJobColumns = []table.Column{
 	{Title: "Context", Width: 40},
 }
 
+// Table columns for the combined queue job list.
+var queueCombinedJobColumns = []table.Column{
+	{Title: "#", Width: 6},
+	{Title: "Queue", Width: 15},
+	{Title: "Job", Width: 30},
+	{Title: "Arguments", Width: 60},
+	{Title: "Context", Width: 40},
+}
+
 func (q *QueueDetails) buildRows(jobs []*sidekiq.PositionedEntry) []table.Row {
 	rows := make([]table.Row, 0, len(jobs))
 	for _, job := range jobs {
+		if q.allQueues {
+			rows = append(rows, table.Row{
+				ID: job.JID(),
+				Cells: []string{
+					strconv.Itoa(job.Position),
+					job.Queue(),
+					jobClassCell(q.styles, job.JobRecord, q.largePayload),
+					display.Args(job.DisplayArgs()),
+					formatContext(job.Context()),
+				},
+			})
+			continue
+		}
 		rows = append(rows, table.Row{
 			ID: job.JID(),
 			Cells: []string{
 				strconv.Itoa(job.Position),
-				job.DisplayClass(),
+				jobClassCell(q.styles, job.JobRecord, q.largePayload),
 				display.Args(job.DisplayArgs()),
 				formatContext(job.Context()),
 			},
@@ -579,10 +1241,46 @@ func formatContext(ctx map[string]any) string {
 // renderJobsBox renders the bordered box containing the jobs table.
 func (q *QueueDetails) renderJobsBox() string {
 	title := "Jobs"
-	if q.selectedQueue >= 0 && q.selectedQueue < len(q.queues) {
+	if q.allQueues {
+		title = "Next jobs in all queues (approximate)"
+	} else if q.selectedQueue >= 0 && q.selectedQueue < len(q.queues) {
 		title = "Jobs in " + q.queues[q.selectedQueue].Name
 	}
-	return q.renderBox(title, len(q.jobs))
+	box := q.renderBox(title, len(q.jobs))
+	if chartHeight := q.ageChartHeight(); chartHeight > 0 {
+		return lipgloss.JoinVertical(lipgloss.Left, box, q.renderAgeChart(chartHeight))
+	}
+	return box
+}
+
+// renderAgeChart renders the job age distribution of the selected queue.
+func (q *QueueDetails) renderAgeChart(height int) string {
+	chart := histogram.New(
+		histogram.WithStyles(histogram.Styles{
+			Axis:  q.styles.ChartAxis,
+			Bar:   q.styles.ChartHistogram,
+			Muted: q.styles.Muted,
+		}),
+		histogram.WithSize(max(q.fullWidth-4, 0), max(height-2, 0)),
+		histogram.WithData(q.ages, queueAgeLabels),
+		histogram.WithEmptyMessage("No job ages"),
+	)
+
+	meta := ""
+	if q.agesSampled {
+		meta = q.styles.Muted.Render("sampled")
+	}
+	box := frame.New(
+		frame.WithStyles(q.frameStyles),
+		frame.WithTitle("Job Age"),
+		frame.WithTitlePadding(0),
+		frame.WithMeta(meta),
+		frame.WithContent(chart.View()),
+		frame.WithPadding(1),
+		frame.WithSize(q.fullWidth, height),
+		frame.WithFocused(false),
+	)
+	return box.View()
 }
 
 // renderJobDetail renders the job detail view.
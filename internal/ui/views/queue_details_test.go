@@ -2,15 +2,24 @@ package views
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
 	"strings"
 	"testing"
+	"time"
 
+	tea "charm.land/bubbletea/v2"
 	"github.com/alicebob/miniredis/v2"
 	"github.com/charmbracelet/x/ansi"
+	"github.com/redis/go-redis/v9"
 
 	"github.com/kpumuk/lazykiq/internal/sidekiq"
 	"github.com/kpumuk/lazykiq/internal/ui/components/lazytable"
 	"github.com/kpumuk/lazykiq/internal/ui/components/table"
+	"github.com/kpumuk/lazykiq/internal/ui/dialogs"
+	confirmdialog "github.com/kpumuk/lazykiq/internal/ui/dialogs/confirm"
+	promptdialog "github.com/kpumuk/lazykiq/internal/ui/dialogs/prompt"
 )
 
 func TestQueueDetailsFetchWindow_FilteredJobs(t *testing.T) {
@@ -118,3 +127,678 @@ func TestQueueDetailsRenderJobsBoxShowsRowsMetaOnly(t *testing.T) {
 		t.Fatalf("renderJobsBox() still shows abbreviated size value:\n%s", output)
 	}
 }
+
+func TestQueueDetailsFetchWindow_AgeDistribution(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client, err := sidekiq.NewClient("redis://" + mr.Addr())
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+
+	_, _ = mr.SetAdd("queues", "default")
+	enqueuedAt := time.Now().Add(-2 * time.Minute).UnixMilli()
+	_, _ = mr.Lpush("queue:default", fmt.Sprintf(`{"jid":"job1","class":"TestJob","enqueued_at":%d}`, enqueuedAt))
+
+	view := NewQueueDetails(client)
+
+	result, err := view.fetchWindow(context.Background(), 0, 10, lazytable.CursorStart)
+	if err != nil {
+		t.Fatalf("fetchWindow failed: %v", err)
+	}
+	if payload := result.Payload.(queueDetailsPayload); payload.ages != nil {
+		t.Fatalf("payload.ages = %v, want nil while the chart is hidden", payload.ages)
+	}
+
+	view.showAges = true
+	result, err = view.fetchWindow(context.Background(), 0, 10, lazytable.CursorStart)
+	if err != nil {
+		t.Fatalf("fetchWindow failed: %v", err)
+	}
+	payload := result.Payload.(queueDetailsPayload)
+	if len(payload.ages) != len(queueAgeLabels) {
+		t.Fatalf("len(payload.ages) = %d, want %d", len(payload.ages), len(queueAgeLabels))
+	}
+	if payload.ages[1] != 1 {
+		t.Fatalf("payload.ages = %v, want one job in the <5m bucket", payload.ages)
+	}
+	if payload.agesSampled {
+		t.Fatal("payload.agesSampled = true, want false for a small queue")
+	}
+}
+
+func TestQueueDetailsAgeChartToggle(t *testing.T) {
+	view := NewQueueDetails(nil)
+	view.SetSize(100, 30)
+	view.SetStyles(Styles{})
+
+	updated, _ := view.Update(lazytable.DataMsg{
+		RequestID: view.lazy.RequestID(),
+		Result: lazytable.FetchResult{
+			Payload: queueDetailsPayload{
+				queues: []*QueueInfo{{Name: "default", Size: 3}},
+			},
+		},
+	})
+	view = updated.(*QueueDetails)
+
+	view.Update(tea.KeyPressMsg{Code: 'a', Text: "a"})
+	if !view.showAges {
+		t.Fatal("expected age chart to be shown")
+	}
+	view.ages = []int64{2, 1, 0, 0, 0, 0, 0}
+	view.agesSampled = true
+
+	output := ansi.Strip(view.View())
+	lines := strings.Split(output, "\n")
+	if len(lines) != 30 {
+		t.Fatalf("lines = %d, want 30", len(lines))
+	}
+	if !strings.Contains(output, "Job Age") || !strings.Contains(output, "sampled") {
+		t.Fatalf("View() missing age chart:\n%s", output)
+	}
+	if !strings.Contains(output, "<5m") {
+		t.Fatalf("View() missing bucket labels:\n%s", output)
+	}
+
+	view.Update(tea.KeyPressMsg{Code: 'a', Text: "a"})
+	if strings.Contains(ansi.Strip(view.View()), "Job Age") {
+		t.Fatal("expected age chart to be hidden")
+	}
+}
+
+func TestQueueDetailsListSortCycles(t *testing.T) {
+	view := NewQueueDetails(nil)
+	view.SetSize(100, 30)
+	view.SetStyles(Styles{})
+
+	updated, _ := view.Update(lazytable.DataMsg{
+		RequestID: view.lazy.RequestID(),
+		Result: lazytable.FetchResult{
+			Payload: queueDetailsPayload{
+				queues: []*QueueInfo{
+					{Name: "critical", Size: 5, Latency: 1},
+					{Name: "default", Size: 100, Latency: 2},
+					{Name: "reports", Size: 10, Latency: 600},
+				},
+			},
+		},
+	})
+	view = updated.(*QueueDetails)
+
+	tests := []struct {
+		sort  string
+		order []string
+	}{
+		{sort: "size", order: []string{"default", "reports", "critical"}},
+		{sort: "latency", order: []string{"reports", "default", "critical"}},
+		{sort: "name", order: []string{"critical", "default", "reports"}},
+		{sort: "size", order: []string{"default", "reports", "critical"}},
+	}
+	for i, tc := range tests {
+		if i > 0 {
+			view.Update(tea.KeyPressMsg{Code: 'o', Text: "o"})
+		}
+		lines := view.HeaderLines()
+		if !strings.HasSuffix(ansi.Strip(lines[0]), "by "+tc.sort) {
+			t.Fatalf("header %q does not show sort %q", ansi.Strip(lines[0]), tc.sort)
+		}
+		for row, name := range tc.order {
+			if !strings.Contains(ansi.Strip(lines[row]), name) {
+				t.Fatalf("sort %s: line %d = %q, want %s", tc.sort, row, ansi.Strip(lines[row]), name)
+			}
+		}
+	}
+
+	// ctrl+1 follows the displayed order, not the fetched one.
+	view.Update(tea.KeyPressMsg{Code: 'o', Text: "o"})
+	view.HeaderLines()
+	view.Update(tea.KeyPressMsg{Code: '1', Mod: tea.ModCtrl})
+	if got := view.queues[view.selectedQueue].Name; got != "reports" {
+		t.Fatalf("ctrl+1 selected %q, want reports", got)
+	}
+}
+
+func TestQueueDetailsPinnedQueuesComeFirst(t *testing.T) {
+	view := NewQueueDetails(nil)
+	view.RestoreState(ViewState{Favorites: []string{"reports"}})
+	view.SetSize(100, 30)
+	view.SetStyles(Styles{})
+
+	updated, _ := view.Update(lazytable.DataMsg{
+		RequestID: view.lazy.RequestID(),
+		Result: lazytable.FetchResult{
+			Payload: queueDetailsPayload{
+				queues: []*QueueInfo{
+					{Name: "critical", Size: 5},
+					{Name: "default", Size: 100},
+					{Name: "reports", Size: 10},
+				},
+			},
+		},
+	})
+	view = updated.(*QueueDetails)
+
+	// Pin critical as well: pins keep their pin order above the rest.
+	view.selectedQueue = 0
+	view.Update(tea.KeyPressMsg{Code: 'P', Text: "P"})
+	lines := view.HeaderLines()
+	for row, name := range []string{"★reports", "★critical", " default"} {
+		if !strings.Contains(ansi.Strip(lines[row]), name) {
+			t.Fatalf("line %d = %q, want %s", row, ansi.Strip(lines[row]), name)
+		}
+	}
+	view.Update(tea.KeyPressMsg{Code: '2', Mod: tea.ModCtrl})
+	if got := view.queues[view.selectedQueue].Name; got != "critical" {
+		t.Fatalf("ctrl+2 selected %q, want critical", got)
+	}
+	if got := view.SaveState().Favorites; !slices.Equal(got, []string{"reports", "critical"}) {
+		t.Fatalf("saved favorites = %v, want [reports critical]", got)
+	}
+
+	view.Update(tea.KeyPressMsg{Code: 'P', Text: "P"})
+	if got := ansi.Strip(view.HeaderLines()[1]); !strings.Contains(got, "default") || strings.Contains(got, "★") {
+		t.Fatalf("line 1 = %q, want unpinned default", got)
+	}
+}
+
+func TestQueueDetailsShowsMissingQueue(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client, err := sidekiq.NewClient("redis://" + mr.Addr())
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+
+	_, _ = mr.SetAdd("queues", "default")
+	_, _ = mr.Lpush("queue:default", `{"jid":"job1","class":"TestJob","args":[]}`)
+
+	view := NewQueueDetails(client)
+	view.SetStyles(Styles{})
+	view.SetQueue("gone")
+
+	result, err := view.fetchWindow(context.Background(), 0, 10, lazytable.CursorStart)
+	if err != nil {
+		t.Fatalf("fetchWindow failed: %v", err)
+	}
+	view.Update(lazytable.DataMsg{RequestID: view.lazy.RequestID(), Result: result})
+
+	if got := view.queues[view.selectedQueue].Name; got != "gone" {
+		t.Fatalf("selected queue = %q, want gone", got)
+	}
+	if len(view.jobs) != 0 {
+		t.Fatalf("len(jobs) = %d, want 0", len(view.jobs))
+	}
+	found := false
+	for _, item := range view.ContextItems() {
+		if item.Label == "Note" && strings.Contains(item.Value, "queue empty or missing") {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("ContextItems() = %#v, want missing queue note", view.ContextItems())
+	}
+
+	// Restored state does not resurrect deleted queues.
+	restored := NewQueueDetails(client)
+	restored.RestoreState(ViewState{Queue: "gone"})
+	result, err = restored.fetchWindow(context.Background(), 0, 10, lazytable.CursorStart)
+	if err != nil {
+		t.Fatalf("fetchWindow failed: %v", err)
+	}
+	payload := result.Payload.(queueDetailsPayload)
+	if len(payload.queues) != 1 || payload.queues[payload.selectedQueue].Name != "default" {
+		t.Fatalf("restored queues = %d, selected %d, want default only", len(payload.queues), payload.selectedQueue)
+	}
+}
+
+func TestQueueDetailsShowsNextUp(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client, err := sidekiq.NewClient("redis://" + mr.Addr())
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+
+	_, _ = mr.SetAdd("queues", "default")
+	_, _ = mr.Lpush("queue:default", `{"jid":"oldest","class":"FirstJob","args":[]}`)
+	_, _ = mr.Lpush("queue:default", `{"jid":"newest","class":"LastJob","args":["match"]}`)
+
+	view := NewQueueDetails(client)
+	view.SetStyles(Styles{})
+	// The filter hides the next job from the table, not from Next Up.
+	view.filter = "match"
+
+	result, err := view.fetchWindow(context.Background(), 0, 10, lazytable.CursorStart)
+	if err != nil {
+		t.Fatalf("fetchWindow failed: %v", err)
+	}
+	view.Update(lazytable.DataMsg{RequestID: view.lazy.RequestID(), Result: result})
+
+	if len(view.jobs) != 1 || view.jobs[0].JID() != "newest" {
+		t.Fatalf("jobs = %v, want only the filtered newest job", view.jobs)
+	}
+	if got := contextValue(view.ContextItems(), "Next Up"); got != "FirstJob oldest" {
+		t.Fatalf("Next Up = %q, want FirstJob oldest", got)
+	}
+
+	view.setAllQueues(true)
+	result, err = view.fetchWindow(context.Background(), 0, 10, lazytable.CursorStart)
+	if err != nil {
+		t.Fatalf("fetchWindow failed: %v", err)
+	}
+	if payload := result.Payload.(queueDetailsPayload); payload.nextJob != nil {
+		t.Fatalf("combined view nextJob = %v, want nil", payload.nextJob)
+	}
+}
+
+func TestQueueDetailsJumpsToNonEmptyQueues(t *testing.T) {
+	view := NewQueueDetails(nil)
+	view.SetStyles(Styles{})
+	// Sorted by size: big, small, then the empty queues by name.
+	view.queues = []*QueueInfo{
+		{Name: "empty-a"},
+		{Name: "small", Size: 1},
+		{Name: "empty-b"},
+		{Name: "big", Size: 7},
+		{Name: "empty-c"},
+		{Name: "empty-d"},
+		{Name: "empty-e"},
+	}
+	view.selectedQueue = 0
+
+	selected := func() string { return view.queues[view.selectedQueue].Name }
+	steps := []struct {
+		key  string
+		want string
+	}{
+		{key: "}", want: "big"},
+		{key: "}", want: "small"},
+		{key: "}", want: "big"},
+		{key: "{", want: "small"},
+		{key: "{", want: "big"},
+	}
+	for i, step := range steps {
+		view.Update(tea.KeyPressMsg{Code: rune(step.key[0]), Text: step.key})
+		if got := selected(); got != step.want {
+			t.Fatalf("step %d %s selected %q, want %q", i, step.key, got, step.want)
+		}
+	}
+
+	for _, queue := range view.queues {
+		queue.Size = 0
+	}
+	if _, cmd := view.Update(tea.KeyPressMsg{Code: '}', Text: "}"}); cmd != nil {
+		t.Fatal("} with every queue empty returned a command")
+	}
+	if got := selected(); got != "big" {
+		t.Fatalf("selected %q after no-op, want big", got)
+	}
+	if got := contextValue(view.ContextItems(), "Note"); got != "all queues are empty" {
+		t.Fatalf("Note = %q, want all queues are empty", got)
+	}
+	view.Update(tea.KeyPressMsg{Code: 'o', Text: "o"})
+	if got := contextValue(view.ContextItems(), "Note"); got != "" {
+		t.Fatalf("Note = %q after another key, want cleared", got)
+	}
+}
+
+// cancelingHook records Redis commands and cancels the request after SMEMBERS.
+type cancelingHook struct {
+	names  *[]string
+	cancel context.CancelFunc
+}
+
+func (h cancelingHook) DialHook(next redis.DialHook) redis.DialHook { return next }
+
+func (h cancelingHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		*h.names = append(*h.names, cmd.Name())
+		err := next(ctx, cmd)
+		if cmd.Name() == "smembers" {
+			h.cancel()
+		}
+		return err
+	}
+}
+
+func (h cancelingHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return next
+}
+
+func TestQueueDetailsFetchWindow_StopsWhenCanceled(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client, err := sidekiq.NewClient("redis://" + mr.Addr())
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+
+	_, _ = mr.SetAdd("queues", "default", "low")
+
+	view := NewQueueDetails(client)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var commands []string
+	client.AddHook(cancelingHook{names: &commands, cancel: cancel})
+
+	// The queue list is fetched, then the request is superseded.
+	if _, err := view.fetchWindow(ctx, 0, 10, lazytable.CursorStart); !errors.Is(err, context.Canceled) {
+		t.Fatalf("fetchWindow error = %v, want context.Canceled", err)
+	}
+	if slices.Contains(commands, "llen") {
+		t.Fatalf("commands = %v, want no queue sizes after cancellation", commands)
+	}
+}
+
+func TestQueueDetailsAllQueuesCombined(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client, err := sidekiq.NewClient("redis://" + mr.Addr())
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+
+	_, _ = mr.SetAdd("queues", "default", "low")
+	_, _ = mr.Lpush("queue:default", `{"jid":"d1","class":"TestJob","args":[],"enqueued_at":10}`)
+	_, _ = mr.Lpush("queue:default", `{"jid":"d2","class":"TestJob","args":[],"enqueued_at":30}`)
+	_, _ = mr.Lpush("queue:low", `{"jid":"l1","class":"TestJob","args":[],"enqueued_at":20}`)
+
+	view := NewQueueDetails(client)
+	view.SetSize(140, 30)
+	view.SetStyles(Styles{})
+	view.Update(tea.KeyPressMsg{Code: 'A', Text: "A"})
+	if !view.allQueues {
+		t.Fatal("expected combined mode after A")
+	}
+
+	result, err := view.fetchWindow(context.Background(), 0, 10, lazytable.CursorStart)
+	if err != nil {
+		t.Fatalf("fetchWindow failed: %v", err)
+	}
+	view.Update(lazytable.DataMsg{RequestID: view.lazy.RequestID(), Result: result})
+
+	wantIDs := []string{"d1", "l1", "d2"}
+	if len(result.Rows) != len(wantIDs) {
+		t.Fatalf("len(rows) = %d, want %d", len(result.Rows), len(wantIDs))
+	}
+	for i, id := range wantIDs {
+		if result.Rows[i].ID != id {
+			t.Fatalf("rows[%d].ID = %q, want %q", i, result.Rows[i].ID, id)
+		}
+	}
+	if got := result.Rows[1].Cells[1]; got != "low" {
+		t.Fatalf("rows[1] queue = %q, want low", got)
+	}
+
+	output := ansi.Strip(view.View())
+	if !strings.Contains(output, "approximate") || !strings.Contains(output, "Queue") {
+		t.Fatalf("View() missing combined title or Queue column:\n%s", output)
+	}
+
+	view.lazy.Table().SetCursor(1)
+	_, cmd := view.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected job detail command")
+	}
+	msg, ok := cmd().(ShowJobDetailMsg)
+	if !ok || msg.Job.JID() != "l1" || msg.Job.Queue() != "low" {
+		t.Fatalf("msg = %#v, want job l1 from low", cmd())
+	}
+
+	view.HeaderLines()
+	view.Update(tea.KeyPressMsg{Code: '1', Mod: tea.ModCtrl})
+	if view.allQueues {
+		t.Fatal("ctrl+1 should leave combined mode")
+	}
+}
+
+type migrateClientStub struct {
+	sidekiq.API
+	from, to string
+	opts     sidekiq.MigrateQueueOptions
+	release  chan struct{}
+}
+
+func (s *migrateClientStub) MigrateQueue(_ context.Context, from, to string, opts sidekiq.MigrateQueueOptions) (int64, error) {
+	s.from, s.to, s.opts = from, to, opts
+	opts.Progress(100)
+	<-s.release
+	return 150, nil
+}
+
+func TestQueueDetailsMigrateQueue(t *testing.T) {
+	client := &migrateClientStub{release: make(chan struct{})}
+	view := NewQueueDetails(client)
+	view.SetStyles(Styles{})
+	view.queues = []*QueueInfo{{Name: "old", Size: 150}}
+
+	m := tea.KeyPressMsg{Code: 'm', Text: "m"}
+	if _, cmd := view.Update(m); cmd != nil {
+		t.Fatal("expected no command without dangerous actions")
+	}
+
+	view.SetDangerousActionsEnabled(true)
+	_, cmd := view.Update(m)
+	if cmd == nil {
+		t.Fatal("expected prompt dialog command")
+	}
+	if _, ok := cmd().(dialogs.OpenDialogMsg); !ok {
+		t.Fatalf("msg = %#v, want OpenDialogMsg", cmd())
+	}
+
+	if _, cmd := view.Update(promptdialog.ActionMsg{Target: queueMigrateTarget, Value: "old"}); cmd != nil {
+		t.Fatal("migrating a queue into itself opened a confirmation")
+	}
+	_, cmd = view.Update(promptdialog.ActionMsg{Target: queueMigrateTarget, Value: " new "})
+	if cmd == nil {
+		t.Fatal("expected confirm dialog command")
+	}
+	_, cmd = view.Update(confirmdialog.ActionMsg{Target: queueMigrateTarget, Confirmed: true})
+	if cmd == nil {
+		t.Fatal("expected migrate command")
+	}
+
+	// Progress arrives while the migration runs, then the final count.
+	_, cmd = view.Update(cmd())
+	if got := contextValue(view.ContextItems(), "Migrate"); got != "moved 100 to new…" {
+		t.Fatalf("Migrate = %q, want progress", got)
+	}
+	close(client.release)
+	view.Update(cmd())
+	if client.from != "old" || client.to != "new" || !client.opts.RemoveSource {
+		t.Fatalf("MigrateQueue(%q, %q, %+v), want old to new removing the source", client.from, client.to, client.opts)
+	}
+	if got := contextValue(view.ContextItems(), "Migrate"); got != "moved 150 to new" {
+		t.Fatalf("Migrate = %q, want result", got)
+	}
+	if view.selectedQueueKey != "new" {
+		t.Fatalf("selected queue = %q, want new", view.selectedQueueKey)
+	}
+}
+
+type drainClientStub struct {
+	sidekiq.API
+	queue string
+}
+
+func (s *drainClientStub) DrainQueueToDead(_ context.Context, queue string) (int64, error) {
+	s.queue = queue
+	return 42, nil
+}
+
+func TestQueueDetailsDrainToDead(t *testing.T) {
+	client := &drainClientStub{}
+	view := NewQueueDetails(client)
+	view.SetStyles(Styles{})
+	view.queues = []*QueueInfo{{Name: "poisoned", Size: 42}}
+
+	k := tea.KeyPressMsg{Code: 'K', Text: "K"}
+	if _, cmd := view.Update(k); cmd != nil {
+		t.Fatal("expected no command without dangerous actions")
+	}
+
+	view.SetDangerousActionsEnabled(true)
+	_, cmd := view.Update(k)
+	if cmd == nil {
+		t.Fatal("expected confirm dialog command")
+	}
+	if _, ok := cmd().(dialogs.OpenDialogMsg); !ok {
+		t.Fatalf("msg = %#v, want OpenDialogMsg", cmd())
+	}
+
+	if _, cmd := view.Update(confirmdialog.ActionMsg{Target: queueDrainTarget}); cmd != nil {
+		t.Fatal("declined confirmation drained the queue")
+	}
+	_, cmd = view.Update(confirmdialog.ActionMsg{Target: queueDrainTarget, Confirmed: true})
+	if cmd == nil {
+		t.Fatal("expected drain command")
+	}
+	view.Update(cmd())
+	if client.queue != "poisoned" {
+		t.Fatalf("DrainQueueToDead(%q), want poisoned", client.queue)
+	}
+	if got := contextValue(view.ContextItems(), "Note"); got != "moved 42 jobs from poisoned to dead" {
+		t.Fatalf("Note = %q, want result", got)
+	}
+}
+
+func TestQueueDetailsFollowMode(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client, err := sidekiq.NewClient("redis://" + mr.Addr())
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+
+	_, _ = mr.SetAdd("queues", "default")
+	_, _ = mr.Lpush("queue:default", `{"jid":"old1","class":"OldJob","args":[]}`)
+	_, _ = mr.Lpush("queue:default", `{"jid":"old2","class":"OldJob","args":[]}`)
+
+	view := NewQueueDetails(client)
+	view.SetSize(100, 30)
+	view.SetStyles(Styles{})
+	refresh := func() {
+		t.Helper()
+		result, err := view.fetchWindow(context.Background(), 0, 10, lazytable.CursorKeep)
+		if err != nil {
+			t.Fatalf("fetchWindow failed: %v", err)
+		}
+		view.Update(lazytable.DataMsg{RequestID: view.lazy.RequestID(), Result: result})
+	}
+	rowIDs := func() []string {
+		var ids []string
+		for _, row := range view.lazy.Table().Rows() {
+			ids = append(ids, row.ID)
+		}
+		return ids
+	}
+
+	view.Update(tea.KeyPressMsg{Code: 'f', Text: "f"})
+	if !view.following {
+		t.Fatal("expected f to start following")
+	}
+	refresh()
+	if got := contextValue(view.ContextItems(), "Follow"); got != "no new jobs" {
+		t.Fatalf("Follow after the first refresh = %q, want no new jobs", got)
+	}
+
+	_, _ = mr.Lpush("queue:default", `{"jid":"new1","class":"NewJob","args":[]}`)
+	refresh()
+	if got := rowIDs(); !slices.Equal(got, []string{"new1", "old2", "old1"}) {
+		t.Fatalf("rows = %v, want the new job on top", got)
+	}
+	if !view.tail.fresh["new1"] || view.tail.fresh["old2"] {
+		t.Fatalf("fresh = %v, want only new1", view.tail.fresh)
+	}
+	if got := contextValue(view.ContextItems(), "Follow"); got != "1 new" {
+		t.Fatalf("Follow = %q, want 1 new", got)
+	}
+
+	// A drained queue keeps the seen jobs and marks them as gone.
+	mr.Del("queue:default")
+	refresh()
+	if got := rowIDs(); !slices.Equal(got, []string{"new1", "old2", "old1"}) {
+		t.Fatalf("rows after drain = %v, want the seen jobs kept", got)
+	}
+	if len(view.tail.gone) != 3 || len(view.tail.fresh) != 0 {
+		t.Fatalf("gone = %v, fresh = %v, want all gone and none fresh", view.tail.gone, view.tail.fresh)
+	}
+	if cell := ansi.Strip(view.lazy.Table().Rows()[0].Cells[0]); cell != "-" {
+		t.Fatalf("position of a gone job = %q, want -", cell)
+	}
+
+	view.Update(tea.KeyPressMsg{Code: 'j', Text: "j"})
+	if view.following {
+		t.Fatal("expected scrolling away to stop following")
+	}
+}
+
+func TestQueueDetailsHidesEmptyQueues(t *testing.T) {
+	view := NewQueueDetails(nil)
+	view.SetHideEmptyQueues(true)
+	view.SetSize(100, 30)
+	view.SetStyles(Styles{})
+
+	updated, _ := view.Update(lazytable.DataMsg{
+		RequestID: view.lazy.RequestID(),
+		Result: lazytable.FetchResult{
+			Payload: queueDetailsPayload{
+				queues: []*QueueInfo{
+					{Name: "critical", Size: 0},
+					{Name: "default", Size: 100},
+					{Name: "mailers", Size: 0},
+					{Name: "reports", Size: 10},
+				},
+				selectedQueue: 1,
+			},
+		},
+	})
+	view = updated.(*QueueDetails)
+
+	lines := view.HeaderLines()
+	if got := ansi.Strip(lines[0]); !strings.Contains(got, "default") || !strings.Contains(got, "2 empty hidden") {
+		t.Fatalf("line 0 = %q, want default with 2 empty hidden", got)
+	}
+	if got := ansi.Strip(lines[2]); strings.TrimSpace(got) != "" {
+		t.Fatalf("line 2 = %q, want blank with empty queues hidden", got)
+	}
+	if got := contextValue(view.ContextItems(), "Hidden"); got != "2 empty" {
+		t.Fatalf("Hidden = %q, want 2 empty", got)
+	}
+	view.Update(tea.KeyPressMsg{Code: '2', Mod: tea.ModCtrl})
+	if got := view.queues[view.selectedQueue].Name; got != "reports" {
+		t.Fatalf("ctrl+2 selected %q, want reports", got)
+	}
+	if !view.SaveState().HideEmptyQueues {
+		t.Fatal("saved state does not hide empty queues")
+	}
+
+	// A queue opened by name stays listed while empty.
+	view.SetQueue("mailers")
+	lines = view.HeaderLines()
+	if got := ansi.Strip(lines[2]); !strings.Contains(got, "mailers") {
+		t.Fatalf("line 2 = %q, want the selected empty queue", got)
+	}
+
+	view.Update(tea.KeyPressMsg{Code: 'e', Text: "e"})
+	view.HeaderLines()
+	if got := len(view.displayOrder); got != 4 {
+		t.Fatalf("len(displayOrder) = %d, want 4 with empty queues shown", got)
+	}
+	if view.SaveState().HideEmptyQueues {
+		t.Fatal("saved state still hides empty queues")
+	}
+}
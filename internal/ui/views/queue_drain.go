@@ -0,0 +1,70 @@
+package views
+
+import (
+	"context"
+	"fmt"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/kpumuk/lazykiq/internal/devtools"
+	"github.com/kpumuk/lazykiq/internal/ui/dialogs"
+	confirmdialog "github.com/kpumuk/lazykiq/internal/ui/dialogs/confirm"
+	"github.com/kpumuk/lazykiq/internal/ui/display"
+)
+
+const queueDrainTarget = "queue.drain"
+
+// queueDrainedMsg reports the outcome of draining a queue into the dead set.
+type queueDrainedMsg struct {
+	queue string
+	moved int64
+	err   error
+}
+
+// openDrainConfirm asks to move every job in queue to the dead set. Draining
+// is an emergency action that empties the queue in one go, so it always asks
+// for the queue name, even without --strict-confirm.
+func (q *QueueDetails) openDrainConfirm(queue string) tea.Cmd {
+	size := int64(0)
+	if q.selectedQueue >= 0 && q.selectedQueue < len(q.queues) {
+		size = q.queues[q.selectedQueue].Size
+	}
+	return func() tea.Msg {
+		return dialogs.OpenDialogMsg{
+			Model: newConfirmDialog(
+				q.styles,
+				"Drain queue to dead",
+				fmt.Sprintf(
+					"Move all %s jobs from %s to the dead set?\n\nJobs are kept for analysis and can be retried from Dead.\n%s is removed from the queue list once empty.",
+					q.styles.Text.Bold(true).Render(display.Number(size)),
+					q.styles.QueueText.Render(queue),
+					q.styles.QueueText.Render(queue),
+				),
+				queueDrainTarget,
+				q.styles.DangerAction,
+				confirmdialog.WithRequiredText(queue),
+			),
+		}
+	}
+}
+
+// drainCmd moves every job in queue to the dead set.
+func (q *QueueDetails) drainCmd(queue string) tea.Cmd {
+	client := q.client
+	return func() tea.Msg {
+		ctx := devtools.WithTracker(context.Background(), "queue_details.drainCmd")
+		moved, err := client.DrainQueueToDead(ctx, queue)
+		return queueDrainedMsg{queue: queue, moved: moved, err: err}
+	}
+}
+
+// handleDrained reports how many jobs were drained and reloads the queue.
+func (q *QueueDetails) handleDrained(msg queueDrainedMsg) tea.Cmd {
+	q.draining = false
+	if msg.err != nil {
+		q.note = fmt.Sprintf("drained %s jobs from %s, then failed", display.Number(msg.moved), msg.queue)
+		return func() tea.Msg { return ConnectionErrorMsg{Err: msg.err} }
+	}
+	q.note = fmt.Sprintf("moved %s jobs from %s to dead", display.Number(msg.moved), msg.queue)
+	return q.reloadFromStart()
+}
@@ -0,0 +1,160 @@
+package views
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/kpumuk/lazykiq/internal/devtools"
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/dialogs"
+	"github.com/kpumuk/lazykiq/internal/ui/display"
+	"github.com/kpumuk/lazykiq/internal/ui/requestctx"
+)
+
+const queueMigrateTarget = "queue.migrate"
+
+// queueMigrationMsg reports the progress of a queue migration. The final
+// message has done set; earlier ones carry the channel to keep listening on.
+type queueMigrationMsg struct {
+	from    string
+	to      string
+	moved   int64
+	done    bool
+	err     error
+	updates <-chan queueMigrationMsg
+}
+
+// migrateSource returns the queue a migration would move jobs out of.
+func (q *QueueDetails) migrateSource() (string, bool) {
+	if q.allQueues || q.selectedQueue < 0 || q.selectedQueue >= len(q.queues) {
+		return "", false
+	}
+	return q.queues[q.selectedQueue].Name, true
+}
+
+func (q *QueueDetails) openMigratePrompt(from string) tea.Cmd {
+	return func() tea.Msg {
+		return dialogs.OpenDialogMsg{
+			Model: newPromptDialog(
+				q.styles,
+				"Migrate "+from+" to",
+				"",
+				queueMigrateTarget,
+				func(value string) error {
+					_, err := parseMigrateTarget(from, value)
+					return err
+				},
+			),
+		}
+	}
+}
+
+func (q *QueueDetails) openMigrateConfirm(from, to string) tea.Cmd {
+	size := int64(0)
+	if q.selectedQueue >= 0 && q.selectedQueue < len(q.queues) {
+		size = q.queues[q.selectedQueue].Size
+	}
+	return func() tea.Msg {
+		return dialogs.OpenDialogMsg{
+			Model: newConfirmDialog(
+				q.styles,
+				"Migrate queue",
+				fmt.Sprintf(
+					"Move all %s jobs from %s to %s?\n\nEach job's queue is rewritten, and %s is removed from the queue list once empty.\nPress m again to stop; jobs already moved stay in %s.",
+					q.styles.Text.Bold(true).Render(display.Number(size)),
+					q.styles.QueueText.Render(from),
+					q.styles.QueueText.Render(to),
+					q.styles.QueueText.Render(from),
+					q.styles.QueueText.Render(to),
+				),
+				queueMigrateTarget,
+				q.styles.DangerAction,
+				strictConfirmOption(q.strictConfirm, from),
+			),
+		}
+	}
+}
+
+// migrateCmd runs the migration in the background and streams its progress
+// back as queueMigrationMsg values.
+func (q *QueueDetails) migrateCmd(from, to string) tea.Cmd {
+	client := q.client
+	ctx := q.migrateRequest.Start(devtools.WithTracker(context.Background(), "queue_details.migrateCmd"))
+	updates := make(chan queueMigrationMsg, 1)
+	go func() {
+		defer close(updates)
+		moved, err := client.MigrateQueue(ctx, from, to, sidekiq.MigrateQueueOptions{
+			RemoveSource: true,
+			Progress: func(moved int64) {
+				// Drop updates the view has not caught up with; the next one
+				// carries the newer count.
+				select {
+				case updates <- queueMigrationMsg{from: from, to: to, moved: moved, updates: updates}:
+				default:
+				}
+			},
+		})
+		// Make room for the final message, which must not be dropped.
+		select {
+		case <-updates:
+		default:
+		}
+		updates <- queueMigrationMsg{from: from, to: to, moved: moved, done: true, err: err}
+	}()
+	return waitForQueueMigration(updates)
+}
+
+func waitForQueueMigration(updates <-chan queueMigrationMsg) tea.Cmd {
+	return func() tea.Msg {
+		msg, ok := <-updates
+		if !ok {
+			return nil
+		}
+		return msg
+	}
+}
+
+// handleMigration updates the migration status and keeps listening until the
+// migration finishes, then shows the target queue.
+func (q *QueueDetails) handleMigration(msg queueMigrationMsg) tea.Cmd {
+	if !q.migrating {
+		// The migration was stopped when the view was hidden.
+		return nil
+	}
+	if !msg.done {
+		q.migrateStatus = fmt.Sprintf("moved %s to %s…", display.Number(msg.moved), msg.to)
+		return waitForQueueMigration(msg.updates)
+	}
+
+	q.migrating = false
+	switch {
+	case requestctx.IsCanceled(msg.err):
+		q.migrateStatus = fmt.Sprintf("stopped after %s to %s", display.Number(msg.moved), msg.to)
+	case msg.err != nil:
+		q.migrateStatus = "failed"
+		return func() tea.Msg { return ConnectionErrorMsg{Err: msg.err} }
+	default:
+		q.migrateStatus = fmt.Sprintf("moved %s to %s", display.Number(msg.moved), msg.to)
+	}
+	q.SetQueue(msg.to)
+	return q.reloadFromStart()
+}
+
+// parseMigrateTarget validates the queue name jobs are migrated to.
+func parseMigrateTarget(from, value string) (string, error) {
+	to := strings.TrimSpace(value)
+	if to == "" {
+		return "", errors.New("enter a queue name")
+	}
+	if strings.ContainsFunc(to, func(r rune) bool { return r == ' ' || r == '\t' }) {
+		return "", errors.New("queue names cannot contain spaces")
+	}
+	if to == from {
+		return "", errors.New("pick a different queue")
+	}
+	return to, nil
+}
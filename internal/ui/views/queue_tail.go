@@ -0,0 +1,178 @@
+package views
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/components/lazytable"
+	"github.com/kpumuk/lazykiq/internal/ui/components/table"
+	"github.com/kpumuk/lazykiq/internal/ui/display"
+)
+
+const (
+	// queueTailFetch is how many of the newest jobs are read on every refresh
+	// while following a queue.
+	queueTailFetch = 50
+	// queueTailLimit caps the rolling list of jobs seen while following.
+	queueTailLimit = 500
+)
+
+// queueTail is the rolling list of jobs that arrived in a queue while it is
+// followed, newest first. Jobs are told apart by JID, or by payload when a
+// job has none.
+type queueTail struct {
+	queue  string
+	filter string
+	jobs   []*sidekiq.PositionedEntry
+	seen   map[string]bool
+	fresh  map[string]bool // Arrived with the latest refresh
+	gone   map[string]bool // Known to have left the queue
+	primed bool
+}
+
+func newQueueTail(queue, filter string) *queueTail {
+	return &queueTail{
+		queue:  queue,
+		filter: filter,
+		seen:   make(map[string]bool),
+	}
+}
+
+func queueTailKey(job *sidekiq.PositionedEntry) string {
+	if jid := job.JID(); jid != "" {
+		return jid
+	}
+	return job.Value()
+}
+
+// merge prepends the jobs of head, the newest slice of the queue, that were
+// not seen before. The first merge only records what is already queued.
+// complete reports whether head covers the whole queue, in which case listed
+// jobs missing from it have left the queue.
+func (t *queueTail) merge(head []*sidekiq.PositionedEntry, complete bool) {
+	inHead := make(map[string]*sidekiq.PositionedEntry, len(head))
+	var added []*sidekiq.PositionedEntry
+	for _, job := range head {
+		key := queueTailKey(job)
+		inHead[key] = job
+		if !t.seen[key] {
+			t.seen[key] = true
+			added = append(added, job)
+		}
+	}
+
+	t.fresh = make(map[string]bool, len(added))
+	if t.primed {
+		for _, job := range added {
+			t.fresh[queueTailKey(job)] = true
+		}
+	}
+	t.primed = true
+
+	t.gone = make(map[string]bool)
+	jobs := make([]*sidekiq.PositionedEntry, 0, min(len(added)+len(t.jobs), queueTailLimit))
+	jobs = append(jobs, added...)
+	for _, job := range t.jobs {
+		key := queueTailKey(job)
+		if current, ok := inHead[key]; ok {
+			// Refresh the position of jobs still near the head.
+			job = current
+		} else if complete {
+			t.gone[key] = true
+		}
+		jobs = append(jobs, job)
+	}
+	if len(jobs) > queueTailLimit {
+		for _, job := range jobs[queueTailLimit:] {
+			delete(t.seen, queueTailKey(job))
+		}
+		jobs = jobs[:queueTailLimit]
+	}
+	t.jobs = jobs
+}
+
+// fetchTailJobs reads the newest slice of queue for follow mode.
+func (q *QueueDetails) fetchTailJobs(
+	ctx context.Context,
+	queue *sidekiq.Queue,
+) ([]*sidekiq.PositionedEntry, int64, error) {
+	jobs, size, err := queue.GetJobs(ctx, 0, queueTailFetch)
+	if err != nil {
+		return nil, 0, err
+	}
+	if q.filter != "" {
+		filtered := jobs[:0]
+		for _, job := range jobs {
+			if strings.Contains(job.Value(), q.filter) {
+				filtered = append(filtered, job)
+			}
+		}
+		jobs = filtered
+	}
+	return jobs, size, nil
+}
+
+// applyTail merges a follow-mode fetch into the rolling list and replaces the
+// fetched rows with it.
+func (q *QueueDetails) applyTail(msg lazytable.DataMsg, payload queueDetailsPayload) lazytable.DataMsg {
+	queueName := ""
+	if payload.selectedQueue >= 0 && payload.selectedQueue < len(payload.queues) {
+		queueName = payload.queues[payload.selectedQueue].Name
+	}
+	if q.tail == nil || q.tail.queue != queueName || q.tail.filter != q.filter {
+		q.tail = newQueueTail(queueName, q.filter)
+	}
+	q.tail.merge(payload.jobs, payload.tailSize <= queueTailFetch)
+
+	payload.jobs = q.tail.jobs
+	msg.Result.Rows = q.buildTailRows()
+	msg.Result.Total = int64(len(q.tail.jobs))
+	msg.Result.WindowStart = 0
+	msg.Result.Payload = payload
+	return msg
+}
+
+// buildTailRows renders the rolling list. New arrivals are highlighted until
+// the next refresh, and jobs that left the queue are dimmed.
+func (q *QueueDetails) buildTailRows() []table.Row {
+	rows := make([]table.Row, 0, len(q.tail.jobs))
+	for _, job := range q.tail.jobs {
+		key := queueTailKey(job)
+		cells := []string{
+			strconv.Itoa(job.Position),
+			job.DisplayClass(),
+			display.Args(job.DisplayArgs()),
+			formatContext(job.Context()),
+		}
+		switch {
+		case q.tail.gone[key]:
+			cells[0] = "-"
+			for i, cell := range cells {
+				cells[i] = q.styles.Muted.Render(cell)
+			}
+		case q.tail.fresh[key]:
+			for i, cell := range cells {
+				cells[i] = q.styles.ChartSuccess.Render(cell)
+			}
+		}
+		rows = append(rows, table.Row{ID: job.JID(), Cells: cells})
+	}
+	return rows
+}
+
+// setFollowing starts or stops following the selected queue.
+func (q *QueueDetails) setFollowing(follow bool) {
+	q.following = follow
+	q.tail = nil
+}
+
+// stopFollowingIfScrolled stops following once the cursor leaves the newest
+// job, so reading older jobs is not interrupted by arrivals.
+func (q *QueueDetails) stopFollowingIfScrolled() {
+	if q.following && q.lazy.Table().Cursor() != 0 {
+		q.setFollowing(false)
+		q.note = "stopped following"
+	}
+}
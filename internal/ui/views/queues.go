@@ -10,6 +10,7 @@ import (
 
 	"charm.land/bubbles/v2/key"
 	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
 
 	"github.com/kpumuk/lazykiq/internal/devtools"
 	"github.com/kpumuk/lazykiq/internal/sidekiq"
@@ -29,6 +30,9 @@ type QueuesListInfo struct {
 	Latency       float64
 	OldestJobTime time.Time
 	HasOldestJob  bool
+	Memory        int64
+	MemoryKnown   bool // False when the server cannot estimate memory
+	Orphan        bool // Found by --discover-queues, missing from the queues set
 }
 
 // queuesListDataMsg carries queues list data internally.
@@ -47,9 +51,11 @@ type QueuesList struct {
 	ready                   bool
 	filter                  string
 	dangerousActionsEnabled bool
+	strictConfirm           bool
 	frameStyles             frame.Styles
 	filterStyle             filterdialog.Styles
 	fetchRequest            requestctx.Controller
+	latency                 LatencyThresholds
 }
 
 // NewQueuesList creates a new QueuesList view.
@@ -60,6 +66,7 @@ func NewQueuesList(client sidekiq.API) *QueuesList {
 			table.WithColumns(queuesListColumns),
 			table.WithEmptyMessage("No queues"),
 		),
+		latency: DefaultLatencyThresholds(),
 	}
 }
 
@@ -78,7 +85,7 @@ func (q *QueuesList) Update(msg tea.Msg) (View, tea.Cmd) {
 		q.updateTableRows()
 		return q, nil
 
-	case RefreshMsg:
+	case RefreshMsg, RefreshViewMsg:
 		return q, q.fetchDataCmd()
 
 	case filterdialog.ActionMsg:
@@ -105,6 +112,10 @@ func (q *QueuesList) Update(msg tea.Msg) (View, tea.Cmd) {
 		return q, q.deleteQueueCmd(msg.Target)
 
 	case tea.KeyPressMsg:
+		if q.table.JumpActive() {
+			q.table, _ = q.table.Update(msg)
+			return q, nil
+		}
 		switch msg.String() {
 		case "/":
 			return q, func() tea.Msg {
@@ -123,6 +134,10 @@ func (q *QueuesList) Update(msg tea.Msg) (View, tea.Cmd) {
 				}
 			}
 			return q, nil
+		case "L":
+			return q, func() tea.Msg {
+				return ShowRateLimitersMsg{}
+			}
 		}
 
 		if q.dangerousActionsEnabled {
@@ -140,6 +155,7 @@ func (q *QueuesList) Update(msg tea.Msg) (View, tea.Cmd) {
 								),
 								queueName,
 								q.styles.DangerAction,
+								strictConfirmOption(q.strictConfirm, queueName),
 							),
 						}
 					}
@@ -185,9 +201,7 @@ func (q *QueuesList) ContextItems() []ContextItem {
 
 	for _, queue := range q.queues {
 		totalItems += queue.Size
-		if queue.Latency > highestLatency {
-			highestLatency = queue.Latency
-		}
+		highestLatency = max(highestLatency, queue.Latency)
 		if queue.HasOldestJob {
 			if oldestJob.IsZero() || queue.OldestJobTime.Before(oldestJob) {
 				oldestJob = queue.OldestJobTime
@@ -196,7 +210,11 @@ func (q *QueuesList) ContextItems() []ContextItem {
 	}
 
 	items = append(items, ContextItem{Label: "Total Items", Value: display.Number(totalItems)})
-	items = append(items, ContextItem{Label: "Highest Latency", Value: formatLatency(highestLatency)})
+	highestLatencyText := formatLatency(highestLatency)
+	if level := worstLatencyLevel(q.latency, q.queues); level != latencyLevelNormal {
+		highestLatencyText = latencyStyle(q.styles, level, lipgloss.NewStyle()).Render(highestLatencyText)
+	}
+	items = append(items, ContextItem{Label: "Highest Latency", Value: highestLatencyText})
 	if !oldestJob.IsZero() {
 		items = append(items, ContextItem{Label: "Oldest Job", Value: oldestJob.Format("2006-01-02 15:04:05")})
 	}
@@ -204,11 +222,23 @@ func (q *QueuesList) ContextItems() []ContextItem {
 	return items
 }
 
+// worstLatencyLevel returns the highest latency level across queues, each
+// classified against its own threshold, so a queue crossing a tighter override
+// is flagged even when another queue has a higher raw latency.
+func worstLatencyLevel(thresholds LatencyThresholds, queues []*QueuesListInfo) latencyLevel {
+	worst := latencyLevelNormal
+	for _, queue := range queues {
+		worst = max(worst, thresholds.For(queue.Name).level(queue.Latency))
+	}
+	return worst
+}
+
 // HintBindings implements HintProvider.
 func (q *QueuesList) HintBindings() []key.Binding {
 	return []key.Binding{
 		helpBinding([]string{"/"}, "/", "filter"),
 		helpBinding([]string{"enter"}, "enter", "view queue"),
+		helpBinding([]string{"L"}, "L", "rate limiters"),
 	}
 }
 
@@ -229,6 +259,7 @@ func (q *QueuesList) HelpSections() []HelpSection {
 		Bindings: []key.Binding{
 			helpBinding([]string{"/"}, "/", "filter queues"),
 			helpBinding([]string{"enter"}, "enter", "view queue details"),
+			helpBinding([]string{"L"}, "L", "show rate limiters (Sidekiq Enterprise)"),
 		},
 	}}
 	if q.dangerousActionsEnabled {
@@ -260,12 +291,22 @@ func (q *QueuesList) SetDangerousActionsEnabled(enabled bool) {
 	q.dangerousActionsEnabled = enabled
 }
 
+// SetStrictConfirm implements StrictConfirmSetter.
+func (q *QueuesList) SetStrictConfirm(strict bool) {
+	q.strictConfirm = strict
+}
+
 // Dispose clears cached data when the view is removed from the stack.
 func (q *QueuesList) Dispose() {
 	q.reset()
 	q.updateTableSize()
 }
 
+// InputFocused implements InputFocuser.
+func (q *QueuesList) InputFocused() bool {
+	return q.table.JumpActive()
+}
+
 // SetStyles implements View.
 func (q *QueuesList) SetStyles(styles Styles) View {
 	q.styles = styles
@@ -275,6 +316,11 @@ func (q *QueuesList) SetStyles(styles Styles) View {
 	return q
 }
 
+// SetLatencyThresholds implements LatencyThresholdsSetter.
+func (q *QueuesList) SetLatencyThresholds(thresholds LatencyThresholds) {
+	q.latency = thresholds
+}
+
 // CancelRequests stops in-flight fetches when the view is hidden.
 func (q *QueuesList) CancelRequests() {
 	q.fetchRequest.Cancel()
@@ -301,11 +347,15 @@ func (q *QueuesList) fetchDataCmd() tea.Cmd {
 
 			size, _ := queue.Size(ctx)
 			latency, _ := queue.Latency(ctx)
+			memory, memoryErr := queue.MemoryUsage(ctx)
 
 			info := &QueuesListInfo{
-				Name:    queue.Name(),
-				Size:    size,
-				Latency: latency,
+				Name:        queue.Name(),
+				Size:        size,
+				Latency:     latency,
+				Memory:      memory,
+				MemoryKnown: memoryErr == nil,
+				Orphan:      queue.Orphan(),
 			}
 
 			// Calculate oldest job timestamp from latency
@@ -349,9 +399,19 @@ var queuesListColumns = []table.Column{
 	{Title: "Name", Width: 30},
 	{Title: "Size", Width: 15, Align: table.AlignRight},
 	{Title: "Latency", Width: 15, Align: table.AlignRight},
+	{Title: "Memory", Width: 12, Align: table.AlignRight},
 	{Title: "Oldest Job", Width: 30},
 }
 
+// formatMemory renders an estimated size in bytes, or "n/a" when the server
+// cannot estimate it.
+func formatMemory(bytes int64, known bool) string {
+	if !known {
+		return "n/a"
+	}
+	return display.Bytes(bytes)
+}
+
 // updateTableSize updates the table dimensions based on current view size.
 func (q *QueuesList) updateTableSize() {
 	tableWidth, tableHeight := framedTableSize(q.width, q.height)
@@ -373,12 +433,18 @@ func (q *QueuesList) updateTableRows() {
 			oldestJobStr = queue.OldestJobTime.Format("2006-01-02 15:04:05")
 		}
 
+		name := q.styles.QueueText.Render(queue.Name)
+		if queue.Orphan {
+			name += " " + q.styles.WarningText.Render("orphan")
+		}
+
 		row := table.Row{
 			ID: queue.Name,
 			Cells: []string{
-				q.styles.QueueText.Render(queue.Name),
+				name,
 				display.Number(queue.Size),
-				formatLatency(queue.Latency),
+				highlightLatency(q.styles, q.latency.For(queue.Name), queue.Latency, formatLatency(queue.Latency)),
+				formatMemory(queue.Memory, queue.MemoryKnown),
 				oldestJobStr,
 			},
 		}
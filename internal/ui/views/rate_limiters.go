@@ -0,0 +1,237 @@
+package views
+
+import (
+	"context"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/kpumuk/lazykiq/internal/devtools"
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/components/frame"
+	"github.com/kpumuk/lazykiq/internal/ui/components/table"
+	"github.com/kpumuk/lazykiq/internal/ui/display"
+	"github.com/kpumuk/lazykiq/internal/ui/requestctx"
+)
+
+// rateLimitersDataMsg carries rate limiters internally.
+type rateLimitersDataMsg struct {
+	limiters []sidekiq.RateLimiter
+}
+
+// RateLimiters lists Sidekiq Enterprise rate limiters with their current
+// usage. It is read-only and empty on OSS and Pro servers.
+type RateLimiters struct {
+	client       sidekiq.API
+	width        int
+	height       int
+	styles       Styles
+	limiters     []sidekiq.RateLimiter
+	ready        bool
+	table        table.Model
+	frameStyles  frame.Styles
+	fetchRequest requestctx.Controller
+}
+
+// NewRateLimiters creates a new RateLimiters view.
+func NewRateLimiters(client sidekiq.API) *RateLimiters {
+	return &RateLimiters{
+		client: client,
+		table: table.New(
+			table.WithColumns(rateLimitersColumns),
+			table.WithEmptyMessage("No rate limiters (Sidekiq Enterprise)"),
+		),
+	}
+}
+
+var rateLimitersColumns = []table.Column{
+	{Title: "Name", Width: 30},
+	{Title: "Type", Width: 10},
+	{Title: "Used", Width: 10, Align: table.AlignRight},
+	{Title: "Size", Width: 10, Align: table.AlignRight},
+	{Title: "Usage", Width: 6, Align: table.AlignRight},
+	{Title: "Key", Width: 40},
+}
+
+// Init implements View.
+func (r *RateLimiters) Init() tea.Cmd {
+	return r.fetchCmd()
+}
+
+// Update implements View.
+func (r *RateLimiters) Update(msg tea.Msg) (View, tea.Cmd) {
+	switch msg := msg.(type) {
+	case rateLimitersDataMsg:
+		r.limiters = msg.limiters
+		r.ready = true
+		r.updateTableRows()
+		return r, nil
+
+	case RefreshMsg, RefreshViewMsg:
+		return r, r.fetchCmd()
+
+	case tea.KeyPressMsg:
+		if r.table.JumpActive() {
+			r.table, _ = r.table.Update(msg)
+			return r, nil
+		}
+		if msg.String() == "c" {
+			if idx := r.table.Cursor(); idx >= 0 && idx < len(r.limiters) {
+				return r, copyTextCmd(r.limiters[idx].Name)
+			}
+			return r, nil
+		}
+		r.table, _ = r.table.Update(msg)
+	}
+
+	return r, nil
+}
+
+// View implements View.
+func (r *RateLimiters) View() string {
+	if !r.ready {
+		return renderStatusMessage("Rate Limiters", "Loading...", r.styles, r.width, r.height)
+	}
+
+	box := frame.New(
+		frame.WithStyles(r.frameStyles),
+		frame.WithTitle("Rate Limiters"),
+		frame.WithTitlePadding(0),
+		frame.WithMeta(r.styles.Muted.Render(display.Number(int64(len(r.limiters)))+" limiters")),
+		frame.WithContent(r.table.View()),
+		frame.WithPadding(1),
+		frame.WithSize(r.width, r.height),
+		frame.WithMinHeight(5),
+		frame.WithFocused(true),
+	)
+	return box.View()
+}
+
+// Name implements View.
+func (r *RateLimiters) Name() string {
+	return "Rate Limiters"
+}
+
+// ShortHelp implements View.
+func (r *RateLimiters) ShortHelp() []key.Binding {
+	return nil
+}
+
+// ContextItems implements ContextProvider.
+func (r *RateLimiters) ContextItems() []ContextItem {
+	counts := make(map[sidekiq.RateLimiterType]int64)
+	var atLimit int64
+	for _, limiter := range r.limiters {
+		counts[limiter.Type]++
+		if limiter.AtLimit() {
+			atLimit++
+		}
+	}
+
+	return []ContextItem{
+		{Label: "Limiters", Value: display.Number(int64(len(r.limiters)))},
+		{Label: "Concurrent", Value: display.Number(counts[sidekiq.RateLimiterConcurrent])},
+		{Label: "Bucket", Value: display.Number(counts[sidekiq.RateLimiterBucket])},
+		{Label: "Window", Value: display.Number(counts[sidekiq.RateLimiterWindow])},
+		{Label: "At Limit", Value: display.Number(atLimit)},
+	}
+}
+
+// HintBindings implements HintProvider.
+func (r *RateLimiters) HintBindings() []key.Binding {
+	return []key.Binding{
+		helpBinding([]string{"c"}, "c", "copy name"),
+	}
+}
+
+// HelpSections implements HelpProvider.
+func (r *RateLimiters) HelpSections() []HelpSection {
+	return []HelpSection{{
+		Title: "Rate Limiters",
+		Bindings: []key.Binding{
+			helpBinding([]string{"c"}, "c", "copy limiter name"),
+		},
+	}}
+}
+
+// TableHelp implements TableHelpProvider.
+func (r *RateLimiters) TableHelp() []key.Binding {
+	return tableHelpBindings(r.table.KeyMap)
+}
+
+// SetSize implements View.
+func (r *RateLimiters) SetSize(width, height int) View {
+	r.width = width
+	r.height = height
+	tableWidth, tableHeight := framedTableSize(width, height)
+	r.table.SetSize(tableWidth, tableHeight)
+	return r
+}
+
+// SetStyles implements View.
+func (r *RateLimiters) SetStyles(styles Styles) View {
+	r.styles = styles
+	r.frameStyles = frameStylesFromTheme(styles)
+	r.table.SetStyles(tableStylesFromTheme(styles))
+	r.updateTableRows()
+	return r
+}
+
+// InputFocused implements InputFocuser.
+func (r *RateLimiters) InputFocused() bool {
+	return r.table.JumpActive()
+}
+
+// Dispose clears cached data when the view is removed from the stack.
+func (r *RateLimiters) Dispose() {
+	r.fetchRequest.Cancel()
+	r.limiters = nil
+	r.ready = false
+	r.table.SetRows(nil)
+	r.table.SetCursor(0)
+}
+
+// CancelRequests stops in-flight fetches when the view is hidden.
+func (r *RateLimiters) CancelRequests() {
+	r.fetchRequest.Cancel()
+}
+
+func (r *RateLimiters) fetchCmd() tea.Cmd {
+	client := r.client
+	ctx := r.fetchRequest.Start(devtools.WithTracker(context.Background(), "rate_limiters.fetchCmd"))
+	return func() tea.Msg {
+		limiters, err := client.GetRateLimiters(ctx)
+		if err != nil {
+			if requestctx.IsCanceled(err) {
+				return nil
+			}
+			return ConnectionErrorMsg{Err: err}
+		}
+		return rateLimitersDataMsg{limiters: limiters}
+	}
+}
+
+func (r *RateLimiters) updateTableRows() {
+	rows := make([]table.Row, len(r.limiters))
+	for i, limiter := range r.limiters {
+		usage := "-"
+		if limiter.Size > 0 {
+			usage = display.Float(float64(limiter.Used)*100/float64(limiter.Size), 0) + "%"
+		}
+		if limiter.AtLimit() {
+			usage = r.styles.WarningText.Render(usage)
+		}
+		rows[i] = table.Row{
+			ID: limiter.Key,
+			Cells: []string{
+				limiter.Name,
+				string(limiter.Type),
+				display.Number(limiter.Used),
+				display.Number(limiter.Size),
+				usage,
+				limiter.Key,
+			},
+		}
+	}
+	r.table.SetRows(rows)
+}
@@ -0,0 +1,48 @@
+package views
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+)
+
+type rateLimitersClientStub struct {
+	sidekiq.API
+	limiters []sidekiq.RateLimiter
+}
+
+func (s *rateLimitersClientStub) GetRateLimiters(context.Context) ([]sidekiq.RateLimiter, error) {
+	return s.limiters, nil
+}
+
+func TestRateLimitersView(t *testing.T) {
+	stub := &rateLimitersClientStub{limiters: []sidekiq.RateLimiter{
+		{Name: "github", Key: "rate:github", Type: sidekiq.RateLimiterBucket, Size: 5000, Used: 800},
+		{Name: "stripe", Key: "limited:stripe", Type: sidekiq.RateLimiterConcurrent, Size: 10, Used: 10},
+	}}
+
+	view := NewRateLimiters(stub)
+	view.SetStyles(Styles{})
+	view.SetSize(120, 20)
+	view.Update(view.Init()())
+
+	output := ansi.Strip(view.View())
+	for _, want := range []string{"github", "bucket", "5,000", "16%", "stripe", "concurrent", "100%", "limited:stripe"} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("view missing %q:\n%s", want, output)
+		}
+	}
+	if got := contextValue(view.ContextItems(), "At Limit"); got != "1" {
+		t.Fatalf("At Limit = %q, want 1", got)
+	}
+
+	stub.limiters = []sidekiq.RateLimiter{}
+	view.Update(view.fetchCmd()())
+	if output := ansi.Strip(view.View()); !strings.Contains(output, "No rate limiters") {
+		t.Fatalf("empty view missing the empty message:\n%s", output)
+	}
+}
@@ -0,0 +1,41 @@
+package views
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+)
+
+// JobSource records where a job shown in job details lives in Redis. The zero
+// value means the location is unknown, e.g. for jobs being processed.
+type JobSource struct {
+	// Key is the Redis key holding the job.
+	Key string
+	// Command is a redis-cli command that fetches the job.
+	Command string
+	// Score is the sorted-set score of the job, 0 outside sorted sets.
+	Score float64
+}
+
+// SortedJobSource locates a job in a sorted set by its score.
+func SortedJobSource(kind sidekiq.SortedSetKind, entry *sidekiq.SortedEntry) JobSource {
+	key := kind.Key()
+	score := strconv.FormatFloat(entry.Score, 'f', -1, 64)
+	return JobSource{
+		Key:     key,
+		Command: fmt.Sprintf("ZRANGEBYSCORE %s %s %s", key, score, score),
+		Score:   entry.Score,
+	}
+}
+
+// queueJobSource locates a job in its queue list. Positions count from the
+// tail, where workers fetch, so the index stays valid while jobs are pushed.
+func queueJobSource(entry *sidekiq.PositionedEntry) JobSource {
+	key := sidekiq.QueueKey(entry.Queue())
+	index := -entry.Position
+	return JobSource{
+		Key:     key,
+		Command: fmt.Sprintf("LRANGE %s %d %d", key, index, index),
+	}
+}
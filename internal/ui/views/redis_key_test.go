@@ -0,0 +1,44 @@
+package views
+
+import (
+	"testing"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+)
+
+func TestJobSourceCommands(t *testing.T) {
+	t.Parallel()
+
+	retry := SortedJobSource(sidekiq.SortedSetRetry, sidekiq.NewSortedEntry(`{"jid":"r1"}`, 1700000000.125))
+	if retry.Key != "retry" || retry.Command != "ZRANGEBYSCORE retry 1700000000.125 1700000000.125" {
+		t.Fatalf("retry source = %+v", retry)
+	}
+	scheduled := SortedJobSource(sidekiq.SortedSetScheduled, sidekiq.NewSortedEntry(`{"jid":"s1"}`, 1700000000))
+	if scheduled.Key != "schedule" || scheduled.Command != "ZRANGEBYSCORE schedule 1700000000 1700000000" {
+		t.Fatalf("scheduled source = %+v", scheduled)
+	}
+
+	queued := queueJobSource(&sidekiq.PositionedEntry{
+		JobRecord: sidekiq.NewJobRecord(`{"jid":"q1","queue":"mailers"}`, "mailers"),
+		Position:  3,
+	})
+	if queued.Key != "queue:mailers" || queued.Command != "LRANGE queue:mailers -3 -3" {
+		t.Fatalf("queue source = %+v", queued)
+	}
+}
+
+func TestJobDetailSetJobClearsSource(t *testing.T) {
+	t.Parallel()
+
+	view := NewJobDetail(nil)
+	view.SetJob(sidekiq.NewJobRecord(`{"jid":"r1","queue":"default"}`, "default"))
+	view.SetJobSource(JobSource{Key: "retry", Command: "ZRANGEBYSCORE retry 1 1"})
+	if view.source.Key != "retry" {
+		t.Fatalf("source = %+v, want the retry set", view.source)
+	}
+
+	view.SetJob(sidekiq.NewJobRecord(`{"jid":"b1","queue":"default"}`, "default"))
+	if view.source != (JobSource{}) {
+		t.Fatalf("source after SetJob = %+v, want it cleared", view.source)
+	}
+}
@@ -0,0 +1,76 @@
+package views
+
+import (
+	"time"
+
+	"charm.land/bubbles/v2/spinner"
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/kpumuk/lazykiq/internal/ui/display"
+)
+
+var nowFuncRefreshIndicator = time.Now
+
+// refreshIndicator renders the "updated 5s ago" frame meta of data-backed
+// views, led by a spinner while a fetch is in flight. Views own the loading
+// flag and the time their last data arrived.
+type refreshIndicator struct {
+	spinner spinner.Model
+}
+
+func newRefreshIndicator() refreshIndicator {
+	return refreshIndicator{spinner: spinner.New(spinner.WithSpinner(spinner.MiniDot))}
+}
+
+// tick starts the spinner. Ticks from an earlier start are dropped by the
+// spinner, so calling it for every fetch is safe.
+func (r *refreshIndicator) tick() tea.Cmd {
+	return r.spinner.Tick
+}
+
+// update advances the spinner while loading and lets it stop otherwise.
+func (r *refreshIndicator) update(msg spinner.TickMsg, loading bool) tea.Cmd {
+	if !loading {
+		return nil
+	}
+	var cmd tea.Cmd
+	r.spinner, cmd = r.spinner.Update(msg)
+	return cmd
+}
+
+// view renders the indicator, or an empty string before the first fetch.
+func (r refreshIndicator) view(styles Styles, loading bool, updatedAt time.Time) string {
+	label := ""
+	switch {
+	case !updatedAt.IsZero():
+		label = updatedAgoLabel(updatedAt)
+	case loading:
+		label = "loading"
+	default:
+		return ""
+	}
+	if loading {
+		label = r.spinner.View() + " " + label
+	}
+	return styles.Muted.Render(label)
+}
+
+// updatedAgoLabel formats how long ago updatedAt was, as "updated 5s ago".
+func updatedAgoLabel(updatedAt time.Time) string {
+	return "updated " + display.Duration(int64(nowFuncRefreshIndicator().Sub(updatedAt).Seconds())) + " ago"
+}
+
+// joinMeta joins frame meta parts with a muted separator, skipping empty ones.
+func joinMeta(styles Styles, parts ...string) string {
+	meta := ""
+	for _, part := range parts {
+		if part == "" {
+			continue
+		}
+		if meta != "" {
+			meta += styles.Muted.Render(" · ")
+		}
+		meta += part
+	}
+	return meta
+}
@@ -0,0 +1,112 @@
+package views
+
+import (
+	"testing"
+	"time"
+
+	"charm.land/bubbles/v2/spinner"
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// fetchResult runs a fetch command and returns its data message, skipping
+// the spinner tick batched with it.
+func fetchResult(cmd tea.Cmd) tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+	msg := cmd()
+	batch, ok := msg.(tea.BatchMsg)
+	if !ok {
+		return msg
+	}
+	for _, sub := range batch {
+		if sub == nil {
+			continue
+		}
+		if msg := sub(); msg != nil {
+			if _, tick := msg.(spinner.TickMsg); !tick {
+				return msg
+			}
+		}
+	}
+	return nil
+}
+
+func freezeRefreshIndicatorTime(t *testing.T, now time.Time) {
+	t.Helper()
+	prev := nowFuncRefreshIndicator
+	nowFuncRefreshIndicator = func() time.Time { return now }
+	t.Cleanup(func() {
+		nowFuncRefreshIndicator = prev
+	})
+}
+
+func TestRefreshIndicatorView(t *testing.T) {
+	now := time.Date(2026, 3, 21, 12, 0, 0, 0, time.UTC)
+	freezeRefreshIndicatorTime(t, now)
+
+	indicator := newRefreshIndicator()
+	spinnerFrame := spinner.MiniDot.Frames[0]
+	tests := []struct {
+		name      string
+		loading   bool
+		updatedAt time.Time
+		want      string
+	}{
+		{name: "idle before first fetch", want: ""},
+		{name: "first fetch", loading: true, want: spinnerFrame + " loading"},
+		{name: "idle", updatedAt: now.Add(-5 * time.Second), want: "updated 5s ago"},
+		{name: "refreshing", loading: true, updatedAt: now.Add(-90 * time.Second), want: spinnerFrame + " updated 1m30s ago"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := ansi.Strip(indicator.view(Styles{}, tc.loading, tc.updatedAt))
+			if got != tc.want {
+				t.Fatalf("view = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRefreshIndicatorSpinsOnlyWhileLoading(t *testing.T) {
+	indicator := newRefreshIndicator()
+	tick, ok := indicator.tick()().(spinner.TickMsg)
+	if !ok {
+		t.Fatal("tick did not return a spinner.TickMsg")
+	}
+	if cmd := indicator.update(tick, false); cmd != nil {
+		t.Fatal("idle indicator scheduled another tick")
+	}
+	if cmd := indicator.update(tick, true); cmd == nil {
+		t.Fatal("loading indicator did not schedule another tick")
+	}
+}
+
+func TestJobMetricsShowsRefreshIndicator(t *testing.T) {
+	now := time.Date(2026, 3, 21, 12, 0, 0, 0, time.UTC)
+	freezeRefreshIndicatorTime(t, now)
+
+	view := NewJobMetrics(nil)
+	view.SetSize(100, 30)
+	view.SetStyles(Styles{})
+	view.jobName = "MailerJob"
+	updated, _ := view.Update(jobMetricsDataMsg{})
+	metrics := updated.(*JobMetrics)
+	if metrics.loading {
+		t.Fatal("loading still set after data arrived")
+	}
+	if !metrics.updatedAt.Equal(now) {
+		t.Fatalf("updatedAt = %v, want %v", metrics.updatedAt, now)
+	}
+
+	metrics.loading = true
+	if got := ansi.Strip(metrics.refresh.view(metrics.styles, metrics.loading, metrics.updatedAt)); got != spinner.MiniDot.Frames[0]+" updated 0s ago" {
+		t.Fatalf("indicator = %q", got)
+	}
+	metrics.CancelRequests()
+	if metrics.loading {
+		t.Fatal("CancelRequests left the spinner running")
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"charm.land/bubbles/v2/key"
 	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
 
 	"github.com/kpumuk/lazykiq/internal/devtools"
 	"github.com/kpumuk/lazykiq/internal/sidekiq"
@@ -16,12 +17,18 @@ import (
 	"github.com/kpumuk/lazykiq/internal/ui/dialogs"
 	confirmdialog "github.com/kpumuk/lazykiq/internal/ui/dialogs/confirm"
 	filterdialog "github.com/kpumuk/lazykiq/internal/ui/dialogs/filter"
+	promptdialog "github.com/kpumuk/lazykiq/internal/ui/dialogs/prompt"
 	"github.com/kpumuk/lazykiq/internal/ui/display"
+	"github.com/kpumuk/lazykiq/internal/ui/requestctx"
 )
 
 const (
 	retriesWindowPages      = 3
 	retriesFallbackPageSize = 25
+	// retriesTimeRangeTarget identifies the time range prompt.
+	retriesTimeRangeTarget = "retries.time_range"
+	// retriesForceDeleteTarget identifies the force delete confirmation.
+	retriesForceDeleteTarget = "retries.force_delete"
 )
 
 type retriesJobAction int
@@ -31,9 +38,12 @@ const (
 	retriesJobActionDelete
 	retriesJobActionKill
 	retriesJobActionRetry
+	retriesJobActionRetryToFront
+	retriesJobActionRetryAndWatch
 	retriesJobActionDeleteAll
 	retriesJobActionKillAll
 	retriesJobActionRetryAll
+	retriesJobActionForceDelete
 )
 
 // Retries shows failed jobs pending retry.
@@ -41,13 +51,22 @@ type Retries struct {
 	client sidekiq.API
 	sortedJobsView
 	dangerousActionsEnabled bool
+	strictConfirm           bool
 	pendingConfirm          pendingConfirm[retriesJobAction]
+	fullWidth               int
+	fullHeight              int
+	showAttempts            bool
+	attempts                sidekiq.RetryCounts
+	attemptsReady           bool
+	attemptsRequest         requestctx.Controller
+	retryBackoff            float64
 }
 
 // NewRetries creates a new Retries view.
 func NewRetries(client sidekiq.API) *Retries {
 	r := &Retries{
-		client: client,
+		client:       client,
+		retryBackoff: DefaultRetryBackoff,
 		sortedJobsView: newSortedJobsView(
 			"Retries",
 			retryJobColumns,
@@ -74,12 +93,26 @@ func (r *Retries) Update(msg tea.Msg) (View, tea.Cmd) {
 		}
 		return r, nil
 
-	case RefreshMsg:
-		return r, r.refreshWindow()
+	case retryAttemptsDataMsg:
+		if !r.showAttempts {
+			return r, nil
+		}
+		r.attempts = msg.counts
+		r.attemptsReady = true
+		return r, nil
+
+	case RefreshMsg, RefreshViewMsg:
+		return r, tea.Batch(r.refreshWindow(), r.fetchAttemptsCmd())
 
 	case filterdialog.ActionMsg:
 		return r, r.handleFilterAction(msg, r.updateEmptyMessage)
 
+	case promptdialog.ActionMsg:
+		if msg.Target == retriesTimeRangeTarget {
+			return r, r.setTimeRange(msg.Value, r.updateEmptyMessage)
+		}
+		return r, nil
+
 	case confirmdialog.ActionMsg:
 		action, entry, ok := r.pendingConfirm.Confirm(msg, r.dangerousActionsEnabled, retriesJobActionNone)
 		if !ok {
@@ -98,38 +131,62 @@ func (r *Retries) Update(msg tea.Msg) (View, tea.Cmd) {
 				return r, nil
 			}
 			return r, r.killJobCmd(entry)
-		case retriesJobActionRetry:
+		case retriesJobActionRetry, retriesJobActionRetryToFront:
 			if entry == nil {
 				return r, nil
 			}
-			return r, r.retryNowJobCmd(entry)
+			return r, r.retryNowJobCmd(entry, action == retriesJobActionRetryToFront)
+		case retriesJobActionRetryAndWatch:
+			if entry == nil {
+				return r, nil
+			}
+			return r, retryAndWatchCmd(r.client, sidekiq.SortedSetRetry, entry, "retries.retryAndWatchCmd")
 		case retriesJobActionDeleteAll:
 			return r, r.deleteAllCmd()
 		case retriesJobActionKillAll:
 			return r, r.killAllCmd()
 		case retriesJobActionRetryAll:
 			return r, r.retryAllCmd()
+		case retriesJobActionForceDelete:
+			if entry == nil {
+				return r, nil
+			}
+			return r, forceDeleteCmd(r.client, sidekiq.SortedSetRetry, entry, "retries.forceDeleteCmd")
 		}
 
+	case forceDeleteOfferMsg:
+		if !r.dangerousActionsEnabled {
+			return r, nil
+		}
+		r.pendingConfirm.Set(retriesJobActionForceDelete, msg.entry, retriesForceDeleteTarget)
+		return r, openForceDeleteConfirm(r.styles, msg, retriesForceDeleteTarget)
+
 	case tea.KeyPressMsg:
 		if handled, cmd := r.handleKeyPress(msg, r.updateEmptyMessage); handled {
 			return r, cmd
 		}
 
 		switch msg.String() {
+		case "t":
+			return r, r.openTimeRangePrompt("Retry time range", retriesTimeRangeTarget)
+		case "o":
+			return r, r.toggleOrder(sidekiq.SortedSetRetry)
+		case "a":
+			return r, r.toggleAttempts()
 		case "c":
 			if entry, ok := r.selectedSortedEntry(); ok {
 				return r, copyTextCmd(entry.JID())
 			}
 			return r, nil
-		case "enter":
-			// Show detail for selected job
-			if idx := r.lazy.Table().Cursor(); idx >= 0 && idx < len(r.jobs) {
-				return r, func() tea.Msg {
-					return ShowJobDetailMsg{Job: r.jobs[idx].JobRecord}
-				}
+		case "C":
+			if entry, ok := r.selectedSortedEntry(); ok {
+				return r, copyJobRefCmd(sidekiq.SortedSetRetry, entry)
 			}
 			return r, nil
+		case "Y":
+			return r, copyTextCmd(sidekiq.SortedSetRetry.Key())
+		case "enter":
+			return r, r.showJobDetailCmd(r.client, sidekiq.SortedSetRetry)
 		}
 
 		if r.dangerousActionsEnabled {
@@ -149,7 +206,19 @@ func (r *Retries) Update(msg tea.Msg) (View, tea.Cmd) {
 			case "R":
 				if entry, ok := r.selectedSortedEntry(); ok {
 					r.pendingConfirm.SetForEntry(retriesJobActionRetry, entry)
-					return r, r.openRetryNowConfirm(entry)
+					return r, r.openRetryNowConfirm(entry, false)
+				}
+				return r, nil
+			case "alt+r":
+				if entry, ok := r.selectedSortedEntry(); ok {
+					r.pendingConfirm.SetForEntry(retriesJobActionRetryToFront, entry)
+					return r, r.openRetryNowConfirm(entry, true)
+				}
+				return r, nil
+			case "W":
+				if entry, ok := r.selectedSortedEntry(); ok {
+					r.pendingConfirm.SetForEntry(retriesJobActionRetryAndWatch, entry)
+					return r, r.openRetryAndWatchConfirm(entry)
 				}
 				return r, nil
 			case "ctrl+d":
@@ -176,7 +245,11 @@ func (r *Retries) View() string {
 		return r.renderLoadingMessage()
 	}
 
-	return r.renderSortedJobsBox("Retries")
+	box := r.renderSortedJobsBox("Retries")
+	if chartHeight := r.attemptsChartHeight(); chartHeight > 0 {
+		return lipgloss.JoinVertical(lipgloss.Left, box, r.renderAttemptsChart(chartHeight))
+	}
+	return box
 }
 
 // Name implements View.
@@ -205,6 +278,11 @@ func (r *Retries) ContextItems() []ContextItem {
 		{Label: "Next retry in", Value: nextRetry},
 		{Label: "Latest retry in", Value: latestRetry},
 		{Label: "Total items", Value: display.Number(r.lazy.Total())},
+		{Label: "Order", Value: r.orderValue(sidekiq.SortedSetRetry)},
+		{Label: "Largest", Value: largestPayloadText(sortedJobRecords(r.jobs), r.largePayload)},
+	}
+	if r.timeRange.active() {
+		items = append(items, ContextItem{Label: "Range", Value: r.timeRange.text})
 	}
 	return items
 }
@@ -214,6 +292,9 @@ func (r *Retries) HintBindings() []key.Binding {
 	return []key.Binding{
 		helpBinding([]string{"/"}, "/", "filter"),
 		helpBinding([]string{"ctrl+u"}, "ctrl+u", "reset filter"),
+		helpBinding([]string{"t"}, "t", "time range"),
+		helpBinding([]string{"o"}, "o", "sort order"),
+		helpBinding([]string{"a"}, "a", "attempts chart"),
 		helpBinding([]string{"[", "]"}, "[ ⋰ ]", "page up/down"),
 		helpBinding([]string{"enter"}, "enter", "job detail"),
 	}
@@ -228,6 +309,8 @@ func (r *Retries) MutationBindings() []key.Binding {
 		helpBinding([]string{"D"}, "shift+d", "delete job"),
 		helpBinding([]string{"K"}, "shift+k", "kill job"),
 		helpBinding([]string{"R"}, "shift+r", "retry now"),
+		helpBinding([]string{"alt+r"}, "alt+r", "retry to front"),
+		helpBinding([]string{"W"}, "shift+w", "retry and watch"),
 		helpBinding([]string{"ctrl+d"}, "ctrl+d", "delete all"),
 		helpBinding([]string{"ctrl+k"}, "ctrl+k", "kill all"),
 		helpBinding([]string{"ctrl+r"}, "ctrl+r", "retry all"),
@@ -242,11 +325,16 @@ func (r *Retries) HelpSections() []HelpSection {
 			Bindings: []key.Binding{
 				helpBinding([]string{"/"}, "/", "filter"),
 				helpBinding([]string{"ctrl+u"}, "ctrl+u", "clear filter"),
+				helpBinding([]string{"t"}, "t", "filter by time range"),
+				helpBinding([]string{"o"}, "o", "toggle newest/oldest first"),
+				helpBinding([]string{"a"}, "a", "toggle retry count chart"),
 				helpBinding([]string{"["}, "[", "page up"),
 				helpBinding([]string{"]"}, "]", "page down"),
 				helpBinding([]string{"g"}, "g", "jump to start"),
 				helpBinding([]string{"G"}, "shift+g", "jump to end"),
 				helpBinding([]string{"c"}, "c", "copy jid"),
+				helpBinding([]string{"C"}, "shift+c", "copy job link"),
+				helpBinding([]string{"Y"}, "shift+y", "copy redis key"),
 				helpBinding([]string{"enter"}, "enter", "job detail"),
 			},
 		},
@@ -258,6 +346,8 @@ func (r *Retries) HelpSections() []HelpSection {
 				helpBinding([]string{"D"}, "shift+d", "delete job"),
 				helpBinding([]string{"K"}, "shift+k", "kill job"),
 				helpBinding([]string{"R"}, "shift+r", "retry now"),
+				helpBinding([]string{"alt+r"}, "alt+r", "retry to front of queue"),
+				helpBinding([]string{"W"}, "shift+w", "retry now and watch until done"),
 				helpBinding([]string{"ctrl+d"}, "ctrl+d", "delete all"),
 				helpBinding([]string{"ctrl+k"}, "ctrl+k", "kill all"),
 				helpBinding([]string{"ctrl+r"}, "ctrl+r", "retry all"),
@@ -274,7 +364,9 @@ func (r *Retries) TableHelp() []key.Binding {
 
 // SetSize implements View.
 func (r *Retries) SetSize(width, height int) View {
-	r.setSize(width, height)
+	r.fullWidth = width
+	r.fullHeight = height
+	r.applySize()
 	return r
 }
 
@@ -283,13 +375,31 @@ func (r *Retries) SetDangerousActionsEnabled(enabled bool) {
 	r.dangerousActionsEnabled = enabled
 }
 
+// SetRetryBackoff implements RetryBackoffSetter.
+func (r *Retries) SetRetryBackoff(multiplier float64) {
+	r.retryBackoff = multiplier
+}
+
+// SetStrictConfirm implements StrictConfirmSetter.
+func (r *Retries) SetStrictConfirm(strict bool) {
+	r.strictConfirm = strict
+}
+
 // Dispose clears cached data when the view is removed from the stack.
 func (r *Retries) Dispose() {
+	r.timeRange = sortedTimeRange{}
+	r.order = sidekiq.SortDefault
+	r.attemptsRequest.Cancel()
+	r.showAttempts = false
+	r.attempts = sidekiq.RetryCounts{}
+	r.attemptsReady = false
 	r.dispose(r.reset)
+	r.applySize()
 }
 
 // CancelRequests stops in-flight fetches when the view is hidden.
 func (r *Retries) CancelRequests() {
+	r.attemptsRequest.Cancel()
 	r.cancelRequests()
 }
 
@@ -309,7 +419,9 @@ func (r *Retries) fetchWindow(
 		tracker:          "retries.fetchWindow",
 		client:           r.client,
 		kind:             sidekiq.SortedSetRetry,
+		order:            r.order,
 		filter:           r.filter,
+		timeRange:        r.timeRange,
 		windowStart:      windowStart,
 		windowSize:       windowSize,
 		fallbackPageSize: retriesFallbackPageSize,
@@ -326,6 +438,7 @@ func (r *Retries) reset() {
 var retryJobColumns = []table.Column{
 	{Title: "Next Retry", Width: 12},
 	{Title: "Retries", Width: 7},
+	{Title: "Then", Width: 16},
 	{Title: "Queue", Width: 15},
 	{Title: "Job", Width: 30},
 	{Title: "Arguments", Width: 40},
@@ -334,7 +447,7 @@ var retryJobColumns = []table.Column{
 
 func (r *Retries) updateEmptyMessage() {
 	msg := "No retries"
-	if r.filter != "" {
+	if r.filter != "" || r.timeRange.active() {
 		msg = "No matches"
 	}
 	r.lazy.SetEmptyMessage(msg)
@@ -347,12 +460,10 @@ func (r *Retries) buildRows(jobs []*sidekiq.SortedEntry) []table.Row {
 		nextRetry := display.Duration(int64(now.Sub(job.At()).Seconds()))
 		retryCount := strconv.Itoa(job.RetryCount())
 
-		errorStr := ""
-		if job.HasError() {
-			errorStr = fmt.Sprintf("%s: %s", job.ErrorClass(), job.ErrorMessage())
-			if len(errorStr) > 100 {
-				errorStr = errorStr[:97] + "..."
-			}
+		// Jobs added to the retry set by hand carry no error details.
+		errorStr := errorDisplay(job)
+		if errorStr == "" {
+			errorStr = "-"
 		}
 
 		rows = append(rows, table.Row{
@@ -360,8 +471,9 @@ func (r *Retries) buildRows(jobs []*sidekiq.SortedEntry) []table.Row {
 			Cells: []string{
 				nextRetry,
 				retryCount,
+				nextRetryEstimateText(job, r.retryBackoff, now),
 				r.styles.QueueText.Render(job.Queue()),
-				job.DisplayClass(),
+				jobClassCell(r.styles, job.JobRecord, r.largePayload),
 				display.Args(job.DisplayArgs()),
 				errorStr,
 			},
@@ -383,6 +495,7 @@ func (r *Retries) openDeleteConfirm(entry *sidekiq.SortedEntry) tea.Cmd {
 				),
 				entry.JID(),
 				r.styles.DangerAction,
+				copyCommandsOption(r.client, "retries.previewDelete", deleteSortedEntryAction(sidekiq.SortedSetRetry, entry)),
 			),
 		}
 	}
@@ -401,24 +514,56 @@ func (r *Retries) openKillConfirm(entry *sidekiq.SortedEntry) tea.Cmd {
 				),
 				entry.JID(),
 				r.styles.DangerAction,
+				copyCommandsOption(r.client, "retries.previewKill", func(ctx context.Context, api sidekiq.API) error {
+					return api.MoveSortedEntryToDead(ctx, sidekiq.SortedSetRetry, entry)
+				}),
 			),
 		}
 	}
 }
 
-func (r *Retries) openRetryNowConfirm(entry *sidekiq.SortedEntry) tea.Cmd {
+// openRetryNowConfirm asks to retry the job now, at the back of its queue or,
+// with front set, ahead of the jobs already waiting.
+func (r *Retries) openRetryNowConfirm(entry *sidekiq.SortedEntry, front bool) tea.Cmd {
 	jobName := r.jobName(entry)
+	detail := "This will enqueue it immediately."
+	if front {
+		detail = "This will enqueue it immediately, ahead of the jobs already waiting."
+	}
 	return func() tea.Msg {
 		return dialogs.OpenDialogMsg{
 			Model: newConfirmDialog(
 				r.styles,
 				"Retry job",
 				fmt.Sprintf(
-					"Retry the %s job now?\n\nThis will enqueue it immediately.",
+					"Retry the %s job now?\n\n%s",
 					r.styles.Text.Bold(true).Render(jobName),
+					detail,
 				),
 				entry.JID(),
 				r.styles.DangerAction,
+				copyCommandsOption(r.client, "retries.previewRetryNow", enqueueSortedEntryAction(sidekiq.SortedSetRetry, entry, front)),
+			),
+		}
+	}
+}
+
+// openRetryAndWatchConfirm asks to retry the job now and follow it until it
+// finishes.
+func (r *Retries) openRetryAndWatchConfirm(entry *sidekiq.SortedEntry) tea.Cmd {
+	jobName := r.jobName(entry)
+	return func() tea.Msg {
+		return dialogs.OpenDialogMsg{
+			Model: newConfirmDialog(
+				r.styles,
+				"Retry and watch job",
+				fmt.Sprintf(
+					"Retry the %s job now?\n\nThis will enqueue it immediately and watch it until it completes or dies again.",
+					r.styles.Text.Bold(true).Render(jobName),
+				),
+				entry.JID(),
+				r.styles.DangerAction,
+				copyCommandsOption(r.client, "retries.previewRetryNow", enqueueSortedEntryAction(sidekiq.SortedSetRetry, entry, false)),
 			),
 		}
 	}
@@ -433,6 +578,7 @@ func (r *Retries) openDeleteAllConfirm() tea.Cmd {
 				"Are you sure you want to delete all retry jobs?\n\nThis action is not recoverable.",
 				"retries.delete_all",
 				r.styles.DangerAction,
+				strictConfirmOption(r.strictConfirm, "retries"),
 			),
 		}
 	}
@@ -516,11 +662,15 @@ func (r *Retries) killJobCmd(entry *sidekiq.SortedEntry) tea.Cmd {
 	}
 }
 
-func (r *Retries) retryNowJobCmd(entry *sidekiq.SortedEntry) tea.Cmd {
+func (r *Retries) retryNowJobCmd(entry *sidekiq.SortedEntry, front bool) tea.Cmd {
+	enqueue := r.client.EnqueueSortedEntry
+	if front {
+		enqueue = r.client.EnqueueSortedEntryToFront
+	}
 	return func() tea.Msg {
 		ctx := devtools.WithTracker(context.Background(), "retries.retryNowJobCmd")
-		if err := r.client.EnqueueSortedEntry(ctx, sidekiq.SortedSetRetry, entry); err != nil {
-			return ConnectionErrorMsg{Err: err}
+		if err := enqueue(ctx, sidekiq.SortedSetRetry, entry); err != nil {
+			return sortedEntryErrorMsg(entry, err)
 		}
 		return RefreshMsg{}
 	}
@@ -0,0 +1,110 @@
+package views
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+)
+
+func TestRetriesBuildRowsErrorColumn(t *testing.T) {
+	view := NewRetries(nil)
+	longMessage := strings.Repeat("x", 200)
+	jobs := []*sidekiq.SortedEntry{
+		sidekiq.NewSortedEntry(`{"jid":"a","class":"SyncJob","queue":"default","error_class":"Timeout::Error","error_message":"execution expired"}`, 1700000000),
+		sidekiq.NewSortedEntry(`{"jid":"b","class":"SyncJob","queue":"default"}`, 1700000000),
+		sidekiq.NewSortedEntry(`{"jid":"c","class":"SyncJob","queue":"default","error_class":"RuntimeError","error_message":"`+longMessage+`"}`, 1700000000),
+	}
+
+	rows := view.buildRows(jobs)
+	errorCol := len(retryJobColumns) - 1
+	if got := rows[0].Cells[errorCol]; got != "Timeout::Error: execution expired" {
+		t.Fatalf("error = %q, want class and message", got)
+	}
+	if got := rows[1].Cells[errorCol]; got != "-" {
+		t.Fatalf("error = %q, want placeholder", got)
+	}
+	if got := rows[2].Cells[errorCol]; !strings.HasPrefix(got, "RuntimeError: xxx") || !strings.HasSuffix(got, "…") {
+		t.Fatalf("error = %q, want truncated message", got)
+	}
+}
+
+func TestNextRetryEstimateText(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	entry := sidekiq.NewSortedEntry(`{"jid":"a","retry":true,"retry_count":2}`, float64(now.Add(time.Hour).Unix()))
+
+	// Retry count 3 backs off 96s to 212s after the pending retry.
+	if got := nextRetryEstimateText(entry, 1, now); got != "in 1h1m–1h3m" {
+		t.Fatalf("estimate = %q, want in 1h1m–1h3m", got)
+	}
+	if got := nextRetryEstimateText(entry, 10, now); got != "in 1h16m–1h35m" {
+		t.Fatalf("estimate with multiplier = %q, want in 1h16m–1h35m", got)
+	}
+
+	last := sidekiq.NewSortedEntry(`{"jid":"b","retry":3,"retry_count":2}`, float64(now.Unix()))
+	if got := nextRetryEstimateText(last, 1, now); got != "dies" {
+		t.Fatalf("estimate on the last retry = %q, want dies", got)
+	}
+}
+
+type retryAttemptsStub struct {
+	sidekiq.API
+	counts sidekiq.RetryCounts
+	calls  int
+}
+
+func (s *retryAttemptsStub) GetRetryCounts(context.Context) (sidekiq.RetryCounts, error) {
+	s.calls++
+	return s.counts, nil
+}
+
+func TestRetriesAttemptsChart(t *testing.T) {
+	counts := make([]int64, 25)
+	counts[0], counts[1], counts[4], counts[24] = 5, 3, 2, 1
+	client := &retryAttemptsStub{counts: sidekiq.RetryCounts{Counts: counts, LastAttempt: 1, Sampled: true}}
+	view := NewRetries(client)
+	view.SetSize(100, 30)
+	view.SetStyles(Styles{})
+	view.ready = true
+
+	_, cmd := view.Update(tea.KeyPressMsg{Code: 'a', Text: "a"})
+	if !view.showAttempts || cmd == nil {
+		t.Fatal("expected attempts chart to be shown and fetched")
+	}
+	view.Update(cmd())
+	if client.calls != 1 {
+		t.Fatalf("GetRetryCounts calls = %d, want 1", client.calls)
+	}
+
+	output := ansi.Strip(view.View())
+	if lines := strings.Split(output, "\n"); len(lines) != 30 {
+		t.Fatalf("lines = %d, want 30", len(lines))
+	}
+	for _, want := range []string{"Attempts", "1 on last attempt", "sampled", "3-4", "20-24"} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("View() missing %q:\n%s", want, output)
+		}
+	}
+
+	view.Update(tea.KeyPressMsg{Code: 'a', Text: "a"})
+	if strings.Contains(ansi.Strip(view.View()), "Attempts") {
+		t.Fatal("expected attempts chart to be hidden")
+	}
+}
+
+func TestBucketRetryCounts(t *testing.T) {
+	counts := make([]int64, 31)
+	for i := range counts {
+		counts[i] = 1
+	}
+	want := []int64{1, 1, 1, 2, 5, 5, 5, 5, 6}
+	if got := bucketRetryCounts(counts); !slices.Equal(got, want) {
+		t.Fatalf("bucketRetryCounts() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,127 @@
+package views
+
+import (
+	"context"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/kpumuk/lazykiq/internal/devtools"
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/components/frame"
+	"github.com/kpumuk/lazykiq/internal/ui/components/histogram"
+	"github.com/kpumuk/lazykiq/internal/ui/display"
+	"github.com/kpumuk/lazykiq/internal/ui/requestctx"
+)
+
+// retryAttemptsChartHeight is the height of the attempts chart box, borders
+// included.
+const retryAttemptsChartHeight = 8
+
+// retryAttemptBuckets are the exclusive upper bounds of the attempts chart
+// columns; the last column holds everything from the final bound up.
+var retryAttemptBuckets = []int{1, 2, 3, 5, 10, 15, 20, 25}
+
+var retryAttemptLabels = []string{"0", "1", "2", "3-4", "5-9", "10-14", "15-19", "20-24", "25+"}
+
+// retryAttemptsDataMsg carries the retry set tally internally.
+type retryAttemptsDataMsg struct {
+	counts sidekiq.RetryCounts
+}
+
+// bucketRetryCounts folds per-attempt counts into the chart columns.
+func bucketRetryCounts(counts []int64) []int64 {
+	buckets := make([]int64, len(retryAttemptBuckets)+1)
+	idx := 0
+	for attempt, count := range counts {
+		for idx < len(retryAttemptBuckets) && attempt >= retryAttemptBuckets[idx] {
+			idx++
+		}
+		buckets[idx] += count
+	}
+	return buckets
+}
+
+func (r *Retries) toggleAttempts() tea.Cmd {
+	r.showAttempts = !r.showAttempts
+	r.attempts = sidekiq.RetryCounts{}
+	r.attemptsReady = false
+	r.applySize()
+	if !r.showAttempts {
+		r.attemptsRequest.Cancel()
+		return nil
+	}
+	return r.fetchAttemptsCmd()
+}
+
+func (r *Retries) fetchAttemptsCmd() tea.Cmd {
+	if !r.showAttempts {
+		return nil
+	}
+	client := r.client
+	ctx := r.attemptsRequest.Start(devtools.WithTracker(context.Background(), "retries.fetchAttemptsCmd"))
+	return func() tea.Msg {
+		counts, err := client.GetRetryCounts(ctx)
+		if err != nil {
+			if requestctx.IsCanceled(err) {
+				return nil
+			}
+			return ConnectionErrorMsg{Err: err}
+		}
+		return retryAttemptsDataMsg{counts: counts}
+	}
+}
+
+func (r *Retries) applySize() {
+	r.setSize(r.fullWidth, r.fullHeight-r.attemptsChartHeight())
+}
+
+// attemptsChartHeight returns the height of the attempts chart, or 0 when it
+// is hidden or the view is too short to fit it alongside the jobs table.
+func (r *Retries) attemptsChartHeight() int {
+	if !r.showAttempts || r.fullHeight < retryAttemptsChartHeight+8 {
+		return 0
+	}
+	return retryAttemptsChartHeight
+}
+
+// renderAttemptsChart renders how many retries are on each attempt. Jobs in
+// the last column are about to exhaust the default retry limit, so the meta
+// line also counts jobs that die on their next failure.
+func (r *Retries) renderAttemptsChart(height int) string {
+	emptyMessage := "No retries"
+	if !r.attemptsReady {
+		emptyMessage = "Loading..."
+	}
+	chart := histogram.New(
+		histogram.WithStyles(histogram.Styles{
+			Axis:  r.styles.ChartAxis,
+			Bar:   r.styles.ChartHistogram,
+			Muted: r.styles.Muted,
+		}),
+		histogram.WithSize(max(r.fullWidth-4, 0), max(height-2, 0)),
+		histogram.WithData(bucketRetryCounts(r.attempts.Counts), retryAttemptLabels),
+		histogram.WithEmptyMessage(emptyMessage),
+	)
+
+	meta := ""
+	if r.attempts.LastAttempt > 0 {
+		meta = r.styles.WarningText.Render(display.Number(r.attempts.LastAttempt) + " on last attempt")
+	}
+	if r.attempts.Sampled {
+		if meta != "" {
+			meta += " "
+		}
+		meta += r.styles.Muted.Render("sampled")
+	}
+	box := frame.New(
+		frame.WithStyles(r.frameStyles),
+		frame.WithTitle("Attempts"),
+		frame.WithTitlePadding(0),
+		frame.WithMeta(meta),
+		frame.WithContent(chart.View()),
+		frame.WithPadding(1),
+		frame.WithSize(r.fullWidth, height),
+		frame.WithFocused(false),
+	)
+	return box.View()
+}
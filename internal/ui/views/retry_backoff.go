@@ -0,0 +1,41 @@
+package views
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/display"
+)
+
+// DefaultRetryBackoff leaves Sidekiq's default retry backoff unscaled.
+const DefaultRetryBackoff = 1.0
+
+// RetryBackoffSetter is implemented by views that estimate when retries fire.
+type RetryBackoffSetter interface {
+	SetRetryBackoff(multiplier float64)
+}
+
+// nextRetryEstimateText describes when entry retries if its pending retry
+// fails too, as a range from now such as "in 1h21m–1h23m", or "dies" when
+// that failure exhausts its retries.
+func nextRetryEstimateText(entry *sidekiq.SortedEntry, multiplier float64, now time.Time) string {
+	estimate, ok := entry.NextRetryEstimate(multiplier)
+	if !ok {
+		return "dies"
+	}
+	return fmt.Sprintf(
+		"in %s–%s",
+		display.Duration(int64(estimate.Earliest.Sub(now).Seconds())),
+		display.Duration(int64(estimate.Latest.Sub(now).Seconds())),
+	)
+}
+
+// formatUpcomingTimestamp formats a time that is usually in the future, such
+// as "2006-01-02 15:04:05 (in 5m0s)".
+func formatUpcomingTimestamp(ts time.Time, now time.Time) string {
+	if !ts.After(now) {
+		return formatTimestamp(ts)
+	}
+	return fmt.Sprintf("%s (in %s)", ts.Format("2006-01-02 15:04:05"), display.Duration(int64(ts.Sub(now).Seconds())))
+}
@@ -7,6 +7,7 @@ import (
 
 	"charm.land/bubbles/v2/key"
 	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
 
 	"github.com/kpumuk/lazykiq/internal/devtools"
 	"github.com/kpumuk/lazykiq/internal/sidekiq"
@@ -16,11 +17,14 @@ import (
 	confirmdialog "github.com/kpumuk/lazykiq/internal/ui/dialogs/confirm"
 	filterdialog "github.com/kpumuk/lazykiq/internal/ui/dialogs/filter"
 	"github.com/kpumuk/lazykiq/internal/ui/display"
+	"github.com/kpumuk/lazykiq/internal/ui/requestctx"
 )
 
 const (
 	scheduledWindowPages      = 3
 	scheduledFallbackPageSize = 25
+	// scheduledForceDeleteTarget identifies the force delete confirmation.
+	scheduledForceDeleteTarget = "scheduled.force_delete"
 )
 
 type scheduledJobAction int
@@ -31,14 +35,28 @@ const (
 	scheduledJobActionAddToQueue
 	scheduledJobActionDeleteAll
 	scheduledJobActionAddAllToQueue
+	scheduledJobActionForceDelete
 )
 
+// schedulerHealthMsg carries the scheduled poller health internally.
+type schedulerHealthMsg struct {
+	health schedulerHealth
+}
+
 // Scheduled shows jobs scheduled for future execution.
 type Scheduled struct {
 	client sidekiq.API
 	sortedJobsView
 	dangerousActionsEnabled bool
+	strictConfirm           bool
 	pendingConfirm          pendingConfirm[scheduledJobAction]
+	health                  schedulerHealth
+	healthRequest           requestctx.Controller
+	fullWidth               int
+	fullHeight              int
+	hideTimeline            bool
+	timeline                []int64
+	timelineRequest         requestctx.Controller
 }
 
 // NewScheduled creates a new Scheduled view.
@@ -59,7 +77,7 @@ func NewScheduled(client sidekiq.API) *Scheduled {
 
 // Init implements View.
 func (s *Scheduled) Init() tea.Cmd {
-	return s.init(s.reset)
+	return tea.Batch(s.init(s.reset), s.fetchHealthCmd(), s.fetchTimelineCmd())
 }
 
 // Update implements View.
@@ -71,8 +89,19 @@ func (s *Scheduled) Update(msg tea.Msg) (View, tea.Cmd) {
 		}
 		return s, nil
 
-	case RefreshMsg:
-		return s, s.refreshWindow()
+	case schedulerHealthMsg:
+		s.health = msg.health
+		return s, nil
+
+	case scheduledTimelineMsg:
+		if s.hideTimeline {
+			return s, nil
+		}
+		s.timeline = msg.counts
+		return s, nil
+
+	case RefreshMsg, RefreshViewMsg:
+		return s, tea.Batch(s.refreshWindow(), s.fetchHealthCmd(), s.fetchTimelineCmd())
 
 	case filterdialog.ActionMsg:
 		return s, s.handleFilterAction(msg, s.updateEmptyMessage)
@@ -99,27 +128,44 @@ func (s *Scheduled) Update(msg tea.Msg) (View, tea.Cmd) {
 			return s, s.deleteAllCmd()
 		case scheduledJobActionAddAllToQueue:
 			return s, s.addAllToQueueCmd()
+		case scheduledJobActionForceDelete:
+			if entry == nil {
+				return s, nil
+			}
+			return s, forceDeleteCmd(s.client, sidekiq.SortedSetScheduled, entry, "scheduled.forceDeleteCmd")
 		}
 
+	case forceDeleteOfferMsg:
+		if !s.dangerousActionsEnabled {
+			return s, nil
+		}
+		s.pendingConfirm.Set(scheduledJobActionForceDelete, msg.entry, scheduledForceDeleteTarget)
+		return s, openForceDeleteConfirm(s.styles, msg, scheduledForceDeleteTarget)
+
 	case tea.KeyPressMsg:
 		if handled, cmd := s.handleKeyPress(msg, s.updateEmptyMessage); handled {
 			return s, cmd
 		}
 
 		switch msg.String() {
+		case "o":
+			return s, s.toggleOrder(sidekiq.SortedSetScheduled)
+		case "t":
+			return s, s.toggleTimeline()
 		case "c":
 			if entry, ok := s.selectedSortedEntry(); ok {
 				return s, copyTextCmd(entry.JID())
 			}
 			return s, nil
-		case "enter":
-			// Show detail for selected job
-			if idx := s.lazy.Table().Cursor(); idx >= 0 && idx < len(s.jobs) {
-				return s, func() tea.Msg {
-					return ShowJobDetailMsg{Job: s.jobs[idx].JobRecord}
-				}
+		case "C":
+			if entry, ok := s.selectedSortedEntry(); ok {
+				return s, copyJobRefCmd(sidekiq.SortedSetScheduled, entry)
 			}
 			return s, nil
+		case "Y":
+			return s, copyTextCmd(sidekiq.SortedSetScheduled.Key())
+		case "enter":
+			return s, s.showJobDetailCmd(s.client, sidekiq.SortedSetScheduled)
 		}
 
 		if s.dangerousActionsEnabled {
@@ -157,7 +203,11 @@ func (s *Scheduled) View() string {
 		return s.renderLoadingMessage()
 	}
 
-	return s.renderSortedJobsBox("Scheduled")
+	box := s.renderSortedJobsBox("Scheduled")
+	if height := s.timelineHeight(); height > 0 {
+		return lipgloss.JoinVertical(lipgloss.Left, s.renderTimeline(height), box)
+	}
+	return box
 }
 
 // Name implements View.
@@ -186,6 +236,10 @@ func (s *Scheduled) ContextItems() []ContextItem {
 		{Label: "Next scheduled in", Value: nextScheduled},
 		{Label: "Latest scheduled in", Value: latestScheduled},
 		{Label: "Total items", Value: display.Number(s.lazy.Total())},
+		{Label: "Order", Value: s.orderValue(sidekiq.SortedSetScheduled)},
+		{Label: "Scheduler", Value: s.health.schedulerValue(s.styles, now)},
+		{Label: "Poller", Value: s.health.pollerValue(now)},
+		{Label: "Largest", Value: largestPayloadText(sortedJobRecords(s.jobs), s.largePayload)},
 	}
 	return items
 }
@@ -195,6 +249,8 @@ func (s *Scheduled) HintBindings() []key.Binding {
 	return []key.Binding{
 		helpBinding([]string{"/"}, "/", "filter"),
 		helpBinding([]string{"ctrl+u"}, "ctrl+u", "reset filter"),
+		helpBinding([]string{"o"}, "o", "sort order"),
+		helpBinding([]string{"t"}, "t", "timeline"),
 		helpBinding([]string{"[", "]"}, "[ ⋰ ]", "page up/down"),
 		helpBinding([]string{"enter"}, "enter", "job detail"),
 	}
@@ -221,11 +277,15 @@ func (s *Scheduled) HelpSections() []HelpSection {
 			Bindings: []key.Binding{
 				helpBinding([]string{"/"}, "/", "filter"),
 				helpBinding([]string{"ctrl+u"}, "ctrl+u", "clear filter"),
+				helpBinding([]string{"o"}, "o", "toggle newest/oldest first"),
+				helpBinding([]string{"t"}, "t", "toggle timeline chart"),
 				helpBinding([]string{"["}, "[", "page up"),
 				helpBinding([]string{"]"}, "]", "page down"),
 				helpBinding([]string{"g"}, "g", "jump to start"),
 				helpBinding([]string{"G"}, "shift+g", "jump to end"),
 				helpBinding([]string{"c"}, "c", "copy jid"),
+				helpBinding([]string{"C"}, "shift+c", "copy job link"),
+				helpBinding([]string{"Y"}, "shift+y", "copy redis key"),
 				helpBinding([]string{"enter"}, "enter", "job detail"),
 			},
 		},
@@ -251,7 +311,9 @@ func (s *Scheduled) TableHelp() []key.Binding {
 
 // SetSize implements View.
 func (s *Scheduled) SetSize(width, height int) View {
-	s.setSize(width, height)
+	s.fullWidth = width
+	s.fullHeight = height
+	s.applySize()
 	return s
 }
 
@@ -260,13 +322,27 @@ func (s *Scheduled) SetDangerousActionsEnabled(enabled bool) {
 	s.dangerousActionsEnabled = enabled
 }
 
+// SetStrictConfirm implements StrictConfirmSetter.
+func (s *Scheduled) SetStrictConfirm(strict bool) {
+	s.strictConfirm = strict
+}
+
 // Dispose clears cached data when the view is removed from the stack.
 func (s *Scheduled) Dispose() {
+	s.healthRequest.Cancel()
+	s.health = schedulerHealth{}
+	s.timelineRequest.Cancel()
+	s.hideTimeline = false
+	s.timeline = nil
+	s.order = sidekiq.SortDefault
 	s.dispose(s.reset)
+	s.applySize()
 }
 
 // CancelRequests stops in-flight fetches when the view is hidden.
 func (s *Scheduled) CancelRequests() {
+	s.healthRequest.Cancel()
+	s.timelineRequest.Cancel()
 	s.cancelRequests()
 }
 
@@ -286,6 +362,7 @@ func (s *Scheduled) fetchWindow(
 		tracker:          "scheduled.fetchWindow",
 		client:           s.client,
 		kind:             sidekiq.SortedSetScheduled,
+		order:            s.order,
 		filter:           s.filter,
 		windowStart:      windowStart,
 		windowSize:       windowSize,
@@ -295,6 +372,21 @@ func (s *Scheduled) fetchWindow(
 	})
 }
 
+func (s *Scheduled) fetchHealthCmd() tea.Cmd {
+	client := s.client
+	ctx := s.healthRequest.Start(devtools.WithTracker(context.Background(), "scheduled.fetchHealthCmd"))
+	return func() tea.Msg {
+		health, err := fetchSchedulerHealth(ctx, client)
+		if err != nil {
+			if requestctx.IsCanceled(err) {
+				return nil
+			}
+			return ConnectionErrorMsg{Err: err}
+		}
+		return schedulerHealthMsg{health: health}
+	}
+}
+
 func (s *Scheduled) reset() {
 	s.resetSortedJobs(s.updateEmptyMessage)
 }
@@ -325,7 +417,7 @@ func (s *Scheduled) buildRows(jobs []*sidekiq.SortedEntry) []table.Row {
 			Cells: []string{
 				when,
 				s.styles.QueueText.Render(job.Queue()),
-				job.DisplayClass(),
+				jobClassCell(s.styles, job.JobRecord, s.largePayload),
 				display.Args(job.DisplayArgs()),
 			},
 		})
@@ -346,6 +438,7 @@ func (s *Scheduled) openDeleteConfirm(entry *sidekiq.SortedEntry) tea.Cmd {
 				),
 				entry.JID(),
 				s.styles.DangerAction,
+				copyCommandsOption(s.client, "scheduled.previewDelete", deleteSortedEntryAction(sidekiq.SortedSetScheduled, entry)),
 			),
 		}
 	}
@@ -364,6 +457,7 @@ func (s *Scheduled) openAddToQueueConfirm(entry *sidekiq.SortedEntry) tea.Cmd {
 				),
 				entry.JID(),
 				s.styles.DangerAction,
+				copyCommandsOption(s.client, "scheduled.previewAddToQueue", enqueueSortedEntryAction(sidekiq.SortedSetScheduled, entry, false)),
 			),
 		}
 	}
@@ -378,6 +472,7 @@ func (s *Scheduled) openDeleteAllConfirm() tea.Cmd {
 				"Are you sure you want to delete all scheduled jobs?\n\nThis action is not recoverable.",
 				"scheduled.delete_all",
 				s.styles.DangerAction,
+				strictConfirmOption(s.strictConfirm, "scheduled"),
 			),
 		}
 	}
@@ -421,7 +516,7 @@ func (s *Scheduled) addToQueueJobCmd(entry *sidekiq.SortedEntry) tea.Cmd {
 	return func() tea.Msg {
 		ctx := devtools.WithTracker(context.Background(), "scheduled.addToQueueJobCmd")
 		if err := s.client.EnqueueSortedEntry(ctx, sidekiq.SortedSetScheduled, entry); err != nil {
-			return ConnectionErrorMsg{Err: err}
+			return sortedEntryErrorMsg(entry, err)
 		}
 		return RefreshMsg{}
 	}
@@ -0,0 +1,72 @@
+package views
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+)
+
+type scheduledTimelineStub struct {
+	sidekiq.API
+	counts  []int64
+	buckets []time.Duration
+	calls   int
+}
+
+func (s *scheduledTimelineStub) GetScheduledTimeline(_ context.Context, buckets []time.Duration) ([]int64, error) {
+	s.calls++
+	s.buckets = buckets
+	return s.counts, nil
+}
+
+func TestScheduledTimeline(t *testing.T) {
+	client := &scheduledTimelineStub{counts: []int64{4, 2, 0, 7, 1}}
+	view := NewScheduled(client)
+	view.SetSize(100, 30)
+	view.SetStyles(Styles{})
+	view.ready = true
+
+	cmd := view.fetchTimelineCmd()
+	if cmd == nil {
+		t.Fatal("expected timeline to be fetched")
+	}
+	view.Update(cmd())
+	if client.calls != 1 {
+		t.Fatalf("GetScheduledTimeline calls = %d, want 1", client.calls)
+	}
+	if !slices.Equal(client.buckets, scheduledTimelineBuckets) {
+		t.Fatalf("buckets = %v, want %v", client.buckets, scheduledTimelineBuckets)
+	}
+
+	output := ansi.Strip(view.View())
+	lines := strings.Split(output, "\n")
+	if len(lines) != 30 {
+		t.Fatalf("lines = %d, want 30", len(lines))
+	}
+	if !strings.Contains(lines[0], "Timeline") {
+		t.Fatalf("expected timeline above the table, first line %q", lines[0])
+	}
+	for _, want := range []string{"<6h", "<1d", "<1w"} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("View() missing %q:\n%s", want, output)
+		}
+	}
+
+	_, cmd = view.Update(tea.KeyPressMsg{Code: 't', Text: "t"})
+	if cmd != nil {
+		t.Fatal("expected no fetch when hiding the timeline")
+	}
+	if strings.Contains(ansi.Strip(view.View()), "Timeline") {
+		t.Fatal("expected timeline to be hidden")
+	}
+	if cmd := view.fetchTimelineCmd(); cmd != nil {
+		t.Fatal("expected hidden timeline to skip refreshes")
+	}
+}
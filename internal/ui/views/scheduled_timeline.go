@@ -0,0 +1,103 @@
+package views
+
+import (
+	"context"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/kpumuk/lazykiq/internal/devtools"
+	"github.com/kpumuk/lazykiq/internal/ui/components/frame"
+	"github.com/kpumuk/lazykiq/internal/ui/components/histogram"
+	"github.com/kpumuk/lazykiq/internal/ui/requestctx"
+)
+
+// scheduledTimelineHeight is the height of the timeline box, borders included.
+const scheduledTimelineHeight = 7
+
+// scheduledTimelineBuckets are the upper bounds on the time until a job runs
+// for the timeline columns; the last column holds everything later.
+var scheduledTimelineBuckets = []time.Duration{
+	time.Hour,
+	6 * time.Hour,
+	24 * time.Hour,
+	7 * 24 * time.Hour,
+}
+
+var scheduledTimelineLabels = []string{"<1h", "<6h", "<1d", "<1w", "later"}
+
+// scheduledTimelineMsg carries the scheduled timeline counts internally.
+type scheduledTimelineMsg struct {
+	counts []int64
+}
+
+func (s *Scheduled) toggleTimeline() tea.Cmd {
+	s.hideTimeline = !s.hideTimeline
+	s.timeline = nil
+	s.applySize()
+	if s.hideTimeline {
+		s.timelineRequest.Cancel()
+		return nil
+	}
+	return s.fetchTimelineCmd()
+}
+
+func (s *Scheduled) fetchTimelineCmd() tea.Cmd {
+	if s.hideTimeline {
+		return nil
+	}
+	client := s.client
+	ctx := s.timelineRequest.Start(devtools.WithTracker(context.Background(), "scheduled.fetchTimelineCmd"))
+	return func() tea.Msg {
+		counts, err := client.GetScheduledTimeline(ctx, scheduledTimelineBuckets)
+		if err != nil {
+			if requestctx.IsCanceled(err) {
+				return nil
+			}
+			return ConnectionErrorMsg{Err: err}
+		}
+		return scheduledTimelineMsg{counts: counts}
+	}
+}
+
+func (s *Scheduled) applySize() {
+	s.setSize(s.fullWidth, s.fullHeight-s.timelineHeight())
+}
+
+// timelineHeight returns the height of the timeline, or 0 when it is hidden
+// or the view is too short to fit it alongside the jobs table.
+func (s *Scheduled) timelineHeight() int {
+	if s.hideTimeline || s.fullHeight < scheduledTimelineHeight+8 {
+		return 0
+	}
+	return scheduledTimelineHeight
+}
+
+// renderTimeline renders how many scheduled jobs run within each time span.
+func (s *Scheduled) renderTimeline(height int) string {
+	emptyMessage := "No scheduled jobs"
+	if s.timeline == nil {
+		emptyMessage = "Loading..."
+	}
+	chart := histogram.New(
+		histogram.WithStyles(histogram.Styles{
+			Axis:  s.styles.ChartAxis,
+			Bar:   s.styles.ChartHistogram,
+			Muted: s.styles.Muted,
+		}),
+		histogram.WithSize(max(s.fullWidth-4, 0), max(height-2, 0)),
+		histogram.WithData(s.timeline, scheduledTimelineLabels),
+		histogram.WithEmptyMessage(emptyMessage),
+	)
+
+	box := frame.New(
+		frame.WithStyles(s.frameStyles),
+		frame.WithTitle("Timeline"),
+		frame.WithTitlePadding(0),
+		frame.WithContent(chart.View()),
+		frame.WithPadding(1),
+		frame.WithSize(s.fullWidth, height),
+		frame.WithFocused(false),
+	)
+	return box.View()
+}
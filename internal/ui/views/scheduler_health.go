@@ -0,0 +1,74 @@
+package views
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/display"
+)
+
+// schedulerHealth tells whether the scheduled poller keeps up: when it last
+// polled and when the earliest scheduled job was due.
+type schedulerHealth struct {
+	lastPoll time.Time
+	earliest time.Time
+	known    bool
+}
+
+// fetchSchedulerHealth reads the poller heartbeat and the earliest scheduled
+// job. A missing heartbeat leaves the last poll unknown rather than failing
+// the fetch.
+func fetchSchedulerHealth(ctx context.Context, client sidekiq.API) (schedulerHealth, error) {
+	lastPoll, err := client.GetPollerHeartbeat(ctx)
+	if err != nil && !errors.Is(err, sidekiq.ErrNoPollerHeartbeat) {
+		return schedulerHealth{}, err
+	}
+	first, _, err := client.GetSortedEntryBounds(ctx, sidekiq.SortedSetScheduled)
+	if err != nil {
+		return schedulerHealth{}, err
+	}
+
+	health := schedulerHealth{lastPoll: lastPoll, known: true}
+	if first != nil {
+		health.earliest = first.At()
+	}
+	return health, nil
+}
+
+// lag returns how long the earliest scheduled job is past due, or 0.
+func (h schedulerHealth) lag(now time.Time) time.Duration {
+	if h.earliest.IsZero() || !h.earliest.Before(now) {
+		return 0
+	}
+	return now.Sub(h.earliest)
+}
+
+// lagging reports whether the poller has fallen behind the schedule.
+func (h schedulerHealth) lagging(now time.Time) bool {
+	return h.lag(now) >= sidekiq.SchedulerLagThreshold
+}
+
+// schedulerValue formats the scheduler state for the context bar, warning
+// once the earliest job is overdue by more than the threshold.
+func (h schedulerHealth) schedulerValue(styles Styles, now time.Time) string {
+	if !h.known {
+		return "-"
+	}
+	if !h.lagging(now) {
+		return "on time"
+	}
+	return styles.WarningText.Render("lagging by " + display.Duration(int64(h.lag(now)/time.Second)))
+}
+
+// pollerValue formats the age of the poller heartbeat, "unknown" without one.
+func (h schedulerHealth) pollerValue(now time.Time) string {
+	if !h.known {
+		return "-"
+	}
+	if h.lastPoll.IsZero() {
+		return "unknown"
+	}
+	return display.Duration(int64(now.Sub(h.lastPoll)/time.Second)) + " ago"
+}
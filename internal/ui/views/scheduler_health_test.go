@@ -0,0 +1,84 @@
+package views
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+)
+
+type schedulerHealthClientStub struct {
+	sidekiq.API
+	lastPoll time.Time
+	earliest *sidekiq.SortedEntry
+}
+
+func (s *schedulerHealthClientStub) GetPollerHeartbeat(context.Context) (time.Time, error) {
+	if s.lastPoll.IsZero() {
+		return time.Time{}, sidekiq.ErrNoPollerHeartbeat
+	}
+	return s.lastPoll, nil
+}
+
+func (s *schedulerHealthClientStub) GetSortedEntryBounds(
+	context.Context,
+	sidekiq.SortedSetKind,
+) (*sidekiq.SortedEntry, *sidekiq.SortedEntry, error) {
+	return s.earliest, s.earliest, nil
+}
+
+func TestSchedulerHealth(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name      string
+		stub      *schedulerHealthClientStub
+		scheduler string
+		poller    string
+	}{
+		{
+			name: "on time",
+			stub: &schedulerHealthClientStub{
+				lastPoll: now.Add(-5 * time.Second),
+				earliest: sidekiq.NewSortedEntry(`{"jid":"a"}`, float64(now.Add(time.Minute).Unix())),
+			},
+			scheduler: "on time",
+			poller:    "5s ago",
+		},
+		{
+			name: "lagging without heartbeat",
+			stub: &schedulerHealthClientStub{
+				earliest: sidekiq.NewSortedEntry(`{"jid":"a"}`, float64(now.Add(-2*time.Minute).Unix())),
+			},
+			scheduler: "lagging by 2m0s",
+			poller:    "unknown",
+		},
+		{
+			name:      "nothing scheduled",
+			stub:      &schedulerHealthClientStub{},
+			scheduler: "on time",
+			poller:    "unknown",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			health, err := fetchSchedulerHealth(context.Background(), tt.stub)
+			if err != nil {
+				t.Fatalf("fetchSchedulerHealth failed: %v", err)
+			}
+			if got := ansi.Strip(health.schedulerValue(Styles{}, now)); got != tt.scheduler {
+				t.Fatalf("scheduler = %q, want %q", got, tt.scheduler)
+			}
+			if got := health.pollerValue(now); got != tt.poller {
+				t.Fatalf("poller = %q, want %q", got, tt.poller)
+			}
+		})
+	}
+
+	if got := (schedulerHealth{}).pollerValue(now); got != "-" {
+		t.Fatalf("unfetched poller = %q, want -", got)
+	}
+}
@@ -2,6 +2,8 @@ package views
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"github.com/kpumuk/lazykiq/internal/devtools"
 	"github.com/kpumuk/lazykiq/internal/sidekiq"
@@ -10,19 +12,44 @@ import (
 )
 
 type sortedEntriesClient interface {
-	GetSortedEntries(context.Context, sidekiq.SortedSetKind, int, int) ([]*sidekiq.SortedEntry, int64, error)
-	ScanSortedEntries(context.Context, sidekiq.SortedSetKind, string) ([]*sidekiq.SortedEntry, error)
+	GetSortedEntries(
+		context.Context,
+		sidekiq.SortedSetKind,
+		sidekiq.SortOrder,
+		int,
+		int,
+	) ([]*sidekiq.SortedEntry, int64, error)
+	ScanSortedEntries(context.Context, sidekiq.SortedSetKind, sidekiq.SortOrder, string) ([]*sidekiq.SortedEntry, error)
 	GetSortedEntryBounds(context.Context, sidekiq.SortedSetKind) (*sidekiq.SortedEntry, *sidekiq.SortedEntry, error)
 }
 
+type sortedEntriesRangeFetcher interface {
+	GetSortedEntriesInRange(
+		context.Context,
+		sidekiq.SortedSetKind,
+		sidekiq.SortOrder,
+		time.Time,
+		time.Time,
+	) ([]*sidekiq.SortedEntry, error)
+}
+
 type sortedEntriesWindowScanner interface {
-	ScanSortedEntriesWindow(context.Context, sidekiq.SortedSetKind, string, int, int) (sidekiq.SortedEntriesWindow, error)
+	ScanSortedEntriesWindow(
+		context.Context,
+		sidekiq.SortedSetKind,
+		sidekiq.SortOrder,
+		string,
+		int,
+		int,
+	) (sidekiq.SortedEntriesWindow, error)
 }
 
 type sortedWindowConfig struct {
 	client           sortedEntriesClient
 	kind             sidekiq.SortedSetKind
+	order            sidekiq.SortOrder
 	filter           string
+	timeRange        sortedTimeRange
 	windowStart      int
 	windowSize       int
 	fallbackPageSize int
@@ -47,7 +74,9 @@ type sortedEntriesFetchConfig struct {
 	tracker          string
 	client           sortedEntriesClient
 	kind             sidekiq.SortedSetKind
+	order            sidekiq.SortOrder
 	filter           string
+	timeRange        sortedTimeRange
 	windowStart      int
 	windowSize       int
 	fallbackPageSize int
@@ -62,7 +91,9 @@ func fetchSortedEntriesWindow(ctx context.Context, cfg sortedEntriesFetchConfig)
 	result, err := fetchSortedWindow(ctx, sortedWindowConfig{
 		client:           cfg.client,
 		kind:             cfg.kind,
+		order:            cfg.order,
 		filter:           cfg.filter,
+		timeRange:        cfg.timeRange,
 		windowStart:      cfg.windowStart,
 		windowSize:       cfg.windowSize,
 		fallbackPageSize: cfg.fallbackPageSize,
@@ -90,11 +121,14 @@ func fetchSortedWindow(ctx context.Context, cfg sortedWindowConfig) (sortedWindo
 		windowSize = max(cfg.fallbackPageSize, 1) * max(cfg.windowPages, 1)
 	}
 
+	if cfg.timeRange.active() {
+		return fetchTimeRangeSortedWindow(ctx, cfg, windowSize)
+	}
 	if cfg.filter != "" {
 		return fetchFilteredSortedWindow(ctx, cfg, windowSize)
 	}
 
-	jobs, totalSize, err := cfg.client.GetSortedEntries(ctx, cfg.kind, cfg.windowStart, windowSize)
+	jobs, totalSize, err := cfg.client.GetSortedEntries(ctx, cfg.kind, cfg.order, cfg.windowStart, windowSize)
 	if err != nil {
 		return sortedWindowResult{}, err
 	}
@@ -111,7 +145,7 @@ func fetchSortedWindow(ctx context.Context, cfg sortedWindowConfig) (sortedWindo
 	maxStart := max(int(totalSize)-windowSize, 0)
 	if windowStart > maxStart {
 		windowStart = maxStart
-		jobs, totalSize, err = cfg.client.GetSortedEntries(ctx, cfg.kind, windowStart, windowSize)
+		jobs, totalSize, err = cfg.client.GetSortedEntries(ctx, cfg.kind, cfg.order, windowStart, windowSize)
 		if err != nil {
 			return sortedWindowResult{}, err
 		}
@@ -153,7 +187,7 @@ func fetchFilteredSortedWindowPage(
 ) (sortedWindowResult, error) {
 	scanner := cfg.client.(sortedEntriesWindowScanner)
 	windowStart := max(cfg.windowStart, 0)
-	window, err := scanner.ScanSortedEntriesWindow(ctx, cfg.kind, cfg.filter, windowStart, windowSize)
+	window, err := scanner.ScanSortedEntriesWindow(ctx, cfg.kind, cfg.order, cfg.filter, windowStart, windowSize)
 	if err != nil {
 		return sortedWindowResult{}, err
 	}
@@ -164,7 +198,7 @@ func fetchFilteredSortedWindowPage(
 	maxStart := max(int(window.Total)-windowSize, 0)
 	if windowStart > maxStart {
 		windowStart = maxStart
-		window, err = scanner.ScanSortedEntriesWindow(ctx, cfg.kind, cfg.filter, windowStart, windowSize)
+		window, err = scanner.ScanSortedEntriesWindow(ctx, cfg.kind, cfg.order, cfg.filter, windowStart, windowSize)
 		if err != nil {
 			return sortedWindowResult{}, err
 		}
@@ -184,17 +218,79 @@ func fetchFilteredSortedWindowFallback(
 	cfg sortedWindowConfig,
 	windowSize int,
 ) (sortedWindowResult, error) {
-	jobs, err := cfg.client.ScanSortedEntries(ctx, cfg.kind, cfg.filter)
+	jobs, err := cfg.client.ScanSortedEntries(ctx, cfg.kind, cfg.order, cfg.filter)
 	if err != nil {
 		return sortedWindowResult{}, err
 	}
+	return sortedWindowFromEntries(jobs, cfg.windowStart, windowSize), nil
+}
 
+// fetchTimeRangeSortedWindow loads every job in the time range and applies
+// the filter in memory. Clients without range queries scan the filtered set
+// and drop jobs outside the range instead.
+func fetchTimeRangeSortedWindow(
+	ctx context.Context,
+	cfg sortedWindowConfig,
+	windowSize int,
+) (sortedWindowResult, error) {
+	from, to := cfg.timeRange.bounds(time.Now())
+	var jobs []*sidekiq.SortedEntry
+	if fetcher, ok := cfg.client.(sortedEntriesRangeFetcher); ok {
+		entries, err := fetcher.GetSortedEntriesInRange(ctx, cfg.kind, cfg.order, from, to)
+		if err != nil {
+			return sortedWindowResult{}, err
+		}
+		for _, entry := range entries {
+			if matchesSortedFilter(entry.Value(), cfg.filter) {
+				jobs = append(jobs, entry)
+			}
+		}
+	} else {
+		entries, err := cfg.client.ScanSortedEntries(ctx, cfg.kind, cfg.order, cfg.filter)
+		if err != nil {
+			return sortedWindowResult{}, err
+		}
+		for _, entry := range entries {
+			at := entry.At()
+			if (from.IsZero() || !at.Before(from)) && (to.IsZero() || at.Before(to)) {
+				jobs = append(jobs, entry)
+			}
+		}
+	}
+	return sortedWindowFromEntries(jobs, cfg.windowStart, windowSize), nil
+}
+
+// matchesSortedFilter applies a sorted-set filter to a raw payload the way
+// the Redis scan does: a filter without "*" matches as a substring, otherwise
+// it is a glob anchored at both ends.
+func matchesSortedFilter(value, filter string) bool {
+	parts := strings.Split(filter, "*")
+	if len(parts) == 1 {
+		return strings.Contains(value, filter)
+	}
+	first, last := parts[0], parts[len(parts)-1]
+	if len(value) < len(first)+len(last) || !strings.HasPrefix(value, first) || !strings.HasSuffix(value, last) {
+		return false
+	}
+	value = value[len(first) : len(value)-len(last)]
+	for _, part := range parts[1 : len(parts)-1] {
+		idx := strings.Index(value, part)
+		if idx < 0 {
+			return false
+		}
+		value = value[idx+len(part):]
+	}
+	return true
+}
+
+// sortedWindowFromEntries cuts one window out of fully loaded entries.
+func sortedWindowFromEntries(jobs []*sidekiq.SortedEntry, windowStart, windowSize int) sortedWindowResult {
 	total := int64(len(jobs))
 	if total <= 0 {
-		return sortedWindowResult{total: 0}, nil
+		return sortedWindowResult{total: 0}
 	}
 
-	windowStart := max(cfg.windowStart, 0)
+	windowStart = max(windowStart, 0)
 	maxStart := max(int(total)-windowSize, 0)
 	if windowStart > maxStart {
 		windowStart = maxStart
@@ -208,7 +304,7 @@ func fetchFilteredSortedWindowFallback(
 		windowStart: windowStart,
 		firstEntry:  firstEntry,
 		lastEntry:   lastEntry,
-	}, nil
+	}
 }
 
 func sortedEntryBounds(entries []*sidekiq.SortedEntry) (*sidekiq.SortedEntry, *sidekiq.SortedEntry) {
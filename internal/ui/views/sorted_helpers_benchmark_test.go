@@ -30,17 +30,19 @@ type benchmarkLegacySortedClient struct {
 func (c benchmarkLegacySortedClient) GetSortedEntries(
 	ctx context.Context,
 	kind sidekiq.SortedSetKind,
+	order sidekiq.SortOrder,
 	start, size int,
 ) ([]*sidekiq.SortedEntry, int64, error) {
-	return c.client.GetSortedEntries(ctx, kind, start, size)
+	return c.client.GetSortedEntries(ctx, kind, order, start, size)
 }
 
 func (c benchmarkLegacySortedClient) ScanSortedEntries(
 	ctx context.Context,
 	kind sidekiq.SortedSetKind,
+	order sidekiq.SortOrder,
 	query string,
 ) ([]*sidekiq.SortedEntry, error) {
-	return c.client.ScanSortedEntries(ctx, kind, query)
+	return c.client.ScanSortedEntries(ctx, kind, order, query)
 }
 
 func (c benchmarkLegacySortedClient) GetSortedEntryBounds(
@@ -3,6 +3,7 @@ package views
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/kpumuk/lazykiq/internal/sidekiq"
 	"github.com/kpumuk/lazykiq/internal/ui/components/lazytable"
@@ -30,6 +31,7 @@ type fakeSortedEntriesClient struct {
 func (c fakeSortedEntriesClient) GetSortedEntries(
 	ctx context.Context,
 	kind sidekiq.SortedSetKind,
+	_ sidekiq.SortOrder,
 	start, size int,
 ) ([]*sidekiq.SortedEntry, int64, error) {
 	if c.getSortedEntries == nil {
@@ -41,6 +43,7 @@ func (c fakeSortedEntriesClient) GetSortedEntries(
 func (c fakeSortedEntriesClient) ScanSortedEntries(
 	ctx context.Context,
 	kind sidekiq.SortedSetKind,
+	_ sidekiq.SortOrder,
 	query string,
 ) ([]*sidekiq.SortedEntry, error) {
 	if c.scanSortedEntries == nil {
@@ -67,6 +70,7 @@ type fakeSortedEntriesWindowClient struct {
 func (c fakeSortedEntriesWindowClient) ScanSortedEntriesWindow(
 	ctx context.Context,
 	kind sidekiq.SortedSetKind,
+	_ sidekiq.SortOrder,
 	query string,
 	start, size int,
 ) (sidekiq.SortedEntriesWindow, error) {
@@ -509,3 +513,200 @@ func TestFetchSortedEntriesWindow(t *testing.T) {
 		})
 	}
 }
+
+type fakeSortedEntriesRangeClient struct {
+	fakeSortedEntriesClient
+	entries  []*sidekiq.SortedEntry
+	order    sidekiq.SortOrder
+	from, to time.Time
+}
+
+func (c *fakeSortedEntriesRangeClient) GetSortedEntriesInRange(
+	_ context.Context,
+	_ sidekiq.SortedSetKind,
+	order sidekiq.SortOrder,
+	from, to time.Time,
+) ([]*sidekiq.SortedEntry, error) {
+	c.order, c.from, c.to = order, from, to
+	return c.entries, nil
+}
+
+func TestFetchSortedWindowTimeRange(t *testing.T) {
+	timeRange, err := parseSortedTimeRange("1h")
+	if err != nil {
+		t.Fatalf("parseSortedTimeRange failed: %v", err)
+	}
+	client := &fakeSortedEntriesRangeClient{entries: []*sidekiq.SortedEntry{
+		sidekiq.NewSortedEntry(`{"jid":"a","class":"Mailer"}`, 3),
+		sidekiq.NewSortedEntry(`{"jid":"b","class":"Report"}`, 2),
+		sidekiq.NewSortedEntry(`{"jid":"c","class":"Mailer"}`, 1),
+	}}
+
+	before := time.Now()
+	result, err := fetchSortedWindow(context.Background(), sortedWindowConfig{
+		client:      client,
+		kind:        sidekiq.SortedSetDead,
+		filter:      "Mailer",
+		timeRange:   timeRange,
+		windowStart: 0,
+		windowSize:  10,
+	})
+	if err != nil {
+		t.Fatalf("fetchSortedWindow failed: %v", err)
+	}
+	if !client.to.IsZero() || client.from.Before(before.Add(-time.Hour)) || client.from.After(time.Now().Add(-time.Hour)) {
+		t.Fatalf("range = [%v, %v), want the last hour", client.from, client.to)
+	}
+	if result.total != 2 || len(result.jobs) != 2 || result.jobs[0].JID() != "a" || result.jobs[1].JID() != "c" {
+		t.Fatalf("result = %d jobs of %d, want a and c", len(result.jobs), result.total)
+	}
+	if result.firstEntry.JID() != "c" || result.lastEntry.JID() != "a" {
+		t.Fatalf("bounds = %s..%s, want c..a", result.firstEntry.JID(), result.lastEntry.JID())
+	}
+}
+
+func TestFetchSortedWindowTimeRangeFallsBackToScan(t *testing.T) {
+	now := time.Now()
+	timeRange, err := parseSortedTimeRange("2h..1h")
+	if err != nil {
+		t.Fatalf("parseSortedTimeRange failed: %v", err)
+	}
+	score := func(ago time.Duration) float64 {
+		return float64(now.Add(-ago).UnixNano()) / float64(time.Second)
+	}
+	client := fakeSortedEntriesClient{
+		scanSortedEntries: func(context.Context, sidekiq.SortedSetKind, string) ([]*sidekiq.SortedEntry, error) {
+			return []*sidekiq.SortedEntry{
+				sidekiq.NewSortedEntry(`{"jid":"new"}`, score(30*time.Minute)),
+				sidekiq.NewSortedEntry(`{"jid":"in"}`, score(90*time.Minute)),
+				sidekiq.NewSortedEntry(`{"jid":"old"}`, score(3*time.Hour)),
+			}, nil
+		},
+	}
+
+	result, err := fetchSortedWindow(context.Background(), sortedWindowConfig{
+		client:     client,
+		kind:       sidekiq.SortedSetDead,
+		timeRange:  timeRange,
+		windowSize: 10,
+	})
+	if err != nil {
+		t.Fatalf("fetchSortedWindow failed: %v", err)
+	}
+	if len(result.jobs) != 1 || result.jobs[0].JID() != "in" {
+		t.Fatalf("jobs = %v, want only the job in range", result.jobs)
+	}
+}
+
+func TestParseSortedTimeRange(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		value    string
+		from, to time.Duration // offsets before now; 0 is an open end
+		wantErr  bool
+	}{
+		{value: "1h", from: time.Hour},
+		{value: "3h..1h", from: 3 * time.Hour, to: time.Hour},
+		{value: "..1d", to: 24 * time.Hour},
+		{value: "..+1h", to: -time.Hour},
+		{value: "1h..3h", wantErr: true},
+		{value: "..", wantErr: true},
+		{value: "yesterday", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := parseSortedTimeRange(tt.value)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("parseSortedTimeRange(%q) succeeded, want error", tt.value)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("parseSortedTimeRange(%q) failed: %v", tt.value, err)
+		}
+		from, to := got.bounds(now)
+		if want := offsetTime(now, tt.from); !from.Equal(want) {
+			t.Errorf("parseSortedTimeRange(%q) from = %v, want %v", tt.value, from, want)
+		}
+		if want := offsetTime(now, tt.to); !to.Equal(want) {
+			t.Errorf("parseSortedTimeRange(%q) to = %v, want %v", tt.value, to, want)
+		}
+	}
+
+	got, err := parseSortedTimeRange("2026-01-02 15:04..2026-01-02 16:00")
+	if err != nil {
+		t.Fatalf("absolute range failed: %v", err)
+	}
+	if from, _ := got.bounds(now); !from.Equal(time.Date(2026, 1, 2, 15, 4, 0, 0, time.Local)) {
+		t.Fatalf("absolute from = %v", from)
+	}
+	if got, err := parseSortedTimeRange(" "); err != nil || got.active() {
+		t.Fatalf("empty range = %+v, %v, want inactive", got, err)
+	}
+}
+
+func offsetTime(now time.Time, ago time.Duration) time.Time {
+	if ago == 0 {
+		return time.Time{}
+	}
+	return now.Add(-ago)
+}
+
+func TestMatchesSortedFilter(t *testing.T) {
+	value := `{"class":"Mailer","queue":"default"}`
+	tests := map[string]bool{
+		"Mailer":           true,
+		"mailer":           false,
+		`{"class":*`:       true,
+		`*"default"}`:      true,
+		`*Mailer*default*`: true,
+		`*default*Mailer*`: false,
+		"Mailer*":          false,
+		"*":                true,
+	}
+	for filter, want := range tests {
+		if got := matchesSortedFilter(value, filter); got != want {
+			t.Errorf("matchesSortedFilter(%q) = %v, want %v", filter, got, want)
+		}
+	}
+}
+
+func TestSortedJobSiblingFetcher(t *testing.T) {
+	entries := []*sidekiq.SortedEntry{
+		sidekiq.NewSortedEntry(`{"jid":"a","class":"MailJob"}`, 1),
+		sidekiq.NewSortedEntry(`{"jid":"b","class":"ReportJob"}`, 2),
+		sidekiq.NewSortedEntry(`{"jid":"c","class":"MailJob"}`, 3),
+	}
+	client := fakeSortedEntriesClient{
+		scanSortedEntries: func(_ context.Context, _ sidekiq.SortedSetKind, query string) ([]*sidekiq.SortedEntry, error) {
+			var matched []*sidekiq.SortedEntry
+			for _, entry := range entries {
+				if matchesSortedFilter(entry.Value(), query) {
+					matched = append(matched, entry)
+				}
+			}
+			return matched, nil
+		},
+	}
+	fetch := sortedJobSiblingFetcher(client, sidekiq.SortedSetDead, sidekiq.SortDefault, "MailJob", sortedTimeRange{})
+
+	sibling, err := fetch(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("fetch failed: %v", err)
+	}
+	if sibling.Job.JID() != "c" || sibling.Index != 1 || sibling.Total != 2 {
+		t.Fatalf("sibling = %s at %d of %d, want c at 1 of 2", sibling.Job.JID(), sibling.Index, sibling.Total)
+	}
+	if sibling.Source.Key != sidekiq.SortedSetDead.Key() {
+		t.Fatalf("source key = %q, want %q", sibling.Source.Key, sidekiq.SortedSetDead.Key())
+	}
+
+	// The list shrank since the detail was opened: the last job is returned.
+	sibling, err = fetch(context.Background(), 5)
+	if err != nil {
+		t.Fatalf("fetch failed: %v", err)
+	}
+	if sibling.Job.JID() != "c" || sibling.Index != 1 {
+		t.Fatalf("sibling = %s at %d, want c at 1", sibling.Job.JID(), sibling.Index)
+	}
+}
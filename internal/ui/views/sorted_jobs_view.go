@@ -1,8 +1,11 @@
 package views
 
 import (
+	"context"
+
 	tea "charm.land/bubbletea/v2"
 
+	"github.com/kpumuk/lazykiq/internal/devtools"
 	"github.com/kpumuk/lazykiq/internal/sidekiq"
 	"github.com/kpumuk/lazykiq/internal/ui/components/lazytable"
 	"github.com/kpumuk/lazykiq/internal/ui/components/table"
@@ -10,9 +13,12 @@ import (
 
 type sortedJobsView struct {
 	detailListView
-	jobs       []*sidekiq.SortedEntry
-	firstEntry *sidekiq.SortedEntry
-	lastEntry  *sidekiq.SortedEntry
+	jobs         []*sidekiq.SortedEntry
+	firstEntry   *sidekiq.SortedEntry
+	lastEntry    *sidekiq.SortedEntry
+	timeRange    sortedTimeRange
+	order        sidekiq.SortOrder
+	largePayload int64
 }
 
 func newSortedJobsView(
@@ -30,6 +36,7 @@ func newSortedJobsView(
 			windowPages,
 			fallbackPageSize,
 		),
+		largePayload: DefaultLargePayload,
 	}
 }
 
@@ -37,8 +44,7 @@ func (v *sortedJobsView) handleSortedEntriesData(msg lazytable.DataMsg) (bool, t
 	return v.handleData(msg, func(result lazytable.FetchResult) {
 		if payload, ok := result.Payload.(sortedEntriesPayload); ok {
 			v.jobs = payload.jobs
-			v.firstEntry = payload.firstEntry
-			v.lastEntry = payload.lastEntry
+			v.firstEntry, v.lastEntry = sortedEntryBounds(nonNilSortedEntries(payload.firstEntry, payload.lastEntry))
 		}
 	})
 }
@@ -59,6 +65,62 @@ func (v *sortedJobsView) selectedSortedEntry() (*sidekiq.SortedEntry, bool) {
 	return v.jobs[idx], true
 }
 
+// showJobDetailCmd opens the selected job. Job details can step through this
+// listing with [ and ], filtered and ordered as it is now.
+func (v *sortedJobsView) showJobDetailCmd(client sidekiq.API, kind sidekiq.SortedSetKind) tea.Cmd {
+	idx := v.lazy.Table().Cursor()
+	if idx < 0 || idx >= len(v.jobs) {
+		return nil
+	}
+	entry := v.jobs[idx]
+	siblings := JobSiblings{
+		Index: v.lazy.WindowStart() + idx,
+		Total: int(v.lazy.Total()),
+	}
+	if client != nil {
+		siblings.Fetch = sortedJobSiblingFetcher(client, kind, v.order, v.filter, v.timeRange)
+	}
+	return func() tea.Msg {
+		return ShowJobDetailMsg{
+			Job:      entry.JobRecord,
+			Source:   SortedJobSource(kind, entry),
+			Siblings: siblings,
+		}
+	}
+}
+
+// sortedJobSiblingFetcher loads single jobs of a sorted set listing by their
+// position.
+func sortedJobSiblingFetcher(
+	client sortedEntriesClient,
+	kind sidekiq.SortedSetKind,
+	order sidekiq.SortOrder,
+	filter string,
+	timeRange sortedTimeRange,
+) JobSiblingFetcher {
+	return func(ctx context.Context, index int) (JobSibling, error) {
+		result, err := fetchSortedWindow(devtools.WithTracker(ctx, "sorted_jobs.fetchSibling"), sortedWindowConfig{
+			client:      client,
+			kind:        kind,
+			order:       order,
+			filter:      filter,
+			timeRange:   timeRange,
+			windowStart: index,
+			windowSize:  1,
+		})
+		if err != nil {
+			return JobSibling{}, err
+		}
+		sibling := JobSibling{Index: result.windowStart, Total: int(result.total)}
+		if len(result.jobs) > 0 {
+			entry := result.jobs[0]
+			sibling.Job = entry.JobRecord
+			sibling.Source = SortedJobSource(kind, entry)
+		}
+		return sibling, nil
+	}
+}
+
 func (v sortedJobsView) renderSortedJobsBox(title string) string {
 	return v.renderBox(title, len(v.jobs))
 }
@@ -69,3 +131,63 @@ func (v sortedJobsView) jobName(entry *sidekiq.SortedEntry) string {
 	}
 	return "selected"
 }
+
+// toggleOrder switches the listing between earliest and latest first and
+// reloads from the start.
+func (v *sortedJobsView) toggleOrder(kind sidekiq.SortedSetKind) tea.Cmd {
+	if v.resolvedOrder(kind) == sidekiq.SortDescending {
+		v.order = sidekiq.SortAscending
+	} else {
+		v.order = sidekiq.SortDescending
+	}
+	return v.reloadFromStart()
+}
+
+func (v sortedJobsView) resolvedOrder(kind sidekiq.SortedSetKind) sidekiq.SortOrder {
+	if v.order == sidekiq.SortDefault {
+		return kind.DefaultOrder()
+	}
+	return v.order
+}
+
+// orderValue describes the listing order for the context bar.
+func (v sortedJobsView) orderValue(kind sidekiq.SortedSetKind) string {
+	if v.resolvedOrder(kind) == sidekiq.SortDescending {
+		return "latest first"
+	}
+	return "earliest first"
+}
+
+// nonNilSortedEntries drops missing bounds so window edges can be reordered
+// by score regardless of the listing order.
+func nonNilSortedEntries(entries ...*sidekiq.SortedEntry) []*sidekiq.SortedEntry {
+	result := entries[:0]
+	for _, entry := range entries {
+		if entry != nil {
+			result = append(result, entry)
+		}
+	}
+	return result
+}
+
+// deleteSortedEntryAction is the call that deletes entry, for previews.
+func deleteSortedEntryAction(kind sidekiq.SortedSetKind, entry *sidekiq.SortedEntry) func(context.Context, sidekiq.API) error {
+	return func(ctx context.Context, api sidekiq.API) error {
+		return api.DeleteSortedEntry(ctx, kind, entry)
+	}
+}
+
+// enqueueSortedEntryAction is the call that enqueues entry now, ahead of the
+// jobs already waiting when front is set, for previews.
+func enqueueSortedEntryAction(
+	kind sidekiq.SortedSetKind,
+	entry *sidekiq.SortedEntry,
+	front bool,
+) func(context.Context, sidekiq.API) error {
+	return func(ctx context.Context, api sidekiq.API) error {
+		if front {
+			return api.EnqueueSortedEntryToFront(ctx, kind, entry)
+		}
+		return api.EnqueueSortedEntry(ctx, kind, entry)
+	}
+}
@@ -0,0 +1,136 @@
+package views
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/kpumuk/lazykiq/internal/ui/dialogs"
+	"github.com/kpumuk/lazykiq/internal/ui/display"
+)
+
+// timeRangeLayouts are the absolute times a time range bound accepts, in
+// local time.
+var timeRangeLayouts = []string{
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// timeBound is one end of a time range: either a fixed time or an offset
+// from now, resolved on every fetch so "1h" keeps meaning the last hour.
+// Positive offsets are in the past.
+type timeBound struct {
+	at  time.Time
+	ago time.Duration
+	set bool
+}
+
+func (b timeBound) resolve(now time.Time) time.Time {
+	switch {
+	case !b.set:
+		return time.Time{}
+	case !b.at.IsZero():
+		return b.at
+	default:
+		return now.Add(-b.ago)
+	}
+}
+
+// sortedTimeRange limits sorted-set views to jobs scored in [from, to).
+type sortedTimeRange struct {
+	text string
+	from timeBound
+	to   timeBound
+}
+
+func (r sortedTimeRange) active() bool {
+	return r.from.set || r.to.set
+}
+
+// bounds returns the range as times; zero times are open ends.
+func (r sortedTimeRange) bounds(now time.Time) (time.Time, time.Time) {
+	return r.from.resolve(now), r.to.resolve(now)
+}
+
+// parseSortedTimeRange parses "1h" (the last hour), "3h..1h" (between three
+// and one hour ago), "..1d" (older than a day), "..+1h" (up to an hour from
+// now), or absolute local times such as "2026-01-02 15:04..2026-01-02 16:00".
+// An empty value clears the range.
+func parseSortedTimeRange(value string) (sortedTimeRange, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return sortedTimeRange{}, nil
+	}
+
+	fromText, toText, _ := strings.Cut(value, "..")
+	from, err := parseTimeBound(fromText)
+	if err != nil {
+		return sortedTimeRange{}, err
+	}
+	to, err := parseTimeBound(toText)
+	if err != nil {
+		return sortedTimeRange{}, err
+	}
+	if !from.set && !to.set {
+		return sortedTimeRange{}, errors.New("enter a range")
+	}
+	now := time.Now()
+	if from.set && to.set && !from.resolve(now).Before(to.resolve(now)) {
+		return sortedTimeRange{}, errors.New("start must be before end")
+	}
+	return sortedTimeRange{text: value, from: from, to: to}, nil
+}
+
+func parseTimeBound(value string) (timeBound, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return timeBound{}, nil
+	}
+	offset, future := strings.CutPrefix(value, "+")
+	if ago, err := display.ParseDuration(offset); err == nil {
+		if ago < 0 {
+			return timeBound{}, errors.New("use +1h for times ahead of now")
+		}
+		if future {
+			ago = -ago
+		}
+		return timeBound{ago: ago, set: true}, nil
+	}
+	for _, layout := range timeRangeLayouts {
+		if at, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return timeBound{at: at, set: true}, nil
+		}
+	}
+	return timeBound{}, errors.New("use a duration like 1h or +1h, or a time like 2006-01-02 15:04")
+}
+
+func (v sortedJobsView) openTimeRangePrompt(title, target string) tea.Cmd {
+	return func() tea.Msg {
+		return dialogs.OpenDialogMsg{
+			Model: newPromptDialog(
+				v.styles,
+				title,
+				v.timeRange.text,
+				target,
+				func(value string) error {
+					_, err := parseSortedTimeRange(value)
+					return err
+				},
+			),
+		}
+	}
+}
+
+// setTimeRange applies a submitted time range and reloads from the start.
+func (v *sortedJobsView) setTimeRange(value string, updateEmptyMessage func()) tea.Cmd {
+	timeRange, err := parseSortedTimeRange(value)
+	if err != nil || timeRange.text == v.timeRange.text {
+		return nil
+	}
+	v.timeRange = timeRange
+	updateEmptyMessage()
+	return v.reloadFromStart()
+}
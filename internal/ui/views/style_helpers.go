@@ -30,6 +30,7 @@ func filterDialogStylesFromTheme(styles Styles) filterdialog.Styles {
 		Text:        styles.Text,
 		Placeholder: styles.Muted,
 		Cursor:      styles.Text,
+		Invalid:     styles.ErrorText,
 	}
 }
 
@@ -0,0 +1,19 @@
+package views
+
+import (
+	textdialog "github.com/kpumuk/lazykiq/internal/ui/dialogs/text"
+)
+
+func newTextDialog(styles Styles, title, note, text string) *textdialog.Model {
+	return textdialog.New(
+		textdialog.WithStyles(textdialog.Styles{
+			Title:  styles.Title,
+			Border: styles.FocusBorder,
+			Text:   styles.Text,
+			Muted:  styles.Muted,
+		}),
+		textdialog.WithTitle(title),
+		textdialog.WithNote(note),
+		textdialog.WithText(text),
+	)
+}
@@ -0,0 +1,49 @@
+package views
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// traceURLPlaceholder is the field a trace URL template must reference.
+const traceURLPlaceholder = "{trace_id}"
+
+// TraceURL is a URL template such as
+// "https://tempo.example.com/trace/{trace_id}" that the job detail view
+// fills in with the job's trace id.
+type TraceURL string
+
+// TraceURLSetter is implemented by views that link jobs to their traces.
+type TraceURLSetter interface {
+	SetTraceURL(template TraceURL)
+}
+
+// ParseTraceURL validates template, which must reference {trace_id} and no
+// other placeholder. An empty template disables trace links.
+func ParseTraceURL(template string) (TraceURL, error) {
+	template = strings.TrimSpace(template)
+	if template == "" {
+		return "", nil
+	}
+	if !strings.Contains(template, traceURLPlaceholder) {
+		return "", fmt.Errorf("missing %s in %q", traceURLPlaceholder, template)
+	}
+	rest := strings.ReplaceAll(template, traceURLPlaceholder, "")
+	if start := strings.Index(rest, "{"); start >= 0 {
+		end := strings.Index(rest[start:], "}")
+		if end < 0 {
+			return "", fmt.Errorf("unterminated placeholder in %q", template)
+		}
+		return "", fmt.Errorf("unknown placeholder %s in %q (want %s)", rest[start:start+end+1], template, traceURLPlaceholder)
+	}
+	return TraceURL(template), nil
+}
+
+// Render fills in the template, or returns "" without a template or trace id.
+func (u TraceURL) Render(traceID string) string {
+	if u == "" || traceID == "" {
+		return ""
+	}
+	return strings.ReplaceAll(string(u), traceURLPlaceholder, url.PathEscape(traceID))
+}
@@ -0,0 +1,40 @@
+package views
+
+import "testing"
+
+func TestParseTraceURL(t *testing.T) {
+	tests := []struct {
+		template string
+		want     TraceURL
+		wantErr  bool
+	}{
+		{template: "", want: ""},
+		{template: " https://tempo.example.com/trace/{trace_id} ", want: "https://tempo.example.com/trace/{trace_id}"},
+		{template: "https://jaeger.example.com/search", wantErr: true},
+		{template: "https://example.com/{trace_id}?span={span_id}", wantErr: true},
+		{template: "https://example.com/{trace_id}/{span", wantErr: true},
+	}
+
+	for _, tc := range tests {
+		got, err := ParseTraceURL(tc.template)
+		if (err != nil) != tc.wantErr {
+			t.Fatalf("ParseTraceURL(%q) error = %v, wantErr %v", tc.template, err, tc.wantErr)
+		}
+		if got != tc.want {
+			t.Fatalf("ParseTraceURL(%q) = %q, want %q", tc.template, got, tc.want)
+		}
+	}
+}
+
+func TestTraceURLRender(t *testing.T) {
+	template := TraceURL("https://tempo.example.com/trace/{trace_id}?from={trace_id}")
+	if got, want := template.Render("4bf92f35"), "https://tempo.example.com/trace/4bf92f35?from=4bf92f35"; got != want {
+		t.Fatalf("Render() = %q, want %q", got, want)
+	}
+	if got := template.Render(""); got != "" {
+		t.Fatalf("Render(\"\") = %q, want empty", got)
+	}
+	if got := TraceURL("").Render("4bf92f35"); got != "" {
+		t.Fatalf("empty template rendered %q", got)
+	}
+}
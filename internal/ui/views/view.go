@@ -2,6 +2,8 @@
 package views
 
 import (
+	"context"
+
 	"charm.land/bubbles/v2/key"
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
@@ -42,12 +44,22 @@ type Styles struct {
 	FilterBlurred   lipgloss.Style
 	DangerAction    lipgloss.Style
 	NeutralAction   lipgloss.Style
+	ErrorText       lipgloss.Style
+	WarningText     lipgloss.Style
 }
 
-// RefreshMsg is broadcast by the app on the 5-second ticker.
+// RefreshMsg is sent by the app to the focused view on the 5-second ticker.
 // Views should respond by fetching their data.
 type RefreshMsg struct{}
 
+// RefreshViewMsg is sent to the focused view when the user asks to refresh
+// it (r). Views should fetch their data right away, bypassing any throttling.
+type RefreshViewMsg struct{}
+
+// RefreshAllMsg asks the app to refresh the stats bar, the focused view, and
+// every view stacked under it once it regains focus (R).
+type RefreshAllMsg struct{}
+
 // ConnectionErrorMsg indicates a Redis connection error occurred.
 // Views emit this when data fetching fails.
 type ConnectionErrorMsg struct {
@@ -104,6 +116,18 @@ type DangerousActionsToggle interface {
 	SetDangerousActionsEnabled(enabled bool)
 }
 
+// StrictConfirmSetter is implemented by views whose most destructive actions
+// can require typing a name to confirm instead of answering y/n.
+type StrictConfirmSetter interface {
+	SetStrictConfirm(strict bool)
+}
+
+// EnqueueAllowedSetter is implemented by views that can enqueue copies of a
+// job, which is allowed separately from other dangerous actions.
+type EnqueueAllowedSetter interface {
+	SetEnqueueAllowed(allowed bool)
+}
+
 // HelpSection groups help bindings under a title.
 type HelpSection struct {
 	Title    string
@@ -141,7 +165,29 @@ type TableHelpProvider interface {
 
 // ShowJobDetailMsg requests a stacked job detail view.
 type ShowJobDetailMsg struct {
-	Job *sidekiq.JobRecord
+	Job      *sidekiq.JobRecord
+	Source   JobSource
+	Siblings JobSiblings
+}
+
+// JobSibling is a job loaded from the list a job detail was opened from.
+type JobSibling struct {
+	Job    *sidekiq.JobRecord
+	Source JobSource
+	Index  int // Position in the list, which may differ from the one asked for when the list shrank
+	Total  int
+}
+
+// JobSiblingFetcher loads the job at index in the list a job detail was
+// opened from. A nil Job means the list is empty now.
+type JobSiblingFetcher func(ctx context.Context, index int) (JobSibling, error)
+
+// JobSiblings lets the job detail view step to the previous or next job in
+// the list it was opened from. A nil Fetch disables stepping.
+type JobSiblings struct {
+	Index int
+	Total int
+	Fetch JobSiblingFetcher
 }
 
 // ShowErrorDetailsMsg requests a stacked error details view.
@@ -156,6 +202,37 @@ type ShowJobMetricsMsg struct {
 	Period string
 }
 
+// ShowBatchMsg requests a stacked batch view.
+type ShowBatchMsg struct {
+	BID string
+}
+
+// ShowProcessWeightsMsg requests a stacked capsule weights view.
+type ShowProcessWeightsMsg struct {
+	Process sidekiq.Process
+}
+
+// ShowJobDiffMsg requests a stacked diff between two jobs.
+type ShowJobDiffMsg struct {
+	A *sidekiq.JobRecord
+	B *sidekiq.JobRecord
+}
+
+// ShowDeadReasonsMsg requests the dead reasons chart.
+type ShowDeadReasonsMsg struct{}
+
+// ShowDuplicatesMsg requests the duplicate JIDs view.
+type ShowDuplicatesMsg struct{}
+
+// ShowJobWatchMsg requests the job watch for a job that was just enqueued.
+type ShowJobWatchMsg struct {
+	Job  *sidekiq.JobRecord
+	From sidekiq.SortedSetKind
+}
+
+// ShowRateLimitersMsg requests the rate limiters view.
+type ShowRateLimitersMsg struct{}
+
 // ShowQueuesListMsg requests the queues list view.
 type ShowQueuesListMsg struct{}
 
@@ -175,6 +252,19 @@ type ShowProcessSelectMsg struct {
 // JobDetailSetter allows setting job data on a job detail view.
 type JobDetailSetter interface {
 	SetJob(job *sidekiq.JobRecord)
+	SetJobSource(source JobSource)
+	SetJobSiblings(siblings JobSiblings)
+}
+
+// ArgsDepthSetter is implemented by views that expand job arguments as a tree.
+type ArgsDepthSetter interface {
+	SetArgsDepth(depth int)
+}
+
+// PagingSetter is implemented by lazily loaded views whose prefetch window
+// can be tuned.
+type PagingSetter interface {
+	SetPaging(pageSize, windowPages int)
 }
 
 // ErrorDetailsSetter allows setting error group data on an error details view.
@@ -187,21 +277,70 @@ type JobMetricsSetter interface {
 	SetJobMetrics(jobName, period string)
 }
 
+// BatchSetter allows setting the batch ID on a batch view.
+type BatchSetter interface {
+	SetBatch(bid string)
+}
+
+// JobDiffSetter allows setting the compared jobs on a job diff view.
+type JobDiffSetter interface {
+	SetJobs(a, b *sidekiq.JobRecord)
+}
+
+// ProcessWeightsSetter allows setting the process on a capsule weights view.
+type ProcessWeightsSetter interface {
+	SetProcess(process sidekiq.Process)
+}
+
 // QueueDetailsSetter allows setting queue name on a queue details view.
 type QueueDetailsSetter interface {
 	SetQueue(queueName string)
 }
 
+// HideEmptyQueuesSetter is implemented by views that can leave empty queues
+// out of their queue list.
+type HideEmptyQueuesSetter interface {
+	SetHideEmptyQueues(hide bool)
+}
+
 // ProcessSelector allows selecting a process in the busy view.
 type ProcessSelector interface {
 	SetProcessIdentity(identity string)
 }
 
+// InputFocuser reports when a view is capturing raw key input, such as a
+// row number entry, so the app routes keys to it before global shortcuts.
+type InputFocuser interface {
+	InputFocused() bool
+}
+
 // Disposable allows views to clean up when removed from the stack.
 type Disposable interface {
 	Dispose()
 }
 
+// ViewState captures view settings persisted across restarts.
+type ViewState struct {
+	Filter string `json:"filter,omitempty"`
+	Queue  string `json:"queue,omitempty"`
+	Period string `json:"period,omitempty"`
+	// Favorites lists queues pinned to the top of the queue list.
+	Favorites []string `json:"favorites,omitempty"`
+	// HideEmptyQueues leaves empty queues out of the queue list.
+	HideEmptyQueues bool `json:"hide_empty_queues,omitempty"`
+}
+
+// IsZero reports whether the state holds nothing worth persisting.
+func (s ViewState) IsZero() bool {
+	return s.Filter == "" && s.Queue == "" && s.Period == "" && len(s.Favorites) == 0 && !s.HideEmptyQueues
+}
+
+// StatePersister allows views to save and restore state across restarts.
+type StatePersister interface {
+	SaveState() ViewState
+	RestoreState(state ViewState)
+}
+
 // RequestCanceler allows views to cancel in-flight requests when hidden.
 type RequestCanceler interface {
 	CancelRequests()